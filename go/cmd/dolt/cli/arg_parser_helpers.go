@@ -102,8 +102,9 @@ func CreateRebaseArgParser() *argparser.ArgParser {
 		return errors.New("rebase takes at most one positional argument.")
 	}
 	ap.SupportsFlag(AbortParam, "", "Abort an interactive rebase and return the working set to the pre-rebase state")
-	ap.SupportsFlag(ContinueFlag, "", "Continue an interactive rebase after adjusting the rebase plan")
+	ap.SupportsFlag(ContinueFlag, "", "Continue an interactive rebase after adjusting the rebase plan, or after it stopped at an edit or exec step")
 	ap.SupportsFlag(InteractiveFlag, "i", "Start an interactive rebase")
+	ap.SupportsString(OntoFlag, "", "newbase", "Replay the commits onto {{.LessThan}}newbase{{.GreaterThan}} instead of onto the upstream branch")
 	return ap
 }
 
@@ -170,11 +171,12 @@ func CreateCheckoutArgParser() *argparser.ArgParser {
 }
 
 func CreateCherryPickArgParser() *argparser.ArgParser {
-	ap := argparser.NewArgParserWithMaxArgs("cherrypick", 1)
-	ap.SupportsFlag(AbortParam, "", "Abort the current conflict resolution process, and revert all changes from the in-process cherry-pick operation.")
-	ap.TooManyArgsErrorFunc = func(receivedArgs []string) error {
-		return errors.New("cherry-picking multiple commits is not supported yet.")
-	}
+	ap := argparser.NewArgParserWithVariableArgs("cherrypick")
+	ap.SupportsFlag(AbortParam, "", "Abort the current conflict resolution process, and revert all changes from the in-process cherry-pick operation. Commits that were already cherry-picked are kept.")
+	ap.SupportsFlag(ContinueFlag, "", "Commit the staged resolution of the current conflicts, and continue cherry-picking the remaining commits.")
+	ap.SupportsFlag(SkipFlag, "", "Discard the changes of the commit whose conflicts are being resolved, and continue cherry-picking the remaining commits.")
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"commit",
+		"The commits to cherry-pick. A range {{.LessThan}}from{{.GreaterThan}}..{{.LessThan}}to{{.GreaterThan}} cherry-picks the commits reachable from {{.LessThan}}to{{.GreaterThan}} but not from {{.LessThan}}from{{.GreaterThan}}, oldest first. Commits are applied in the order given."})
 	return ap
 }
 
@@ -252,6 +254,7 @@ func CreateBackupArgParser() *argparser.ArgParser {
 	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"profile", "AWS profile to use."})
 	ap.SupportsFlag(VerboseFlag, "v", "When printing the list of backups adds additional details.")
 	ap.SupportsFlag(ForceFlag, "f", "When restoring a backup, overwrite the contents of the existing database with the same name.")
+	ap.SupportsString(AtFlag, "", "timestamp", "When restoring a backup, restore the newest restore point recorded at or before {{.LessThan}}timestamp{{.GreaterThan}} by a scheduled backup.")
 	ap.SupportsString(dbfactory.AWSRegionParam, "", "region", "")
 	ap.SupportsValidatedString(dbfactory.AWSCredsTypeParam, "", "creds-type", "", argparser.ValidatorFromStrList(dbfactory.AWSCredsTypeParam, dbfactory.AWSCredTypes))
 	ap.SupportsString(dbfactory.AWSCredsFileParam, "", "file", "AWS credentials file")
@@ -275,6 +278,11 @@ func CreateLogArgParser(isTableFunction bool) *argparser.ArgParser {
 	ap.SupportsFlag(ParentsFlag, "", "Shows all parents of each commit in the log.")
 	ap.SupportsString(DecorateFlag, "", "decorate_fmt", "Shows refs next to commits. Valid options are short, full, no, and auto")
 	ap.SupportsStringList(NotFlag, "", "revision", "Excludes commits from revision.")
+	ap.SupportsString(AuthorParam, "", "pattern", "Limits the log to commits whose author, formatted as {{.LessThan}}name{{.GreaterThan}} {{.LessThan}}{{.LessThan}}email{{.GreaterThan}}{{.GreaterThan}}, matches the regular expression {{.LessThan}}pattern{{.GreaterThan}}.")
+	ap.SupportsString(SinceFlag, "", "date", "Limits the log to commits made on or after {{.LessThan}}date{{.GreaterThan}}, formatted as YYYY-MM-DD or YYYY-MM-DDThh:mm:ss.")
+	ap.SupportsString(UntilFlag, "", "date", "Limits the log to commits made on or before {{.LessThan}}date{{.GreaterThan}}, formatted as YYYY-MM-DD or YYYY-MM-DDThh:mm:ss. A date without a time includes the whole day.")
+	ap.SupportsString(GrepFlag, "", "pattern", "Limits the log to commits whose message matches the regular expression {{.LessThan}}pattern{{.GreaterThan}}.")
+	ap.SupportsString(WhereFlag, "", "table.column = value", "Limits the log to commits that added, removed or modified a row of {{.LessThan}}table{{.GreaterThan}} in which {{.LessThan}}column{{.GreaterThan}} equals {{.LessThan}}value{{.GreaterThan}}, compared to the commit's first parent.")
 	if isTableFunction {
 		ap.SupportsStringList(TablesFlag, "t", "table", "Restricts the log to commits that modified the specified tables.")
 	} else {
@@ -21,6 +21,7 @@ const (
 	AllFlag              = "all"
 	AllowEmptyFlag       = "allow-empty"
 	AmendFlag            = "amend"
+	AtFlag               = "at"
 	AuthorParam          = "author"
 	BranchParam          = "branch"
 	CachedFlag           = "cached"
@@ -37,6 +38,7 @@ const (
 	DryRunFlag           = "dry-run"
 	ForceFlag            = "force"
 	GraphFlag            = "graph"
+	GrepFlag             = "grep"
 	HardResetParam       = "hard"
 	HostFlag             = "host"
 	InteractiveFlag      = "interactive"
@@ -54,6 +56,7 @@ const (
 	NotFlag              = "not"
 	NumberFlag           = "number"
 	OneLineFlag          = "oneline"
+	OntoFlag             = "onto"
 	OursFlag             = "ours"
 	OutputOnlyFlag       = "output-only"
 	ParentsFlag          = "parents"
@@ -65,8 +68,10 @@ const (
 	ShallowFlag          = "shallow"
 	ShowIgnoredFlag      = "ignored"
 	SilentFlag           = "silent"
+	SinceFlag            = "since"
 	SingleBranchFlag     = "single-branch"
 	SkipEmptyFlag        = "skip-empty"
+	SkipFlag             = "skip"
 	SoftResetParam       = "soft"
 	SquashParam          = "squash"
 	StatFlag             = "stat"
@@ -74,6 +79,8 @@ const (
 	TablesFlag           = "tables"
 	TheirsFlag           = "theirs"
 	TrackFlag            = "track"
+	UntilFlag            = "until"
 	UpperCaseAllFlag     = "ALL"
 	UserFlag             = "user"
+	WhereFlag            = "where"
 )
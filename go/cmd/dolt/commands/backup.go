@@ -19,12 +19,15 @@ import (
 	"encoding/json"
 	"strings"
 
+	"github.com/dolthub/dolt/go/store/hash"
 	"github.com/dolthub/dolt/go/store/types"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
 	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
 	eventsapi "github.com/dolthub/dolt/go/gen/proto/dolt/services/eventsapi/v1alpha1"
+	restorepoints "github.com/dolthub/dolt/go/libraries/doltcore/backup"
 	"github.com/dolthub/dolt/go/libraries/doltcore/dbfactory"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
 	"github.com/dolthub/dolt/go/libraries/utils/argparser"
@@ -59,6 +62,8 @@ Remove the backup named {{.LessThan}}name{{.GreaterThan}}. All configuration set
 {{.EmphasisLeft}}restore{{.EmphasisRight}}
 Restore a Dolt database from a given {{.LessThan}}url{{.GreaterThan}} into a specified directory {{.LessThan}}name{{.GreaterThan}}. This will fail if {{.LessThan}}name{{.GreaterThan}} is already a Dolt database unless '--force' is provided, in which case the existing database will be overwritten with the contents of the restored backup.
 
+Backups which are synced on a schedule by {{.EmphasisLeft}}dolt sql-server{{.EmphasisRight}} keep a number of timestamped restore points. With '--at', the backup is restored as of the newest restore point recorded at or before {{.LessThan}}timestamp{{.GreaterThan}}, such as {{.EmphasisLeft}}2024-03-13T10:00:00Z{{.EmphasisRight}} or {{.EmphasisLeft}}'2024-03-13 10:00:00'{{.EmphasisRight}}. Timestamps without a time zone are in UTC.
+
 {{.EmphasisLeft}}sync{{.EmphasisRight}}
 Snapshot the database and upload to the backup {{.LessThan}}name{{.GreaterThan}}. This includes branches, tags, working sets, and remote tracking refs.
 
@@ -70,7 +75,7 @@ Snapshot the database and upload the backup to {{.LessThan}}url{{.GreaterThan}}.
 		"[-v | --verbose]",
 		"add [--aws-region {{.LessThan}}region{{.GreaterThan}}] [--aws-creds-type {{.LessThan}}creds-type{{.GreaterThan}}] [--aws-creds-file {{.LessThan}}file{{.GreaterThan}}] [--aws-creds-profile {{.LessThan}}profile{{.GreaterThan}}] {{.LessThan}}name{{.GreaterThan}} {{.LessThan}}url{{.GreaterThan}}",
 		"remove {{.LessThan}}name{{.GreaterThan}}",
-		"restore [--force] {{.LessThan}}url{{.GreaterThan}} {{.LessThan}}name{{.GreaterThan}}",
+		"restore [--force] [--at {{.LessThan}}timestamp{{.GreaterThan}}] {{.LessThan}}url{{.GreaterThan}} {{.LessThan}}name{{.GreaterThan}}",
 		"sync {{.LessThan}}name{{.GreaterThan}}",
 		"sync-url [--aws-region {{.LessThan}}region{{.GreaterThan}}] [--aws-creds-type {{.LessThan}}creds-type{{.GreaterThan}}] [--aws-creds-file {{.LessThan}}file{{.GreaterThan}}] [--aws-creds-profile {{.LessThan}}profile{{.GreaterThan}}] {{.LessThan}}url{{.GreaterThan}}",
 	},
@@ -335,6 +340,22 @@ func restoreBackup(ctx context.Context, dEnv *env.DoltEnv, apr *argparser.ArgPar
 		return errhand.VerboseErrorFromError(err)
 	}
 
+	var restoreRoot hash.Hash
+	if at, ok := apr.GetValue(cli.AtFlag); ok {
+		t, err := restorepoints.ParseTime(at)
+		if err != nil {
+			return errhand.VerboseErrorFromError(err)
+		}
+		blobParams := make(map[string]interface{})
+		for k, v := range params {
+			blobParams[k] = v
+		}
+		restoreRoot, err = restorepoints.RootAt(ctx, remoteUrl, blobParams, t)
+		if err != nil {
+			return errhand.BuildDError("error: unable to find a restore point of the backup").AddCause(err).Build()
+		}
+	}
+
 	mrEnv, err := env.MultiEnvForDirectory(ctx, dEnv.Config.WriteableConfig(), dEnv.FS, dEnv.Version, dEnv)
 	if err != nil {
 		return errhand.BuildDError("error: Unable to list databases").AddCause(err).Build()
@@ -361,7 +382,7 @@ func restoreBackup(ctx context.Context, dEnv *env.DoltEnv, apr *argparser.ArgPar
 			return errhand.VerboseErrorFromError(err)
 		}
 
-		err = actions.SyncRoots(ctx, srcDb, existingDEnv.DoltDB, tmpDir, buildProgStarter(downloadLanguage), stopProgFuncs)
+		err = syncRootsFromBackup(ctx, srcDb, existingDEnv.DoltDB, restoreRoot, tmpDir)
 		if err != nil {
 			return errhand.VerboseErrorFromError(err)
 		}
@@ -384,7 +405,7 @@ func restoreBackup(ctx context.Context, dEnv *env.DoltEnv, apr *argparser.ArgPar
 		if err != nil {
 			return errhand.VerboseErrorFromError(err)
 		}
-		err = actions.SyncRoots(ctx, srcDb, clonedEnv.DoltDB, tmpDir, buildProgStarter(downloadLanguage), stopProgFuncs)
+		err = syncRootsFromBackup(ctx, srcDb, clonedEnv.DoltDB, restoreRoot, tmpDir)
 		if err != nil {
 			// If we're cloning into a directory that already exists do not erase it. Otherwise
 			// make best effort to delete the directory we created.
@@ -399,3 +420,12 @@ func restoreBackup(ctx context.Context, dEnv *env.DoltEnv, apr *argparser.ArgPar
 
 	return nil
 }
+
+// syncRootsFromBackup restores |destDb| from the backup |srcDb|, as of the restore point whose root is |root|, or as of
+// the latest sync of the backup if |root| is empty.
+func syncRootsFromBackup(ctx context.Context, srcDb, destDb *doltdb.DoltDB, root hash.Hash, tmpDir string) error {
+	if root.IsEmpty() {
+		return actions.SyncRoots(ctx, srcDb, destDb, tmpDir, buildProgStarter(downloadLanguage), stopProgFuncs)
+	}
+	return actions.SyncRootsAt(ctx, srcDb, destDb, root, tmpDir, buildProgStarter(downloadLanguage), stopProgFuncs)
+}
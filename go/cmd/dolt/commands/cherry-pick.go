@@ -34,22 +34,26 @@ import (
 )
 
 var cherryPickDocs = cli.CommandDocumentationContent{
-	ShortDesc: `Apply the changes introduced by an existing commit.`,
+	ShortDesc: `Apply the changes introduced by some existing commits.`,
 	LongDesc: `
-Applies the changes from an existing commit and creates a new commit from the current HEAD. This requires your working tree to be clean (no modifications from the HEAD commit).
+Applies the changes from some existing commits and creates a new commit from the current HEAD for each of them. This requires your working tree to be clean (no modifications from the HEAD commit).
+
+Commits are applied in the order given. A range {{.LessThan}}from{{.GreaterThan}}..{{.LessThan}}to{{.GreaterThan}} applies the commits reachable from {{.LessThan}}to{{.GreaterThan}} but not from {{.LessThan}}from{{.GreaterThan}}, oldest first. When more than one commit is cherry-picked, commits that would make no changes are skipped.
 
 Cherry-picking merge commits or commits with table drops/renames is not currently supported. 
 
-If any data conflicts, schema conflicts, or constraint violations are detected during cherry-picking, you can use Dolt's conflict resolution features to resolve them. For more information on resolving conflicts, see: https://docs.dolthub.com/concepts/dolt/git/conflicts.
+If any data conflicts, schema conflicts, or constraint violations are detected during cherry-picking, you can use Dolt's conflict resolution features to resolve them. For more information on resolving conflicts, see: https://docs.dolthub.com/concepts/dolt/git/conflicts. Once they are resolved and staged, {{.EmphasisLeft}}dolt cherry-pick --continue{{.EmphasisRight}} commits them and cherry-picks the remaining commits. {{.EmphasisLeft}}dolt cherry-pick --skip{{.EmphasisRight}} discards the conflicting commit instead, and {{.EmphasisLeft}}dolt cherry-pick --abort{{.EmphasisRight}} stops cherry-picking, keeping any commits that were already applied.
 `,
 	Synopsis: []string{
-		`{{.LessThan}}commit{{.GreaterThan}}`,
+		`{{.LessThan}}commit{{.GreaterThan}}...`,
+		`--continue | --skip | --abort`,
 	},
 }
 
 var ErrCherryPickConflictsOrViolations = errors.NewKind("error: Unable to apply commit cleanly due to conflicts " +
 	"or constraint violations. Please resolve the conflicts and/or constraint violations, then use `dolt add` " +
-	"to add the tables to the staged set, and `dolt commit` to commit the changes and finish cherry-picking. \n" +
+	"to add the tables to the staged set, and `dolt cherry-pick --continue` to commit the changes and continue cherry-picking. \n" +
+	"To skip this commit, use `dolt cherry-pick --skip`. " +
 	"To undo all changes from this cherry-pick operation, use `dolt cherry-pick --abort`.\n" +
 	"For more information on handling conflicts, see: https://docs.dolthub.com/concepts/dolt/git/conflicts")
 
@@ -114,12 +118,18 @@ func (cmd CherryPickCmd) Exec(ctx context.Context, commandStr string, args []str
 		}
 	}
 
-	// TODO : support single commit cherry-pick only for now
+	if apr.Contains(cli.ContinueFlag) || apr.Contains(cli.SkipFlag) {
+		if apr.NArg() > 0 {
+			usage()
+			return 1
+		}
+		err = cherryPickResume(queryist, sqlCtx, apr)
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
 	if apr.NArg() == 0 {
 		usage()
 		return 1
-	} else if apr.NArg() > 1 {
-		return HandleVErrAndExitCode(errhand.BuildDError("cherry-picking multiple commits is not supported yet").SetPrintUsage().Build(), usage)
 	}
 
 	err = cherryPick(queryist, sqlCtx, apr)
@@ -127,9 +137,10 @@ func (cmd CherryPickCmd) Exec(ctx context.Context, commandStr string, args []str
 }
 
 func cherryPick(queryist cli.Queryist, sqlCtx *sql.Context, apr *argparser.ArgParseResults) error {
-	cherryStr := apr.Arg(0)
-	if len(cherryStr) == 0 {
-		return fmt.Errorf("error: cannot cherry-pick empty string")
+	for _, cherryStr := range apr.Args {
+		if len(cherryStr) == 0 {
+			return fmt.Errorf("error: cannot cherry-pick empty string")
+		}
 	}
 
 	hasStagedChanges, hasUnstagedChanges, err := hasStagedAndUnstagedChanged(queryist, sqlCtx)
@@ -144,7 +155,30 @@ func cherryPick(queryist cli.Queryist, sqlCtx *sql.Context, apr *argparser.ArgPa
 hint: commit your changes (dolt commit -am \"<message>\") or reset them (dolt reset --hard) to proceed.`)
 	}
 
-	_, err = GetRowsForSql(queryist, sqlCtx, "set @@dolt_allow_commit_conflicts = 1")
+	params := make([]interface{}, len(apr.Args))
+	for i, cherryStr := range apr.Args {
+		params[i] = cherryStr
+	}
+	q, err := dbr.InterpolateForDialect("call dolt_cherry_pick("+strings.Repeat("?, ", len(params)-1)+"?)", params, dialect.MySQL)
+	if err != nil {
+		return fmt.Errorf("error: failed to interpolate query: %w", err)
+	}
+	return runCherryPickQuery(queryist, sqlCtx, q)
+}
+
+// cherryPickResume continues or skips the commit of a cherry-pick that stopped because of conflicts.
+func cherryPickResume(queryist cli.Queryist, sqlCtx *sql.Context, apr *argparser.ArgParseResults) error {
+	query := "call dolt_cherry_pick('--continue')"
+	if apr.Contains(cli.SkipFlag) {
+		query = "call dolt_cherry_pick('--skip')"
+	}
+	return runCherryPickQuery(queryist, sqlCtx, query)
+}
+
+// runCherryPickQuery runs |query|, a call to dolt_cherry_pick, and prints the commit it created, or an error
+// describing how to resolve the conflicts it stopped on.
+func runCherryPickQuery(queryist cli.Queryist, sqlCtx *sql.Context, query string) error {
+	_, err := GetRowsForSql(queryist, sqlCtx, "set @@dolt_allow_commit_conflicts = 1")
 	if err != nil {
 		return fmt.Errorf("error: failed to set @@dolt_allow_commit_conflicts: %w", err)
 	}
@@ -154,11 +188,7 @@ hint: commit your changes (dolt commit -am \"<message>\") or reset them (dolt re
 		return fmt.Errorf("error: failed to set @@dolt_force_transaction_commit: %w", err)
 	}
 
-	q, err := dbr.InterpolateForDialect("call dolt_cherry_pick(?)", []interface{}{cherryStr}, dialect.MySQL)
-	if err != nil {
-		return fmt.Errorf("error: failed to interpolate query: %w", err)
-	}
-	rows, err := GetRowsForSql(queryist, sqlCtx, q)
+	rows, err := GetRowsForSql(queryist, sqlCtx, query)
 	if err != nil {
 		errorText := err.Error()
 		switch {
@@ -194,6 +224,9 @@ hint: commit your changes (dolt commit -am \"<message>\") or reset them (dolt re
 		// if we have a hash and all 0s, then the cherry-pick succeeded
 		if len(commitHash) > 0 && dataConflicts == 0 && schemaConflicts == 0 && constraintViolations == 0 {
 			succeeded = true
+		} else if dataConflicts == 0 && schemaConflicts == 0 && constraintViolations == 0 {
+			// skipping the last commit of a cherry-pick leaves nothing to report
+			return nil
 		}
 	}
 
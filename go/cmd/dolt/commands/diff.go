@@ -25,11 +25,13 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/types"
 	ast "github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/fatih/color"
 	"github.com/go-sql-driver/mysql"
 	"github.com/gocraft/dbr/v2"
 	"github.com/gocraft/dbr/v2/dialect"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/commands/engine"
 	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
 	eventsapi "github.com/dolthub/dolt/go/gen/proto/dolt/services/eventsapi/v1alpha1"
 	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
@@ -38,6 +40,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema/typeinfo"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/untyped/tabular"
 	"github.com/dolthub/dolt/go/libraries/utils/argparser"
 	"github.com/dolthub/dolt/go/libraries/utils/iohelp"
@@ -56,9 +59,13 @@ const (
 
 	SchemaAndDataDiff = SchemaOnlyDiff | DataOnlyDiff
 
-	TabularDiffOutput diffOutput = 1
-	SQLDiffOutput     diffOutput = 2
-	JsonDiffOutput    diffOutput = 3
+	TabularDiffOutput  diffOutput = 1
+	SQLDiffOutput      diffOutput = 2
+	JsonDiffOutput     diffOutput = 3
+	MarkdownDiffOutput diffOutput = 4
+	HTMLDiffOutput     diffOutput = 5
+	// CsvDiffOutput is only used by incremental dumps, see dolt dump --since
+	CsvDiffOutput diffOutput = 6
 
 	DataFlag     = "data"
 	SchemaFlag   = "schema"
@@ -71,6 +78,7 @@ const (
 	MergeBase    = "merge-base"
 	DiffMode     = "diff-mode"
 	ReverseFlag  = "reverse"
+	JsonPaths    = "json-paths"
 )
 
 var diffDocs = cli.CommandDocumentationContent{
@@ -97,6 +105,8 @@ The diffs displayed can be limited to show the first N by providing the paramete
 
 To filter which data rows are displayed, use {{.EmphasisLeft}}--where <SQL expression>{{.EmphasisRight}}. Table column names in the filter expression must be prefixed with {{.EmphasisLeft}}from_{{.EmphasisRight}} or {{.EmphasisLeft}}to_{{.EmphasisRight}}, e.g. {{.EmphasisLeft}}to_COLUMN_NAME > 100{{.EmphasisRight}} or {{.EmphasisLeft}}from_COLUMN_NAME + to_COLUMN_NAME = 0{{.EmphasisRight}}.
 
+To see changes to JSON columns as individual paths within each document rather than as a replacement of the whole value, use {{.EmphasisLeft}}--json-paths{{.EmphasisRight}}. After the rows of each table, every changed path of each JSON column is listed along with its old and new value. This is only supported with tabular output.
+
 The {{.EmphasisLeft}}--diff-mode{{.EmphasisRight}} argument controls how modified rows are presented when the format output is set to {{.EmphasisLeft}}tabular{{.EmphasisRight}}. When set to {{.EmphasisLeft}}row{{.EmphasisRight}}, modified rows are presented as old and new rows. When set to {{.EmphasisLeft}}line{{.EmphasisRight}}, modified rows are presented as a single row, and changes are presented using "+" and "-" within the column. When set to {{.EmphasisLeft}}in-place{{.EmphasisRight}}, modified rows are presented as a single row, and changes are presented side-by-side with a color distinction (requires a color-enabled terminal). When set to {{.EmphasisLeft}}context{{.EmphasisRight}}, rows that contain at least one column that spans multiple lines uses {{.EmphasisLeft}}line{{.EmphasisRight}}, while all other rows use {{.EmphasisLeft}}row{{.EmphasisRight}}. The default value is {{.EmphasisLeft}}context{{.EmphasisRight}}.
 `,
 	Synopsis: []string{
@@ -112,6 +122,9 @@ type diffDisplaySettings struct {
 	limit      int
 	where      string
 	skinny     bool
+	jsonPaths  bool
+	// sqlSchemaChanges writes the data diffs of tables whose schema changed as SQL, rather than skipping them
+	sqlSchemaChanges bool
 }
 
 type diffDatasets struct {
@@ -168,7 +181,7 @@ func (cmd DiffCmd) ArgParser() *argparser.ArgParser {
 	ap.SupportsFlag(SchemaFlag, "s", "Show only the schema changes, do not show the data changes (Both shown by default).")
 	ap.SupportsFlag(StatFlag, "", "Show stats of data changes")
 	ap.SupportsFlag(SummaryFlag, "", "Show summary of data and schema changes")
-	ap.SupportsString(FormatFlag, "r", "result output format", "How to format diff output. Valid values are tabular, sql, json. Defaults to tabular.")
+	ap.SupportsString(FormatFlag, "r", "result output format", "How to format diff output. Valid values are tabular, sql, json, markdown, html. Defaults to tabular.")
 	ap.SupportsString(whereParam, "", "column", "filters columns based on values in the diff.  See {{.EmphasisLeft}}dolt diff --help{{.EmphasisRight}} for details.")
 	ap.SupportsInt(limitParam, "", "record_count", "limits to the first N diffs.")
 	ap.SupportsFlag(cli.CachedFlag, "c", "Show only the staged data changes.")
@@ -177,6 +190,7 @@ func (cmd DiffCmd) ArgParser() *argparser.ArgParser {
 	ap.SupportsString(DiffMode, "", "diff mode", "Determines how to display modified rows with tabular output. Valid values are row, line, in-place, context. Defaults to context.")
 	ap.SupportsFlag(ReverseFlag, "R", "Reverses the direction of the diff.")
 	ap.SupportsFlag(NameOnlyFlag, "", "Only shows table names.")
+	ap.SupportsFlag(JsonPaths, "", "Shows the changed paths within JSON columns after the row diff of each table.")
 	return ap
 }
 
@@ -229,11 +243,20 @@ func (cmd DiffCmd) validateArgs(apr *argparser.ArgParseResults) errhand.VerboseE
 
 	f, _ := apr.GetValue(FormatFlag)
 	switch strings.ToLower(f) {
-	case "tabular", "sql", "json", "":
+	case "tabular", "sql", "json", "markdown", "html", "":
 	default:
 		return errhand.BuildDError("invalid output format: %s", f).Build()
 	}
 
+	if apr.Contains(JsonPaths) {
+		if apr.Contains(SchemaFlag) || apr.Contains(StatFlag) || apr.Contains(SummaryFlag) || apr.Contains(NameOnlyFlag) {
+			return errhand.BuildDError("invalid Arguments: --json-paths cannot be combined with --schema, --stat, --summary, or --name-only").Build()
+		}
+		if f != "" && strings.ToLower(f) != "tabular" {
+			return errhand.BuildDError("invalid Arguments: --json-paths is only supported with tabular output").Build()
+		}
+	}
+
 	return nil
 }
 
@@ -254,6 +277,7 @@ func parseDiffDisplaySettings(apr *argparser.ArgParseResults) *diffDisplaySettin
 	}
 
 	displaySettings.skinny = apr.Contains(SkinnyFlag)
+	displaySettings.jsonPaths = apr.Contains(JsonPaths)
 
 	f := apr.GetValueOrDefault(FormatFlag, "tabular")
 	switch strings.ToLower(f) {
@@ -273,6 +297,10 @@ func parseDiffDisplaySettings(apr *argparser.ArgParseResults) *diffDisplaySettin
 		displaySettings.diffOutput = SQLDiffOutput
 	case "json":
 		displaySettings.diffOutput = JsonDiffOutput
+	case "markdown":
+		displaySettings.diffOutput = MarkdownDiffOutput
+	case "html":
+		displaySettings.diffOutput = HTMLDiffOutput
 	}
 
 	displaySettings.limit, _ = apr.GetInt(limitParam)
@@ -581,7 +609,15 @@ var diffSummarySchema = sql.Schema{
 
 func printDiffSummary(ctx context.Context, diffSummaries []diff.TableDeltaSummary, dArgs *diffArgs) errhand.VerboseError {
 	cliWR := iohelp.NopWrCloser(cli.OutStream)
-	wr := tabular.NewFixedWidthTableWriter(diffSummarySchema, cliWR, 100)
+	var wr table.SqlRowWriter
+	switch dArgs.diffOutput {
+	case MarkdownDiffOutput:
+		wr = tabular.NewMarkdownTableWriter(diffSummarySchema, cliWR)
+	case HTMLDiffOutput:
+		wr = tabular.NewHTMLTableWriter(diffSummarySchema, cliWR)
+	default:
+		wr = tabular.NewFixedWidthTableWriter(diffSummarySchema, cliWR, 100)
+	}
 	defer wr.Close(ctx)
 
 	for _, diffSummary := range diffSummaries {
@@ -768,6 +804,12 @@ func diffUserTables(queryist cli.Queryist, sqlCtx *sql.Context, dArgs *diffArgs)
 		return errhand.VerboseErrorFromError(err)
 	}
 
+	return writeUserTableDiffs(queryist, sqlCtx, deltas, dArgs, dw)
+}
+
+// writeUserTableDiffs writes the diffs of the table deltas given to |dw|, closing it once all of them are written.
+// Changes to dolt_schemas are written last.
+func writeUserTableDiffs(queryist cli.Queryist, sqlCtx *sql.Context, deltas []diff.TableDeltaSummary, dArgs *diffArgs, dw diffWriter) errhand.VerboseError {
 	ignoredTablePatterns, err := getIgnoredTablePatternsFromSql(queryist, sqlCtx)
 	if err != nil {
 		return errhand.VerboseErrorFromError(fmt.Errorf("couldn't get ignored table patterns, cause: %w", err))
@@ -1187,9 +1229,84 @@ func diffUserTable(
 		return verr
 	}
 
+	if dArgs.jsonPaths && dArgs.diffParts&DataOnlyDiff != 0 {
+		return diffJsonPaths(queryist, sqlCtx, tableSummary, fromTableInfo, toTableInfo, dArgs)
+	}
+
+	return nil
+}
+
+// diffJsonPaths prints the changed paths within each JSON column of the table given, as computed by the
+// dolt_json_diff table function. Tables without a primary key, or whose primary keys changed, are skipped.
+func diffJsonPaths(
+	queryist cli.Queryist,
+	sqlCtx *sql.Context,
+	tableSummary diff.TableDeltaSummary,
+	fromTableInfo, toTableInfo *diff.TableInfo,
+	dArgs *diffArgs,
+) errhand.VerboseError {
+	if !arePrimaryKeySetsDiffable(fromTableInfo, toTableInfo) {
+		return nil
+	}
+	if (fromTableInfo != nil && schema.IsKeyless(fromTableInfo.Sch)) || (toTableInfo != nil && schema.IsKeyless(toTableInfo.Sch)) {
+		return nil
+	}
+
+	// TODO: schema names
+	tableName := tableSummary.ToTableName.Name
+	if len(tableName) == 0 {
+		tableName = tableSummary.FromTableName.Name
+	}
+
+	for _, colName := range getJsonColumnNames(fromTableInfo, toTableInfo) {
+		query, err := dbr.InterpolateForDialect("select * from dolt_json_diff(?, ?, ?, ?)",
+			[]interface{}{dArgs.fromRef, dArgs.toRef, tableName, colName}, dialect.MySQL)
+		if err != nil {
+			return errhand.BuildDError("Error building JSON diff query").AddCause(err).Build()
+		}
+
+		sch, rowIter, _, err := queryist.Query(sqlCtx, query)
+		if err != nil {
+			return errhand.BuildDError("Error running JSON diff query:\n%s", query).AddCause(err).Build()
+		}
+		rows, err := sql.RowIterToRows(sqlCtx, rowIter)
+		if err != nil {
+			return errhand.BuildDError("Error running JSON diff query:\n%s", query).AddCause(err).Build()
+		}
+		if len(rows) == 0 {
+			continue
+		}
+
+		cli.Println(color.New(color.Bold).Sprintf("JSON paths changed in column %s", colName))
+		err = engine.PrettyPrintResults(sqlCtx, engine.FormatTabular, sch, sql.RowsToRowIter(rows...))
+		if err != nil {
+			return errhand.VerboseErrorFromError(err)
+		}
+	}
+
 	return nil
 }
 
+// getJsonColumnNames returns the names of the JSON columns in either version of a table, in schema order, with
+// columns from the newer version first.
+func getJsonColumnNames(fromTableInfo, toTableInfo *diff.TableInfo) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, info := range []*diff.TableInfo{toTableInfo, fromTableInfo} {
+		if info == nil {
+			continue
+		}
+		_ = info.Sch.GetAllCols().Iter(func(tag uint64, col schema.Column) (stop bool, err error) {
+			if _, ok := col.TypeInfo.ToSqlType().(types.JsonType); ok && !seen[strings.ToLower(col.Name)] {
+				seen[strings.ToLower(col.Name)] = true
+				names = append(names, col.Name)
+			}
+			return false, nil
+		})
+	}
+	return names
+}
+
 func diffDoltSchemasTable(
 	queryist cli.Queryist,
 	sqlCtx *sql.Context,
@@ -1414,7 +1531,7 @@ func diffRows(
 			return errhand.VerboseErrorFromError(err)
 		}
 		return nil
-	} else if dArgs.diffOutput == SQLDiffOutput && !canSqlDiff {
+	} else if dArgs.diffOutput == SQLDiffOutput && !canSqlDiff && !canSqlDiffAfterSchemaChange(dArgs, toTableInfo) {
 		// TODO: this is overly broad, we can absolutely do better
 		_, _ = fmt.Fprintf(cli.CliErr, "Incompatible schema change, skipping data diff for table '%s'\n", tableSummary.ToTableName)
 		err = rowWriter.Close(sqlCtx)
@@ -1527,6 +1644,13 @@ func diffRows(
 	return nil
 }
 
+// canSqlDiffAfterSchemaChange returns whether the SQL data diff of a table whose schema changed can be written anyway.
+// That's the case when the ALTER statements bringing the table to its new schema were written before the rows, which
+// the sqlDiffWriter projects onto the new schema.
+func canSqlDiffAfterSchemaChange(dArgs *diffArgs, toTableInfo *diff.TableInfo) bool {
+	return dArgs.sqlSchemaChanges && dArgs.diffParts&SchemaOnlyDiff != 0 && toTableInfo != nil
+}
+
 func unionSchemas(s1 sql.Schema, s2 sql.Schema) sql.Schema {
 	var union sql.Schema
 	union = append(union, s1...)
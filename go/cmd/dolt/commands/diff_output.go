@@ -19,7 +19,9 @@ import (
 	ejson "encoding/json"
 	"errors"
 	"fmt"
+	"html"
 	"io"
+	"strings"
 
 	textdiff "github.com/andreyvit/diff"
 	"github.com/dolthub/go-mysql-server/sql"
@@ -65,9 +67,13 @@ func newDiffWriter(diffOutput diffOutput) (diffWriter, error) {
 	case TabularDiffOutput:
 		return tabularDiffWriter{}, nil
 	case SQLDiffOutput:
-		return sqlDiffWriter{}, nil
+		return newSqlDiffWriter(iohelp.NopWrCloser(cli.CliOut)), nil
 	case JsonDiffOutput:
 		return newJsonDiffWriter(iohelp.NopWrCloser(cli.CliOut))
+	case MarkdownDiffOutput:
+		return newMarkdownDiffWriter(iohelp.NopWrCloser(cli.CliOut)), nil
+	case HTMLDiffOutput:
+		return newHTMLDiffWriter(iohelp.NopWrCloser(cli.CliOut)), nil
 	default:
 		panic(fmt.Sprintf("unexpected diff output: %v", diffOutput))
 	}
@@ -180,6 +186,16 @@ func (t tabularDiffWriter) WriteTableDiffStats(diffStats []diffStatistics, oldCo
 }
 
 func (t tabularDiffWriter) printStat(acc diff.DiffStatProgress, oldColLen, newColLen int) {
+	lines := diffStatLines(acc, oldColLen, newColLen)
+	for _, line := range lines[:len(lines)-1] {
+		cli.Printf("%s\n", line)
+	}
+	cli.Printf("%s\n\n", lines[len(lines)-1])
+}
+
+// diffStatLines returns the lines of text describing the accumulated diff stats given, the last of which is the
+// comparison of old and new row counts.
+func diffStatLines(acc diff.DiffStatProgress, oldColLen, newColLen int) []string {
 	numCellInserts, numCellDeletes := sqle.GetCellsAddedAndDeleted(acc, newColLen)
 	rowsUnmodified := uint64(acc.OldRowSize - acc.Changes - acc.Removes)
 	unmodified := pluralize("Row Unmodified", "Rows Unmodified", rowsUnmodified)
@@ -203,34 +219,49 @@ func (t tabularDiffWriter) printStat(acc diff.DiffStatProgress, oldColLen, newCo
 		return float64(100*num) / (float64(dom))
 	}
 
-	cli.Printf("%s (%.2f%%)\n", unmodified, safePercent(rowsUnmodified, acc.OldRowSize))
-	cli.Printf("%s (%.2f%%)\n", insertions, safePercent(acc.Adds, acc.OldRowSize))
-	cli.Printf("%s (%.2f%%)\n", deletions, safePercent(acc.Removes, acc.OldRowSize))
-	cli.Printf("%s (%.2f%%)\n", changes, safePercent(acc.Changes, acc.OldRowSize))
-	cli.Printf("%s (%.2f%%)\n", cellInsertions, safePercent(numCellInserts, acc.OldCellSize))
-	cli.Printf("%s (%.2f%%)\n", cellDeletions, safePercent(numCellDeletes, acc.OldCellSize))
-	cli.Printf("%s (%.2f%%)\n", cellChanges, percentCellsChanged)
-	cli.Printf("(%s vs %s)\n\n", oldValues, newValues)
+	return []string{
+		fmt.Sprintf("%s (%.2f%%)", unmodified, safePercent(rowsUnmodified, acc.OldRowSize)),
+		fmt.Sprintf("%s (%.2f%%)", insertions, safePercent(acc.Adds, acc.OldRowSize)),
+		fmt.Sprintf("%s (%.2f%%)", deletions, safePercent(acc.Removes, acc.OldRowSize)),
+		fmt.Sprintf("%s (%.2f%%)", changes, safePercent(acc.Changes, acc.OldRowSize)),
+		fmt.Sprintf("%s (%.2f%%)", cellInsertions, safePercent(numCellInserts, acc.OldCellSize)),
+		fmt.Sprintf("%s (%.2f%%)", cellDeletions, safePercent(numCellDeletes, acc.OldCellSize)),
+		fmt.Sprintf("%s (%.2f%%)", cellChanges, percentCellsChanged),
+		fmt.Sprintf("(%s vs %s)", oldValues, newValues),
+	}
 }
 
-func (t tabularDiffWriter) printKeylessStat(acc diff.DiffStatProgress) {
-	insertions := pluralize("Row Added", "Rows Added", acc.Adds)
-	deletions := pluralize("Row Deleted", "Rows Deleted", acc.Removes)
+// keylessDiffStatLines returns the lines of text describing the accumulated diff stats given for a keyless table.
+func keylessDiffStatLines(acc diff.DiffStatProgress) []string {
+	return []string{
+		pluralize("Row Added", "Rows Added", acc.Adds),
+		pluralize("Row Deleted", "Rows Deleted", acc.Removes),
+	}
+}
 
-	cli.Printf("%s\n", insertions)
-	cli.Printf("%s\n", deletions)
+func (t tabularDiffWriter) printKeylessStat(acc diff.DiffStatProgress) {
+	for _, line := range keylessDiffStatLines(acc) {
+		cli.Printf("%s\n", line)
+	}
 }
 
 func (t tabularDiffWriter) RowWriter(fromTableInfo, toTableInfo *diff.TableInfo, tds diff.TableDeltaSummary, unionSch sql.Schema) (diff.SqlRowDiffWriter, error) {
 	return tabular.NewFixedWidthDiffTableWriter(unionSch, iohelp.NopWrCloser(cli.CliOut), 100), nil
 }
 
-type sqlDiffWriter struct{}
+// sqlDiffWriter writes diffs as SQL statements that, when applied to the from side of the diff, produce the to side
+type sqlDiffWriter struct {
+	wr io.WriteCloser
+}
 
-var _ diffWriter = (*tabularDiffWriter)(nil)
+var _ diffWriter = (*sqlDiffWriter)(nil)
+
+func newSqlDiffWriter(wr io.WriteCloser) *sqlDiffWriter {
+	return &sqlDiffWriter{wr: wr}
+}
 
 func (s sqlDiffWriter) Close(ctx context.Context) error {
-	return nil
+	return s.wr.Close()
 }
 
 func (s sqlDiffWriter) BeginTable(fromTableName, toTableName string, isAdd, isDrop bool) error {
@@ -248,51 +279,38 @@ func (s sqlDiffWriter) WriteTableSchemaDiff(fromTableInfo, toTableInfo *diff.Tab
 		if len(stmt) == 0 {
 			continue
 		}
-		cli.Println(stmt)
+		if err := iohelp.WriteLine(s.wr, stmt); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
 func (s sqlDiffWriter) WriteEventDiff(ctx context.Context, eventName, oldDefn, newDefn string) error {
-	// definitions will already be semicolon terminated, no need to add additional ones
-	if oldDefn == "" {
-		cli.Println(newDefn)
-	} else if newDefn == "" {
-		cli.Println(fmt.Sprintf("DROP EVENT %s;", sql.QuoteIdentifier(eventName)))
-	} else {
-		cli.Println(fmt.Sprintf("DROP EVENT %s;", sql.QuoteIdentifier(eventName)))
-		cli.Println(newDefn)
-	}
-
-	return nil
+	return s.writeDropCreate("EVENT", eventName, oldDefn, newDefn)
 }
 
 func (s sqlDiffWriter) WriteTriggerDiff(ctx context.Context, triggerName, oldDefn, newDefn string) error {
-	// definitions will already be semicolon terminated, no need to add additional ones
-	if oldDefn == "" {
-		cli.Println(newDefn)
-	} else if newDefn == "" {
-		cli.Println(fmt.Sprintf("DROP TRIGGER %s;", sql.QuoteIdentifier(triggerName)))
-	} else {
-		cli.Println(fmt.Sprintf("DROP TRIGGER %s;", sql.QuoteIdentifier(triggerName)))
-		cli.Println(newDefn)
-	}
-
-	return nil
+	return s.writeDropCreate("TRIGGER", triggerName, oldDefn, newDefn)
 }
 
 func (s sqlDiffWriter) WriteViewDiff(ctx context.Context, viewName, oldDefn, newDefn string) error {
+	return s.writeDropCreate("VIEW", viewName, oldDefn, newDefn)
+}
+
+// writeDropCreate writes the statements to replace the schema element of the kind given, such as a VIEW, having
+// definition |oldDefn| with |newDefn|. An empty definition means the element doesn't exist on that side of the diff.
+func (s sqlDiffWriter) writeDropCreate(kind, name, oldDefn, newDefn string) error {
 	// definitions will already be semicolon terminated, no need to add additional ones
-	if oldDefn == "" {
-		cli.Println(newDefn)
-	} else if newDefn == "" {
-		cli.Println(fmt.Sprintf("DROP VIEW %s;", sql.QuoteIdentifier(viewName)))
-	} else {
-		cli.Println(fmt.Sprintf("DROP VIEW %s;", sql.QuoteIdentifier(viewName)))
-		cli.Println(newDefn)
+	if oldDefn != "" {
+		if err := iohelp.WriteLine(s.wr, fmt.Sprintf("DROP %s %s;", kind, sql.QuoteIdentifier(name))); err != nil {
+			return err
+		}
+	}
+	if newDefn != "" {
+		return iohelp.WriteLine(s.wr, newDefn)
 	}
-
 	return nil
 }
 
@@ -311,7 +329,62 @@ func (s sqlDiffWriter) RowWriter(fromTableInfo, toTableInfo *diff.TableInfo, tds
 	}
 
 	// TOOD: schema names
-	return sqlexport.NewSqlDiffWriter(tds.ToTableName.Name, targetSch, iohelp.NopWrCloser(cli.CliOut)), nil
+	var wr diff.SqlRowDiffWriter = sqlexport.NewSqlDiffWriter(tds.ToTableName.Name, targetSch, iohelp.NopWrCloser(s.wr))
+
+	// Rows are written in the order of |unionSch|, which differs from the target schema when columns were added,
+	// dropped or reordered
+	cols := targetSch.GetAllCols().GetColumns()
+	projection := make([]int, len(cols))
+	identity := len(cols) == len(unionSch)
+	for i, col := range cols {
+		projection[i] = unionSch.IndexOfColName(col.Name)
+		if projection[i] < 0 {
+			return nil, fmt.Errorf("column %s not found in diff schema for table %s", col.Name, tds.ToTableName.Name)
+		}
+		identity = identity && projection[i] == i
+	}
+	if !identity {
+		wr = projectingRowDiffWriter{wr: wr, projection: projection}
+	}
+
+	return wr, nil
+}
+
+// projectingRowDiffWriter reorders the rows written to it, and their column diff types, before passing them to |wr|.
+// The value at index i of each row written to |wr| is taken from index projection[i] of the original row.
+type projectingRowDiffWriter struct {
+	wr         diff.SqlRowDiffWriter
+	projection []int
+}
+
+var _ diff.SqlRowDiffWriter = projectingRowDiffWriter{}
+
+func (p projectingRowDiffWriter) WriteRow(ctx context.Context, row sql.Row, rowDiffType diff.ChangeType, colDiffTypes []diff.ChangeType) error {
+	projectedDiffTypes := make([]diff.ChangeType, len(p.projection))
+	for i, idx := range p.projection {
+		if idx < len(colDiffTypes) {
+			projectedDiffTypes[i] = colDiffTypes[idx]
+		}
+	}
+	return p.wr.WriteRow(ctx, p.project(row), rowDiffType, projectedDiffTypes)
+}
+
+func (p projectingRowDiffWriter) WriteCombinedRow(ctx context.Context, oldRow, newRow sql.Row, mode diff.Mode) error {
+	return p.wr.WriteCombinedRow(ctx, p.project(oldRow), p.project(newRow), mode)
+}
+
+func (p projectingRowDiffWriter) project(row sql.Row) sql.Row {
+	projectedRow := make(sql.Row, len(p.projection))
+	for i, idx := range p.projection {
+		if idx < len(row) {
+			projectedRow[i] = row[idx]
+		}
+	}
+	return projectedRow
+}
+
+func (p projectingRowDiffWriter) Close(ctx context.Context) error {
+	return p.wr.Close(ctx)
 }
 
 type jsonDiffWriter struct {
@@ -650,3 +723,228 @@ func (j *jsonDiffWriter) Close(ctx context.Context) error {
 	// Writer has already been closed here during row iteration, no need to close it here
 	return nil
 }
+
+// sumDiffStats accumulates the per-partition diff stats given into a single set of totals
+func sumDiffStats(diffStats []diffStatistics) diff.DiffStatProgress {
+	acc := diff.DiffStatProgress{}
+	for _, diffStat := range diffStats {
+		acc.Adds += diffStat.RowsAdded
+		acc.Removes += diffStat.RowsDeleted
+		acc.Changes += diffStat.RowsModified
+		acc.CellChanges += diffStat.CellsModified
+		acc.NewRowSize += diffStat.NewRowCount
+		acc.OldRowSize += diffStat.OldRowCount
+		acc.NewCellSize += diffStat.NewCellCount
+		acc.OldCellSize += diffStat.OldCellCount
+	}
+	return acc
+}
+
+// diffStatsTextLines returns the lines describing the diff stats given, or nil if there are no data changes.
+func diffStatsTextLines(diffStats []diffStatistics, oldColLen, newColLen int, areTablesKeyless bool) []string {
+	acc := sumDiffStats(diffStats)
+	if (acc.Adds+acc.Removes+acc.Changes) == 0 && (acc.OldCellSize-acc.NewCellSize) == 0 {
+		return nil
+	}
+	if areTablesKeyless {
+		return keylessDiffStatLines(acc)
+	}
+	return diffStatLines(acc, oldColLen, newColLen)
+}
+
+// markdownDiffWriter writes diffs as GitHub flavored markdown, suitable for pasting into pull request descriptions.
+// Each table gets a heading, schema changes are written as fenced diff blocks, and row changes as markdown tables.
+type markdownDiffWriter struct {
+	wr io.WriteCloser
+}
+
+var _ diffWriter = (*markdownDiffWriter)(nil)
+
+func newMarkdownDiffWriter(wr io.WriteCloser) *markdownDiffWriter {
+	return &markdownDiffWriter{wr: wr}
+}
+
+func (m markdownDiffWriter) printf(format string, args ...interface{}) error {
+	_, err := fmt.Fprintf(m.wr, format, args...)
+	return err
+}
+
+func (m markdownDiffWriter) Close(ctx context.Context) error {
+	return nil
+}
+
+func (m markdownDiffWriter) BeginTable(fromTableName, toTableName string, isAdd, isDrop bool) error {
+	if isDrop {
+		return m.printf("### `%s` (deleted table)\n\n", fromTableName)
+	} else if isAdd {
+		return m.printf("### `%s` (added table)\n\n", toTableName)
+	} else if fromTableName != toTableName {
+		return m.printf("### `%s` → `%s`\n\n", fromTableName, toTableName)
+	}
+	return m.printf("### `%s`\n\n", toTableName)
+}
+
+func (m markdownDiffWriter) writeTextDiff(oldText, newText string) error {
+	if oldText == newText {
+		return nil
+	}
+	return m.printf("```diff\n%s\n```\n\n", textdiff.LineDiff(oldText, newText))
+}
+
+func (m markdownDiffWriter) WriteTableSchemaDiff(fromTableInfo, toTableInfo *diff.TableInfo, tds diff.TableDeltaSummary) error {
+	var fromCreateStmt, toCreateStmt string
+	if fromTableInfo != nil {
+		fromCreateStmt = fromTableInfo.CreateStmt
+	}
+	if toTableInfo != nil {
+		toCreateStmt = toTableInfo.CreateStmt
+	}
+	return m.writeTextDiff(fromCreateStmt, toCreateStmt)
+}
+
+func (m markdownDiffWriter) writeSchemaFragmentDiff(kind, name, oldDefn, newDefn string) error {
+	if err := m.printf("### %s `%s`\n\n", kind, name); err != nil {
+		return err
+	}
+	return m.writeTextDiff(oldDefn, newDefn)
+}
+
+func (m markdownDiffWriter) WriteEventDiff(ctx context.Context, eventName, oldDefn, newDefn string) error {
+	return m.writeSchemaFragmentDiff("Event", eventName, oldDefn, newDefn)
+}
+
+func (m markdownDiffWriter) WriteTriggerDiff(ctx context.Context, triggerName, oldDefn, newDefn string) error {
+	return m.writeSchemaFragmentDiff("Trigger", triggerName, oldDefn, newDefn)
+}
+
+func (m markdownDiffWriter) WriteViewDiff(ctx context.Context, viewName, oldDefn, newDefn string) error {
+	return m.writeSchemaFragmentDiff("View", viewName, oldDefn, newDefn)
+}
+
+func (m markdownDiffWriter) WriteTableDiffStats(diffStats []diffStatistics, oldColLen, newColLen int, areTablesKeyless bool) error {
+	lines := diffStatsTextLines(diffStats, oldColLen, newColLen, areTablesKeyless)
+	if len(lines) == 0 {
+		return m.printf("No data changes.\n\n")
+	}
+	for _, line := range lines {
+		if err := m.printf("- %s\n", line); err != nil {
+			return err
+		}
+	}
+	return m.printf("\n")
+}
+
+func (m markdownDiffWriter) RowWriter(fromTableInfo, toTableInfo *diff.TableInfo, tds diff.TableDeltaSummary, unionSch sql.Schema) (diff.SqlRowDiffWriter, error) {
+	return tabular.NewMarkdownDiffTableWriter(unionSch, iohelp.NopWrCloser(m.wr)), nil
+}
+
+// htmlDiffWriter writes diffs as an HTML fragment, suitable for emailed reports. Changed cells are highlighted with
+// inline styles, since many email clients discard stylesheets.
+type htmlDiffWriter struct {
+	wr io.WriteCloser
+}
+
+var _ diffWriter = (*htmlDiffWriter)(nil)
+
+func newHTMLDiffWriter(wr io.WriteCloser) *htmlDiffWriter {
+	return &htmlDiffWriter{wr: wr}
+}
+
+func (h htmlDiffWriter) printf(format string, args ...interface{}) error {
+	_, err := fmt.Fprintf(h.wr, format, args...)
+	return err
+}
+
+func (h htmlDiffWriter) Close(ctx context.Context) error {
+	return nil
+}
+
+func (h htmlDiffWriter) BeginTable(fromTableName, toTableName string, isAdd, isDrop bool) error {
+	if isDrop {
+		return h.printf("<h3><code>%s</code> (deleted table)</h3>\n", html.EscapeString(fromTableName))
+	} else if isAdd {
+		return h.printf("<h3><code>%s</code> (added table)</h3>\n", html.EscapeString(toTableName))
+	} else if fromTableName != toTableName {
+		return h.printf("<h3><code>%s</code> &rarr; <code>%s</code></h3>\n", html.EscapeString(fromTableName), html.EscapeString(toTableName))
+	}
+	return h.printf("<h3><code>%s</code></h3>\n", html.EscapeString(toTableName))
+}
+
+var htmlTextDiffLineStyles = map[byte]tabular.HTMLCellStyle{
+	'+': tabular.HTMLDiffStyles[diff.Added],
+	'-': tabular.HTMLDiffStyles[diff.Removed],
+}
+
+func (h htmlDiffWriter) writeTextDiff(oldText, newText string) error {
+	if oldText == newText {
+		return nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("<pre>")
+	for _, line := range textdiff.LineDiffAsLines(oldText, newText) {
+		escaped := html.EscapeString(line)
+		if len(line) > 0 {
+			if style, ok := htmlTextDiffLineStyles[line[0]]; ok {
+				escaped = fmt.Sprintf(`<span class="%s" style="%s">%s</span>`, style.Class, style.Style, escaped)
+			}
+		}
+		sb.WriteString(escaped)
+		sb.WriteString("\n")
+	}
+	sb.WriteString("</pre>\n")
+
+	return h.printf("%s", sb.String())
+}
+
+func (h htmlDiffWriter) WriteTableSchemaDiff(fromTableInfo, toTableInfo *diff.TableInfo, tds diff.TableDeltaSummary) error {
+	var fromCreateStmt, toCreateStmt string
+	if fromTableInfo != nil {
+		fromCreateStmt = fromTableInfo.CreateStmt
+	}
+	if toTableInfo != nil {
+		toCreateStmt = toTableInfo.CreateStmt
+	}
+	return h.writeTextDiff(fromCreateStmt, toCreateStmt)
+}
+
+func (h htmlDiffWriter) writeSchemaFragmentDiff(kind, name, oldDefn, newDefn string) error {
+	if err := h.printf("<h3>%s <code>%s</code></h3>\n", kind, html.EscapeString(name)); err != nil {
+		return err
+	}
+	return h.writeTextDiff(oldDefn, newDefn)
+}
+
+func (h htmlDiffWriter) WriteEventDiff(ctx context.Context, eventName, oldDefn, newDefn string) error {
+	return h.writeSchemaFragmentDiff("Event", eventName, oldDefn, newDefn)
+}
+
+func (h htmlDiffWriter) WriteTriggerDiff(ctx context.Context, triggerName, oldDefn, newDefn string) error {
+	return h.writeSchemaFragmentDiff("Trigger", triggerName, oldDefn, newDefn)
+}
+
+func (h htmlDiffWriter) WriteViewDiff(ctx context.Context, viewName, oldDefn, newDefn string) error {
+	return h.writeSchemaFragmentDiff("View", viewName, oldDefn, newDefn)
+}
+
+func (h htmlDiffWriter) WriteTableDiffStats(diffStats []diffStatistics, oldColLen, newColLen int, areTablesKeyless bool) error {
+	lines := diffStatsTextLines(diffStats, oldColLen, newColLen, areTablesKeyless)
+	if len(lines) == 0 {
+		return h.printf("<p>No data changes.</p>\n")
+	}
+
+	var sb strings.Builder
+	sb.WriteString("<ul>\n")
+	for _, line := range lines {
+		sb.WriteString("<li>")
+		sb.WriteString(html.EscapeString(line))
+		sb.WriteString("</li>\n")
+	}
+	sb.WriteString("</ul>\n")
+
+	return h.printf("%s", sb.String())
+}
+
+func (h htmlDiffWriter) RowWriter(fromTableInfo, toTableInfo *diff.TableInfo, tds diff.TableDeltaSummary, unionSch sql.Schema) (diff.SqlRowDiffWriter, error) {
+	return tabular.NewHTMLDiffTableWriter(unionSch, iohelp.NopWrCloser(h.wr)), nil
+}
@@ -16,6 +16,7 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -25,18 +26,23 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/planbuilder"
+	"github.com/dolthub/go-mysql-server/sql/types"
 	"github.com/fatih/color"
+	"github.com/gocraft/dbr/v2"
+	"github.com/gocraft/dbr/v2/dialect"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
 	"github.com/dolthub/dolt/go/cmd/dolt/commands/engine"
 	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
 	eventsapi "github.com/dolthub/dolt/go/gen/proto/dolt/services/eventsapi/v1alpha1"
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
 	"github.com/dolthub/dolt/go/libraries/doltcore/mvdata"
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/untyped/csv"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/untyped/sqlexport"
 	"github.com/dolthub/dolt/go/libraries/utils/argparser"
 	"github.com/dolthub/dolt/go/libraries/utils/filesys"
@@ -52,6 +58,7 @@ const (
 	noAutocommitFlag = "no-autocommit"
 	schemaOnlyFlag   = "schema-only"
 	noCreateDbFlag   = "no-create-db"
+	sinceFlag        = "since"
 
 	sqlFileExt     = "sql"
 	csvFileExt     = "csv"
@@ -63,15 +70,21 @@ const (
 
 var dumpDocs = cli.CommandDocumentationContent{
 	ShortDesc: `Export all tables.`,
-	LongDesc: `{{.EmphasisLeft}}dolt dump{{.EmphasisRight}} dumps all tables in the working set. 
+	LongDesc: `{{.EmphasisLeft}}dolt dump{{.EmphasisRight}} dumps all tables in the working set, or at the {{.LessThan}}revision{{.GreaterThan}} given, which may be a branch, tag or commit. 
 If a dump file already exists then the operation will fail, unless the {{.EmphasisLeft}}--force | -f{{.EmphasisRight}} flag 
 is provided. The force flag forces the existing dump file to be overwritten. The {{.EmphasisLeft}}-r{{.EmphasisRight}} flag 
 is used to support different file formats of the dump. In the case of non .sql files each table is written to a separate
 csv,json or parquet file. 
+
+The {{.EmphasisLeft}}--since{{.EmphasisRight}} flag produces an incremental dump holding only the changes made between the revision given 
+to it and the dumped revision. A SQL dump contains the DDL and DML statements that apply those changes, the same as 
+{{.EmphasisLeft}}dolt diff -r sql{{.EmphasisRight}}. A CSV dump writes a file for each table with changed rows, containing the new version of each 
+added or modified row and the old version of each removed row, along with a leading {{.EmphasisLeft}}diff_type{{.EmphasisRight}} column. 
+Incremental dumps are not supported for json or parquet files.
 `,
 
 	Synopsis: []string{
-		"[-f] [-r {{.LessThan}}result-format{{.GreaterThan}}] [-fn {{.LessThan}}file_name{{.GreaterThan}}]  [-d {{.LessThan}}directory{{.GreaterThan}}] [--batch] [--no-batch] [--no-autocommit] [--no-create-db] ",
+		"[-f] [-r {{.LessThan}}result-format{{.GreaterThan}}] [-fn {{.LessThan}}file_name{{.GreaterThan}}]  [-d {{.LessThan}}directory{{.GreaterThan}}] [--batch] [--no-batch] [--no-autocommit] [--no-create-db] [--since {{.LessThan}}revision{{.GreaterThan}}] [{{.LessThan}}revision{{.GreaterThan}}]",
 	},
 }
 
@@ -94,7 +107,8 @@ func (cmd DumpCmd) Docs() *cli.CommandDocumentation {
 }
 
 func (cmd DumpCmd) ArgParser() *argparser.ArgParser {
-	ap := argparser.NewArgParserWithMaxArgs(cmd.Name(), 0)
+	ap := argparser.NewArgParserWithMaxArgs(cmd.Name(), 1)
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"revision", "The branch, tag or commit to dump. Defaults to the working set."})
 	ap.SupportsString(FormatFlag, "r", "result_file_type", "Define the type of the output file. Defaults to sql. Valid values are sql, csv, json and parquet.")
 	ap.SupportsString(filenameFlag, "fn", "file_name", "Define file name for dump file. Defaults to `doltdump.sql`.")
 	ap.SupportsString(directoryFlag, "d", "directory_name", "Define directory name to dump the files in. Defaults to `doltdump/`.")
@@ -104,6 +118,7 @@ func (cmd DumpCmd) ArgParser() *argparser.ArgParser {
 	ap.SupportsFlag(noAutocommitFlag, "na", "Turn off autocommit for each dumped table. Useful for speeding up loading of output SQL file.")
 	ap.SupportsFlag(schemaOnlyFlag, "", "Dump a table's schema, without including any data, to the output SQL file.")
 	ap.SupportsFlag(noCreateDbFlag, "", "Do not write `CREATE DATABASE` statements in SQL files.")
+	ap.SupportsString(sinceFlag, "", "revision", "Only dump the changes made since the revision given. Supported for sql and csv files.")
 	return ap
 }
 
@@ -118,7 +133,12 @@ func (cmd DumpCmd) Exec(ctx context.Context, commandStr string, args []string, d
 	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, dumpDocs, ap))
 	apr := cli.ParseArgsOrDie(ap, args, help)
 
-	root, verr := GetWorkingWithVErr(dEnv)
+	var revision string
+	if apr.NArg() == 1 {
+		revision = apr.Arg(0)
+	}
+
+	root, verr := getDumpRoot(ctx, dEnv, revision)
 	if verr != nil {
 		return HandleVErrAndExitCode(verr, usage)
 	}
@@ -142,6 +162,13 @@ func (cmd DumpCmd) Exec(ctx context.Context, commandStr string, args []string, d
 		return HandleVErrAndExitCode(vErr, usage)
 	}
 
+	since, incremental := apr.GetValue(sinceFlag)
+	if incremental {
+		if _, verr := getDumpRoot(ctx, dEnv, since); verr != nil {
+			return HandleVErrAndExitCode(verr, usage)
+		}
+	}
+
 	switch resFormat {
 	case emptyFileExt, sqlFileExt:
 		var defaultName string
@@ -181,20 +208,41 @@ func (cmd DumpCmd) Exec(ctx context.Context, commandStr string, args []string, d
 			return HandleVErrAndExitCode(err, usage)
 		}
 
+		if incremental {
+			err = dumpSqlChanges(ctx, dEnv, since, revision, schemaOnly, fPath)
+			if err != nil {
+				return HandleVErrAndExitCode(err, usage)
+			}
+			break
+		}
+
 		for _, tbl := range tblNames {
 			tblOpts := newTableArgs(tbl, dumpOpts.dest, !apr.Contains(noBatchFlag), apr.Contains(noAutocommitFlag), schemaOnly)
-			err = dumpTable(ctx, dEnv, tblOpts, fPath)
+			tblOpts.asOf = revision
+			err = dumpTable(ctx, dEnv, root, tblOpts, fPath)
 			if err != nil {
 				return HandleVErrAndExitCode(err, usage)
 			}
 		}
 
-		err = dumpSchemaElements(ctx, dEnv, fPath)
+		err = dumpSchemaElements(ctx, dEnv, root, revision, fPath)
 		if err != nil {
 			return HandleVErrAndExitCode(err, usage)
 		}
-	case csvFileExt, jsonFileExt, parquetFileExt:
-		err = dumpNonSqlTables(ctx, root, dEnv, force, tblNames, resFormat, outputFileOrDirName, false)
+	case csvFileExt:
+		if incremental {
+			err = dumpCsvChanges(ctx, root, dEnv, force, since, revision, outputFileOrDirName)
+		} else {
+			err = dumpNonSqlTables(ctx, root, dEnv, force, tblNames, resFormat, outputFileOrDirName, revision, false)
+		}
+		if err != nil {
+			return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+		}
+	case jsonFileExt, parquetFileExt:
+		if incremental {
+			return HandleVErrAndExitCode(errhand.BuildDError("%s is not supported for %s exports", sinceFlag, resFormat).SetPrintUsage().Build(), usage)
+		}
+		err = dumpNonSqlTables(ctx, root, dEnv, force, tblNames, resFormat, outputFileOrDirName, revision, false)
 		if err != nil {
 			return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
 		}
@@ -207,8 +255,9 @@ func (cmd DumpCmd) Exec(ctx context.Context, commandStr string, args []string, d
 	return 0
 }
 
-// dumpSchemaElements writes the non-table schema elements (views, triggers, procedures) to the file path given
-func dumpSchemaElements(ctx context.Context, dEnv *env.DoltEnv, path string) errhand.VerboseError {
+// dumpSchemaElements writes the non-table schema elements (views, triggers, procedures) of |root| to the file path
+// given. |asOf| is the revision of |root|, or empty for the working set.
+func dumpSchemaElements(ctx context.Context, dEnv *env.DoltEnv, root doltdb.RootValue, asOf string, path string) errhand.VerboseError {
 	writer, err := dEnv.FS.OpenForWriteAppend(path, os.ModePerm)
 	if err != nil {
 		return errhand.VerboseErrorFromError(err)
@@ -225,22 +274,17 @@ func dumpSchemaElements(ctx context.Context, dEnv *env.DoltEnv, path string) err
 	}
 	sqlCtx.SetCurrentDatabase(dbName)
 
-	root, err := dEnv.WorkingRoot(ctx)
-	if err != nil {
-		return errhand.VerboseErrorFromError(err)
-	}
-
-	err = dumpViews(sqlCtx, engine, root, writer)
+	err = dumpViews(sqlCtx, engine, root, asOf, writer)
 	if err != nil {
 		return errhand.VerboseErrorFromError(err)
 	}
 
-	err = dumpTriggers(sqlCtx, engine, root, writer)
+	err = dumpTriggers(sqlCtx, engine, root, asOf, writer)
 	if err != nil {
 		return errhand.VerboseErrorFromError(err)
 	}
 
-	err = dumpProcedures(sqlCtx, engine, root, writer)
+	err = dumpProcedures(sqlCtx, engine, root, asOf, writer)
 	if err != nil {
 		return errhand.VerboseErrorFromError(err)
 	}
@@ -253,7 +297,7 @@ func dumpSchemaElements(ctx context.Context, dEnv *env.DoltEnv, path string) err
 	return nil
 }
 
-func dumpProcedures(sqlCtx *sql.Context, engine *engine.SqlEngine, root doltdb.RootValue, writer io.WriteCloser) (rerr error) {
+func dumpProcedures(sqlCtx *sql.Context, engine *engine.SqlEngine, root doltdb.RootValue, asOf string, writer io.WriteCloser) (rerr error) {
 	_, _, ok, err := doltdb.GetTableInsensitive(sqlCtx, root, doltdb.TableName{Name: doltdb.ProceduresTableName})
 	if err != nil {
 		return err
@@ -263,7 +307,12 @@ func dumpProcedures(sqlCtx *sql.Context, engine *engine.SqlEngine, root doltdb.R
 		return nil
 	}
 
-	sch, iter, _, err := engine.Query(sqlCtx, "select * from "+doltdb.ProceduresTableName)
+	query, err := selectAllAsOf(doltdb.ProceduresTableName, asOf)
+	if err != nil {
+		return err
+	}
+
+	sch, iter, _, err := engine.Query(sqlCtx, query)
 	if err != nil {
 		return err
 	}
@@ -324,7 +373,7 @@ func dumpProcedures(sqlCtx *sql.Context, engine *engine.SqlEngine, root doltdb.R
 	return nil
 }
 
-func dumpTriggers(sqlCtx *sql.Context, engine *engine.SqlEngine, root doltdb.RootValue, writer io.WriteCloser) (rerr error) {
+func dumpTriggers(sqlCtx *sql.Context, engine *engine.SqlEngine, root doltdb.RootValue, asOf string, writer io.WriteCloser) (rerr error) {
 	_, _, ok, err := doltdb.GetTableInsensitive(sqlCtx, root, doltdb.TableName{Name: doltdb.SchemasTableName})
 	if err != nil {
 		return err
@@ -334,7 +383,12 @@ func dumpTriggers(sqlCtx *sql.Context, engine *engine.SqlEngine, root doltdb.Roo
 		return nil
 	}
 
-	sch, iter, _, err := engine.Query(sqlCtx, "select * from "+doltdb.SchemasTableName)
+	query, err := selectAllAsOf(doltdb.SchemasTableName, asOf)
+	if err != nil {
+		return err
+	}
+
+	sch, iter, _, err := engine.Query(sqlCtx, query)
 	if err != nil {
 		return err
 	}
@@ -390,7 +444,7 @@ func dumpTriggers(sqlCtx *sql.Context, engine *engine.SqlEngine, root doltdb.Roo
 	return nil
 }
 
-func dumpViews(ctx *sql.Context, engine *engine.SqlEngine, root doltdb.RootValue, writer io.WriteCloser) (rerr error) {
+func dumpViews(ctx *sql.Context, engine *engine.SqlEngine, root doltdb.RootValue, asOf string, writer io.WriteCloser) (rerr error) {
 	_, _, ok, err := doltdb.GetTableInsensitive(ctx, root, doltdb.TableName{Name: doltdb.SchemasTableName})
 	if err != nil {
 		return err
@@ -400,7 +454,12 @@ func dumpViews(ctx *sql.Context, engine *engine.SqlEngine, root doltdb.RootValue
 		return nil
 	}
 
-	sch, iter, _, err := engine.Query(ctx, "select * from "+doltdb.SchemasTableName)
+	query, err := selectAllAsOf(doltdb.SchemasTableName, asOf)
+	if err != nil {
+		return err
+	}
+
+	sch, iter, _, err := engine.Query(ctx, query)
 	if err != nil {
 		return err
 	}
@@ -472,6 +531,15 @@ func dumpViews(ctx *sql.Context, engine *engine.SqlEngine, root doltdb.RootValue
 	return nil
 }
 
+// selectAllAsOf returns a query for all rows of |tableName| as of the revision given, or in the working set if |asOf|
+// is empty
+func selectAllAsOf(tableName, asOf string) (string, error) {
+	if asOf == "" {
+		return "select * from " + tableName, nil
+	}
+	return dbr.InterpolateForDialect("select * from ? as of ?", []interface{}{dbr.I(tableName), asOf}, dialect.MySQL)
+}
+
 // changeSqlMode checks if the current SQL session's @@SQL_MODE is different from the requested |newSqlMode| and if so,
 // outputs a SQL statement to |writer| to save the current @@SQL_MODE to the @previousSqlMode variable and then outputs
 // a SQL statement to set the @@SQL_MODE to |sqlMode|. If |newSqlMode| is the identical to the current session's
@@ -523,6 +591,7 @@ type dumpOptions struct {
 
 type tableOptions struct {
 	tableName     string
+	asOf          string
 	schemaOnly    bool
 	dest          mvdata.DataLocation
 	batched       bool
@@ -559,14 +628,15 @@ func (m dumpOptions) DumpDestName() string {
 	return m.dest.String()
 }
 
-// dumpTable dumps table in file given specific table and file location info
-func dumpTable(ctx context.Context, dEnv *env.DoltEnv, tblOpts *tableOptions, filePath string) errhand.VerboseError {
-	rd, err := mvdata.NewSqlEngineReader(ctx, dEnv, tblOpts.tableName)
+// dumpTable dumps table in file given specific table and file location info. |root| is the root value the table is
+// read from, which must match the revision in |tblOpts|.
+func dumpTable(ctx context.Context, dEnv *env.DoltEnv, root doltdb.RootValue, tblOpts *tableOptions, filePath string) errhand.VerboseError {
+	rd, err := mvdata.NewSqlEngineReaderAsOf(ctx, dEnv, root, tblOpts.tableName, tblOpts.asOf)
 	if err != nil {
 		return errhand.BuildDError("Error creating reader for %s.", tblOpts.SrcName()).AddCause(err).Build()
 	}
 
-	wr, err := getTableWriter(ctx, dEnv, tblOpts, rd.GetSchema(), filePath)
+	wr, err := getTableWriter(ctx, dEnv, root, tblOpts, rd.GetSchema(), filePath)
 	if err != nil {
 		return errhand.BuildDError("Error creating writer for %s.", tblOpts.SrcName()).AddCause(err).Build()
 	}
@@ -590,7 +660,7 @@ func dumpTable(ctx context.Context, dEnv *env.DoltEnv, tblOpts *tableOptions, fi
 	return nil
 }
 
-func getTableWriter(ctx context.Context, dEnv *env.DoltEnv, tblOpts *tableOptions, outSch schema.Schema, filePath string) (table.SqlRowWriter, errhand.VerboseError) {
+func getTableWriter(ctx context.Context, dEnv *env.DoltEnv, root doltdb.RootValue, tblOpts *tableOptions, outSch schema.Schema, filePath string) (table.SqlRowWriter, errhand.VerboseError) {
 	tmpDir, err := dEnv.TempTableFilesDir()
 	if err != nil {
 		return nil, errhand.BuildDError("error: ").AddCause(err).Build()
@@ -602,11 +672,6 @@ func getTableWriter(ctx context.Context, dEnv *env.DoltEnv, tblOpts *tableOption
 		return nil, errhand.BuildDError("Error opening writer for %s.", tblOpts.DestName()).AddCause(err).Build()
 	}
 
-	root, err := dEnv.WorkingRoot(ctx)
-	if err != nil {
-		return nil, errhand.BuildDError("Could not create table writer for %s", tblOpts.tableName).AddCause(err).Build()
-	}
-
 	wr, err := tblOpts.dest.NewCreatingWriter(ctx, tblOpts, root, outSch, opts, writer)
 	if err != nil {
 		return nil, errhand.BuildDError("Could not create table writer for %s", tblOpts.tableName).AddCause(err).Build()
@@ -636,7 +701,14 @@ func checkAndCreateOpenDestFile(ctx context.Context, root doltdb.RootValue, dEnv
 		return emptyStr, errhand.VerboseErrorFromError(err)
 	}
 
-	os.OpenFile(filePath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, os.ModePerm)
+	f, err := os.OpenFile(filePath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, os.ModePerm)
+	if err != nil {
+		return emptyStr, errhand.VerboseErrorFromError(err)
+	}
+	err = f.Close()
+	if err != nil {
+		return emptyStr, errhand.VerboseErrorFromError(err)
+	}
 
 	return filePath, nil
 }
@@ -737,16 +809,10 @@ func newTableArgs(tblName string, destination mvdata.DataLocation, batched, auto
 }
 
 // dumpNonSqlTables returns nil if all tables is dumped successfully, and it returns err if there is one.
-// It handles only csv and json file types(rf).
-func dumpNonSqlTables(ctx context.Context, root doltdb.RootValue, dEnv *env.DoltEnv, force bool, tblNames []string, rf string, dirName string, batched bool) errhand.VerboseError {
+// It handles only csv and json file types(rf). |asOf| is the revision of |root|, or empty for the working set.
+func dumpNonSqlTables(ctx context.Context, root doltdb.RootValue, dEnv *env.DoltEnv, force bool, tblNames []string, rf string, dirName string, asOf string, batched bool) errhand.VerboseError {
 	var fName string
-	if dirName == emptyStr {
-		dirName = "doltdump/"
-	} else {
-		if !strings.HasSuffix(dirName, "/") {
-			dirName = fmt.Sprintf("%s/", dirName)
-		}
-	}
+	dirName = dumpDirName(dirName)
 
 	for _, tbl := range tblNames {
 		fName = fmt.Sprintf("%s%s.%s", dirName, tbl, rf)
@@ -758,8 +824,9 @@ func dumpNonSqlTables(ctx context.Context, root doltdb.RootValue, dEnv *env.Dolt
 		}
 
 		tblOpts := newTableArgs(tbl, dumpOpts.dest, batched, false, false)
+		tblOpts.asOf = asOf
 
-		err = dumpTable(ctx, dEnv, tblOpts, fPath)
+		err = dumpTable(ctx, dEnv, root, tblOpts, fPath)
 		if err != nil {
 			return err
 		}
@@ -767,6 +834,226 @@ func dumpNonSqlTables(ctx context.Context, root doltdb.RootValue, dEnv *env.Dolt
 	return nil
 }
 
+// getDumpRoot returns the root value of the revision given, or of the working set if |revision| is empty
+func getDumpRoot(ctx context.Context, dEnv *env.DoltEnv, revision string) (doltdb.RootValue, errhand.VerboseError) {
+	if revision == "" {
+		return GetWorkingWithVErr(dEnv)
+	}
+
+	cm, verr := MaybeGetCommitWithVErr(dEnv, revision)
+	if verr != nil {
+		return nil, verr
+	}
+	if cm == nil {
+		return nil, errhand.BuildDError("error: unable to resolve revision '%s'", revision).Build()
+	}
+
+	root, err := cm.GetRootValue(ctx)
+	if err != nil {
+		return nil, errhand.BuildDError("error: unable to read revision '%s'", revision).AddCause(err).Build()
+	}
+	return root, nil
+}
+
+// dumpSqlChanges appends to the file path given the SQL statements that apply the changes made between the revisions
+// |since| and |asOf|. An empty |asOf| means the working set.
+func dumpSqlChanges(ctx context.Context, dEnv *env.DoltEnv, since, asOf string, schemaOnly bool, path string) errhand.VerboseError {
+	writer, err := dEnv.FS.OpenForWriteAppend(path, os.ModePerm)
+	if err != nil {
+		return errhand.VerboseErrorFromError(err)
+	}
+
+	diffParts := SchemaAndDataDiff
+	if schemaOnly {
+		diffParts = SchemaOnlyDiff
+	}
+	return dumpChanges(ctx, dEnv, since, asOf, diffParts, SQLDiffOutput, newSqlDiffWriter(writer))
+}
+
+// dumpCsvChanges writes a csv file to the directory given for each table with rows changed between the revisions
+// |since| and |asOf|. An empty |asOf| means the working set.
+func dumpCsvChanges(ctx context.Context, root doltdb.RootValue, dEnv *env.DoltEnv, force bool, since, asOf string, dirName string) errhand.VerboseError {
+	dirName = dumpDirName(dirName)
+	dw := newCsvDiffWriter(func(tableName string) (io.WriteCloser, error) {
+		fName := fmt.Sprintf("%s%s.%s", dirName, tableName, csvFileExt)
+		dumpOpts := getDumpOptions(fName, csvFileExt, false)
+		fPath, verr := checkAndCreateOpenDestFile(ctx, root, dEnv, force, dumpOpts, fName)
+		if verr != nil {
+			return nil, verr
+		}
+		return dEnv.FS.OpenForWriteAppend(fPath, os.ModePerm)
+	})
+
+	return dumpChanges(ctx, dEnv, since, asOf, DataOnlyDiff, CsvDiffOutput, dw)
+}
+
+// dumpChanges writes the diff of every table between the revisions |since| and |asOf| to |dw|, the same way dolt diff
+// does, and closes it.
+func dumpChanges(ctx context.Context, dEnv *env.DoltEnv, since, asOf string, diffParts diffPart, output diffOutput, dw diffWriter) (verr errhand.VerboseError) {
+	// writeUserTableDiffs only closes |dw| once every diff was written
+	defer func() {
+		if verr != nil {
+			_ = dw.Close(ctx)
+		}
+	}()
+
+	if asOf == "" {
+		asOf = doltdb.Working
+	}
+
+	engine, dbName, err := engine.NewSqlEngineForEnv(ctx, dEnv)
+	if err != nil {
+		return errhand.VerboseErrorFromError(err)
+	}
+
+	sqlCtx, err := engine.NewLocalContext(ctx)
+	if err != nil {
+		return errhand.VerboseErrorFromError(err)
+	}
+	sqlCtx.SetCurrentDatabase(dbName)
+
+	datasets := &diffDatasets{fromRef: since, toRef: asOf}
+	tableSet, err := parseDiffTableSetSql(engine, sqlCtx, datasets, nil)
+	if err != nil {
+		return errhand.VerboseErrorFromError(err)
+	}
+
+	deltas, err := getDeltasBetweenRefs(engine, sqlCtx, since, asOf)
+	if err != nil {
+		return errhand.BuildDError("error: unable to get changes since '%s'", since).AddCause(err).Build()
+	}
+
+	dArgs := &diffArgs{
+		diffDisplaySettings: &diffDisplaySettings{
+			diffParts:  diffParts,
+			diffOutput: output,
+			diffMode:   diff.ModeRow,
+			limit:      -1,
+			// an incremental dump must contain every changed row, so rows of tables with schema changes are
+			// written in terms of the new schema
+			sqlSchemaChanges: true,
+		},
+		diffDatasets: datasets,
+		tableSet:     tableSet,
+	}
+	return writeUserTableDiffs(engine, sqlCtx, deltas, dArgs, dw)
+}
+
+// csvDiffWriter is a diffWriter for incremental csv dumps. Each table with changed rows is written to its own file,
+// opened on the first changed row. Schema changes are not written, since csv can't express them.
+type csvDiffWriter struct {
+	openTableFile func(tableName string) (io.WriteCloser, error)
+}
+
+var _ diffWriter = (*csvDiffWriter)(nil)
+
+func newCsvDiffWriter(openTableFile func(tableName string) (io.WriteCloser, error)) *csvDiffWriter {
+	return &csvDiffWriter{openTableFile: openTableFile}
+}
+
+func (c csvDiffWriter) BeginTable(fromTableName, toTableName string, isAdd, isDrop bool) error {
+	return nil
+}
+
+func (c csvDiffWriter) WriteTableSchemaDiff(fromTableInfo, toTableInfo *diff.TableInfo, tds diff.TableDeltaSummary) error {
+	return nil
+}
+
+func (c csvDiffWriter) WriteEventDiff(ctx context.Context, eventName, oldDefn, newDefn string) error {
+	return nil
+}
+
+func (c csvDiffWriter) WriteTriggerDiff(ctx context.Context, triggerName, oldDefn, newDefn string) error {
+	return nil
+}
+
+func (c csvDiffWriter) WriteViewDiff(ctx context.Context, viewName, oldDefn, newDefn string) error {
+	return nil
+}
+
+func (c csvDiffWriter) WriteTableDiffStats(diffStats []diffStatistics, oldColLen, newColLen int, areTablesKeyless bool) error {
+	return errors.New("diff stats are not supported for csv output")
+}
+
+func (c csvDiffWriter) RowWriter(fromTableInfo, toTableInfo *diff.TableInfo, tds diff.TableDeltaSummary, unionSch sql.Schema) (diff.SqlRowDiffWriter, error) {
+	// TODO: schema names
+	tableName := tds.ToTableName.Name
+	if tableName == "" {
+		tableName = tds.FromTableName.Name
+	}
+
+	sch := append(sql.Schema{&sql.Column{Name: "diff_type", Type: types.Text}}, unionSch...)
+	return &csvDiffRowWriter{
+		open: func() (io.WriteCloser, error) {
+			return c.openTableFile(tableName)
+		},
+		sch: sch,
+	}, nil
+}
+
+func (c csvDiffWriter) Close(ctx context.Context) error {
+	return nil
+}
+
+// csvDiffRowWriter writes the changed rows of a single table as csv, preceded by their diff type. The old version of
+// a modified row is skipped, leaving the rows needed to apply the change.
+type csvDiffRowWriter struct {
+	open func() (io.WriteCloser, error)
+	sch  sql.Schema
+	wr   *csv.CSVWriter
+}
+
+var _ diff.SqlRowDiffWriter = (*csvDiffRowWriter)(nil)
+
+func (w *csvDiffRowWriter) WriteRow(ctx context.Context, row sql.Row, rowDiffType diff.ChangeType, colDiffTypes []diff.ChangeType) error {
+	var diffType string
+	switch rowDiffType {
+	case diff.Added:
+		diffType = "added"
+	case diff.ModifiedNew:
+		diffType = "modified"
+	case diff.Removed:
+		diffType = "removed"
+	default:
+		return nil
+	}
+
+	if w.wr == nil {
+		f, err := w.open()
+		if err != nil {
+			return err
+		}
+		w.wr, err = csv.NewCSVSqlWriter(f, w.sch, csv.NewCSVInfo())
+		if err != nil {
+			return err
+		}
+	}
+
+	return w.wr.WriteSqlRow(ctx, append(sql.Row{diffType}, row...))
+}
+
+func (w *csvDiffRowWriter) WriteCombinedRow(ctx context.Context, oldRow, newRow sql.Row, mode diff.Mode) error {
+	return fmt.Errorf("csv format is unable to output diffs for combined rows")
+}
+
+func (w *csvDiffRowWriter) Close(ctx context.Context) error {
+	if w.wr == nil {
+		return nil
+	}
+	return w.wr.Close(ctx)
+}
+
+// dumpDirName returns the directory that non-SQL dump files are written to, with a trailing slash
+func dumpDirName(dirName string) string {
+	if dirName == emptyStr {
+		return "doltdump/"
+	}
+	if !strings.HasSuffix(dirName, "/") {
+		return fmt.Sprintf("%s/", dirName)
+	}
+	return dirName
+}
+
 // addBulkLoadingParadigms adds statements that are used to expedite dump file ingestion.
 // cc. https://dev.mysql.com/doc/refman/8.0/en/optimizing-innodb-bulk-data-loading.html
 // This includes turning off FOREIGN_KEY_CHECKS and UNIQUE_CHECKS off at the beginning of the file.
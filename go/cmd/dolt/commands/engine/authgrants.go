@@ -0,0 +1,262 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+)
+
+// branchGrant is an entry of dolt_branch_control granted to an account.
+type branchGrant struct {
+	database string
+	branch   string
+	perms    branch_control.Permissions
+}
+
+// key returns the database and branch of the grant as they are stored in dolt_branch_control.
+func (g branchGrant) key() branchKey {
+	return branchKey{
+		database: strings.ToLower(branch_control.FoldExpression(g.database)),
+		branch:   strings.ToLower(branch_control.FoldExpression(g.branch)),
+	}
+}
+
+type branchKey struct {
+	database string
+	branch   string
+}
+
+// accountGrants are the roles and branch permissions an account is granted out of the ones managed by an
+// authentication plugin. The managed roles and branch permissions which are not granted are revoked.
+type accountGrants struct {
+	managedRoles    []string
+	roles           []string
+	managedBranches []branchGrant
+	branches        []branchGrant
+	// provision is the account to create for a user who logged in through an anonymous account, or nil if they may not
+	// be given an account of their own.
+	provision *mysql_db.User
+}
+
+// authenticatedGrants holds the grants which authentication plugins resolved for accounts as they logged in, until they
+// are applied to the account when the session of its connection is created. They cannot be applied while the account
+// is being authenticated, since the privileges are locked for reading then.
+type authenticatedGrants struct {
+	mu      sync.Mutex
+	pending map[mysql_db.UserPrimaryKey]accountGrants
+}
+
+func newAuthenticatedGrants() *authenticatedGrants {
+	return &authenticatedGrants{pending: make(map[mysql_db.UserPrimaryKey]accountGrants)}
+}
+
+// set records |grants| as the grants of |user| logging in to the account with the host |host|, replacing any which were
+// not applied yet. The account is an anonymous one when users are provisioned from it.
+func (g *authenticatedGrants) set(user, host string, grants accountGrants) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.pending[mysql_db.UserPrimaryKey{User: user, Host: host}] = grants
+}
+
+func (g *authenticatedGrants) take(key mysql_db.UserPrimaryKey) (accountGrants, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	grants, ok := g.pending[key]
+	delete(g.pending, key)
+	return grants, ok
+}
+
+// apply provisions the account of |user| logging in from |host| if needed, then grants and revokes the roles and branch
+// permissions resolved for it. The privileges and branch permissions are persisted with a context from |newCtx| if that
+// changed any of them.
+func (g *authenticatedGrants) apply(db *mysql_db.MySQLDb, controller *branch_control.Controller, user, host string, newCtx func() (*sql.Context, error)) error {
+	rd := db.Reader()
+	entry := db.GetUser(rd, user, host, false)
+	rd.Close()
+	if entry == nil {
+		return nil
+	}
+	grants, ok := g.take(mysql_db.UserPrimaryKey{User: user, Host: entry.Host})
+	if !ok {
+		return nil
+	}
+	if entry.User == "" && grants.provision == nil {
+		if len(grants.roles) > 0 || len(grants.branches) > 0 {
+			logrus.Warnf("unable to grant roles or branch permissions to '%s': they logged in through the anonymous account ''@'%s'", user, entry.Host)
+		}
+		return nil
+	}
+
+	var ctx *sql.Context
+	getCtx := func() (*sql.Context, error) {
+		var err error
+		if ctx == nil {
+			ctx, err = newCtx()
+		}
+		return ctx, err
+	}
+
+	entry, err := applyAccountGrants(db, entry, grants, getCtx)
+	if err != nil {
+		return err
+	}
+	if controller == nil || len(grants.managedBranches) == 0 {
+		return nil
+	}
+	if !applyBranchGrants(controller, entry, grants) {
+		return nil
+	}
+	sqlCtx, err := getCtx()
+	if err != nil {
+		return err
+	}
+	return branch_control.SaveData(sqlCtx)
+}
+
+// applyAccountGrants creates the account of |grants| if |entry| is anonymous, and grants and revokes its managed roles.
+// It returns the account the grants were applied to.
+func applyAccountGrants(db *mysql_db.MySQLDb, entry *mysql_db.User, grants accountGrants, newCtx func() (*sql.Context, error)) (*mysql_db.User, error) {
+	ed := db.Editor()
+	defer ed.Close()
+
+	changed := false
+	if entry.User == "" {
+		if existing, ok := ed.GetUser(mysql_db.UserPrimaryKey{User: grants.provision.User, Host: grants.provision.Host}); ok {
+			entry = existing
+		} else {
+			account := *grants.provision
+			account.PasswordLastChanged = time.Now().UTC()
+			ed.PutUser(&account)
+			entry = &account
+			changed = true
+		}
+	}
+
+	granted := make(map[string]bool, len(grants.roles))
+	for _, role := range grants.roles {
+		granted[role] = true
+	}
+	current := make(map[mysql_db.UserPrimaryKey]bool)
+	for _, edge := range ed.GetToUserRoleEdges(mysql_db.RoleEdgesToKey{ToHost: entry.Host, ToUser: entry.User}) {
+		current[mysql_db.UserPrimaryKey{User: edge.FromUser, Host: edge.FromHost}] = true
+	}
+
+	for _, name := range sortedUnique(grants.managedRoles) {
+		role := db.GetUser(ed, name, "%", true)
+		if role == nil {
+			if granted[name] {
+				logrus.Warnf("unable to grant role '%s' to '%s'@'%s': the role does not exist", name, entry.User, entry.Host)
+			}
+			continue
+		}
+		key := mysql_db.UserPrimaryKey{User: role.User, Host: role.Host}
+		if granted[name] && !current[key] {
+			ed.PutRoleEdge(&mysql_db.RoleEdge{
+				FromHost: role.Host,
+				FromUser: role.User,
+				ToHost:   entry.Host,
+				ToUser:   entry.User,
+			})
+			changed = true
+		} else if !granted[name] && current[key] {
+			ed.RemoveRoleEdge(mysql_db.RoleEdgesPrimaryKey{
+				FromHost: role.Host,
+				FromUser: role.User,
+				ToHost:   entry.Host,
+				ToUser:   entry.User,
+			})
+			changed = true
+		}
+	}
+	if !changed {
+		return entry, nil
+	}
+	ctx, err := newCtx()
+	if err != nil {
+		return nil, err
+	}
+	return entry, db.Persist(ctx, ed)
+}
+
+// applyBranchGrants inserts and deletes the managed entries of dolt_branch_control for the account |entry|, so that it
+// has exactly the ones in |grants|. Permissions granted for the same database and branch by several grants are
+// combined. It returns whether any entry changed.
+func applyBranchGrants(controller *branch_control.Controller, entry *mysql_db.User, grants accountGrants) bool {
+	granted := make(map[branchKey]branchGrant)
+	for _, grant := range grants.branches {
+		key := grant.key()
+		if existing, ok := granted[key]; ok {
+			grant.perms |= existing.perms
+		}
+		granted[key] = grant
+	}
+
+	user := branch_control.FoldExpression(entry.User)
+	host := strings.ToLower(branch_control.FoldExpression(entry.Host))
+
+	controller.Access.RWMutex.Lock()
+	defer controller.Access.RWMutex.Unlock()
+
+	current := make(map[branchKey]branch_control.Permissions)
+	iter := controller.Access.Iter()
+	for row, ok := iter.Next(); ok; row, ok = iter.Next() {
+		if row.User == user && row.Host == host && row.Table == branch_control.AllTables {
+			current[branchKey{database: row.Database, branch: row.Branch}] = row.Permissions
+		}
+	}
+
+	changed := false
+	seen := make(map[branchKey]bool)
+	for _, managed := range grants.managedBranches {
+		key := managed.key()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		perms, exists := current[key]
+		if grant, ok := granted[key]; ok {
+			if !exists || perms != grant.perms {
+				controller.Access.Insert(grant.database, grant.branch, entry.User, entry.Host, branch_control.AllTables, "", grant.perms)
+				changed = true
+			}
+		} else if exists {
+			controller.Access.Delete(managed.database, managed.branch, entry.User, entry.Host, branch_control.AllTables)
+			changed = true
+		}
+	}
+	return changed
+}
+
+func sortedUnique(names []string) []string {
+	seen := make(map[string]struct{}, len(names))
+	unique := make([]string, 0, len(names))
+	for _, name := range names {
+		if _, ok := seen[name]; !ok {
+			seen[name] = struct{}{}
+			unique = append(unique, name)
+		}
+	}
+	sort.Strings(unique)
+	return unique
+}
@@ -17,19 +17,25 @@ package engine
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
 	"github.com/sirupsen/logrus"
 
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
 	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
 	"github.com/dolthub/dolt/go/libraries/utils/jwtauth"
 )
 
-// authenticateDoltJWTPlugin is used to authenticate plaintext user plugins
+// authenticateDoltJWTPlugin is used to authenticate plaintext user plugins. A user may also log in through an anonymous
+// account, which has an empty user name, created with the plugin. Their token's subject must be their user name then.
 type authenticateDoltJWTPlugin struct {
+	mu         sync.RWMutex
 	jwksConfig []servercfg.JwksConfig
+	grants     *authenticatedGrants
 }
 
 func NewAuthenticateDoltJWTPlugin(jwksConfig []servercfg.JwksConfig) mysql_db.PlaintextAuthPlugin {
@@ -37,36 +43,90 @@ func NewAuthenticateDoltJWTPlugin(jwksConfig []servercfg.JwksConfig) mysql_db.Pl
 }
 
 func (p *authenticateDoltJWTPlugin) Authenticate(db *mysql_db.MySQLDb, user string, userEntry *mysql_db.User, pass string) (bool, error) {
-	return validateJWT(p.jwksConfig, user, userEntry.Identity, pass, time.Now())
+	return p.authenticate(user, userEntry, pass, time.Now())
+}
+
+func (p *authenticateDoltJWTPlugin) authenticate(user string, userEntry *mysql_db.User, token string, reqTime time.Time) (bool, error) {
+	p.mu.RLock()
+	jwksConfig := p.jwksConfig
+	p.mu.RUnlock()
+
+	identity := userEntry.Identity
+	if userEntry.User == "" {
+		// The identity cannot hold a subject which contains its separators
+		if user == "" || strings.ContainsAny(user, ",=") {
+			return false, nil
+		}
+		identity = withSubject(identity, user)
+	}
+	claims, config, err := validateJWTClaims(jwksConfig, user, identity, token, reqTime)
+	if err != nil {
+		return false, err
+	}
+	if p.grants == nil {
+		return true, nil
+	}
+
+	grants := mappedClaims(config.ClaimMappings, claims.Private)
+	if userEntry.User == "" && config.ProvisionUsers() {
+		grants.provision = &mysql_db.User{
+			User:         user,
+			Host:         userEntry.Host,
+			PrivilegeSet: mysql_db.NewPrivilegeSet(),
+			Plugin:       userEntry.Plugin,
+			Identity:     identity,
+		}
+	}
+	if len(grants.managedRoles) > 0 || len(grants.managedBranches) > 0 || grants.provision != nil {
+		p.grants.set(user, userEntry.Host, grants)
+	}
+	return true, nil
+}
+
+// setJwksConfig replaces the JWKS servers used to validate the JWTs of future logins.
+func (p *authenticateDoltJWTPlugin) setJwksConfig(jwksConfig []servercfg.JwksConfig) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.jwksConfig = jwksConfig
 }
 
 func validateJWT(config []servercfg.JwksConfig, username, identity, token string, reqTime time.Time) (bool, error) {
+	_, _, err := validateJWTClaims(config, username, identity, token, reqTime)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// validateJWTClaims validates |token| against the claims expected by |identity|, and returns the token's claims along
+// with the config of the JWKS it was validated with.
+func validateJWTClaims(config []servercfg.JwksConfig, username, identity, token string, reqTime time.Time) (*jwtauth.Claims, *servercfg.JwksConfig, error) {
 	if len(config) == 0 {
-		return false, errors.New("ValidateJWT: JWKS server config not found")
+		return nil, nil, errors.New("ValidateJWT: JWKS server config not found")
 	}
 
 	expectedClaimsMap := parseUserIdentity(identity)
 	sub, ok := expectedClaimsMap["sub"]
 	if ok && sub != username {
-		return false, errors.New("ValidateJWT: Subjects do not match")
+		return nil, nil, errors.New("ValidateJWT: Subjects do not match")
 	}
 
 	jwksConfig, err := getMatchingJwksConfig(config, expectedClaimsMap["jwks"])
 	if err != nil {
-		return false, err
+		return nil, nil, err
 	}
 
 	pr, err := getJWTProvider(expectedClaimsMap, jwksConfig.LocationUrl)
 	if err != nil {
-		return false, err
+		return nil, nil, err
 	}
 	vd, err := jwtauth.NewJWTValidator(pr)
 	if err != nil {
-		return false, err
+		return nil, nil, err
 	}
 	claims, err := vd.ValidateJWT(token, reqTime)
 	if err != nil {
-		return false, err
+		return nil, nil, err
 	}
 
 	logString := "Authenticating with JWT: "
@@ -74,7 +134,87 @@ func validateJWT(config []servercfg.JwksConfig, username, identity, token string
 		logString += fmt.Sprintf("%s: %s,", field, getClaimFromKey(claims, field))
 	}
 	logrus.Info(logString)
-	return true, nil
+	return claims, jwksConfig, nil
+}
+
+// mappedClaims returns the roles and branch permissions which a user with |claims| is granted by |mappings|, out of all
+// of the ones they grant.
+func mappedClaims(mappings []servercfg.JwksClaimMapping, claims map[string]interface{}) accountGrants {
+	var grants accountGrants
+	for _, m := range mappings {
+		matches := claimHasValue(lookupClaim(claims, m.Claim()), m.Value())
+		grants.managedRoles = append(grants.managedRoles, m.Roles...)
+		if matches {
+			grants.roles = append(grants.roles, m.Roles...)
+		}
+		for _, entry := range m.BranchControl {
+			grant := branchGrant{database: entry.Database(), branch: entry.Branch()}
+			for _, perm := range entry.Permissions() {
+				switch perm {
+				case "admin":
+					grant.perms |= branch_control.Permissions_Admin
+				case "write":
+					grant.perms |= branch_control.Permissions_Write
+				case "read":
+					grant.perms |= branch_control.Permissions_Read
+				}
+			}
+			grants.managedBranches = append(grants.managedBranches, grant)
+			if matches {
+				grants.branches = append(grants.branches, grant)
+			}
+		}
+	}
+	return grants
+}
+
+// lookupClaim returns the claim |name| of |claims|, or nil if there is no such claim. A name which is not a claim itself
+// may be the dotted path of a claim nested in objects.
+func lookupClaim(claims map[string]interface{}, name string) interface{} {
+	if val, ok := claims[name]; ok {
+		return val
+	}
+	for i := 0; i < len(name); i++ {
+		if name[i] != '.' {
+			continue
+		}
+		if nested, ok := claims[name[:i]].(map[string]interface{}); ok {
+			if val := lookupClaim(nested, name[i+1:]); val != nil {
+				return val
+			}
+		}
+	}
+	return nil
+}
+
+// claimHasValue returns whether |claim|, or any element of it if it is a list, is equal to |value|.
+func claimHasValue(claim interface{}, value string) bool {
+	switch claim := claim.(type) {
+	case string:
+		return claim == value
+	case float64:
+		return strconv.FormatFloat(claim, 'f', -1, 64) == value
+	case bool:
+		return strconv.FormatBool(claim) == value
+	case []interface{}:
+		for _, elem := range claim {
+			if claimHasValue(elem, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// withSubject returns |identity| with its expected subject replaced by |sub|.
+func withSubject(identity, sub string) string {
+	var items []string
+	for _, item := range strings.Split(identity, ",") {
+		if item != "" && !strings.HasPrefix(item, "sub=") {
+			items = append(items, item)
+		}
+	}
+	return strings.Join(append(items, "sub="+sub), ",")
 }
 
 func getJWTProvider(expectedClaimsMap map[string]string, url string) (jwtauth.JWTProvider, error) {
@@ -15,10 +15,16 @@
 package engine
 
 import (
+	"context"
 	"fmt"
+	"sort"
 	"testing"
 	"time"
 
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
 	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
 
 	"github.com/stretchr/testify/require"
@@ -78,3 +84,152 @@ func TestJWTAuth(t *testing.T) {
 	require.Error(t, err)
 	require.False(t, authed)
 }
+
+func TestJWTClaimMappings(t *testing.T) {
+	jwksConfig := []servercfg.JwksConfig{
+		{
+			Name:            jwksName,
+			LocationUrl:     "file:///testdata/test_jwks.json",
+			ProvisionUsers_: ptr(true),
+			ClaimMappings: []servercfg.JwksClaimMapping{
+				{
+					Claim_: ptr("on_behalf_of"),
+					Value_: ptr(onBehalfOf),
+					Roles:  []string{"developer"},
+					BranchControl: []servercfg.JwksBranchControlEntry{
+						{Branch_: ptr("main"), Permissions_: ptr("write")},
+					},
+				},
+				{
+					Claim_: ptr("aud"),
+					Value_: ptr(aud),
+					Roles:  []string{"reader"},
+					BranchControl: []servercfg.JwksBranchControlEntry{
+						{Branch_: ptr("main"), Permissions_: ptr("read")},
+					},
+				},
+				{
+					Claim_: ptr("iss"),
+					Value_: ptr("example.com"),
+					Roles:  []string{"dba"},
+					BranchControl: []servercfg.JwksBranchControlEntry{
+						{Database_: ptr("mydb"), Branch_: ptr("release/%"), Permissions_: ptr("admin")},
+					},
+				},
+			},
+		},
+	}
+	grants := newAuthenticatedGrants()
+	plugin := &authenticateDoltJWTPlugin{jwksConfig: jwksConfig, grants: grants}
+
+	db := mysql_db.CreateEmptyMySQLDb()
+	db.SetPersister(&mysql_db.NoopPersister{})
+	ed := db.Editor()
+	for _, u := range []*mysql_db.User{
+		{User: "", Host: "%", Plugin: "authentication_dolt_jwt", Identity: fmt.Sprintf("jwks=%s,iss=%s,aud=%s", jwksName, iss, aud), PrivilegeSet: mysql_db.NewPrivilegeSet()},
+		{User: "developer", Host: "%", Locked: true, IsRole: true, PrivilegeSet: mysql_db.NewPrivilegeSet()},
+		{User: "reader", Host: "%", Locked: true, IsRole: true, PrivilegeSet: mysql_db.NewPrivilegeSet()},
+		{User: "dba", Host: "%", Locked: true, IsRole: true, PrivilegeSet: mysql_db.NewPrivilegeSet()},
+	} {
+		ed.PutUser(u)
+	}
+	ed.Close()
+
+	controller := branch_control.CreateDefaultController(context.Background())
+	// Branch permissions which are no longer granted by the token are revoked
+	controller.Access.Insert("mydb", "release/%", sub, "%", branch_control.AllTables, "", branch_control.Permissions_Admin)
+
+	tokenCreated := time.Date(2022, 07, 20, 0, 12, 0, 0, time.UTC)
+	login := func(user string) (bool, error) {
+		rd := db.Reader()
+		entry := db.GetUser(rd, user, "127.0.0.1", false)
+		rd.Close()
+		authed, err := plugin.authenticate(user, entry, jwt, tokenCreated)
+		if err != nil || !authed {
+			return authed, err
+		}
+		return true, grants.apply(db, controller, user, "127.0.0.1", func() (*sql.Context, error) {
+			return sql.NewEmptyContext(), nil
+		})
+	}
+
+	// The token's subject must be the name of a user logging in through the anonymous account
+	authed, err := login("someone_else")
+	require.Error(t, err)
+	require.False(t, authed)
+
+	for i := 0; i < 2; i++ {
+		authed, err = login(sub)
+		require.NoError(t, err)
+		require.True(t, authed)
+
+		rd := db.Reader()
+		account, ok := rd.GetUser(mysql_db.UserPrimaryKey{User: sub, Host: "%"})
+		rd.Close()
+		require.True(t, ok)
+		require.Equal(t, fmt.Sprintf("jwks=%s,iss=%s,aud=%s,sub=%s", jwksName, iss, aud, sub), account.Identity)
+		require.Equal(t, []string{"developer", "reader"}, grantedRoles(db, sub))
+		require.Equal(t, []branch_control.AccessRow{
+			{Database: "%", Branch: "main", User: sub, Host: "%", Table: branch_control.AllTables, Permissions: branch_control.Permissions_Write | branch_control.Permissions_Read},
+		}, branchRows(controller, sub))
+	}
+
+	// Branch permissions which changed replace the ones granted before
+	jwksConfig[0].ClaimMappings[0].BranchControl[0].Permissions_ = ptr("admin")
+	authed, err = login(sub)
+	require.NoError(t, err)
+	require.True(t, authed)
+	require.Equal(t, []branch_control.AccessRow{
+		{Database: "%", Branch: "main", User: sub, Host: "%", Table: branch_control.AllTables, Permissions: branch_control.Permissions_Admin | branch_control.Permissions_Read},
+	}, branchRows(controller, sub))
+
+	rd := db.Reader()
+	_, ok := rd.GetUser(mysql_db.UserPrimaryKey{User: "someone_else", Host: "%"})
+	rd.Close()
+	require.False(t, ok)
+}
+
+func TestClaimHasValue(t *testing.T) {
+	claims := map[string]interface{}{
+		"groups":                   []interface{}{"analysts", "developers"},
+		"admin":                    true,
+		"level":                    float64(3),
+		"realm_access":             map[string]interface{}{"roles": []interface{}{"dba"}},
+		"https://example.com/team": "platform",
+	}
+	tests := []struct {
+		claim   string
+		value   string
+		matches bool
+	}{
+		{"groups", "developers", true},
+		{"groups", "admins", false},
+		{"admin", "true", true},
+		{"level", "3", true},
+		{"realm_access.roles", "dba", true},
+		{"realm_access.groups", "dba", false},
+		{"https://example.com/team", "platform", true},
+		{"missing", "", false},
+	}
+	for _, test := range tests {
+		t.Run(test.claim+"="+test.value, func(t *testing.T) {
+			require.Equal(t, test.matches, claimHasValue(lookupClaim(claims, test.claim), test.value))
+		})
+	}
+}
+
+func branchRows(controller *branch_control.Controller, user string) []branch_control.AccessRow {
+	controller.Access.RWMutex.RLock()
+	defer controller.Access.RWMutex.RUnlock()
+	var rows []branch_control.AccessRow
+	iter := controller.Access.Iter()
+	for row, ok := iter.Next(); ok; row, ok = iter.Next() {
+		if row.User == user {
+			rows = append(rows, row)
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].Database < rows[j].Database || rows[i].Database == rows[j].Database && rows[i].Branch < rows[j].Branch
+	})
+	return rows
+}
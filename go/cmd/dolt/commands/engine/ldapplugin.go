@@ -0,0 +1,202 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+	"github.com/go-ldap/ldap/v3"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+)
+
+// LDAPPluginName is the name of the plugin which users authenticated by an LDAP server are created with, as in
+// CREATE USER alice IDENTIFIED WITH authentication_ldap_simple. A user created with an authentication string, as in
+// IDENTIFIED WITH authentication_ldap_simple AS 'uid=alice,ou=people,dc=example,dc=com', binds as that DN instead of
+// the DN given by the bind DN template.
+const LDAPPluginName = "authentication_ldap_simple"
+
+// authenticateLDAPPlugin is used to authenticate users by binding to an LDAP server as them
+type authenticateLDAPPlugin struct {
+	mu     sync.RWMutex
+	config servercfg.LDAPConfig
+	grants *authenticatedGrants
+}
+
+var _ mysql_db.PlaintextAuthPlugin = &authenticateLDAPPlugin{}
+
+func (p *authenticateLDAPPlugin) Authenticate(db *mysql_db.MySQLDb, user string, userEntry *mysql_db.User, pass string) (bool, error) {
+	p.mu.RLock()
+	config := p.config
+	p.mu.RUnlock()
+	if config == nil {
+		return false, errors.New("LDAP server config not found")
+	}
+
+	authed, groups, err := authenticateLDAP(config, user, userEntry.Identity, pass)
+	if err != nil || !authed {
+		return false, err
+	}
+
+	gs := config.GroupSearch()
+	if gs == nil {
+		return true, nil
+	}
+	if required := gs.RequiredGroups(); len(required) > 0 && len(matchingGroups(groups, required)) == 0 {
+		return false, nil
+	}
+	if groupRoles := gs.GroupRoles(); len(groupRoles) > 0 {
+		p.grants.set(user, userEntry.Host, mappedRoles(groupRoles, groups))
+	}
+	return true, nil
+}
+
+// setConfig replaces the LDAP server configuration used to authenticate future logins.
+func (p *authenticateLDAPPlugin) setConfig(config servercfg.LDAPConfig) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.config = config
+}
+
+// authenticateLDAP binds to the LDAP server of |config| as |user| with the password |pass|, and returns whether the
+// bind succeeded along with the names of the user's groups, if |config| has a group search. The user binds as
+// |identity| if it is not empty, and otherwise as the DN given by the bind DN template.
+func authenticateLDAP(config servercfg.LDAPConfig, user, identity, pass string) (bool, []string, error) {
+	// An LDAP server accepts a bind with an empty password as an anonymous bind, which must not authenticate anyone.
+	if pass == "" {
+		return false, nil, nil
+	}
+
+	bindDN := identity
+	if bindDN == "" {
+		bindDN = strings.ReplaceAll(config.BindDNTemplate(), servercfg.LDAPUserPlaceholder, ldap.EscapeDN(user))
+	}
+
+	conn, err := dialLDAP(config)
+	if err != nil {
+		return false, nil, err
+	}
+	defer conn.Close()
+
+	if err = conn.Bind(bindDN, pass); ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+		return false, nil, nil
+	} else if err != nil {
+		return false, nil, err
+	}
+
+	gs := config.GroupSearch()
+	if gs == nil {
+		return true, nil, nil
+	}
+	filter := strings.NewReplacer(
+		servercfg.LDAPDNPlaceholder, ldap.EscapeFilter(bindDN),
+		servercfg.LDAPUserPlaceholder, ldap.EscapeFilter(user),
+	).Replace(gs.Filter())
+	timeLimit := int(time.Duration(config.TimeoutMillis()) * time.Millisecond / time.Second)
+	res, err := conn.Search(ldap.NewSearchRequest(
+		gs.BaseDN(), ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, timeLimit, false,
+		filter, []string{gs.NameAttribute()}, nil,
+	))
+	if err != nil {
+		return false, nil, fmt.Errorf("LDAP group search failed: %w", err)
+	}
+	groups := make([]string, 0, len(res.Entries))
+	for _, entry := range res.Entries {
+		if name := entry.GetAttributeValue(gs.NameAttribute()); name != "" {
+			groups = append(groups, name)
+		}
+	}
+	return true, groups, nil
+}
+
+func dialLDAP(config servercfg.LDAPConfig) (*ldap.Conn, error) {
+	timeout := time.Duration(config.TimeoutMillis()) * time.Millisecond
+	tlsConfig, err := ldapTLSConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := ldap.DialURL(config.URL(), ldap.DialWithDialer(&net.Dialer{Timeout: timeout}), ldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, err
+	}
+	conn.SetTimeout(timeout)
+	if config.StartTLS() {
+		if err = conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+func ldapTLSConfig(config servercfg.LDAPConfig) (*tls.Config, error) {
+	u, err := url.Parse(config.URL())
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{
+		ServerName:         u.Hostname(),
+		InsecureSkipVerify: config.InsecureSkipVerify(),
+	}
+	if config.TLSCA() != "" {
+		pem, err := os.ReadFile(config.TLSCA())
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in ldap.tls_ca %s", config.TLSCA())
+		}
+	}
+	return tlsConfig, nil
+}
+
+// matchingGroups returns the names of |groups| which are in |names|. Group names are compared case-insensitively, as
+// LDAP compares them.
+func matchingGroups(groups, names []string) []string {
+	var matching []string
+	for _, name := range names {
+		for _, group := range groups {
+			if strings.EqualFold(name, group) {
+				matching = append(matching, name)
+				break
+			}
+		}
+	}
+	return matching
+}
+
+// mappedRoles returns the roles which a member of |groups| is granted by |groupRoles|, out of all of the roles it maps
+// groups to.
+func mappedRoles(groupRoles map[string][]string, groups []string) accountGrants {
+	var grants accountGrants
+	for group, mapped := range groupRoles {
+		grants.managedRoles = append(grants.managedRoles, mapped...)
+		if len(matchingGroups(groups, []string{group})) > 0 {
+			grants.roles = append(grants.roles, mapped...)
+		}
+	}
+	return grants
+}
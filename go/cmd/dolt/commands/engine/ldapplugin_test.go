@@ -0,0 +1,437 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+)
+
+const (
+	testPeopleDN = "ou=people,dc=example,dc=com"
+	testGroupsDN = "ou=groups,dc=example,dc=com"
+	testAliceDN  = "uid=alice,ou=people,dc=example,dc=com"
+	testBobDN    = "uid=bob,ou=people,dc=example,dc=com"
+	testCarolDN  = "uid=carol,ou=people,dc=example,dc=com"
+)
+
+func TestLDAPAuth(t *testing.T) {
+	srv := newTestLDAPServer(t, nil, false)
+
+	config := &servercfg.LDAPYAMLConfig{
+		URL_:            ptr("ldap://" + srv.addr()),
+		BindDNTemplate_: ptr("uid={user}," + testPeopleDN),
+	}
+	plugin := &authenticateLDAPPlugin{config: config, grants: newAuthenticatedGrants()}
+	alice := &mysql_db.User{User: "alice", Host: "%", Plugin: LDAPPluginName}
+
+	authed, err := plugin.Authenticate(nil, "alice", alice, "alice-password")
+	require.NoError(t, err)
+	require.True(t, authed)
+
+	authed, err = plugin.Authenticate(nil, "alice", alice, "wrong-password")
+	require.NoError(t, err)
+	require.False(t, authed)
+
+	// An empty password would be an anonymous bind
+	authed, err = plugin.Authenticate(nil, "alice", alice, "")
+	require.NoError(t, err)
+	require.False(t, authed)
+
+	// The identity of the user overrides the bind DN template
+	authed, err = plugin.Authenticate(nil, "alice", &mysql_db.User{User: "alice", Host: "%", Identity: testBobDN}, "bob-password")
+	require.NoError(t, err)
+	require.True(t, authed)
+
+	// User names are escaped in the bind DN
+	authed, err = plugin.Authenticate(nil, "alice,ou=people", &mysql_db.User{User: "alice,ou=people", Host: "%"}, "alice-password")
+	require.NoError(t, err)
+	require.False(t, authed)
+	require.Contains(t, srv.binds(), `uid=alice\,ou=people,`+testPeopleDN)
+
+	// An unreachable server is an error
+	plugin.setConfig(&servercfg.LDAPYAMLConfig{
+		URL_:            ptr("ldap://" + closedAddr(t)),
+		BindDNTemplate_: ptr("uid={user}," + testPeopleDN),
+		TimeoutMillis_:  ptr(uint64(1000)),
+	})
+	authed, err = plugin.Authenticate(nil, "alice", alice, "alice-password")
+	require.Error(t, err)
+	require.False(t, authed)
+}
+
+func TestLDAPAuthTLS(t *testing.T) {
+	certFile, serverTLS := testTLSConfig(t)
+
+	t.Run("ldaps", func(t *testing.T) {
+		srv := newTestLDAPServer(t, serverTLS, true)
+		authed, err := newTestLDAPPlugin(&servercfg.LDAPYAMLConfig{
+			URL_:            ptr("ldaps://" + srv.addr()),
+			BindDNTemplate_: ptr("uid={user}," + testPeopleDN),
+			TLSCA_:          ptr(certFile),
+		}).Authenticate(nil, "alice", &mysql_db.User{User: "alice", Host: "%"}, "alice-password")
+		require.NoError(t, err)
+		require.True(t, authed)
+	})
+
+	t.Run("start tls", func(t *testing.T) {
+		srv := newTestLDAPServer(t, serverTLS, false)
+		authed, err := newTestLDAPPlugin(&servercfg.LDAPYAMLConfig{
+			URL_:            ptr("ldap://" + srv.addr()),
+			BindDNTemplate_: ptr("uid={user}," + testPeopleDN),
+			StartTLS_:       ptr(true),
+			TLSCA_:          ptr(certFile),
+		}).Authenticate(nil, "alice", &mysql_db.User{User: "alice", Host: "%"}, "alice-password")
+		require.NoError(t, err)
+		require.True(t, authed)
+		require.True(t, srv.startedTLS())
+	})
+
+	t.Run("untrusted certificate", func(t *testing.T) {
+		srv := newTestLDAPServer(t, serverTLS, false)
+		authed, err := newTestLDAPPlugin(&servercfg.LDAPYAMLConfig{
+			URL_:            ptr("ldap://" + srv.addr()),
+			BindDNTemplate_: ptr("uid={user}," + testPeopleDN),
+			StartTLS_:       ptr(true),
+		}).Authenticate(nil, "alice", &mysql_db.User{User: "alice", Host: "%"}, "alice-password")
+		require.Error(t, err)
+		require.False(t, authed)
+	})
+}
+
+func TestLDAPGroupRoles(t *testing.T) {
+	srv := newTestLDAPServer(t, nil, false)
+	config := &servercfg.LDAPYAMLConfig{
+		URL_:            ptr("ldap://" + srv.addr()),
+		BindDNTemplate_: ptr("uid={user}," + testPeopleDN),
+		GroupSearch_: &servercfg.LDAPGroupSearchYAMLConfig{
+			BaseDN_:         ptr(testGroupsDN),
+			RequiredGroups_: []string{"Engineering", "analysts"},
+			GroupRoles_: map[string][]string{
+				"engineering": {"developer"},
+				"analysts":    {"reader"},
+				"admins":      {"dba", "missing_role"},
+			},
+		},
+	}
+	grants := newAuthenticatedGrants()
+	plugin := &authenticateLDAPPlugin{config: config, grants: grants}
+
+	db := mysql_db.CreateEmptyMySQLDb()
+	db.SetPersister(&mysql_db.NoopPersister{})
+	ed := db.Editor()
+	for _, u := range []*mysql_db.User{
+		{User: "alice", Host: "%", Plugin: LDAPPluginName, PrivilegeSet: mysql_db.NewPrivilegeSet()},
+		{User: "carol", Host: "%", Plugin: LDAPPluginName, PrivilegeSet: mysql_db.NewPrivilegeSet()},
+		{User: "developer", Host: "%", Locked: true, IsRole: true, PrivilegeSet: mysql_db.NewPrivilegeSet()},
+		{User: "reader", Host: "%", Locked: true, IsRole: true, PrivilegeSet: mysql_db.NewPrivilegeSet()},
+		{User: "dba", Host: "%", Locked: true, IsRole: true, PrivilegeSet: mysql_db.NewPrivilegeSet()},
+		{User: "auditor", Host: "%", Locked: true, IsRole: true, PrivilegeSet: mysql_db.NewPrivilegeSet()},
+	} {
+		ed.PutUser(u)
+	}
+	// Roles which are not mapped from any group are left alone
+	ed.PutRoleEdge(&mysql_db.RoleEdge{FromHost: "%", FromUser: "auditor", ToHost: "%", ToUser: "alice"})
+	ed.Close()
+
+	login := func(user, pass string) (bool, error) {
+		rd := db.Reader()
+		entry := db.GetUser(rd, user, "127.0.0.1", false)
+		rd.Close()
+		authed, err := plugin.Authenticate(db, user, entry, pass)
+		if err != nil || !authed {
+			return authed, err
+		}
+		return true, grants.apply(db, nil, user, "127.0.0.1", func() (*sql.Context, error) {
+			return sql.NewEmptyContext(), nil
+		})
+	}
+
+	authed, err := login("alice", "alice-password")
+	require.NoError(t, err)
+	require.True(t, authed)
+	require.Equal(t, []string{"auditor", "developer", "reader"}, grantedRoles(db, "alice"))
+
+	// alice leaves analysts and joins admins
+	srv.setMembers("analysts")
+	srv.setMembers("admins", testAliceDN)
+	authed, err = login("alice", "alice-password")
+	require.NoError(t, err)
+	require.True(t, authed)
+	require.Equal(t, []string{"auditor", "dba", "developer"}, grantedRoles(db, "alice"))
+
+	// carol is not a member of a required group
+	authed, err = login("carol", "carol-password")
+	require.NoError(t, err)
+	require.False(t, authed)
+	require.Empty(t, grantedRoles(db, "carol"))
+}
+
+func newTestLDAPPlugin(config servercfg.LDAPConfig) *authenticateLDAPPlugin {
+	return &authenticateLDAPPlugin{config: config, grants: newAuthenticatedGrants()}
+}
+
+func ptr[T any](t T) *T {
+	return &t
+}
+
+func grantedRoles(db *mysql_db.MySQLDb, user string) []string {
+	rd := db.Reader()
+	defer rd.Close()
+	var roles []string
+	for _, edge := range rd.GetToUserRoleEdges(mysql_db.RoleEdgesToKey{ToHost: "%", ToUser: user}) {
+		roles = append(roles, edge.FromUser)
+	}
+	sort.Strings(roles)
+	return roles
+}
+
+func closedAddr(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := l.Addr().String()
+	require.NoError(t, l.Close())
+	return addr
+}
+
+// testTLSConfig returns a TLS config with a self-signed certificate for 127.0.0.1, along with a file holding the
+// certificate.
+func testTLSConfig(t *testing.T) (string, *tls.Config) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IsCA:         true,
+
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	certFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	return certFile, &tls.Config{Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}}}
+}
+
+// testLDAPServer is a minimal in-process LDAP server. It supports the simple binds, StartTLS and equality filter
+// searches which the authentication_ldap_simple plugin makes, against a fixed directory of people and groups.
+type testLDAPServer struct {
+	listener  net.Listener
+	tlsConfig *tls.Config
+
+	mu        sync.Mutex
+	passwords map[string]string
+	members   map[string][]string
+	bindDNs   []string
+	tlsUsed   bool
+}
+
+// newTestLDAPServer starts a testLDAPServer which supports StartTLS with |tlsConfig|, if it is not nil. If |ldaps| is
+// true, connections use TLS from the start instead.
+func newTestLDAPServer(t *testing.T, tlsConfig *tls.Config, ldaps bool) *testLDAPServer {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	if ldaps {
+		l = tls.NewListener(l, tlsConfig)
+	}
+	srv := &testLDAPServer{
+		listener:  l,
+		tlsConfig: tlsConfig,
+		passwords: map[string]string{
+			testAliceDN: "alice-password",
+			testBobDN:   "bob-password",
+			testCarolDN: "carol-password",
+		},
+		members: map[string][]string{
+			"engineering": {testAliceDN, testBobDN},
+			"analysts":    {testAliceDN},
+			"admins":      {},
+		},
+	}
+	t.Cleanup(func() {
+		srv.listener.Close()
+	})
+	go srv.serve()
+	return srv
+}
+
+func (s *testLDAPServer) addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *testLDAPServer) setMembers(group string, dns ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.members[group] = dns
+}
+
+func (s *testLDAPServer) binds() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.bindDNs...)
+}
+
+func (s *testLDAPServer) startedTLS() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tlsUsed
+}
+
+func (s *testLDAPServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *testLDAPServer) handle(conn net.Conn) {
+	defer func() {
+		// |conn| is replaced by a TLS connection after StartTLS
+		conn.Close()
+	}()
+	var bound string
+	for {
+		req, err := ber.ReadPacket(conn)
+		if err != nil || len(req.Children) < 2 {
+			return
+		}
+		id := req.Children[0].Value.(int64)
+		op := req.Children[1]
+		switch op.Tag {
+		case ldap.ApplicationBindRequest:
+			dn := op.Children[1].Data.String()
+			pass := op.Children[2].Data.String()
+			s.mu.Lock()
+			s.bindDNs = append(s.bindDNs, dn)
+			expected, ok := s.passwords[dn]
+			s.mu.Unlock()
+			code := ldap.LDAPResultInvalidCredentials
+			if ok && pass != "" && pass == expected {
+				code = ldap.LDAPResultSuccess
+				bound = dn
+			}
+			writeLDAPResult(conn, id, ldap.ApplicationBindResponse, code)
+		case ldap.ApplicationExtendedRequest:
+			if s.tlsConfig == nil || op.Children[0].Data.String() != "1.3.6.1.4.1.1466.20037" {
+				writeLDAPResult(conn, id, ldap.ApplicationExtendedResponse, ldap.LDAPResultProtocolError)
+				continue
+			}
+			writeLDAPResult(conn, id, ldap.ApplicationExtendedResponse, ldap.LDAPResultSuccess)
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if tlsConn.Handshake() != nil {
+				return
+			}
+			conn = tlsConn
+			s.mu.Lock()
+			s.tlsUsed = true
+			s.mu.Unlock()
+		case ldap.ApplicationSearchRequest:
+			if bound == "" {
+				writeLDAPResult(conn, id, ldap.ApplicationSearchResultDone, ldap.LDAPResultInsufficientAccessRights)
+				continue
+			}
+			s.search(conn, id, op)
+		case ldap.ApplicationUnbindRequest:
+			return
+		default:
+			return
+		}
+	}
+}
+
+// search returns the groups under the base DN of |op| with a member matching its equality filter.
+func (s *testLDAPServer) search(conn net.Conn, id int64, op *ber.Packet) {
+	baseDN := op.Children[0].Data.String()
+	filter := op.Children[6]
+	if filter.Tag != ldap.FilterEqualityMatch || !strings.EqualFold(filter.Children[0].Data.String(), "member") {
+		writeLDAPResult(conn, id, ldap.ApplicationSearchResultDone, ldap.LDAPResultUnwillingToPerform)
+		return
+	}
+	member := filter.Children[1].Data.String()
+
+	s.mu.Lock()
+	var groups []string
+	for group, members := range s.members {
+		for _, m := range members {
+			if m == member {
+				groups = append(groups, group)
+			}
+		}
+	}
+	s.mu.Unlock()
+	sort.Strings(groups)
+
+	if strings.EqualFold(baseDN, testGroupsDN) {
+		for _, group := range groups {
+			entry := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldap.ApplicationSearchResultEntry, nil, "")
+			entry.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "cn="+group+","+testGroupsDN, ""))
+			attrs := ber.NewSequence("")
+			attr := ber.NewSequence("")
+			attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "cn", ""))
+			vals := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "")
+			vals.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, group, ""))
+			attr.AppendChild(vals)
+			attrs.AppendChild(attr)
+			entry.AppendChild(attrs)
+			writeLDAPMessage(conn, id, entry)
+		}
+	}
+	writeLDAPResult(conn, id, ldap.ApplicationSearchResultDone, ldap.LDAPResultSuccess)
+}
+
+func writeLDAPResult(conn net.Conn, id int64, tag ber.Tag, code int) {
+	res := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "")
+	res.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(code), ""))
+	res.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", ""))
+	res.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", ""))
+	writeLDAPMessage(conn, id, res)
+}
+
+func writeLDAPMessage(conn net.Conn, id int64, op *ber.Packet) {
+	msg := ber.NewSequence("")
+	msg.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, id, ""))
+	msg.AppendChild(op)
+	conn.Write(msg.Bytes())
+}
@@ -41,8 +41,10 @@ import (
 	dblr "github.com/dolthub/dolt/go/libraries/doltcore/sqle/binlogreplication"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/cluster"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtables"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/kvexec"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/mysql_file_handler"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/slowlog"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/statsnoms"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/statspro"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/writer"
@@ -56,6 +58,10 @@ type SqlEngine struct {
 	contextFactory contextFactory
 	dsessFactory   sessionFactory
 	engine         *gms.Engine
+	jwtPlugin      *authenticateDoltJWTPlugin
+	ldapPlugin     *authenticateLDAPPlugin
+	authGrants     *authenticatedGrants
+	bcController   *branch_control.Controller
 }
 
 type sessionFactory func(mysqlSess *sql.BaseSession, pro sql.DatabaseProvider) (*dsess.DoltSession, error)
@@ -76,10 +82,12 @@ type SqlEngineConfig struct {
 	DoltTransactionCommit   bool
 	Bulk                    bool
 	JwksConfig              []servercfg.JwksConfig
+	LDAPConfig              servercfg.LDAPConfig
 	SystemVariables         SystemVariables
 	ClusterController       *cluster.Controller
 	BinlogReplicaController binlogreplication.BinlogReplicaController
 	EventSchedulerStatus    eventscheduler.SchedulerStatus
+	SlowQueryLog            *slowlog.Log
 }
 
 // NewSqlEngine returns a SqlEngine
@@ -140,7 +148,9 @@ func NewSqlEngine(
 		pro.InitDatabaseHooks = append(pro.InitDatabaseHooks, cluster.NewInitDatabaseHook(config.ClusterController, bThreads))
 		pro.DropDatabaseHooks = append(pro.DropDatabaseHooks, config.ClusterController.DropDatabaseHook())
 		config.ClusterController.SetDropDatabase(pro.DropDatabase)
+		pro.SetStandbyReadCheck(config.ClusterController.CheckReplicationLag)
 	}
+	pro.SetServerTable(dtables.NewSlowQueriesTable(config.SlowQueryLog))
 
 	sqlEngine := &SqlEngine{}
 
@@ -184,9 +194,16 @@ func NewSqlEngine(
 
 	// Setup the engine.
 	engine.Analyzer.Catalog.MySQLDb.SetPersister(persister)
+	pro.SetMySQLDb(engine.Analyzer.Catalog.MySQLDb)
+	pro.SetEngine(engine)
+	pro.SetServerTable(dtables.NewResourceLimitsTable(engine.Analyzer.Catalog.MySQLDb))
 
+	authGrants := newAuthenticatedGrants()
+	jwtPlugin := &authenticateDoltJWTPlugin{jwksConfig: config.JwksConfig, grants: authGrants}
+	ldapPlugin := &authenticateLDAPPlugin{config: config.LDAPConfig, grants: authGrants}
 	engine.Analyzer.Catalog.MySQLDb.SetPlugins(map[string]mysql_db.PlaintextAuthPlugin{
-		"authentication_dolt_jwt": NewAuthenticateDoltJWTPlugin(config.JwksConfig),
+		"authentication_dolt_jwt": jwtPlugin,
+		LDAPPluginName:            ldapPlugin,
 	})
 
 	statsPro := statspro.NewProvider(pro, statsnoms.NewNomsStatsFactory(mrEnv.RemoteDialProvider()))
@@ -198,6 +215,10 @@ func NewSqlEngine(
 	sqlEngine.contextFactory = sqlContextFactory()
 	sqlEngine.dsessFactory = sessFactory
 	sqlEngine.engine = engine
+	sqlEngine.jwtPlugin = jwtPlugin
+	sqlEngine.ldapPlugin = ldapPlugin
+	sqlEngine.authGrants = authGrants
+	sqlEngine.bcController = bcController
 
 	// configuring stats depends on sessionBuilder
 	// sessionBuilder needs ref to statsProv
@@ -240,7 +261,7 @@ func NewSqlEngine(
 			return nil, err
 		}
 
-		err = configureBinlogReplicaController(config, engine, binLogSession)
+		err = configureBinlogReplicaController(config, engine, binLogSession, sessFactory, pro)
 		if err != nil {
 			return nil, err
 		}
@@ -319,6 +340,36 @@ func (se *SqlEngine) GetUnderlyingEngine() *gms.Engine {
 	return se.engine
 }
 
+// SetJwksConfig replaces the JWKS servers used to authenticate users with the authentication_dolt_jwt plugin. Logins
+// already authenticated are not affected.
+func (se *SqlEngine) SetJwksConfig(jwksConfig []servercfg.JwksConfig) {
+	if se.jwtPlugin != nil {
+		se.jwtPlugin.setJwksConfig(jwksConfig)
+	}
+}
+
+// SetLDAPConfig replaces the LDAP server used to authenticate users with the authentication_ldap_simple plugin. Logins
+// already authenticated are not affected.
+func (se *SqlEngine) SetLDAPConfig(config servercfg.LDAPConfig) {
+	if se.ldapPlugin != nil {
+		se.ldapPlugin.setConfig(config)
+	}
+}
+
+// ApplyAuthenticatedGrants grants and revokes the roles and branch permissions which an authentication plugin resolved
+// for the user of |sess| as they logged in, such as the roles mapped from their LDAP groups or the claims of their JWT,
+// and creates their account if they are provisioned on their first login. It must be called once the user is
+// authenticated and before the session runs any queries.
+func (se *SqlEngine) ApplyAuthenticatedGrants(ctx context.Context, sess *dsess.DoltSession) error {
+	if se.authGrants == nil {
+		return nil
+	}
+	client := sess.Client()
+	return se.authGrants.apply(se.engine.Analyzer.Catalog.MySQLDb, se.bcController, client.User, client.Address, func() (*sql.Context, error) {
+		return se.NewContext(ctx, sess)
+	})
+}
+
 func (se *SqlEngine) Close() error {
 	if se.engine != nil {
 		return se.engine.Close()
@@ -326,16 +377,26 @@ func (se *SqlEngine) Close() error {
 	return nil
 }
 
-// configureBinlogReplicaController configures the binlog replication controller with the |engine|.
-func configureBinlogReplicaController(config *SqlEngineConfig, engine *gms.Engine, session *dsess.DoltSession) error {
+// configureBinlogReplicaController configures the binlog replication controller with the |engine|. The |session|
+// is used to apply changes from the default replication channel, and |sessFactory| creates the sessions used to apply
+// changes from any other named replication channels.
+func configureBinlogReplicaController(config *SqlEngineConfig, engine *gms.Engine, session *dsess.DoltSession, sessFactory sessionFactory, pro *dsqle.DoltDatabaseProvider) error {
 	ctxFactory := sqlContextFactory()
 	executionCtx, err := ctxFactory(context.Background(), session)
 	if err != nil {
 		return err
 	}
 	dblr.DoltBinlogReplicaController.SetExecutionContext(executionCtx)
+	dblr.DoltBinlogReplicaController.SetExecutionContextFactory(func() (*sql.Context, error) {
+		sess, err := sessFactory(sql.NewBaseSession(), pro)
+		if err != nil {
+			return nil, err
+		}
+		return ctxFactory(context.Background(), sess)
+	})
 	dblr.DoltBinlogReplicaController.SetEngine(engine)
 	engine.Analyzer.Catalog.BinlogReplicaController = config.BinlogReplicaController
+	engine.Parser = dblr.NewReplicaStatementParser(engine.Parser)
 
 	return nil
 }
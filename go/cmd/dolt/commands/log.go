@@ -57,7 +57,13 @@ The command takes options to control what is shown and how.
 	
 {{.EmphasisLeft}}dolt log <revisionB>...<revisionA>{{.EmphasisRight}}
 {{.EmphasisLeft}}dolt log <revisionA> <revisionB> --not $(dolt merge-base <revisionA> <revisionB>){{.EmphasisRight}}
-  Different ways to list three dot logs. These will list commit logs reachable by revisionA OR revisionB, while excluding commits reachable by BOTH revisionA AND revisionB.`,
+  Different ways to list three dot logs. These will list commit logs reachable by revisionA OR revisionB, while excluding commits reachable by BOTH revisionA AND revisionB.
+
+{{.EmphasisLeft}}dolt log --author <pattern> --since <date> --until <date> --grep <pattern>{{.EmphasisRight}}
+  Lists only the commits whose author, date and message match the given filters. Patterns are regular expressions.
+
+{{.EmphasisLeft}}dolt log --where "<table>.<column> = <value>"{{.EmphasisRight}}
+  Lists only the commits that added, removed or modified a row of table in which column equals value, compared to each commit's first parent.`,
 	Synopsis: []string{
 		`[-n {{.LessThan}}num_commits{{.GreaterThan}}] [{{.LessThan}}revision-range{{.GreaterThan}}] [[--] {{.LessThan}}table{{.GreaterThan}}]`,
 	},
@@ -233,6 +239,13 @@ func constructInterpolatedDoltLogQuery(apr *argparser.ArgParseResults, queryist
 		}
 	}
 
+	for _, filter := range []string{cli.AuthorParam, cli.SinceFlag, cli.UntilFlag, cli.GrepFlag, cli.WhereFlag} {
+		if value, hasFilter := apr.GetValue(filter); hasFilter {
+			writeToBuffer("?")
+			params = append(params, "--"+filter+"="+value)
+		}
+	}
+
 	// included to check for invalid --decorate options
 	if decorate, hasDecorate := apr.GetValue(cli.DecorateFlag); hasDecorate {
 		writeToBuffer("?")
@@ -48,9 +48,18 @@ the same as Git and Dolt's "two dot log" syntax, or |upstreamBranch|..|currentBr
 Rebasing is useful to clean and organize your commit history, especially before merging a feature branch back to a shared 
 branch. For example, you can drop commits that contain debugging or test changes, or squash or fixup small commits into a 
 single commit, or reorder commits so that related changes are adjacent in the new commit history.
+
+An {{.EmphasisLeft}}edit{{.EmphasisRight}} action applies a commit and then stops the rebase, so that the commit can be amended. An 
+{{.EmphasisLeft}}exec{{.EmphasisRight}} action runs a SQL statement, and stops the rebase if the statement fails. SELECT statements are 
+treated as assertions, which fail if the first value they return is false, zero or NULL. Once stopped, the rebase is 
+resumed with {{.EmphasisLeft}}--continue{{.EmphasisRight}}, which first amends any uncommitted changes into the last rebased commit.
+
+Without {{.EmphasisLeft}}--interactive{{.EmphasisRight}}, every commit is picked and the rebase completes right away. With 
+{{.EmphasisLeft}}--onto{{.EmphasisRight}}, the commits are replayed onto {{.LessThan}}newbase{{.GreaterThan}} instead of onto the upstream branch, which 
+can be used to transplant a branch from one base to another.
 `,
 	Synopsis: []string{
-		`(-i | --interactive) {{.LessThan}}upstream{{.GreaterThan}}`,
+		`[-i | --interactive] [--onto {{.LessThan}}newbase{{.GreaterThan}}] {{.LessThan}}upstream{{.GreaterThan}}`,
 		`(--continue | --abort)`,
 	},
 }
@@ -116,17 +125,29 @@ func (cmd RebaseCmd) Exec(ctx context.Context, commandStr string, args []string,
 	if err != nil {
 		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
 	}
+	message, _ := rows[0][1].(string)
+	if isRebaseStopped(message) {
+		return printRebaseStopped(dEnv, branchName, status, message)
+	}
 	if status == 1 {
-		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(errors.New("error: "+rows[0][1].(string))), usage)
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(errors.New("error: "+message)), usage)
 	}
 
-	message := rows[0][1].(string)
 	if strings.Contains(message, dprocedures.SuccessfulRebaseMessage) {
-		cli.Println(dprocedures.SuccessfulRebaseMessage + branchName)
+		rebasedBranch := strings.TrimPrefix(branchName, dprocedures.RebaseWorkingBranchPrefix)
+		if err := saveRebaseHeadBranch(dEnv, branchName, rebasedBranch); err != nil {
+			return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+		}
+		cli.Println(dprocedures.SuccessfulRebaseMessage + rebasedBranch)
 	} else if strings.Contains(message, dprocedures.RebaseAbortedMessage) {
+		rebasedBranch := strings.TrimPrefix(branchName, dprocedures.RebaseWorkingBranchPrefix)
+		if err := saveRebaseHeadBranch(dEnv, branchName, rebasedBranch); err != nil {
+			return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+		}
 		cli.Println(dprocedures.RebaseAbortedMessage)
 	} else {
-		rebasePlan, err := getRebasePlan(cliCtx, sqlCtx, queryist, apr.Arg(0), branchName)
+		onto := apr.GetValueOrDefault(cli.OntoFlag, apr.Arg(0))
+		rebasePlan, err := getRebasePlan(cliCtx, sqlCtx, queryist, apr.Arg(0), onto, branchName)
 		if err != nil {
 			// attempt to abort the rebase
 			_, _, _, _ = queryist.Query(sqlCtx, "CALL DOLT_REBASE('--abort');")
@@ -168,6 +189,9 @@ func (cmd RebaseCmd) Exec(ctx context.Context, commandStr string, args []string,
 				_, _, _, _ = queryist.Query(sqlCtx, "CALL DOLT_REBASE('--abort');")
 				return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
 			}
+			if message, _ := rows[0][1].(string); isRebaseStopped(message) {
+				return printRebaseStopped(dEnv, branchName, status, message)
+			}
 			if status == 1 {
 				// attempt to abort the rebase
 				_, _, _, _ = queryist.Query(sqlCtx, "CALL DOLT_REBASE('--abort');")
@@ -181,6 +205,48 @@ func (cmd RebaseCmd) Exec(ctx context.Context, commandStr string, args []string,
 	return HandleVErrAndExitCode(nil, usage)
 }
 
+// isRebaseStopped returns whether |message| reports a rebase that stopped at an edit or exec step of its plan, and
+// that can be resumed with --continue.
+func isRebaseStopped(message string) bool {
+	return strings.HasPrefix(message, dprocedures.RebaseStoppedAtEditMessage) ||
+		strings.HasPrefix(message, dprocedures.RebaseExecFailedMessage)
+}
+
+// printRebaseStopped prints the |message| of a stopped rebase and returns the exit code for its |status|. The rebase's
+// working branch is checked out, so that the rebase can be continued by later commands.
+func printRebaseStopped(dEnv *env.DoltEnv, branchName string, status int64, message string) int {
+	rebaseWorkingBranch := branchName
+	if !strings.HasPrefix(branchName, dprocedures.RebaseWorkingBranchPrefix) {
+		rebaseWorkingBranch = dprocedures.RebaseWorkingBranchPrefix + branchName
+	}
+	if err := saveRebaseHeadBranch(dEnv, branchName, rebaseWorkingBranch); err != nil {
+		cli.PrintErrln(err.Error())
+		return 1
+	}
+
+	message = strings.ReplaceAll(message, "calling dolt_rebase('--continue')", "running dolt rebase --continue")
+	message = strings.ReplaceAll(message, "calling dolt_rebase('--abort')", "running dolt rebase --abort")
+	if status == 1 {
+		cli.PrintErrln(message)
+		return 1
+	}
+	cli.Println(message)
+	return 0
+}
+
+// saveRebaseHeadBranch checks out |newBranch| in the repository, if it's different from the |currentBranch|. This is a
+// no-op when running against a remote server, whose sessions track their own branch.
+func saveRebaseHeadBranch(dEnv *env.DoltEnv, currentBranch, newBranch string) error {
+	if dEnv == nil || currentBranch == newBranch {
+		return nil
+	}
+	err := saveHeadBranch(dEnv.FS, newBranch)
+	if err != nil {
+		return err
+	}
+	return dEnv.ReloadRepoState()
+}
+
 // constructInterpolatedDoltRebaseQuery generates the sql query necessary to call the DOLT_REBASE() function.
 // Also interpolates this query to prevent sql injection.
 func constructInterpolatedDoltRebaseQuery(apr *argparser.ArgParseResults) (string, error) {
@@ -194,6 +260,10 @@ func constructInterpolatedDoltRebaseQuery(apr *argparser.ArgParseResults) (strin
 	if apr.Contains(cli.InteractiveFlag) {
 		args = append(args, "'--interactive'")
 	}
+	if onto, ok := apr.GetValue(cli.OntoFlag); ok {
+		params = append(params, onto)
+		args = append(args, "'--onto'", "?")
+	}
 	if apr.Contains(cli.ContinueFlag) {
 		args = append(args, "'--continue'")
 	}
@@ -206,7 +276,7 @@ func constructInterpolatedDoltRebaseQuery(apr *argparser.ArgParseResults) (strin
 }
 
 // getRebasePlan opens an editor for users to edit the rebase plan and returns the parsed rebase plan from the editor.
-func getRebasePlan(cliCtx cli.CliContext, sqlCtx *sql.Context, queryist cli.Queryist, rebaseBranch, currentBranch string) (*rebase.RebasePlan, error) {
+func getRebasePlan(cliCtx cli.CliContext, sqlCtx *sql.Context, queryist cli.Queryist, rebaseBranch, ontoBranch, currentBranch string) (*rebase.RebasePlan, error) {
 	if cli.ExecuteWithStdioRestored == nil {
 		return nil, nil
 	}
@@ -215,7 +285,7 @@ func getRebasePlan(cliCtx cli.CliContext, sqlCtx *sql.Context, queryist cli.Quer
 		return nil, nil
 	}
 
-	initialRebaseMsg, err := buildInitialRebaseMsg(sqlCtx, queryist, rebaseBranch, currentBranch)
+	initialRebaseMsg, err := buildInitialRebaseMsg(sqlCtx, queryist, rebaseBranch, ontoBranch, currentBranch)
 	if err != nil {
 		return nil, err
 	}
@@ -241,7 +311,7 @@ func getRebasePlan(cliCtx cli.CliContext, sqlCtx *sql.Context, queryist cli.Quer
 
 // buildInitialRebaseMsg builds the initial message to display to the user when they open the rebase plan editor,
 // including the formatted rebase plan.
-func buildInitialRebaseMsg(sqlCtx *sql.Context, queryist cli.Queryist, rebaseBranch, currentBranch string) (string, error) {
+func buildInitialRebaseMsg(sqlCtx *sql.Context, queryist cli.Queryist, rebaseBranch, ontoBranch, currentBranch string) (string, error) {
 	var buffer bytes.Buffer
 
 	rows, err := GetRowsForSql(queryist, sqlCtx, "SELECT action, commit_hash, commit_message FROM dolt_rebase ORDER BY rebase_order")
@@ -257,6 +327,10 @@ func buildInitialRebaseMsg(sqlCtx *sql.Context, queryist cli.Queryist, rebaseBra
 		}
 		commitHash := row[1].(string)
 		commitMessage := row[2].(string)
+		if action == rebase.RebaseActionExec {
+			buffer.WriteString(fmt.Sprintf("%s %s\n", action, commitMessage))
+			continue
+		}
 		buffer.WriteString(fmt.Sprintf("%s %s %s\n", action, commitHash, commitMessage))
 	}
 	buffer.WriteString("\n")
@@ -270,8 +344,12 @@ func buildInitialRebaseMsg(sqlCtx *sql.Context, queryist cli.Queryist, rebaseBra
 	if err != nil {
 		return "", err
 	}
+	ontoBranchHash, err := getHashOf(queryist, sqlCtx, ontoBranch)
+	if err != nil {
+		return "", err
+	}
 	numSteps := len(rows)
-	buffer.WriteString(fmt.Sprintf("# Rebase %s..%s onto %s (%d commands)\n#\n", rebaseBranchHash, currentBranchHash, rebaseBranchHash, numSteps))
+	buffer.WriteString(fmt.Sprintf("# Rebase %s..%s onto %s (%d commands)\n#\n", rebaseBranchHash, currentBranchHash, ontoBranchHash, numSteps))
 
 	buffer.WriteString("# Commands:\n")
 	buffer.WriteString("# p, pick <commit> = use commit\n")
@@ -279,6 +357,8 @@ func buildInitialRebaseMsg(sqlCtx *sql.Context, queryist cli.Queryist, rebaseBra
 	buffer.WriteString("# r, reword <commit> = use commit, but edit the commit message\n")
 	buffer.WriteString("# s, squash <commit> = use commit, but meld into previous commit\n")
 	buffer.WriteString("# f, fixup <commit> = like \"squash\", but discard this commit's message\n")
+	buffer.WriteString("# e, edit <commit> = use commit, but stop for amending\n")
+	buffer.WriteString("# x, exec <statement> = run SQL statement, and stop if it fails\n")
 	buffer.WriteString("# These lines can be re-ordered; they are executed from top to bottom.\n")
 	buffer.WriteString("#\n")
 	buffer.WriteString("# If you remove a line here THAT COMMIT WILL BE LOST.\n")
@@ -307,6 +387,13 @@ func parseRebaseMessage(rebaseMsg string) (*rebase.RebasePlan, error) {
 	splitMsg := strings.Split(rebaseMsg, "\n")
 	for i, line := range splitMsg {
 		if !strings.HasPrefix(line, "#") && strings.TrimSpace(line) != "" {
+			if action, statement, ok := strings.Cut(line, " "); ok && action == rebase.RebaseActionExec {
+				plan.Steps = append(plan.Steps, rebase.RebasePlanStep{
+					Action:    action,
+					CommitMsg: strings.TrimSpace(statement),
+				})
+				continue
+			}
 			rebaseStepParts := strings.SplitN(line, " ", 3)
 			if len(rebaseStepParts) != 3 {
 				return nil, fmt.Errorf("invalid line %d: %s", i, line)
@@ -331,7 +418,12 @@ func insertRebasePlanIntoDoltRebaseTable(plan *rebase.RebasePlan, sqlCtx *sql.Co
 	}
 
 	for i, step := range plan.Steps {
-		_, err := GetRowsForSql(queryist, sqlCtx, fmt.Sprintf("INSERT INTO dolt_rebase VALUES (%d, '%s', '%s', '%s')", i+1, step.Action, step.CommitHash, step.CommitMsg))
+		query, err := dbr.InterpolateForDialect("INSERT INTO dolt_rebase VALUES (?, ?, ?, ?)",
+			[]interface{}{i + 1, step.Action, step.CommitHash, step.CommitMsg}, dialect.MySQL)
+		if err != nil {
+			return err
+		}
+		_, err = GetRowsForSql(queryist, sqlCtx, query)
 		if err != nil {
 			return err
 		}
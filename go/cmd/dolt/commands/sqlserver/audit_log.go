@@ -0,0 +1,194 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/auditlog"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+)
+
+// newAuditLog returns the audit log configured by |cfg|, opening its log file for appending. The audit log is only
+// configured by the server's config file, and is not changed when the config is reloaded, so that it cannot be
+// disabled without restarting the server.
+func newAuditLog(cfg servercfg.AuditLogConfig) (*auditlog.Log, error) {
+	filter := auditlog.Filter{
+		Users:        cfg.Users(),
+		ExcludeUsers: cfg.ExcludeUsers(),
+	}
+	for _, class := range cfg.StatementClasses() {
+		filter.Classes = append(filter.Classes, auditlog.Class(class))
+	}
+	return auditlog.Open(cfg.LogFile(), int64(cfg.MaxSizeMB())*1024*1024, cfg.MaxFiles(), filter)
+}
+
+// auditHook is a statementHook which records the statements run by the handler it wraps in its audit log. When
+// |failClosed| is set, it refuses to run audited statements while the audit log can't be written.
+type auditHook struct {
+	log        *auditlog.Log
+	sessions   *connectionSessions
+	failClosed bool
+
+	mu          sync.Mutex
+	classifiers map[uint32]*auditlog.Classifier
+}
+
+var _ statementHook = (*auditHook)(nil)
+
+func newAuditHandler(h mysql.Handler, log *auditlog.Log, sessions *connectionSessions, failClosed bool) *statementHandler {
+	return newStatementHandler(h, &auditHook{
+		log:         log,
+		sessions:    sessions,
+		failClosed:  failClosed,
+		classifiers: make(map[uint32]*auditlog.Classifier),
+	})
+}
+
+// auditedStatement is the statementObserver of a single statement which is audited by an auditHook.
+type auditedStatement struct {
+	hook         *auditHook
+	entry        auditlog.Entry
+	rowsAffected uint64
+	sess         *dsess.DoltSession
+	// headUpdate is the last commit the session made before the statement, which tells whether the statement made any.
+	headUpdate *dsess.HeadUpdate
+}
+
+func (h *auditHook) classifier(connID uint32) *auditlog.Classifier {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	c, ok := h.classifiers[connID]
+	if !ok {
+		c = &auditlog.Classifier{}
+		h.classifiers[connID] = c
+	}
+	return c
+}
+
+// startStatement implements statementHook. Statements which are not audited are not observed. Returns an error if the
+// statement must not run, because the audit log can't be written and the hook fails closed.
+func (h *auditHook) startStatement(ctx context.Context, c *mysql.Conn, stmt statement) (context.Context, statementObserver, error) {
+	// Prepared statements are classified on their own, as they can't change the delimiter of the connection
+	var class auditlog.Class
+	if stmt.prepare != nil {
+		class = auditlog.Classify(stmt.query)
+	} else {
+		class = h.classifier(c.ConnectionID).Classify(stmt.query)
+	}
+	sess := h.sessions.get(c.ConnectionID)
+	user := c.User
+	if sess != nil {
+		user = sess.Client().User
+	}
+	if !h.log.Audits(user, class) {
+		return ctx, nil, nil
+	}
+	if h.failClosed {
+		if err := h.log.Check(); err != nil {
+			return ctx, nil, fmt.Errorf("statement refused, as it can't be written to the audit log: %s", err.Error())
+		}
+	}
+
+	a := &auditedStatement{hook: h, entry: auditlog.Entry{
+		Time:         time.Now(),
+		ConnectionID: c.ConnectionID,
+		User:         user,
+		Class:        class,
+		Statement:    stmt.query,
+	}}
+	if stmt.prepare != nil {
+		a.entry.Parameters = boundParameters(stmt.prepare)
+	}
+	if sess == nil {
+		return ctx, a, nil
+	}
+	a.sess = sess
+	a.headUpdate = sess.LastHeadUpdate()
+	a.entry.Host = clientHost(c, sess)
+	a.entry.Database = sess.GetCurrentDatabase()
+	if a.entry.Database != "" {
+		a.entry.Branch, _ = sess.GetBranch()
+	}
+	return ctx, a, nil
+}
+
+// connectionClosed implements statementHook.
+func (h *auditHook) connectionClosed(c *mysql.Conn) {
+	h.mu.Lock()
+	delete(h.classifiers, c.ConnectionID)
+	h.mu.Unlock()
+	h.sessions.remove(c.ConnectionID)
+}
+
+// result implements statementObserver.
+func (a *auditedStatement) result(res *sqltypes.Result) error {
+	if res != nil {
+		a.rowsAffected += res.RowsAffected
+	}
+	return nil
+}
+
+// finish implements statementObserver.
+func (a *auditedStatement) finish(err error) error {
+	a.entry.RowsAffected = a.rowsAffected
+	if err != nil {
+		a.entry.Error = err.Error()
+	}
+	// Only the session's own commits are recorded, as other sessions may move the head of its branch at the same time
+	if a.sess != nil {
+		if update := a.sess.LastHeadUpdate(); update != nil && update != a.headUpdate {
+			a.entry.Commit = update.Commit.String()
+		}
+	}
+	if werr := a.hook.log.Record(a.entry); werr != nil {
+		if a.hook.failClosed {
+			logrus.Errorf("error writing to the audit log, refusing audited statements until it can be written: %s", werr.Error())
+		} else {
+			logrus.Warnf("error writing to the audit log: %s", werr.Error())
+		}
+	}
+	return err
+}
+
+// boundParameters returns the values bound to the placeholders of |prepare|, in order, as SQL literals.
+func boundParameters(prepare *mysql.PrepareData) []string {
+	params := make([]string, prepare.ParamsCount)
+	for i := range params {
+		// A parameter which couldn't be read is left as a placeholder
+		params[i] = "?"
+		bv, ok := prepare.BindVars[fmt.Sprintf("v%d", i+1)]
+		if !ok {
+			continue
+		}
+		v, err := sqltypes.BindVariableToValue(bv)
+		if err != nil {
+			continue
+		}
+		var sb strings.Builder
+		v.EncodeSQL(&sb)
+		params[i] = sb.String()
+	}
+	return params
+}
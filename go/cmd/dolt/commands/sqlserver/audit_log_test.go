@@ -0,0 +1,207 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlserver
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gocraft/dbr/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/auditlog"
+	"github.com/dolthub/dolt/go/libraries/utils/svcs"
+)
+
+func TestServerAuditLog(t *testing.T) {
+	env, err := sqle.CreateEnvWithSeedData()
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, env.DoltDB.Close())
+	}()
+
+	logFile := filepath.Join(t.TempDir(), "audit.log")
+	serverConfig, err := servercfg.NewYamlConfig([]byte(fmt.Sprintf(`
+log_level: fatal
+listener:
+  host: 127.0.0.1
+  port: 15304
+audit_log:
+  log_file: %s
+  statement_classes: [ddl, dml, version_control]
+`, logFile)))
+	require.NoError(t, err)
+
+	sc := svcs.NewController()
+	defer sc.Stop()
+	go func() {
+		_, _ = Serve(context.Background(), "0.0.0", serverConfig, nil, sc, env)
+	}()
+	require.NoError(t, sc.WaitForStart())
+
+	conn, err := dbr.Open("mysql", servercfg.ConnectionString(serverConfig, "dolt"), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	sess := conn.NewSession(nil)
+
+	ctx := context.Background()
+	for _, query := range []string{
+		"create table audited (a int primary key)",
+		"insert into audited values (1), (2)",
+		"select * from audited",
+		"set @unaudited = 1",
+	} {
+		_, err = sess.ExecContext(ctx, query)
+		require.NoError(t, err, query)
+	}
+	var commit string
+	require.NoError(t, sess.QueryRowContext(ctx, "call dolt_commit('-Am', 'add audited')").Scan(&commit))
+	for _, query := range []string{
+		"call dolt_branch('feature')",
+		"call dolt_branch('-D', 'feature')",
+	} {
+		_, err = sess.ExecContext(ctx, query)
+		require.NoError(t, err, query)
+	}
+	_, err = sess.ExecContext(ctx, "insert into audited values (1)")
+	require.Error(t, err)
+	// Queries with arguments are run as prepared statements
+	_, err = conn.ExecContext(ctx, "insert into audited values (?), (?)", 3, 4)
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	// Stopping the server closes the audit log
+	sc.Stop()
+	require.NoError(t, sc.WaitForStop())
+
+	entries := readAuditLog(t, logFile)
+	require.Len(t, entries, 7)
+	for _, e := range entries {
+		assert.Equal(t, "root", e.User)
+		assert.Equal(t, "127.0.0.1", e.Host)
+		assert.Equal(t, "dolt", e.Database)
+		assert.Equal(t, "main", e.Branch)
+	}
+
+	assert.Equal(t, auditlog.ClassDDL, entries[0].Class)
+	assert.Equal(t, "create table audited (a int primary key)", entries[0].Statement)
+
+	assert.Equal(t, auditlog.ClassDML, entries[1].Class)
+	assert.Equal(t, uint64(2), entries[1].RowsAffected)
+	assert.Empty(t, entries[1].Commit)
+
+	assert.Equal(t, auditlog.ClassVersionControl, entries[2].Class)
+	assert.Equal(t, "call dolt_commit('-Am', 'add audited')", entries[2].Statement)
+	assert.Equal(t, commit, entries[2].Commit)
+
+	assert.Equal(t, "call dolt_branch('feature')", entries[3].Statement)
+	assert.Equal(t, "call dolt_branch('-D', 'feature')", entries[4].Statement)
+	assert.Empty(t, entries[4].Commit)
+
+	assert.Equal(t, auditlog.ClassDML, entries[5].Class)
+	assert.Contains(t, entries[5].Error, "duplicate primary key")
+
+	assert.Equal(t, "insert into audited values (?), (?)", entries[6].Statement)
+	assert.Equal(t, []string{"3", "4"}, entries[6].Parameters)
+	assert.Equal(t, uint64(2), entries[6].RowsAffected)
+}
+
+func TestServerAuditLogFailClosed(t *testing.T) {
+	env, err := sqle.CreateEnvWithSeedData()
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, env.DoltDB.Close())
+	}()
+
+	logDir := filepath.Join(t.TempDir(), "audit")
+	require.NoError(t, os.Mkdir(logDir, 0700))
+	logFile := filepath.Join(logDir, "audit.log")
+	serverConfig, err := servercfg.NewYamlConfig([]byte(fmt.Sprintf(`
+log_level: fatal
+listener:
+  host: 127.0.0.1
+  port: 15305
+audit_log:
+  log_file: %s
+  max_size_mb: 1
+  statement_classes: [ddl, dml]
+  fail_closed: true
+`, logFile)))
+	require.NoError(t, err)
+
+	sc := svcs.NewController()
+	defer sc.Stop()
+	go func() {
+		_, _ = Serve(context.Background(), "0.0.0", serverConfig, nil, sc, env)
+	}()
+	require.NoError(t, sc.WaitForStart())
+
+	conn, err := dbr.Open("mysql", servercfg.ConnectionString(serverConfig, "dolt"), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	sess := conn.NewSession(nil)
+
+	ctx := context.Background()
+	_, err = sess.ExecContext(ctx, "create table audited (a int primary key)")
+	require.NoError(t, err)
+
+	// Without its directory, the log file can't be rotated, so the entry of a statement big enough to rotate it is lost
+	require.NoError(t, os.RemoveAll(logDir))
+	_, err = sess.ExecContext(ctx, "insert into audited values (1) /* "+strings.Repeat("x", 1024*1024)+" */")
+	require.NoError(t, err)
+
+	// Later audited statements are refused until the log file can be written again
+	_, err = sess.ExecContext(ctx, "insert into audited values (2)")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "can't be written to the audit log")
+	_, err = sess.ExecContext(ctx, "select * from audited")
+	require.NoError(t, err)
+
+	require.NoError(t, os.Mkdir(logDir, 0700))
+	_, err = sess.ExecContext(ctx, "insert into audited values (3)")
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	sc.Stop()
+	require.NoError(t, sc.WaitForStop())
+
+	entries := readAuditLog(t, logFile)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "insert into audited values (3)", entries[0].Statement)
+}
+
+func readAuditLog(t *testing.T, path string) []auditlog.Entry {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var entries []auditlog.Entry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e auditlog.Entry
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &e))
+		entries = append(entries, e)
+	}
+	require.NoError(t, scanner.Err())
+	return entries
+}
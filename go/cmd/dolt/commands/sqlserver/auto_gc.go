@@ -0,0 +1,95 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/commands/engine"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/autogc"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dprocedures"
+)
+
+// newAutoGC returns an autogc.Scheduler which collects the garbage of the databases served by |pro|, as configured by
+// |cfg|.
+//
+// By default, a database is only collected while no client is connected to the server, and a GC is abandoned if a
+// client connects before it reaches its safepoint, since it would otherwise have to close the connection. If the
+// config allows a GC to kill connections, a database is collected while no queries are running instead, and any
+// connection open at the safepoint is closed. Either way, a database which isn't collected is checked again at the
+// next interval. Idle pooled connections count as connected clients, so with the default config a server behind a
+// connection pool never collects anything.
+func newAutoGC(cfg servercfg.AutoGCConfig, sqlEngine *engine.SqlEngine, pro *sqle.DoltDatabaseProvider, killConnection func(uint32) error, lgr *logrus.Logger) *autogc.Scheduler {
+	processList := sqlEngine.GetUnderlyingEngine().ProcessList
+
+	dbs := func(context.Context) (map[string]*doltdb.DoltDB, error) {
+		dbs := make(map[string]*doltdb.DoltDB)
+		for _, db := range pro.DoltDatabases() {
+			if ddb := db.DbData().Ddb; ddb != nil {
+				dbs[db.Name()] = ddb
+			}
+		}
+		return dbs, nil
+	}
+
+	gc := func(ctx context.Context, database string) error {
+		if cfg.KillConnections() {
+			if queriesRunning(processList) {
+				return fmt.Errorf("%w: queries were running", autogc.ErrBusy)
+			}
+		} else if len(processList.Processes()) > 0 {
+			return fmt.Errorf("%w: clients were connected", autogc.ErrBusy)
+		}
+		session, err := sqlEngine.NewDoltSession(ctx, sql.NewBaseSession())
+		if err != nil {
+			return err
+		}
+		sqlCtx := sql.NewContext(ctx,
+			sql.WithSession(session),
+			sql.WithProcessList(processList),
+			sql.WithServices(sql.Services{KillConnection: killConnection}))
+		err = dprocedures.CollectGarbage(sqlCtx, database, false, cfg.KillConnections())
+		if errors.Is(err, dprocedures.ErrGCConnectionsOpen) {
+			return fmt.Errorf("%w: clients connected during the gc", autogc.ErrBusy)
+		}
+		return err
+	}
+
+	return autogc.NewScheduler(autogc.Config{
+		CheckInterval: time.Duration(cfg.CheckIntervalMillis()) * time.Millisecond,
+		JournalSize:   cfg.JournalSizeBytes(),
+		NewTableFiles: cfg.NewTableFiles(),
+		Growth:        cfg.GrowthBytes(),
+	}, dbs, gc, lgr)
+}
+
+// queriesRunning returns whether any connection in |processList| is running a query.
+func queriesRunning(processList sql.ProcessList) bool {
+	for _, p := range processList.Processes() {
+		if p.Command == sql.ProcessCommandQuery {
+			return true
+		}
+	}
+	return false
+}
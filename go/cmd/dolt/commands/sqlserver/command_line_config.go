@@ -307,6 +307,30 @@ func (cfg *commandLineServerConfig) ClusterConfig() servercfg.ClusterConfig {
 	return nil
 }
 
+func (cfg *commandLineServerConfig) SlowQueryLogConfig() servercfg.SlowQueryLogConfig {
+	return nil
+}
+
+func (cfg *commandLineServerConfig) TracingConfig() servercfg.TracingConfig {
+	return nil
+}
+
+func (cfg *commandLineServerConfig) AutoGCConfig() servercfg.AutoGCConfig {
+	return nil
+}
+
+func (cfg *commandLineServerConfig) BackupConfigs() []servercfg.BackupConfig {
+	return nil
+}
+
+func (cfg *commandLineServerConfig) LDAPConfig() servercfg.LDAPConfig {
+	return nil
+}
+
+func (cfg *commandLineServerConfig) AuditLogConfig() servercfg.AuditLogConfig {
+	return nil
+}
+
 // PrivilegeFilePath returns the path to the file which contains all needed privilege information in the form of a
 // JSON string.
 func (cfg *commandLineServerConfig) PrivilegeFilePath() string {
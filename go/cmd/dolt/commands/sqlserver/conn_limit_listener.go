@@ -0,0 +1,102 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlserver
+
+import (
+	"net"
+	"sync"
+)
+
+// connLimitListener is a net.Listener which holds off accepting new connections while |limit| connections accepted
+// from it are still open, the same way the vitess listener applies max_connections. Unlike the vitess listener, its
+// limit can be changed while the server is running. A limit of zero means connections are not limited.
+type connLimitListener struct {
+	net.Listener
+
+	mu     sync.Mutex
+	cond   *sync.Cond
+	limit  uint64
+	open   uint64
+	closed bool
+}
+
+var _ net.Listener = (*connLimitListener)(nil)
+
+func newConnLimitListener(l net.Listener, limit uint64) *connLimitListener {
+	cl := &connLimitListener{Listener: l, limit: limit}
+	cl.cond = sync.NewCond(&cl.mu)
+	return cl
+}
+
+// setLimit changes the number of connections which may be open at once. Connections which are already open are
+// not closed when the limit is lowered below their number.
+func (l *connLimitListener) setLimit(limit uint64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.limit = limit
+	l.cond.Broadcast()
+}
+
+// Accept implements net.Listener.
+func (l *connLimitListener) Accept() (net.Conn, error) {
+	l.mu.Lock()
+	for !l.closed && l.limit > 0 && l.open >= l.limit {
+		l.cond.Wait()
+	}
+	if l.closed {
+		l.mu.Unlock()
+		return nil, net.ErrClosed
+	}
+	l.open++
+	l.mu.Unlock()
+
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		l.release()
+		return nil, err
+	}
+	return &limitedConn{Conn: conn, l: l}, nil
+}
+
+// Close implements net.Listener.
+func (l *connLimitListener) Close() error {
+	l.mu.Lock()
+	l.closed = true
+	l.cond.Broadcast()
+	l.mu.Unlock()
+	return l.Listener.Close()
+}
+
+func (l *connLimitListener) release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.open--
+	l.cond.Broadcast()
+}
+
+// limitedConn is a connection accepted from a connLimitListener, which releases its place under the listener's
+// limit when it is closed.
+type limitedConn struct {
+	net.Conn
+	l    *connLimitListener
+	once sync.Once
+}
+
+// Close implements net.Conn.
+func (c *limitedConn) Close() error {
+	err := c.Conn.Close()
+	c.once.Do(c.l.release)
+	return err
+}
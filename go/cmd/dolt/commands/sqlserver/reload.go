@@ -0,0 +1,361 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/commands/engine"
+	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+)
+
+// ConfigReader reads the configuration of a running server again, so that changes to it can be applied without
+// restarting the server.
+type ConfigReader func() (servercfg.ServerConfig, error)
+
+var errConfigNotReloadable = errors.New("the server configuration can only be reloaded when the server was started with --config")
+
+const (
+	settingApplied         = "applied"
+	settingRequiresRestart = "requires restart"
+)
+
+// settingChange is a setting which changed when the config was reloaded, and whether the change was applied.
+type settingChange struct {
+	setting string
+	status  string
+}
+
+// tlsCertificate holds the certificate the server presents to clients, which can be replaced while the server is
+// running. Connections which have already completed their handshake keep using the certificate they were given.
+type tlsCertificate struct {
+	cert atomic.Pointer[tls.Certificate]
+}
+
+// newTLSCertificate returns a tlsCertificate serving the certificate of |cfg|, along with a copy of |cfg| which
+// serves whichever certificate is current at the time of each handshake.
+func newTLSCertificate(cfg *tls.Config) (*tlsCertificate, *tls.Config) {
+	c := &tlsCertificate{}
+	c.cert.Store(&cfg.Certificates[0])
+	rotating := cfg.Clone()
+	rotating.Certificates = nil
+	rotating.GetCertificate = func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+		return c.cert.Load(), nil
+	}
+	return c, rotating
+}
+
+// set replaces the certificate, returning whether it differs from the previous one.
+func (c *tlsCertificate) set(cert tls.Certificate) bool {
+	prev := c.cert.Swap(&cert)
+	if len(prev.Certificate) != len(cert.Certificate) {
+		return true
+	}
+	for i := range cert.Certificate {
+		if !bytes.Equal(prev.Certificate[i], cert.Certificate[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// userSessionVars holds the session variables set on the sessions of each user, which can be replaced while the
+// server is running. Sessions which already exist keep the variables they were created with.
+type userSessionVars struct {
+	vars atomic.Pointer[map[string]map[string]string]
+}
+
+func newUserSessionVars(userVars []servercfg.UserSessionVars) *userSessionVars {
+	u := &userSessionVars{}
+	u.set(userVars)
+	return u
+}
+
+func (u *userSessionVars) set(userVars []servercfg.UserSessionVars) {
+	userToSessionVars := make(map[string]map[string]string)
+	for _, curr := range userVars {
+		userToSessionVars[curr.Name] = curr.Vars
+	}
+	u.vars.Store(&userToSessionVars)
+}
+
+func (u *userSessionVars) forUser(user string) map[string]string {
+	return (*u.vars.Load())[user]
+}
+
+// configReloader reads the server's config again and applies the settings which can change at runtime.
+type configReloader struct {
+	read        ConfigReader
+	sqlEngine   *engine.SqlEngine
+	listener    *connLimitListener
+	cert        *tlsCertificate
+	sessionVars *userSessionVars
+
+	mu sync.Mutex
+	// |running| is the flattened YAML of the config the server is running with, which only takes on the new values
+	// of settings as they are applied.
+	running map[string]interface{}
+}
+
+func newConfigReloader(read ConfigReader, cfg servercfg.ServerConfig, sqlEngine *engine.SqlEngine, listener *connLimitListener, cert *tlsCertificate, sessionVars *userSessionVars) (*configReloader, error) {
+	running, err := flattenedSettings(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &configReloader{
+		read:        read,
+		sqlEngine:   sqlEngine,
+		listener:    listener,
+		cert:        cert,
+		sessionVars: sessionVars,
+		running:     running,
+	}, nil
+}
+
+// reload reads the config again and applies every changed setting which can change at runtime, returning each
+// changed setting and whether it was applied or requires a restart. The settings which can change at runtime are
+// log_level, listener.max_connections, listener.tls_key and listener.tls_cert (only while TLS stays enabled), jwks,
+// ldap and user_session_vars. If the new config is invalid, no setting is applied. The TLS certificate is read again
+// even when its path did not change, so that certificates replaced on disk can be picked up.
+func (r *configReloader) reload() ([]settingChange, error) {
+	if r.read == nil {
+		return nil, errConfigNotReloadable
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cfg, err := r.read()
+	if err != nil {
+		return nil, err
+	}
+	if err = servercfg.ValidateConfig(cfg); err != nil {
+		return nil, err
+	}
+	updated, err := flattenedSettings(cfg)
+	if err != nil {
+		return nil, err
+	}
+	level, err := logrus.ParseLevel(cfg.LogLevel().String())
+	if err != nil {
+		return nil, err
+	}
+
+	changed := changedSettings(r.running, updated)
+	tlsChanged := changed["listener.tls_key"] || changed["listener.tls_cert"]
+	tlsEnabled := cfg.TLSKey() != "" || cfg.TLSCert() != ""
+	tlsReloadable := r.cert != nil && tlsEnabled
+	var tlsConfig *tls.Config
+	if tlsReloadable {
+		if tlsConfig, err = servercfg.LoadTLSConfig(cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	var changes []settingChange
+	status := func(setting string, applied bool) {
+		if applied {
+			if v, ok := updated[setting]; ok {
+				r.running[setting] = v
+			} else {
+				delete(r.running, setting)
+			}
+			changes = append(changes, settingChange{setting: setting, status: settingApplied})
+		} else {
+			changes = append(changes, settingChange{setting: setting, status: settingRequiresRestart})
+		}
+	}
+	for _, setting := range sortedSettings(changed) {
+		switch {
+		case setting == "log_level":
+			logrus.SetLevel(level)
+			if err = sql.SystemVariables.SetGlobal(dsess.DoltLogLevel, level.String()); err != nil {
+				return nil, err
+			}
+			status(setting, true)
+		case setting == "listener.max_connections":
+			r.listener.setLimit(cfg.MaxConnections())
+			if err = sql.SystemVariables.SetGlobal("max_connections", cfg.MaxConnections()); err != nil {
+				return nil, err
+			}
+			status(setting, true)
+		case setting == "listener.tls_key" || setting == "listener.tls_cert":
+			status(setting, tlsReloadable)
+		case setting == "jwks":
+			r.sqlEngine.SetJwksConfig(cfg.JwksConfig())
+			status(setting, true)
+		case strings.HasPrefix(setting, "ldap."):
+			r.sqlEngine.SetLDAPConfig(cfg.LDAPConfig())
+			status(setting, true)
+		case setting == "user_session_vars":
+			r.sessionVars.set(cfg.UserVars())
+			status(setting, true)
+		default:
+			status(setting, false)
+		}
+	}
+
+	if tlsReloadable && r.cert.set(tlsConfig.Certificates[0]) && !tlsChanged {
+		// The certificate was replaced on disk without its path changing.
+		changes = append(changes, settingChange{setting: "listener.tls_cert", status: settingApplied})
+	}
+	return changes, nil
+}
+
+// changedSettings returns the settings which differ between |running| and |updated|.
+func changedSettings(running, updated map[string]interface{}) map[string]bool {
+	changed := make(map[string]bool)
+	for setting, v := range running {
+		if !reflect.DeepEqual(v, updated[setting]) {
+			changed[setting] = true
+		}
+	}
+	for setting := range updated {
+		if _, ok := running[setting]; !ok {
+			changed[setting] = true
+		}
+	}
+	return changed
+}
+
+func sortedSettings(settings map[string]bool) []string {
+	sorted := make([]string, 0, len(settings))
+	for setting := range settings {
+		sorted = append(sorted, setting)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+// flattenedSettings returns the settings of |cfg| as they appear in its YAML, keyed by their dotted path. Nested
+// objects are flattened, except for system_variables, and lists are kept as a single setting.
+func flattenedSettings(cfg servercfg.ServerConfig) (map[string]interface{}, error) {
+	var yamlCfg interface{}
+	switch cfg.(type) {
+	case servercfg.YAMLConfig, *servercfg.YAMLConfig:
+		yamlCfg = cfg
+	default:
+		yamlCfg = servercfg.ServerConfigAsYAMLConfig(cfg)
+	}
+	data, err := yaml.Marshal(yamlCfg)
+	if err != nil {
+		return nil, err
+	}
+	var doc map[interface{}]interface{}
+	if err = yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	settings := make(map[string]interface{})
+	flattenInto(settings, "", doc)
+	return settings, nil
+}
+
+func flattenInto(settings map[string]interface{}, prefix string, doc map[interface{}]interface{}) {
+	for k, v := range doc {
+		key := fmt.Sprint(k)
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		if nested, ok := v.(map[interface{}]interface{}); ok && key != "system_variables" {
+			flattenInto(settings, key, nested)
+		} else if v != nil {
+			settings[key] = v
+		}
+	}
+}
+
+// newReloadConfigProcedure returns the dolt_reload_config() stored procedure, which reloads the server's config and
+// returns each changed setting and whether it was applied.
+func newReloadConfigProcedure(r *configReloader) sql.ExternalStoredProcedureDetails {
+	return sql.ExternalStoredProcedureDetails{
+		Name: "dolt_reload_config",
+		Schema: sql.Schema{
+			&sql.Column{
+				Name:     "setting",
+				Type:     types.LongText,
+				Nullable: false,
+			},
+			&sql.Column{
+				Name:     "status",
+				Type:     types.LongText,
+				Nullable: false,
+			},
+		},
+		Function: func(ctx *sql.Context) (sql.RowIter, error) {
+			changes, err := r.reload()
+			if err != nil {
+				return nil, err
+			}
+			logReloadedSettings(changes)
+			rows := make([]sql.Row, len(changes))
+			for i, c := range changes {
+				rows[i] = sql.Row{c.setting, c.status}
+			}
+			return sql.RowsToRowIter(rows...), nil
+		},
+		ReadOnly:  true,
+		AdminOnly: true,
+	}
+}
+
+// reloadOnSIGHUP reloads the server's config each time the process receives SIGHUP, until |ctx| is done.
+func reloadOnSIGHUP(ctx context.Context, r *configReloader) {
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+	defer signal.Stop(sighup)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sighup:
+			changes, err := r.reload()
+			if err != nil {
+				logrus.Errorf("unable to reload the server config: %s", err.Error())
+				continue
+			}
+			logReloadedSettings(changes)
+		}
+	}
+}
+
+func logReloadedSettings(changes []settingChange) {
+	if len(changes) == 0 {
+		logrus.Info("reloaded the server config; no settings changed")
+	}
+	for _, c := range changes {
+		if c.status == settingApplied {
+			logrus.Infof("reloaded the server config; applied the new value of %s", c.setting)
+		} else {
+			logrus.Warnf("reloaded the server config; the new value of %s takes effect when the server is restarted", c.setting)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlserver
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+)
+
+func TestConnLimitListener(t *testing.T) {
+	inner, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	l := newConnLimitListener(inner, 1)
+	defer l.Close()
+
+	accepted := make(chan net.Conn, 3)
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				close(accepted)
+				return
+			}
+			accepted <- conn
+		}
+	}()
+	dial := func() net.Conn {
+		conn, err := net.Dial("tcp", inner.Addr().String())
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+		return conn
+	}
+
+	dial()
+	first := <-accepted
+	dial()
+	select {
+	case <-accepted:
+		t.Fatal("accepted a connection over the limit")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Raising the limit accepts the waiting connection
+	l.setLimit(2)
+	second := <-accepted
+	dial()
+	select {
+	case <-accepted:
+		t.Fatal("accepted a connection over the limit")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// So does closing an open one, which is only released once
+	require.NoError(t, first.Close())
+	_ = first.Close()
+	<-accepted
+	require.NoError(t, second.Close())
+
+	require.NoError(t, l.Close())
+	_, ok := <-accepted
+	assert.False(t, ok)
+}
+
+func TestChangedSettings(t *testing.T) {
+	running, err := flattenedSettings(yamlConfig(t, `
+log_level: info
+listener:
+  port: 3306
+  max_connections: 10
+system_variables:
+  max_connections: 10
+`))
+	require.NoError(t, err)
+	updated, err := flattenedSettings(yamlConfig(t, `
+log_level: debug
+listener:
+  port: 3306
+system_variables:
+  max_connections: 20
+user_session_vars:
+- name: root
+  vars:
+    autocommit: 0
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"listener.max_connections",
+		"log_level",
+		"system_variables",
+		"user_session_vars",
+	}, sortedSettings(changedSettings(running, updated)))
+	assert.Empty(t, changedSettings(running, running))
+}
+
+func TestConfigReloader(t *testing.T) {
+	inner, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	l := newConnLimitListener(inner, 10)
+	defer l.Close()
+
+	initial := yamlConfig(t, `
+listener:
+  port: 3306
+  max_connections: 10
+`)
+	current := initial
+	sessionVars := newUserSessionVars(initial.UserVars())
+	r, err := newConfigReloader(func() (servercfg.ServerConfig, error) {
+		return current, nil
+	}, initial, nil, l, nil, sessionVars)
+	require.NoError(t, err)
+
+	changes, err := r.reload()
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+
+	current = yamlConfig(t, `
+listener:
+  port: 3307
+  max_connections: 20
+  tls_cert: chain_cert.pem
+  tls_key: chain_key.pem
+user_session_vars:
+- name: root
+  vars:
+    autocommit: 0
+`)
+	changes, err = r.reload()
+	require.NoError(t, err)
+	assert.Equal(t, []settingChange{
+		{setting: "listener.max_connections", status: settingApplied},
+		{setting: "listener.port", status: settingRequiresRestart},
+		{setting: "listener.tls_cert", status: settingRequiresRestart},
+		{setting: "listener.tls_key", status: settingRequiresRestart},
+		{setting: "user_session_vars", status: settingApplied},
+	}, changes)
+	assert.Equal(t, uint64(20), l.limit)
+	assert.Equal(t, map[string]string{"autocommit": "0"}, sessionVars.forUser("root"))
+
+	// Settings which need a restart are reported until the server is restarted
+	changes, err = r.reload()
+	require.NoError(t, err)
+	assert.Equal(t, []settingChange{
+		{setting: "listener.port", status: settingRequiresRestart},
+		{setting: "listener.tls_cert", status: settingRequiresRestart},
+		{setting: "listener.tls_key", status: settingRequiresRestart},
+	}, changes)
+
+	// An invalid config is not applied
+	current = yamlConfig(t, `
+log_level: loud
+listener:
+  max_connections: 30
+`)
+	_, err = r.reload()
+	require.Error(t, err)
+	assert.Equal(t, uint64(20), l.limit)
+
+	r.read = nil
+	_, err = r.reload()
+	assert.ErrorIs(t, err, errConfigNotReloadable)
+}
+
+func yamlConfig(t *testing.T, yaml string) *servercfg.YAMLConfig {
+	cfg, err := servercfg.NewYamlConfig([]byte(yaml))
+	require.NoError(t, err)
+	return cfg
+}
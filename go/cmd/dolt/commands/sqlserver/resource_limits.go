@@ -0,0 +1,191 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlserver
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/resourcelimits"
+)
+
+// limitsForConn returns the resource limits of the account |c| authenticated as.
+func limitsForConn(mysqlDb *mysql_db.MySQLDb, c *mysql.Conn) (sql.MysqlConnectionUser, resourcelimits.Limits) {
+	account, ok := c.UserData.(sql.MysqlConnectionUser)
+	if !ok {
+		return account, resourcelimits.Limits{}
+	}
+	limits, err := resourcelimits.ForAccount(mysqlDb, account.User, account.Host)
+	if err != nil {
+		logrus.Warnf("unable to read the resource limits of '%s'@'%s': %s", account.User, account.Host, err.Error())
+	}
+	return account, limits
+}
+
+func userLimitReachedError(user string, resource string, limit uint64) error {
+	return mysql.NewSQLError(mysql.ERUserLimitReached, mysql.SSClientError,
+		"User '%s' has exceeded the '%s' resource (current value: %d)", user, resource, limit)
+}
+
+// connectionCounts counts the open connections of each account, so that connections beyond an account's
+// max_connections limit can be refused.
+type connectionCounts struct {
+	mysqlDb *mysql_db.MySQLDb
+
+	mu sync.Mutex
+	// |open| is the number of open connections of each account, and |accounts| is the account of each of them.
+	open     map[sql.MysqlConnectionUser]uint64
+	accounts map[uint32]sql.MysqlConnectionUser
+}
+
+func newConnectionCounts(mysqlDb *mysql_db.MySQLDb) *connectionCounts {
+	return &connectionCounts{
+		mysqlDb:  mysqlDb,
+		open:     make(map[sql.MysqlConnectionUser]uint64),
+		accounts: make(map[uint32]sql.MysqlConnectionUser),
+	}
+}
+
+// add counts |conn| against the max_connections limit of its account, returning an error if it would take the
+// account over the limit. A connection is only counted once.
+func (cc *connectionCounts) add(conn *mysql.Conn) error {
+	account, limits := limitsForConn(cc.mysqlDb, conn)
+
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if _, ok := cc.accounts[conn.ConnectionID]; ok {
+		return nil
+	}
+	if limits.MaxConnections != 0 && cc.open[account] >= limits.MaxConnections {
+		return userLimitReachedError(account.User, "max_user_connections", limits.MaxConnections)
+	}
+	cc.open[account]++
+	cc.accounts[conn.ConnectionID] = account
+	return nil
+}
+
+func (cc *connectionCounts) remove(connID uint32) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	account, ok := cc.accounts[connID]
+	if !ok {
+		return
+	}
+	delete(cc.accounts, connID)
+	if cc.open[account] <= 1 {
+		delete(cc.open, account)
+	} else {
+		cc.open[account]--
+	}
+}
+
+// resourceLimitHandler is a mysql.Handler which enforces the resource limits of the account of each connection.
+// Connections which would take their account over its max_connections limit are refused during the handshake, and
+// the per-statement limits are enforced by its resourceLimitHook.
+type resourceLimitHandler struct {
+	*statementHandler
+	connections *connectionCounts
+}
+
+var _ mysql.Handler = (*resourceLimitHandler)(nil)
+
+func newResourceLimitHandler(h mysql.Handler, mysqlDb *mysql_db.MySQLDb) *resourceLimitHandler {
+	connections := newConnectionCounts(mysqlDb)
+	return &resourceLimitHandler{
+		statementHandler: newStatementHandler(h, &resourceLimitHook{mysqlDb: mysqlDb, connections: connections}),
+		connections:      connections,
+	}
+}
+
+// ComInitDB implements mysql.Handler. It is first called during the handshake of a connection, once the client has
+// authenticated and before the connection is established, so the connection is counted against the max_connections
+// limit of its account there. A refused connection is closed without ever running a statement.
+func (h *resourceLimitHandler) ComInitDB(c *mysql.Conn, schemaName string) error {
+	if err := h.connections.add(c); err != nil {
+		return err
+	}
+	return h.statementHandler.ComInitDB(c, schemaName)
+}
+
+// resourceLimitHook is a statementHook which enforces the per-statement resource limits of the account of each
+// connection: statements running for longer than max_execution_time_millis are canceled, and statements returning
+// more than max_rows_returned rows fail. It also releases the connection counted by its connectionCounts when a
+// connection is closed.
+type resourceLimitHook struct {
+	mysqlDb     *mysql_db.MySQLDb
+	connections *connectionCounts
+}
+
+var _ statementHook = (*resourceLimitHook)(nil)
+
+// statementLimits is the statementObserver which tracks the resources used by a single statement against the limits
+// of its account.
+type statementLimits struct {
+	user   string
+	limits resourcelimits.Limits
+	ctx    context.Context
+	cancel context.CancelFunc
+	rows   uint64
+}
+
+// startStatement implements statementHook. The statement runs with a context which is canceled once it has run for
+// as long as its account may run a statement.
+func (h *resourceLimitHook) startStatement(ctx context.Context, c *mysql.Conn, _ statement) (context.Context, statementObserver, error) {
+	account, limits := limitsForConn(h.mysqlDb, c)
+	if limits.MaxExecutionTimeMillis == 0 && limits.MaxRowsReturned == 0 {
+		return ctx, nil, nil
+	}
+	cancel := func() {}
+	if limits.MaxExecutionTimeMillis != 0 {
+		ctx, cancel = context.WithTimeout(ctx, limits.MaxExecutionTime())
+	}
+	return ctx, &statementLimits{user: account.User, limits: limits, ctx: ctx, cancel: cancel}, nil
+}
+
+// connectionClosed implements statementHook.
+func (h *resourceLimitHook) connectionClosed(c *mysql.Conn) {
+	h.connections.remove(c.ConnectionID)
+}
+
+// result implements statementObserver. It counts the rows of |res| towards the statement's max_rows_returned limit,
+// returning an error once it has been exceeded.
+func (s *statementLimits) result(res *sqltypes.Result) error {
+	if res == nil || s.limits.MaxRowsReturned == 0 {
+		return nil
+	}
+	s.rows += uint64(len(res.Rows))
+	if s.rows > s.limits.MaxRowsReturned {
+		return userLimitReachedError(s.user, "max_rows_returned", s.limits.MaxRowsReturned)
+	}
+	return nil
+}
+
+// finish implements statementObserver. A statement which failed after running out of time fails with
+// ER_QUERY_TIMEOUT.
+func (s *statementLimits) finish(err error) error {
+	defer s.cancel()
+	if err != nil && errors.Is(s.ctx.Err(), context.DeadlineExceeded) {
+		return mysql.NewSQLError(mysql.ERQueryTimeout, mysql.SSUnknownSQLState,
+			"Query execution was interrupted, maximum statement execution time exceeded")
+	}
+	return err
+}
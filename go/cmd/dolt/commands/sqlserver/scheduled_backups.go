@@ -0,0 +1,59 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlserver
+
+import (
+	"context"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/commands/engine"
+	"github.com/dolthub/dolt/go/libraries/doltcore/backup"
+	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dprocedures"
+	"github.com/dolthub/dolt/go/libraries/utils/schedule"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+// newBackupScheduler returns a backup.Scheduler which backs up the databases of |sqlEngine| as configured by |cfgs|.
+// Each backup is synced in a session of its own, and its restore points are kept alongside its table files.
+func newBackupScheduler(cfgs []servercfg.BackupConfig, sqlEngine *engine.SqlEngine, lgr *logrus.Logger) (*backup.Scheduler, error) {
+	jobs := make([]backup.Job, len(cfgs))
+	for i, cfg := range cfgs {
+		s, err := schedule.Parse(cfg.Schedule())
+		if err != nil {
+			return nil, err
+		}
+		jobs[i] = backup.Job{
+			Database:  cfg.Database(),
+			URL:       cfg.URL(),
+			Spec:      cfg.Schedule(),
+			Schedule:  s,
+			Retention: cfg.Retention(),
+		}
+	}
+
+	sync := func(ctx context.Context, database, url string) (hash.Hash, error) {
+		session, err := sqlEngine.NewDoltSession(ctx, sql.NewBaseSession())
+		if err != nil {
+			return hash.Hash{}, err
+		}
+		sqlCtx := sql.NewContext(ctx, sql.WithSession(session))
+		return dprocedures.SyncBackup(sqlCtx, database, url)
+	}
+
+	return backup.NewScheduler(jobs, sync, backup.DefaultBlobstoreFunc, lgr), nil
+}
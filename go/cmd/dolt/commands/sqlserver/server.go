@@ -37,6 +37,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
 	goerrors "gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
@@ -44,15 +47,20 @@ import (
 	"github.com/dolthub/dolt/go/cmd/dolt/commands/engine"
 	eventsapi "github.com/dolthub/dolt/go/gen/proto/dolt/services/eventsapi/v1alpha1"
 	remotesapi "github.com/dolthub/dolt/go/gen/proto/dolt/services/remotesapi/v1alpha1"
+	"github.com/dolthub/dolt/go/libraries/doltcore/backup"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
 	"github.com/dolthub/dolt/go/libraries/doltcore/remotesrv"
 	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/auditlog"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/autogc"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/binlogreplication"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/cluster"
 	_ "github.com/dolthub/dolt/go/libraries/doltcore/sqle/dfunctions"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtables"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/slowlog"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqlserver"
 	"github.com/dolthub/dolt/go/libraries/events"
 	"github.com/dolthub/dolt/go/libraries/utils/config"
@@ -78,6 +86,7 @@ func Serve(
 	ctx context.Context,
 	version string,
 	serverConfig servercfg.ServerConfig,
+	configReader ConfigReader,
 	controller *svcs.Controller,
 	dEnv *env.DoltEnv,
 ) (startError error, closeError error) {
@@ -86,7 +95,7 @@ func Serve(
 		controller = svcs.NewController()
 	}
 
-	ConfigureServices(serverConfig, controller, version, dEnv)
+	ConfigureServices(serverConfig, configReader, controller, version, dEnv)
 
 	go controller.Start(ctx)
 	err := controller.WaitForStart()
@@ -96,8 +105,12 @@ func Serve(
 	return nil, controller.WaitForStop()
 }
 
+// ConfigureServices registers the services which make up a running sql-server with |controller|. If |configReader| is
+// not nil, the server's config is read again with it and reloaded when the process receives SIGHUP or when
+// dolt_reload_config() is called.
 func ConfigureServices(
 	serverConfig servercfg.ServerConfig,
+	configReader ConfigReader,
 	controller *svcs.Controller,
 	version string,
 	dEnv *env.DoltEnv,
@@ -222,15 +235,92 @@ func ConfigureServices(
 	}
 	controller.Register(InitClusterController)
 
+	var slowQueryLog *slowlog.Log
+	InitSlowQueryLog := &svcs.AnonService{
+		InitF: func(context.Context) (err error) {
+			if cfg := serverConfig.SlowQueryLogConfig(); cfg != nil {
+				slowQueryLog, err = newSlowQueryLog(cfg)
+			}
+			return err
+		},
+		StopF: func() error {
+			if slowQueryLog == nil {
+				return nil
+			}
+			return slowQueryLog.Close()
+		},
+	}
+	controller.Register(InitSlowQueryLog)
+
+	var auditLog *auditlog.Log
+	InitAuditLog := &svcs.AnonService{
+		InitF: func(context.Context) (err error) {
+			if cfg := serverConfig.AuditLogConfig(); cfg != nil {
+				auditLog, err = newAuditLog(cfg)
+			}
+			return err
+		},
+		StopF: func() error {
+			if auditLog == nil {
+				return nil
+			}
+			return auditLog.Close()
+		},
+	}
+	controller.Register(InitAuditLog)
+
 	var serverConf server.Config
+	var tlsCert *tlsCertificate
 	LoadServerConfig := &svcs.AnonService{
 		InitF: func(context.Context) (err error) {
 			serverConf, err = getConfigFromServerConfig(serverConfig)
-			return err
+			if err != nil {
+				return err
+			}
+			// Serve the certificate through a tlsCertificate, so that it can be rotated when the config is reloaded
+			if serverConf.TLSConfig != nil {
+				tlsCert, serverConf.TLSConfig = newTLSCertificate(serverConf.TLSConfig)
+			}
+			return nil
 		},
 	}
 	controller.Register(LoadServerConfig)
 
+	var tracerProvider *tracesdk.TracerProvider
+	InitTracing := &svcs.AnonService{
+		InitF: func(ctx context.Context) (err error) {
+			cfg := serverConfig.TracingConfig()
+			if cfg == nil {
+				return nil
+			}
+			tracerProvider, err = newTracerProvider(ctx, cfg)
+			if err != nil {
+				return err
+			}
+			otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+				lgr.Warnf("error exporting traces: %s", err.Error())
+			}))
+			otel.SetTracerProvider(tracerProvider)
+			otel.SetTextMapPropagator(propagation.TraceContext{})
+			serverConf.Tracer = tracerProvider.Tracer("github.com/dolthub/dolt/go/cmd/dolt/commands/sqlserver")
+			return nil
+		},
+		StopF: func() error {
+			if tracerProvider == nil {
+				return nil
+			}
+			// Export the spans which are still buffered before exiting, without holding up shutdown for long if the
+			// collector is unreachable
+			ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+			defer cancel()
+			if err := tracerProvider.Shutdown(ctx); err != nil {
+				lgr.Warnf("unable to export buffered traces: %s", err.Error())
+			}
+			return nil
+		},
+	}
+	controller.Register(InitTracing)
+
 	// Create SQL Engine with users
 	var config *engine.SqlEngineConfig
 	InitSqlEngineConfig := &svcs.AnonService{
@@ -246,9 +336,11 @@ func ConfigureServices(
 				Autocommit:              serverConfig.AutoCommit(),
 				DoltTransactionCommit:   serverConfig.DoltTransactionCommit(),
 				JwksConfig:              serverConfig.JwksConfig(),
+				LDAPConfig:              serverConfig.LDAPConfig(),
 				SystemVariables:         serverConfig.SystemVars(),
 				ClusterController:       clusterController,
 				BinlogReplicaController: binlogreplication.DoltBinlogReplicaController,
+				SlowQueryLog:            slowQueryLog,
 			}
 			return nil
 		},
@@ -611,31 +703,72 @@ func ConfigureServices(
 
 	var sqlServerClosed bool
 	var mySQLServer *server.Server
+	var sessionVars *userSessionVars
+	var connLimiter *connLimitListener
 	InitSQLServer := &svcs.AnonService{
 		InitF: func(context.Context) (err error) {
+			sessionVars = newUserSessionVars(serverConfig.UserVars())
+			sessionBuilder := newSessionBuilder(sqlEngine, sessionVars)
+			var wrappers []server.HandlerWrapper
 			v, ok := serverConfig.(servercfg.ValidatingServerConfig)
 			if ok && v.GoldenMysqlConnectionString() != "" {
-				mySQLServer, err = server.NewServerWithHandler(
-					serverConf,
-					sqlEngine.GetUnderlyingEngine(),
-					newSessionBuilder(sqlEngine, serverConfig),
-					metListener,
-					func(h mysql.Handler) (mysql.Handler, error) {
-						return golden.NewValidatingHandler(h, v.GoldenMysqlConnectionString(), logrus.StandardLogger())
-					},
-				)
-			} else {
-				mySQLServer, err = server.NewServer(
-					serverConf,
-					sqlEngine.GetUnderlyingEngine(),
-					newSessionBuilder(sqlEngine, serverConfig),
-					metListener,
-				)
+				wrappers = append(wrappers, func(h mysql.Handler) (mysql.Handler, error) {
+					return golden.NewValidatingHandler(h, v.GoldenMysqlConnectionString(), logrus.StandardLogger())
+				})
 			}
+			mysqlDb := sqlEngine.GetUnderlyingEngine().Analyzer.Catalog.MySQLDb
+			wrappers = append(wrappers, func(h mysql.Handler) (mysql.Handler, error) {
+				return newResourceLimitHandler(h, mysqlDb), nil
+			})
+			if slowQueryLog != nil || auditLog != nil {
+				sessions := newConnectionSessions()
+				sessionBuilder = sessions.wrap(sessionBuilder)
+				if slowQueryLog != nil {
+					wrappers = append(wrappers, func(h mysql.Handler) (mysql.Handler, error) {
+						return newSlowQueryHandler(h, slowQueryLog, sessions), nil
+					})
+				}
+				if auditLog != nil {
+					wrappers = append(wrappers, func(h mysql.Handler) (mysql.Handler, error) {
+						return newAuditHandler(h, auditLog, sessions, serverConfig.AuditLogConfig().FailClosed()), nil
+					})
+				}
+			}
+			if tracerProvider != nil {
+				// Applied last, so that every other handler runs within the client's trace
+				wrappers = append(wrappers, func(h mysql.Handler) (mysql.Handler, error) {
+					return newTraceContextHandler(h), nil
+				})
+			}
+
+			// The server's connections are limited by a listener of our own rather than by vitess, so that the limit can
+			// be changed when the config is reloaded.
+			l, err := server.NewListener(serverConf.Protocol, serverConf.Address, serverConf.Socket)
 			if errors.Is(err, server.UnixSocketInUseError) {
 				lgr.Warn("unix socket set up failed: file already in use: ", serverConf.Socket)
-				err = nil
+			} else if err != nil {
+				return err
 			}
+			connLimiter = newConnLimitListener(l, serverConf.MaxConnections)
+			listenerConf := serverConf
+			listenerConf.Listener = connLimiter
+			listenerConf.MaxConnections = 0
+
+			mySQLServer, err = server.NewServerWithHandler(
+				listenerConf,
+				sqlEngine.GetUnderlyingEngine(),
+				sessionBuilder,
+				metListener,
+				func(h mysql.Handler) (mysql.Handler, error) {
+					for _, wrapper := range wrappers {
+						h, err = wrapper(h)
+						if err != nil {
+							return nil, err
+						}
+					}
+					return h, nil
+				},
+			)
 			return err
 		},
 		StopF: func() (err error) {
@@ -648,6 +781,33 @@ func ConfigureServices(
 	}
 	controller.Register(InitSQLServer)
 
+	var stopReloadOnSIGHUP context.CancelFunc
+	InitConfigReloader := &svcs.AnonService{
+		InitF: func(ctx context.Context) error {
+			reloader, err := newConfigReloader(configReader, serverConfig, sqlEngine, connLimiter, tlsCert, sessionVars)
+			if err != nil {
+				return err
+			}
+			provider := sqlEngine.GetUnderlyingEngine().Analyzer.Catalog.DbProvider
+			if doltProvider, ok := provider.(*sqle.DoltDatabaseProvider); ok {
+				doltProvider.Register(newReloadConfigProcedure(reloader))
+			}
+			if configReader != nil {
+				var sighupCtx context.Context
+				sighupCtx, stopReloadOnSIGHUP = context.WithCancel(context.Background())
+				go reloadOnSIGHUP(sighupCtx, reloader)
+			}
+			return nil
+		},
+		StopF: func() error {
+			if stopReloadOnSIGHUP != nil {
+				stopReloadOnSIGHUP()
+			}
+			return nil
+		},
+	}
+	controller.Register(InitConfigReloader)
+
 	// Automatically restart binlog replication if replication was enabled when the server was last shut down
 	AutoStartBinlogReplica := &svcs.AnonService{
 		InitF: func(ctx context.Context) error {
@@ -688,6 +848,76 @@ func ConfigureServices(
 	}
 	controller.Register(RunClusterController)
 
+	var autoGC *autogc.Scheduler
+	var autoGCCtx context.Context
+	var stopAutoGC context.CancelFunc
+	RunAutoGC := &svcs.AnonService{
+		InitF: func(context.Context) error {
+			cfg := serverConfig.AutoGCConfig()
+			if cfg == nil {
+				return nil
+			}
+			provider, ok := sqlEngine.GetUnderlyingEngine().Analyzer.Catalog.DbProvider.(*sqle.DoltDatabaseProvider)
+			if !ok {
+				return nil
+			}
+			autoGC = newAutoGC(cfg, sqlEngine, provider, mySQLServer.SessionManager().KillConnection, lgr)
+			provider.SetServerTable(dtables.NewGCStatusTable(autoGC))
+			autoGCCtx, stopAutoGC = context.WithCancel(context.Background())
+			return nil
+		},
+		RunF: func(context.Context) {
+			if autoGC == nil {
+				return
+			}
+			autoGC.Run(autoGCCtx)
+		},
+		StopF: func() error {
+			if stopAutoGC != nil {
+				stopAutoGC()
+			}
+			return nil
+		},
+	}
+	controller.Register(RunAutoGC)
+
+	var backupScheduler *backup.Scheduler
+	var backupsCtx context.Context
+	var stopBackups context.CancelFunc
+	RunScheduledBackups := &svcs.AnonService{
+		InitF: func(context.Context) error {
+			cfgs := serverConfig.BackupConfigs()
+			if len(cfgs) == 0 {
+				return nil
+			}
+			provider, ok := sqlEngine.GetUnderlyingEngine().Analyzer.Catalog.DbProvider.(*sqle.DoltDatabaseProvider)
+			if !ok {
+				return nil
+			}
+			var err error
+			backupScheduler, err = newBackupScheduler(cfgs, sqlEngine, lgr)
+			if err != nil {
+				return err
+			}
+			provider.SetServerTable(dtables.NewBackupStatusTable(backupScheduler))
+			backupsCtx, stopBackups = context.WithCancel(context.Background())
+			return nil
+		},
+		RunF: func(context.Context) {
+			if backupScheduler == nil {
+				return
+			}
+			backupScheduler.Run(backupsCtx)
+		},
+		StopF: func() error {
+			if stopBackups != nil {
+				stopBackups()
+			}
+			return nil
+		},
+	}
+	controller.Register(RunScheduledBackups)
+
 	RunSQLServer := &svcs.AnonService{
 		RunF: func(context.Context) {
 			sqlserver.SetRunningServer(mySQLServer)
@@ -893,13 +1123,7 @@ func portInUse(hostPort string) bool {
 	return false
 }
 
-func newSessionBuilder(se *engine.SqlEngine, config servercfg.ServerConfig) server.SessionBuilder {
-	userToSessionVars := make(map[string]map[string]string)
-	userVars := config.UserVars()
-	for _, curr := range userVars {
-		userToSessionVars[curr.Name] = curr.Vars
-	}
-
+func newSessionBuilder(se *engine.SqlEngine, userVars *userSessionVars) server.SessionBuilder {
 	return func(ctx context.Context, conn *mysql.Conn, addr string) (sql.Session, error) {
 		baseSession, err := sql.BaseSessionFromConnection(ctx, conn, addr)
 		if err != nil {
@@ -911,7 +1135,11 @@ func newSessionBuilder(se *engine.SqlEngine, config servercfg.ServerConfig) serv
 			return nil, err
 		}
 
-		varsForUser := userToSessionVars[conn.User]
+		if err = se.ApplyAuthenticatedGrants(ctx, dsess); err != nil {
+			return nil, err
+		}
+
+		varsForUser := userVars.forUser(conn.User)
 		if len(varsForUser) > 0 {
 			sqlCtx, err := se.NewContext(ctx, dsess)
 			if err != nil {
@@ -968,6 +1196,7 @@ func getConfigFromServerConfig(serverConfig servercfg.ServerConfig) (server.Conf
 	serverConf.MaxConnections = serverConfig.MaxConnections()
 	serverConf.TLSConfig = tlsConfig
 	serverConf.RequireSecureTransport = serverConfig.RequireSecureTransport()
+	serverConf.AllowClearTextWithoutTLS = serverConfig.AllowCleartextPasswords()
 	serverConf.MaxLoggedQueryLen = serverConfig.MaxLoggedQueryLen()
 	serverConf.EncodeLoggedQuery = serverConfig.ShouldEncodeLoggedQuery()
 
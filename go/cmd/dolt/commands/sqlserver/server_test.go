@@ -187,7 +187,7 @@ func TestServerGoodParams(t *testing.T) {
 		t.Run(servercfg.ConfigInfo(test), func(t *testing.T) {
 			sc := svcs.NewController()
 			go func(config servercfg.ServerConfig, sc *svcs.Controller) {
-				_, _ = Serve(context.Background(), "0.0.0", config, sc, env)
+				_, _ = Serve(context.Background(), "0.0.0", config, nil, sc, env)
 			}(test, sc)
 			err := sc.WaitForStart()
 			require.NoError(t, err)
@@ -214,7 +214,7 @@ func TestServerSelect(t *testing.T) {
 	sc := svcs.NewController()
 	defer sc.Stop()
 	go func() {
-		_, _ = Serve(context.Background(), "0.0.0", serverConfig, sc, env)
+		_, _ = Serve(context.Background(), "0.0.0", serverConfig, nil, sc, env)
 	}()
 	err = sc.WaitForStart()
 	require.NoError(t, err)
@@ -313,7 +313,7 @@ func TestServerSetDefaultBranch(t *testing.T) {
 	sc := svcs.NewController()
 	defer sc.Stop()
 	go func() {
-		_, _ = Serve(context.Background(), "0.0.0", serverConfig, sc, dEnv)
+		_, _ = Serve(context.Background(), "0.0.0", serverConfig, nil, sc, dEnv)
 	}()
 	err = sc.WaitForStart()
 	require.NoError(t, err)
@@ -477,7 +477,7 @@ func TestReadReplica(t *testing.T) {
 
 	os.Chdir(multiSetup.DbPaths[readReplicaDbName])
 	go func() {
-		err, _ = Serve(context.Background(), "0.0.0", serverConfig, sc, multiSetup.GetEnv(readReplicaDbName))
+		err, _ = Serve(context.Background(), "0.0.0", serverConfig, nil, sc, multiSetup.GetEnv(readReplicaDbName))
 		require.NoError(t, err)
 	}()
 	require.NoError(t, sc.WaitForStart())
@@ -0,0 +1,193 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlserver
+
+import (
+	"context"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/server"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/slowlog"
+)
+
+// newSlowQueryLog returns the slow query log configured by |cfg|, opening its log file for appending if it has one.
+func newSlowQueryLog(cfg servercfg.SlowQueryLogConfig) (*slowlog.Log, error) {
+	var w io.WriteCloser
+	if cfg.LogFile() != "" {
+		f, err := os.OpenFile(cfg.LogFile(), os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+		if err != nil {
+			return nil, err
+		}
+		w = f
+	}
+	// Sessions only count the rows they examine once this is called, as nothing else reads the counts
+	index.EnableRowsExamined()
+	threshold := time.Duration(cfg.ThresholdMillis()) * time.Millisecond
+	return slowlog.New(threshold, cfg.MaxEntries(), w), nil
+}
+
+// connectionSessions tracks the session of each connection, so that the hooks of the handlers wrapping the server's
+// handler, such as slowQueryHook, can read the session's current database and counters around each query they run.
+type connectionSessions struct {
+	mu       sync.Mutex
+	sessions map[uint32]*dsess.DoltSession
+}
+
+func newConnectionSessions() *connectionSessions {
+	return &connectionSessions{sessions: make(map[uint32]*dsess.DoltSession)}
+}
+
+// wrap returns a SessionBuilder which records each session built by |sb|.
+func (s *connectionSessions) wrap(sb server.SessionBuilder) server.SessionBuilder {
+	return func(ctx context.Context, conn *mysql.Conn, addr string) (sql.Session, error) {
+		sess, err := sb(ctx, conn, addr)
+		if err != nil {
+			return nil, err
+		}
+		if doltSess, ok := sess.(*dsess.DoltSession); ok {
+			s.mu.Lock()
+			s.sessions[conn.ConnectionID] = doltSess
+			s.mu.Unlock()
+		}
+		return sess, nil
+	}
+}
+
+func (s *connectionSessions) get(connID uint32) *dsess.DoltSession {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sessions[connID]
+}
+
+func (s *connectionSessions) remove(connID uint32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.sessions, connID)
+}
+
+// slowQueryHook is a statementHook which times every statement run by the handler it wraps, and records the ones
+// which exceed the threshold of its slow query log.
+type slowQueryHook struct {
+	log      *slowlog.Log
+	sessions *connectionSessions
+}
+
+var _ statementHook = (*slowQueryHook)(nil)
+
+func newSlowQueryHandler(h mysql.Handler, log *slowlog.Log, sessions *connectionSessions) *statementHandler {
+	return newStatementHandler(h, &slowQueryHook{log: log, sessions: sessions})
+}
+
+// slowQuery is the statementObserver of a single statement timed by a slowQueryHook. Rows examined are counted per
+// session, but chunks read are counted for the whole database, as the chunk store doesn't know which session
+// requested a chunk.
+type slowQuery struct {
+	log          *slowlog.Log
+	conn         *mysql.Conn
+	query        string
+	sess         *dsess.DoltSession
+	time         time.Time
+	database     string
+	ddb          *doltdb.DoltDB
+	rowsExamined uint64
+	chunksRead   uint64
+	rowsReturned uint64
+}
+
+// startStatement implements statementHook.
+func (h *slowQueryHook) startStatement(ctx context.Context, c *mysql.Conn, stmt statement) (context.Context, statementObserver, error) {
+	q := &slowQuery{log: h.log, conn: c, query: stmt.query, time: time.Now()}
+	q.sess = h.sessions.get(c.ConnectionID)
+	if q.sess == nil {
+		return ctx, q, nil
+	}
+	q.rowsExamined = q.sess.RowsExamined()
+	q.database = q.sess.GetCurrentDatabase()
+	if q.database != "" {
+		sqlCtx := sql.NewContext(ctx, sql.WithSession(q.sess))
+		if db, ok := q.sess.Provider().BaseDatabase(sqlCtx, q.database); ok {
+			q.ddb = db.DbData().Ddb
+			q.chunksRead = q.ddb.ChunksRequested()
+		}
+	}
+	return ctx, q, nil
+}
+
+// connectionClosed implements statementHook.
+func (h *slowQueryHook) connectionClosed(c *mysql.Conn) {
+	h.sessions.remove(c.ConnectionID)
+}
+
+// result implements statementObserver.
+func (q *slowQuery) result(res *sqltypes.Result) error {
+	if res != nil {
+		q.rowsReturned += uint64(len(res.Rows))
+	}
+	return nil
+}
+
+// finish implements statementObserver.
+func (q *slowQuery) finish(err error) error {
+	duration := time.Since(q.time)
+	if duration < q.log.Threshold() || q.sess == nil {
+		return err
+	}
+
+	entry := slowlog.Entry{
+		Start:        q.time,
+		ConnectionID: q.conn.ConnectionID,
+		User:         q.sess.Client().User,
+		Host:         clientHost(q.conn, q.sess),
+		Database:     q.database,
+		Duration:     duration,
+		RowsExamined: q.sess.RowsExamined() - q.rowsExamined,
+		RowsReturned: q.rowsReturned,
+		Fingerprint:  slowlog.Fingerprint(q.query),
+		Query:        q.query,
+	}
+	if q.ddb != nil {
+		entry.ChunksRead = q.ddb.ChunksRequested() - q.chunksRead
+	}
+	if q.database != "" {
+		entry.Branch, _ = q.sess.GetBranch()
+	}
+	if werr := q.log.Record(entry); werr != nil {
+		logrus.Warnf("error writing to the slow query log: %s", werr.Error())
+	}
+	return err
+}
+
+// clientHost returns the address |c| connected from, falling back to the host of the account it authenticated as.
+func clientHost(c *mysql.Conn, sess *dsess.DoltSession) string {
+	if addr := c.RemoteAddr(); addr != nil {
+		if host, _, err := net.SplitHostPort(addr.String()); err == nil {
+			return host
+		}
+	}
+	return sess.Client().Address
+}
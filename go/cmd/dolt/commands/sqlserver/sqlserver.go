@@ -246,7 +246,7 @@ func StartServer(ctx context.Context, versionStr, commandStr string, args []stri
 
 	cli.PrintErrf("Starting server with Config %v\n", servercfg.ConfigInfo(serverConfig))
 
-	startError, closeError := Serve(ctx, versionStr, serverConfig, controller, dEnv)
+	startError, closeError := Serve(ctx, versionStr, serverConfig, configFileReader(ap, help, args, dEnv), controller, dEnv)
 	if startError != nil {
 		return startError
 	}
@@ -257,6 +257,21 @@ func StartServer(ctx context.Context, versionStr, commandStr string, args []stri
 	return nil
 }
 
+// configFileReader returns a ConfigReader which reads the config file given in |args| again, or nil if the server is
+// not configured with a config file.
+func configFileReader(ap *argparser.ArgParser, help cli.UsagePrinter, args []string, dEnv *env.DoltEnv) ConfigReader {
+	apr := cli.ParseArgsOrDie(ap, args, help)
+	if _, ok := apr.GetValue(configFileFlag); !ok {
+		return nil
+	}
+	// The server changes the working directory of dEnv.FS to its data dir, but the config file is relative to the
+	// directory the server was started in.
+	cwdFS := dEnv.FS
+	return func() (servercfg.ServerConfig, error) {
+		return getServerConfig(cwdFS, apr, DoltServerConfigReader{})
+	}
+}
+
 // ServerConfigFromArgs returns a ServerConfig from the given args
 func ServerConfigFromArgs(ap *argparser.ArgParser, help cli.UsagePrinter, args []string, dEnv *env.DoltEnv) (servercfg.ServerConfig, error) {
 	return ServerConfigFromArgsWithReader(ap, help, args, dEnv, DoltServerConfigReader{})
@@ -0,0 +1,148 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlserver
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// statement is a statement a statementHandler is about to run.
+type statement struct {
+	// query is the text of the statement. Of a query with multiple statements, it is only the first one, which is
+	// the one that is run.
+	query string
+	// prepare is the prepared statement being executed, or nil if the statement was sent as a query.
+	prepare *mysql.PrepareData
+}
+
+// statementHook is what a statementHandler does around each statement it runs.
+type statementHook interface {
+	// startStatement is called before |stmt| runs on |c|. It returns the context to run the statement with and the
+	// statementObserver to tell about it, which may be nil. Returning an error refuses to run the statement.
+	startStatement(ctx context.Context, c *mysql.Conn, stmt statement) (context.Context, statementObserver, error)
+	// connectionClosed is called when |c| is closed.
+	connectionClosed(c *mysql.Conn)
+}
+
+// statementObserver is told about the results of a single statement.
+type statementObserver interface {
+	// result is called with each result of the statement before it is sent to the client. Returning an error fails
+	// the statement.
+	result(res *sqltypes.Result) error
+	// finish is called with the error the statement ran with once it has run, and returns the error it fails with.
+	finish(err error) error
+}
+
+// statementHandler is a mysql.Handler which runs every statement of the handler it wraps through its statementHook.
+// The handlers which wrap the server's handler, such as the slow query log and the audit log, are statementHandlers,
+// so that each of them only implements what it does around a statement.
+type statementHandler struct {
+	mysql.Handler
+	hook statementHook
+}
+
+var _ mysql.Handler = (*statementHandler)(nil)
+var _ mysql.BinlogReplicaHandler = (*statementHandler)(nil)
+
+func newStatementHandler(h mysql.Handler, hook statementHook) *statementHandler {
+	return &statementHandler{Handler: h, hook: hook}
+}
+
+// ComQuery implements mysql.Handler.
+func (h *statementHandler) ComQuery(ctx context.Context, c *mysql.Conn, query string, callback mysql.ResultSpoolFn) error {
+	ctx, obs, err := h.hook.startStatement(ctx, c, statement{query: query})
+	if err != nil {
+		return err
+	}
+	if obs == nil {
+		return h.Handler.ComQuery(ctx, c, query, callback)
+	}
+	err = h.Handler.ComQuery(ctx, c, query, func(res *sqltypes.Result, more bool) error {
+		if err := obs.result(res); err != nil {
+			return err
+		}
+		return callback(res, more)
+	})
+	return obs.finish(err)
+}
+
+// ComMultiQuery implements mysql.Handler.
+func (h *statementHandler) ComMultiQuery(ctx context.Context, c *mysql.Conn, query string, callback mysql.ResultSpoolFn) (string, error) {
+	// Only the first statement in |query| is run, the rest of it is returned in the remainder. Hooks are told about
+	// the statement before it runs, so it is split off by parsing it rather than from the remainder.
+	stmt := query
+	if _, ri, err := sqlparser.ParseOne(ctx, query); err == nil && ri > 0 && ri < len(query) {
+		stmt = strings.TrimSpace(query[:ri])
+	}
+	ctx, obs, err := h.hook.startStatement(ctx, c, statement{query: stmt})
+	if err != nil {
+		return "", err
+	}
+	if obs == nil {
+		return h.Handler.ComMultiQuery(ctx, c, query, callback)
+	}
+	remainder, err := h.Handler.ComMultiQuery(ctx, c, query, func(res *sqltypes.Result, more bool) error {
+		if err := obs.result(res); err != nil {
+			return err
+		}
+		return callback(res, more)
+	})
+	return remainder, obs.finish(err)
+}
+
+// ComStmtExecute implements mysql.Handler.
+func (h *statementHandler) ComStmtExecute(ctx context.Context, c *mysql.Conn, prepare *mysql.PrepareData, callback func(*sqltypes.Result) error) error {
+	ctx, obs, err := h.hook.startStatement(ctx, c, statement{query: prepare.PrepareStmt, prepare: prepare})
+	if err != nil {
+		return err
+	}
+	if obs == nil {
+		return h.Handler.ComStmtExecute(ctx, c, prepare, callback)
+	}
+	err = h.Handler.ComStmtExecute(ctx, c, prepare, func(res *sqltypes.Result) error {
+		if err := obs.result(res); err != nil {
+			return err
+		}
+		return callback(res)
+	})
+	return obs.finish(err)
+}
+
+// ConnectionClosed implements mysql.Handler.
+func (h *statementHandler) ConnectionClosed(c *mysql.Conn) {
+	h.hook.connectionClosed(c)
+	h.Handler.ConnectionClosed(c)
+}
+
+// ComRegisterReplica implements mysql.BinlogReplicaHandler.
+func (h *statementHandler) ComRegisterReplica(c *mysql.Conn, replicaHost string, replicaPort uint16, replicaUser string, replicaPassword string) error {
+	if brh, ok := h.Handler.(mysql.BinlogReplicaHandler); ok {
+		return brh.ComRegisterReplica(c, replicaHost, replicaPort, replicaUser, replicaPassword)
+	}
+	return mysql.NewSQLError(mysql.ERUnknownComError, mysql.SSUnknownComError, "command handling not implemented yet: COM_REGISTER_REPLICA")
+}
+
+// ComBinlogDumpGTID implements mysql.BinlogReplicaHandler.
+func (h *statementHandler) ComBinlogDumpGTID(c *mysql.Conn, logFile string, logPos uint64, gtidSet mysql.GTIDSet) error {
+	if brh, ok := h.Handler.(mysql.BinlogReplicaHandler); ok {
+		return brh.ComBinlogDumpGTID(c, logFile, logPos, gtidSet)
+	}
+	return mysql.NewSQLError(mysql.ERUnknownComError, mysql.SSUnknownComError, "command handling not implemented yet: COM_BINLOG_DUMP_GTID")
+}
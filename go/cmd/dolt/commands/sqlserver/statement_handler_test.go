@@ -0,0 +1,127 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlserver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatementHandler(t *testing.T) {
+	hook := &recordingHook{}
+	h := newStatementHandler(&resultHandler{}, hook)
+	ctx := context.Background()
+
+	var sent int
+	require.NoError(t, h.ComQuery(ctx, nil, "select 1", func(res *sqltypes.Result, more bool) error {
+		sent++
+		return nil
+	}))
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, []string{"select 1"}, hook.started)
+	assert.Equal(t, 2, hook.rows)
+	assert.Equal(t, 1, hook.finished)
+
+	// Only the first statement of a multi-statement query is run
+	_, err := h.ComMultiQuery(ctx, nil, "select 2; select 3", func(*sqltypes.Result, bool) error { return nil })
+	require.NoError(t, err)
+	assert.Equal(t, "select 2;", hook.started[1])
+
+	prepare := &mysql.PrepareData{PrepareStmt: "select ?"}
+	require.NoError(t, h.ComStmtExecute(ctx, nil, prepare, func(*sqltypes.Result) error { return nil }))
+	assert.Equal(t, "select ?", hook.started[2])
+	assert.Same(t, prepare, hook.prepare)
+
+	// A refused statement isn't run, and a failed result isn't sent to the client
+	hook.refuse = errors.New("refused")
+	assert.ErrorIs(t, h.ComQuery(ctx, nil, "select 4", nil), hook.refuse)
+	assert.Equal(t, 3, hook.finished)
+	hook.refuse = nil
+	hook.fail = errors.New("too many rows")
+	sent = 0
+	err = h.ComQuery(ctx, nil, "select 5", func(*sqltypes.Result, bool) error {
+		sent++
+		return nil
+	})
+	assert.ErrorIs(t, err, hook.fail)
+	assert.Equal(t, 0, sent)
+
+	h.ConnectionClosed(nil)
+	assert.True(t, hook.closed)
+}
+
+// resultHandler is a mysql.Handler which returns a single result with two rows for every statement.
+type resultHandler struct {
+	mysql.Handler
+}
+
+var twoRows = &sqltypes.Result{Rows: [][]sqltypes.Value{{sqltypes.NewInt64(1)}, {sqltypes.NewInt64(2)}}}
+
+func (h *resultHandler) ComQuery(_ context.Context, _ *mysql.Conn, _ string, callback mysql.ResultSpoolFn) error {
+	return callback(twoRows, false)
+}
+
+func (h *resultHandler) ComMultiQuery(_ context.Context, _ *mysql.Conn, _ string, callback mysql.ResultSpoolFn) (string, error) {
+	return "", callback(twoRows, false)
+}
+
+func (h *resultHandler) ComStmtExecute(_ context.Context, _ *mysql.Conn, _ *mysql.PrepareData, callback func(*sqltypes.Result) error) error {
+	return callback(twoRows)
+}
+
+func (h *resultHandler) ConnectionClosed(*mysql.Conn) {}
+
+// recordingHook is a statementHook which records the statements it is told about, and refuses or fails them as set.
+type recordingHook struct {
+	started  []string
+	prepare  *mysql.PrepareData
+	rows     int
+	finished int
+	closed   bool
+	refuse   error
+	fail     error
+}
+
+func (h *recordingHook) startStatement(ctx context.Context, _ *mysql.Conn, stmt statement) (context.Context, statementObserver, error) {
+	if h.refuse != nil {
+		return ctx, nil, h.refuse
+	}
+	h.started = append(h.started, stmt.query)
+	h.prepare = stmt.prepare
+	return ctx, h, nil
+}
+
+func (h *recordingHook) connectionClosed(*mysql.Conn) {
+	h.closed = true
+}
+
+func (h *recordingHook) result(res *sqltypes.Result) error {
+	if h.fail != nil {
+		return h.fail
+	}
+	h.rows += len(res.Rows)
+	return nil
+}
+
+func (h *recordingHook) finish(err error) error {
+	h.finished++
+	return err
+}
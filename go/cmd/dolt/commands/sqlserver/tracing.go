@@ -0,0 +1,114 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlserver
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+)
+
+// tracingShutdownTimeout is how long the server waits for buffered spans to be exported when it stops.
+const tracingShutdownTimeout = 5 * time.Second
+
+// newTracerProvider returns a TracerProvider which exports the spans it samples to the OTLP collector in |cfg|.
+func newTracerProvider(ctx context.Context, cfg servercfg.TracingConfig) (*tracesdk.TracerProvider, error) {
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.OTLPEndpoint())}
+	if cfg.Insecure() {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exp, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return tracesdk.NewTracerProvider(
+		tracesdk.WithBatcher(exp),
+		tracesdk.WithSampler(tracesdk.ParentBased(tracesdk.TraceIDRatioBased(cfg.SampleRatio()))),
+		tracesdk.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceNameKey.String(cfg.ServiceName()),
+		)),
+	), nil
+}
+
+// traceCommentField matches the traceparent and tracestate fields of a comment in the format used by sqlcommenter,
+// such as /*traceparent='00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01'*/.
+var traceCommentField = regexp.MustCompile(`(traceparent|tracestate)\s*=\s*'([^']*)'`)
+
+// queryTraceCarrier returns the W3C trace context fields found in the comments of |query|.
+func queryTraceCarrier(query string) propagation.MapCarrier {
+	carrier := propagation.MapCarrier{}
+	for rest := query; ; {
+		start := strings.Index(rest, "/*")
+		if start < 0 {
+			break
+		}
+		end := strings.Index(rest[start+2:], "*/")
+		if end < 0 {
+			break
+		}
+		comment := rest[start+2 : start+2+end]
+		rest = rest[start+2+end+2:]
+		for _, field := range traceCommentField.FindAllStringSubmatch(comment, -1) {
+			// sqlcommenter URL encodes the values of its fields
+			value, err := url.QueryUnescape(field[2])
+			if err != nil {
+				continue
+			}
+			carrier.Set(field[1], value)
+		}
+	}
+	return carrier
+}
+
+// traceContextHook is a statementHook which continues the trace of a client which sent its trace context in the
+// comments of a query, so that the spans of the query become children of the client's span.
+type traceContextHook struct {
+	propagator propagation.TextMapPropagator
+}
+
+var _ statementHook = (*traceContextHook)(nil)
+
+func newTraceContextHandler(h mysql.Handler) *statementHandler {
+	return newStatementHandler(h, &traceContextHook{propagator: propagation.TraceContext{}})
+}
+
+// startStatement implements statementHook. The statement runs with the remote span context of its query, if it has
+// one.
+func (h *traceContextHook) startStatement(ctx context.Context, _ *mysql.Conn, stmt statement) (context.Context, statementObserver, error) {
+	if !strings.Contains(stmt.query, "traceparent") {
+		return ctx, nil, nil
+	}
+	extracted := h.propagator.Extract(ctx, queryTraceCarrier(stmt.query))
+	if !trace.SpanContextFromContext(extracted).IsValid() {
+		return ctx, nil, nil
+	}
+	return extracted, nil, nil
+}
+
+// connectionClosed implements statementHook.
+func (h *traceContextHook) connectionClosed(*mysql.Conn) {}
@@ -0,0 +1,95 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestQueryTraceCarrier(t *testing.T) {
+	assert.Equal(t, propagation.MapCarrier{}, queryTraceCarrier("select 1"))
+	assert.Equal(t, propagation.MapCarrier{}, queryTraceCarrier("select 'traceparent=''abc''' /* unterminated"))
+
+	assert.Equal(t, propagation.MapCarrier{
+		"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	}, queryTraceCarrier("select * from t /*traceparent='00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01'*/"))
+
+	// Values are URL encoded by sqlcommenter, and fields may be spread over several comments
+	assert.Equal(t, propagation.MapCarrier{
+		"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"tracestate":  "congo=t61rcWkgMzE,rojo=00f067aa0ba902b7",
+	}, queryTraceCarrier("/* app='orders' */ select * from t /*traceparent='00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01',"+
+		"tracestate='congo%3Dt61rcWkgMzE%2Crojo%3D00f067aa0ba902b7'*/"))
+}
+
+func TestTraceContextHandler(t *testing.T) {
+	var got context.Context
+	h := newTraceContextHandler(&recordingHandler{ctx: &got})
+	ctx := context.Background()
+
+	require.NoError(t, h.ComQuery(ctx, nil, "select 1", nil))
+	assert.False(t, trace.SpanContextFromContext(got).IsValid())
+
+	require.NoError(t, h.ComQuery(ctx, nil, "select 1 /*traceparent='00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01'*/", nil))
+	sc := trace.SpanContextFromContext(got)
+	require.True(t, sc.IsValid())
+	assert.True(t, sc.IsRemote())
+	assert.True(t, sc.IsSampled())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", sc.TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", sc.SpanID().String())
+
+	// A malformed traceparent is ignored
+	require.NoError(t, h.ComQuery(ctx, nil, "select 1 /*traceparent='00-xyz'*/", nil))
+	assert.False(t, trace.SpanContextFromContext(got).IsValid())
+
+	_, err := h.ComMultiQuery(ctx, nil, "select 1 /*traceparent='00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00'*/", nil)
+	require.NoError(t, err)
+	sc = trace.SpanContextFromContext(got)
+	require.True(t, sc.IsValid())
+	assert.False(t, sc.IsSampled())
+
+	prepare := &mysql.PrepareData{PrepareStmt: "select ? /*traceparent='00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01'*/"}
+	require.NoError(t, h.ComStmtExecute(ctx, nil, prepare, nil))
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", trace.SpanContextFromContext(got).TraceID().String())
+}
+
+// recordingHandler is a mysql.Handler which records the context of the last query it was given.
+type recordingHandler struct {
+	mysql.Handler
+	ctx *context.Context
+}
+
+func (h *recordingHandler) ComQuery(ctx context.Context, _ *mysql.Conn, _ string, _ mysql.ResultSpoolFn) error {
+	*h.ctx = ctx
+	return nil
+}
+
+func (h *recordingHandler) ComMultiQuery(ctx context.Context, _ *mysql.Conn, _ string, _ mysql.ResultSpoolFn) (string, error) {
+	*h.ctx = ctx
+	return "", nil
+}
+
+func (h *recordingHandler) ComStmtExecute(ctx context.Context, _ *mysql.Conn, _ *mysql.PrepareData, _ func(*sqltypes.Result) error) error {
+	*h.ctx = ctx
+	return nil
+}
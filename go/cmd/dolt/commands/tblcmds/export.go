@@ -104,6 +104,9 @@ func getExportDestination(apr *argparser.ArgParseResults) mvdata.DataLocation {
 				color.RedString("Could not infer type file '%s'\n", path),
 				"File extensions should match supported file types, or should be explicitly defined via the file-type parameter")
 			return nil
+		} else if val.Format == mvdata.FixedWidthFile {
+			cli.PrintErrln(color.RedString("Cannot export to fixed-width files"))
+			return nil
 		}
 
 	case mvdata.StreamDataLocation:
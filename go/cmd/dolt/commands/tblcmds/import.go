@@ -54,23 +54,25 @@ import (
 )
 
 const (
-	createParam       = "create-table"
-	updateParam       = "update-table"
-	replaceParam      = "replace-table"
-	appendParam       = "append-table"
-	tableParam        = "table"
-	fileParam         = "file"
-	schemaParam       = "schema"
-	mappingFileParam  = "map"
-	forceParam        = "force"
-	contOnErrParam    = "continue"
-	primaryKeyParam   = "pk"
-	fileTypeParam     = "file-type"
-	delimParam        = "delim"
-	quiet             = "quiet"
-	ignoreSkippedRows = "ignore-skipped-rows" // alias for quiet
-	disableFkChecks   = "disable-fk-checks"
-	allTextParam      = "all-text"
+	createParam         = "create-table"
+	updateParam         = "update-table"
+	replaceParam        = "replace-table"
+	appendParam         = "append-table"
+	tableParam          = "table"
+	fileParam           = "file"
+	schemaParam         = "schema"
+	mappingFileParam    = "map"
+	forceParam          = "force"
+	contOnErrParam      = "continue"
+	primaryKeyParam     = "pk"
+	fileTypeParam       = "file-type"
+	delimParam          = "delim"
+	quiet               = "quiet"
+	ignoreSkippedRows   = "ignore-skipped-rows" // alias for quiet
+	disableFkChecks     = "disable-fk-checks"
+	allTextParam        = "all-text"
+	flattenStructsParam = "flatten-structs"
+	specParam           = "spec"
 )
 
 var jsonInputFileHelp = "The expected JSON input file format is:" + `
@@ -109,13 +111,31 @@ During import, if there is an error importing any row, the import will be aborte
 		`
 ` + jsonInputFileHelp +
 		`
-In create, update, and replace scenarios the file's extension is used to infer the type of the file.  If a file does not have the expected extension then the {{.EmphasisLeft}}--file-type{{.EmphasisRight}} parameter should be used to explicitly define the format of the file in one of the supported formats (csv, psv, json, xlsx).  For files separated by a delimiter other than a ',' (type csv) or a '|' (type psv), the --delim parameter can be used to specify a delimiter`,
+When creating a table from a parquet file without a schema file, the schema is inferred from the types in the file, including decimal precision, timestamp units and UUIDs. Struct, list and map columns are imported as JSON columns. Use {{.EmphasisLeft}}--flatten-structs{{.EmphasisRight}} to instead import each field of a struct as its own column, named {{.LessThan}}struct{{.GreaterThan}}.{{.LessThan}}field{{.GreaterThan}}.
+
+Fixed-width and other delimited text files can be imported with {{.EmphasisLeft}}--file-type fixedwidth{{.EmphasisRight}} along with a YAML spec file given by {{.EmphasisLeft}}--spec{{.EmphasisRight}}. The spec lists the columns of each line, in order, with their name, 1-based start position and length, and optionally their SQL type and trim rules:
+
+	skip_lines: 1
+	columns:
+	  - name: id
+	    start: 1
+	    length: 6
+	    type: int
+	    trim_chars: "0 "
+	  - name: name
+	    start: 7
+	    length: 20
+	    trim: right
+
+Columns without a type are imported as varchar columns as wide as their field. Fields are trimmed of spaces, or the given {{.EmphasisLeft}}trim_chars{{.EmphasisRight}}, on the side given by {{.EmphasisLeft}}trim{{.EmphasisRight}} (both, left, right or none), and empty fields are imported as NULL. If the spec sets a {{.EmphasisLeft}}delimiter{{.EmphasisRight}}, fields are split on it instead of by position. Lines that do not match the spec are reported as bad rows, and can be skipped with {{.EmphasisLeft}}--continue{{.EmphasisRight}}.
+
+In create, update, and replace scenarios the file's extension is used to infer the type of the file.  If a file does not have the expected extension then the {{.EmphasisLeft}}--file-type{{.EmphasisRight}} parameter should be used to explicitly define the format of the file in one of the supported formats (csv, psv, json, xlsx, parquet, fixedwidth).  For files separated by a delimiter other than a ',' (type csv) or a '|' (type psv), the --delim parameter can be used to specify a delimiter`,
 
 	Synopsis: []string{
-		"-c [-f] [--pk {{.LessThan}}field{{.GreaterThan}}] [--all-text] [--schema {{.LessThan}}file{{.GreaterThan}}] [--map {{.LessThan}}file{{.GreaterThan}}] [--continue]  [--quiet] [--disable-fk-checks] [--file-type {{.LessThan}}type{{.GreaterThan}}] {{.LessThan}}table{{.GreaterThan}} {{.LessThan}}file{{.GreaterThan}}",
-		"-u [--map {{.LessThan}}file{{.GreaterThan}}] [--continue] [--quiet] [--file-type {{.LessThan}}type{{.GreaterThan}}] {{.LessThan}}table{{.GreaterThan}} {{.LessThan}}file{{.GreaterThan}}",
-		"-a [--map {{.LessThan}}file{{.GreaterThan}}] [--continue] [--quiet] [--file-type {{.LessThan}}type{{.GreaterThan}}] {{.LessThan}}table{{.GreaterThan}} {{.LessThan}}file{{.GreaterThan}}",
-		"-r [--map {{.LessThan}}file{{.GreaterThan}}] [--file-type {{.LessThan}}type{{.GreaterThan}}] {{.LessThan}}table{{.GreaterThan}} {{.LessThan}}file{{.GreaterThan}}",
+		"-c [-f] [--pk {{.LessThan}}field{{.GreaterThan}}] [--all-text] [--flatten-structs] [--schema {{.LessThan}}file{{.GreaterThan}}] [--map {{.LessThan}}file{{.GreaterThan}}] [--continue]  [--quiet] [--disable-fk-checks] [--file-type {{.LessThan}}type{{.GreaterThan}}] [--spec {{.LessThan}}file{{.GreaterThan}}] {{.LessThan}}table{{.GreaterThan}} {{.LessThan}}file{{.GreaterThan}}",
+		"-u [--map {{.LessThan}}file{{.GreaterThan}}] [--continue] [--quiet] [--file-type {{.LessThan}}type{{.GreaterThan}}] [--spec {{.LessThan}}file{{.GreaterThan}}] {{.LessThan}}table{{.GreaterThan}} {{.LessThan}}file{{.GreaterThan}}",
+		"-a [--map {{.LessThan}}file{{.GreaterThan}}] [--continue] [--quiet] [--file-type {{.LessThan}}type{{.GreaterThan}}] [--spec {{.LessThan}}file{{.GreaterThan}}] {{.LessThan}}table{{.GreaterThan}} {{.LessThan}}file{{.GreaterThan}}",
+		"-r [--map {{.LessThan}}file{{.GreaterThan}}] [--file-type {{.LessThan}}type{{.GreaterThan}}] [--spec {{.LessThan}}file{{.GreaterThan}}] {{.LessThan}}table{{.GreaterThan}} {{.LessThan}}file{{.GreaterThan}}",
 	},
 }
 
@@ -175,6 +195,16 @@ func (m importOptions) srcIsJson() bool {
 	return isJson
 }
 
+func (m importOptions) srcIsParquet() bool {
+	_, isParquet := m.srcOptions.(mvdata.ParquetOptions)
+	return isParquet
+}
+
+func (m importOptions) srcIsFixedWidth() bool {
+	_, isFixedWidth := m.srcOptions.(mvdata.FixedWidthOptions)
+	return isFixedWidth
+}
+
 func (m importOptions) srcIsStream() bool {
 	_, isStream := m.src.(mvdata.StreamDataLocation)
 	return isStream
@@ -226,8 +256,16 @@ func getImportMoveOptions(ctx context.Context, apr *argparser.ArgParseResults, d
 			srcOpts = mvdata.XlsxOptions{SheetName: tableName}
 		} else if val.Format == mvdata.JsonFile {
 			srcOpts = mvdata.JSONOptions{TableName: tableName, SchFile: schemaFile}
+		} else if val.Format == mvdata.FixedWidthFile {
+			specFile, _ := apr.GetValue(specParam)
+			srcOpts = mvdata.FixedWidthOptions{SpecFile: specFile}
 		} else if val.Format == mvdata.ParquetFile {
-			srcOpts = mvdata.ParquetOptions{TableName: tableName, SchFile: schemaFile}
+			srcOpts = mvdata.ParquetOptions{
+				TableName:      tableName,
+				SchFile:        schemaFile,
+				InferSchema:    apr.Contains(createParam),
+				FlattenStructs: apr.Contains(flattenStructsParam),
+			}
 		}
 
 	case mvdata.StreamDataLocation:
@@ -346,8 +384,16 @@ func validateImportArgs(apr *argparser.ArgParseResults) errhand.VerboseError {
 		_, hasSchema := apr.GetValue(schemaParam)
 		if srcFileLoc.Format == mvdata.JsonFile && apr.Contains(createParam) && !hasSchema {
 			return errhand.BuildDError("Please specify schema file for .json tables.").Build()
-		} else if srcFileLoc.Format == mvdata.ParquetFile && apr.Contains(createParam) && !hasSchema {
-			return errhand.BuildDError("Please specify schema file for .parquet tables.").Build()
+		}
+
+		if srcFileLoc.Format == mvdata.FixedWidthFile && !apr.Contains(specParam) {
+			return errhand.BuildDError("Please specify a spec file with --%s for fixed-width files.", specParam).Build()
+		} else if srcFileLoc.Format != mvdata.FixedWidthFile && apr.Contains(specParam) {
+			return errhand.BuildDError("fatal: --%s is only supported for fixed-width files", specParam).Build()
+		}
+
+		if apr.Contains(flattenStructsParam) && (srcFileLoc.Format != mvdata.ParquetFile || !apr.Contains(createParam) || hasSchema) {
+			return errhand.BuildDError("fatal: --%s is only supported when creating a table from a parquet file without a schema file", flattenStructsParam).Build()
 		}
 	}
 
@@ -390,6 +436,8 @@ func (cmd ImportCmd) ArgParser() *argparser.ArgParser {
 	ap.SupportsString(fileTypeParam, "", "file_type", "Explicitly define the type of the file if it can't be inferred from the file extension.")
 	ap.SupportsString(delimParam, "", "delimiter", "Specify a delimiter for a csv style file with a non-comma delimiter.")
 	ap.SupportsFlag(allTextParam, "", "Treats all fields as text. Can only be used when creating a table.")
+	ap.SupportsString(specParam, "", "spec_file", "A YAML file describing the columns of a fixed-width file. Required when the file type is fixedwidth.")
+	ap.SupportsFlag(flattenStructsParam, "", "Imports each field of a parquet struct column as its own column, named {{.LessThan}}struct{{.GreaterThan}}.{{.LessThan}}field{{.GreaterThan}}, rather than as a single JSON column. Can only be used when creating a table from a parquet file.")
 	return ap
 }
 
@@ -597,6 +645,10 @@ func move(ctx context.Context, rd table.SqlRowReader, wr *mvdata.SqlEngineTableW
 		}
 
 		cli.PrintErrln(sql.FormatRow(row))
+		if options.srcIsFixedWidth() && table.IsBadRow(err) {
+			// fixed-width readers report why each line was skipped
+			cli.PrintErrln("\t" + err.Error())
+		}
 
 		return false
 	}
@@ -660,6 +712,9 @@ func moveRows(
 			return nil
 		}
 		line += 1
+		if lr, ok := rd.(interface{ LineNumber() int }); ok {
+			line = lr.LineNumber()
+		}
 
 		if err != nil {
 			if table.IsBadRow(err) {
@@ -731,6 +786,14 @@ func getImportSchema(ctx context.Context, dEnv *env.DoltEnv, impOpts *importOpti
 			return nil, &mvdata.DataMoverCreationError{ErrType: mvdata.SchemaErr, Cause: err}
 		}
 
+		if impOpts.srcIsParquet() || impOpts.srcIsFixedWidth() {
+			outSch, err := mvdata.SchemaFromInferredCols(ctx, root, impOpts.destTableName, rd.GetSchema().GetAllCols(), impOpts.primaryKeys)
+			if err != nil {
+				return nil, &mvdata.DataMoverCreationError{ErrType: mvdata.SchemaErr, Cause: err}
+			}
+			return outSch, nil
+		}
+
 		outSch, err := mvdata.InferSchema(ctx, root, rd, impOpts.destTableName, impOpts.primaryKeys, impOpts)
 		if err != nil {
 			return nil, &mvdata.DataMoverCreationError{ErrType: mvdata.SchemaErr, Cause: err}
@@ -84,7 +84,23 @@ func (rcv *BranchControl) TryNamespaceTbl(obj *BranchControlNamespace) (*BranchC
 	return nil, nil
 }
 
-const BranchControlNumFields = 2
+func (rcv *BranchControl) TryTableTbl(obj *BranchControlAccess) (*BranchControlAccess, error) {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		x := rcv._tab.Indirect(o + rcv._tab.Pos)
+		if obj == nil {
+			obj = new(BranchControlAccess)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		if BranchControlAccessNumFields < obj.Table().NumFields() {
+			return nil, flatbuffers.ErrTableHasUnknownFields
+		}
+		return obj, nil
+	}
+	return nil, nil
+}
+
+const BranchControlNumFields = 3
 
 func BranchControlStart(builder *flatbuffers.Builder) {
 	builder.StartObject(BranchControlNumFields)
@@ -95,6 +111,9 @@ func BranchControlAddAccessTbl(builder *flatbuffers.Builder, accessTbl flatbuffe
 func BranchControlAddNamespaceTbl(builder *flatbuffers.Builder, namespaceTbl flatbuffers.UOffsetT) {
 	builder.PrependUOffsetTSlot(1, flatbuffers.UOffsetT(namespaceTbl), 0)
 }
+func BranchControlAddTableTbl(builder *flatbuffers.Builder, tableTbl flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(2, flatbuffers.UOffsetT(tableTbl), 0)
+}
 func BranchControlEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
@@ -852,7 +871,23 @@ func (rcv *BranchControlBinlogRow) MutatePermissions(n uint64) bool {
 	return rcv._tab.MutateUint64Slot(14, n)
 }
 
-const BranchControlBinlogRowNumFields = 6
+func (rcv *BranchControlBinlogRow) TableName() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(16))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *BranchControlBinlogRow) Columns() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(18))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+const BranchControlBinlogRowNumFields = 8
 
 func BranchControlBinlogRowStart(builder *flatbuffers.Builder) {
 	builder.StartObject(BranchControlBinlogRowNumFields)
@@ -875,6 +910,12 @@ func BranchControlBinlogRowAddHost(builder *flatbuffers.Builder, host flatbuffer
 func BranchControlBinlogRowAddPermissions(builder *flatbuffers.Builder, permissions uint64) {
 	builder.PrependUint64Slot(5, permissions, 0)
 }
+func BranchControlBinlogRowAddTableName(builder *flatbuffers.Builder, tableName flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(6, flatbuffers.UOffsetT(tableName), 0)
+}
+func BranchControlBinlogRowAddColumns(builder *flatbuffers.Builder, columns flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(7, flatbuffers.UOffsetT(columns), 0)
+}
 func BranchControlBinlogRowEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
@@ -188,7 +188,23 @@ func (rcv *WorkingSet) TryRebaseState(obj *RebaseState) (*RebaseState, error) {
 	return nil, nil
 }
 
-const WorkingSetNumFields = 8
+func (rcv *WorkingSet) TryCherryPickState(obj *CherryPickState) (*CherryPickState, error) {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(20))
+	if o != 0 {
+		x := rcv._tab.Indirect(o + rcv._tab.Pos)
+		if obj == nil {
+			obj = new(CherryPickState)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		if CherryPickStateNumFields < obj.Table().NumFields() {
+			return nil, flatbuffers.ErrTableHasUnknownFields
+		}
+		return obj, nil
+	}
+	return nil, nil
+}
+
+const WorkingSetNumFields = 9
 
 func WorkingSetStart(builder *flatbuffers.Builder) {
 	builder.StartObject(WorkingSetNumFields)
@@ -223,6 +239,9 @@ func WorkingSetAddMergeState(builder *flatbuffers.Builder, mergeState flatbuffer
 func WorkingSetAddRebaseState(builder *flatbuffers.Builder, rebaseState flatbuffers.UOffsetT) {
 	builder.PrependUOffsetTSlot(7, flatbuffers.UOffsetT(rebaseState), 0)
 }
+func WorkingSetAddCherryPickState(builder *flatbuffers.Builder, cherryPickState flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(8, flatbuffers.UOffsetT(cherryPickState), 0)
+}
 func WorkingSetEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
@@ -531,7 +550,31 @@ func (rcv *RebaseState) MutateOntoCommitAddr(j int, n byte) bool {
 	return false
 }
 
-const RebaseStateNumFields = 3
+func (rcv *RebaseState) LastAttemptedStep() int64 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		return rcv._tab.GetInt64(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *RebaseState) MutateLastAttemptedStep(n int64) bool {
+	return rcv._tab.MutateInt64Slot(10, n)
+}
+
+func (rcv *RebaseState) RebasingStarted() bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		return rcv._tab.GetBool(o + rcv._tab.Pos)
+	}
+	return false
+}
+
+func (rcv *RebaseState) MutateRebasingStarted(n bool) bool {
+	return rcv._tab.MutateBoolSlot(12, n)
+}
+
+const RebaseStateNumFields = 5
 
 func RebaseStateStart(builder *flatbuffers.Builder) {
 	builder.StartObject(RebaseStateNumFields)
@@ -554,6 +597,93 @@ func RebaseStateAddOntoCommitAddr(builder *flatbuffers.Builder, ontoCommitAddr f
 func RebaseStateStartOntoCommitAddrVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
 	return builder.StartVector(1, numElems, 1)
 }
+func RebaseStateAddLastAttemptedStep(builder *flatbuffers.Builder, lastAttemptedStep int64) {
+	builder.PrependInt64Slot(3, lastAttemptedStep, 0)
+}
+func RebaseStateAddRebasingStarted(builder *flatbuffers.Builder, rebasingStarted bool) {
+	builder.PrependBoolSlot(4, rebasingStarted, false)
+}
 func RebaseStateEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
+
+type CherryPickState struct {
+	_tab flatbuffers.Table
+}
+
+func InitCherryPickStateRoot(o *CherryPickState, buf []byte, offset flatbuffers.UOffsetT) error {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	return o.Init(buf, n+offset)
+}
+
+func TryGetRootAsCherryPickState(buf []byte, offset flatbuffers.UOffsetT) (*CherryPickState, error) {
+	x := &CherryPickState{}
+	return x, InitCherryPickStateRoot(x, buf, offset)
+}
+
+func TryGetSizePrefixedRootAsCherryPickState(buf []byte, offset flatbuffers.UOffsetT) (*CherryPickState, error) {
+	x := &CherryPickState{}
+	return x, InitCherryPickStateRoot(x, buf, offset+flatbuffers.SizeUint32)
+}
+
+func (rcv *CherryPickState) Init(buf []byte, i flatbuffers.UOffsetT) error {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+	if CherryPickStateNumFields < rcv.Table().NumFields() {
+		return flatbuffers.ErrTableHasUnknownFields
+	}
+	return nil
+}
+
+func (rcv *CherryPickState) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *CherryPickState) RemainingCommitAddrs(j int) byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		a := rcv._tab.Vector(o)
+		return rcv._tab.GetByte(a + flatbuffers.UOffsetT(j*1))
+	}
+	return 0
+}
+
+func (rcv *CherryPickState) RemainingCommitAddrsLength() int {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.VectorLen(o)
+	}
+	return 0
+}
+
+func (rcv *CherryPickState) RemainingCommitAddrsBytes() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *CherryPickState) MutateRemainingCommitAddrs(j int, n byte) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		a := rcv._tab.Vector(o)
+		return rcv._tab.MutateByte(a+flatbuffers.UOffsetT(j*1), n)
+	}
+	return false
+}
+
+const CherryPickStateNumFields = 1
+
+func CherryPickStateStart(builder *flatbuffers.Builder) {
+	builder.StartObject(CherryPickStateNumFields)
+}
+func CherryPickStateAddRemainingCommitAddrs(builder *flatbuffers.Builder, remainingCommitAddrs flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(0, flatbuffers.UOffsetT(remainingCommitAddrs), 0)
+}
+func CherryPickStateStartRemainingCommitAddrsVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
+	return builder.StartVector(1, numElems, 1)
+}
+func CherryPickStateEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
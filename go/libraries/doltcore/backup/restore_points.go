@@ -0,0 +1,187 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package backup implements scheduled backups with restore points. A backup
+// is a copy of the chunk store of a database, which is kept up to date by
+// syncing only the chunks it does not have yet. Every sync records a restore
+// point, the root of the backup at that time, in a blob stored alongside the
+// backup's table files, so that the backup can later be restored as of any of
+// its restore points.
+package backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/dbfactory"
+	"github.com/dolthub/dolt/go/store/blobstore"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+// restorePointsKey is the key of the blob which holds the restore points of a
+// backup. It does not look like a table file name, so it is never mistaken for
+// one by the chunk store of the backup.
+const restorePointsKey = "dolt_restore_points"
+
+// maxCheckAndPutAttempts bounds how many times AddRestorePoint retries when
+// another writer updates the restore points concurrently.
+const maxCheckAndPutAttempts = 5
+
+// RestorePoint is a point in time which a backup can be restored to.
+type RestorePoint struct {
+	Time time.Time
+	// Root is the root of the backup's chunk store as of Time.
+	Root hash.Hash
+}
+
+type restorePointsJSON struct {
+	RestorePoints []restorePointJSON `json:"restore_points"`
+}
+
+type restorePointJSON struct {
+	Time time.Time `json:"time"`
+	Root string    `json:"root"`
+}
+
+// LoadRestorePoints returns the restore points of the backup whose blobs are
+// kept in |bs|, ordered from oldest to newest.
+func LoadRestorePoints(ctx context.Context, bs blobstore.Blobstore) ([]RestorePoint, error) {
+	points, _, err := loadRestorePoints(ctx, bs)
+	return points, err
+}
+
+func loadRestorePoints(ctx context.Context, bs blobstore.Blobstore) ([]RestorePoint, string, error) {
+	data, ver, err := blobstore.GetBytes(ctx, bs, restorePointsKey, blobstore.AllRange)
+	if blobstore.IsNotFoundError(err) {
+		return nil, "", nil
+	} else if err != nil {
+		return nil, "", err
+	}
+
+	var stored restorePointsJSON
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, "", fmt.Errorf("invalid restore points: %w", err)
+	}
+
+	points := make([]RestorePoint, len(stored.RestorePoints))
+	for i, p := range stored.RestorePoints {
+		root, ok := hash.MaybeParse(p.Root)
+		if !ok {
+			return nil, "", fmt.Errorf("invalid restore points: invalid root hash '%s'", p.Root)
+		}
+		points[i] = RestorePoint{Time: p.Time, Root: root}
+	}
+	sort.SliceStable(points, func(i, j int) bool {
+		return points[i].Time.Before(points[j].Time)
+	})
+	return points, ver, nil
+}
+
+// AddRestorePoint records |point| as the newest restore point of the backup
+// whose blobs are kept in |bs|, and forgets the oldest restore points so that
+// at most |retention| are kept. If the newest restore point already has the
+// same root, nothing has changed since it was recorded, and |point| is not
+// added. It returns the restore points which are kept, ordered from oldest to
+// newest.
+//
+// Forgetting a restore point does not remove any table files of the backup,
+// since its chunks are shared with the restore points which are kept.
+func AddRestorePoint(ctx context.Context, bs blobstore.Blobstore, point RestorePoint, retention int) ([]RestorePoint, error) {
+	for attempt := 0; ; attempt++ {
+		points, ver, err := loadRestorePoints(ctx, bs)
+		if err != nil {
+			return nil, err
+		}
+		if len(points) > 0 && points[len(points)-1].Root == point.Root {
+			return points, nil
+		}
+
+		points = append(points, point)
+		if len(points) > retention {
+			points = points[len(points)-retention:]
+		}
+
+		stored := restorePointsJSON{RestorePoints: make([]restorePointJSON, len(points))}
+		for i, p := range points {
+			stored.RestorePoints[i] = restorePointJSON{Time: p.Time.UTC(), Root: p.Root.String()}
+		}
+		data, err := json.Marshal(stored)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = bs.CheckAndPut(ctx, ver, restorePointsKey, int64(len(data)), bytes.NewReader(data))
+		if err == nil {
+			return points, nil
+		}
+		if !blobstore.IsCheckAndPutError(err) || attempt+1 >= maxCheckAndPutAttempts {
+			return nil, err
+		}
+	}
+}
+
+// RestorePointAt returns the newest of |points| which is not after |t|, and
+// false if they are all after |t|. |points| must be ordered from oldest to
+// newest.
+func RestorePointAt(points []RestorePoint, t time.Time) (RestorePoint, bool) {
+	i := sort.Search(len(points), func(i int) bool {
+		return points[i].Time.After(t)
+	})
+	if i == 0 {
+		return RestorePoint{}, false
+	}
+	return points[i-1], true
+}
+
+// RootAt returns the root of the restore point of the backup at |backupUrl|
+// which was current at |t|.
+func RootAt(ctx context.Context, backupUrl string, params map[string]interface{}, t time.Time) (hash.Hash, error) {
+	bs, err := dbfactory.CreateBlobstore(ctx, backupUrl, params)
+	if err != nil {
+		return hash.Hash{}, err
+	}
+	points, err := LoadRestorePoints(ctx, bs)
+	if err != nil {
+		return hash.Hash{}, err
+	}
+	point, ok := RestorePointAt(points, t)
+	if !ok {
+		return hash.Hash{}, fmt.Errorf("backup %s has no restore point at or before %s", backupUrl, t.UTC().Format(time.RFC3339))
+	}
+	return point.Root, nil
+}
+
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// ParseTime parses a time given to restore a backup at, such as
+// "2024-03-13T10:00:00Z" or "2024-03-13 10:00:00". Times without a time zone
+// are in UTC.
+func ParseTime(s string) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time '%s'; expected a time such as 2006-01-02T15:04:05Z or 2006-01-02 15:04:05", s)
+}
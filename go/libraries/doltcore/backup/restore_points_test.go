@@ -0,0 +1,92 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/store/blobstore"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+func TestRestorePoints(t *testing.T) {
+	ctx := context.Background()
+	bs := blobstore.NewLocalBlobstore(t.TempDir())
+
+	points, err := LoadRestorePoints(ctx, bs)
+	require.NoError(t, err)
+	assert.Empty(t, points)
+
+	start := time.Date(2024, time.March, 13, 10, 0, 0, 0, time.UTC)
+	point := func(i int) RestorePoint {
+		return RestorePoint{Time: start.Add(time.Duration(i) * time.Hour), Root: hash.Of([]byte{byte(i)})}
+	}
+
+	for i := 0; i < 5; i++ {
+		points, err = AddRestorePoint(ctx, bs, point(i), 3)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []RestorePoint{point(2), point(3), point(4)}, points)
+
+	// A restore point with the same root as the newest one is not added
+	unchanged := point(4)
+	unchanged.Time = unchanged.Time.Add(time.Hour)
+	points, err = AddRestorePoint(ctx, bs, unchanged, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []RestorePoint{point(2), point(3), point(4)}, points)
+
+	points, err = LoadRestorePoints(ctx, bs)
+	require.NoError(t, err)
+	assert.Equal(t, []RestorePoint{point(2), point(3), point(4)}, points)
+
+	_, ok := RestorePointAt(points, start.Add(90*time.Minute))
+	assert.False(t, ok)
+	p, ok := RestorePointAt(points, start.Add(2*time.Hour))
+	assert.True(t, ok)
+	assert.Equal(t, point(2), p)
+	p, ok = RestorePointAt(points, start.Add(150*time.Minute))
+	assert.True(t, ok)
+	assert.Equal(t, point(2), p)
+	p, ok = RestorePointAt(points, start.Add(24*time.Hour))
+	assert.True(t, ok)
+	assert.Equal(t, point(4), p)
+}
+
+func TestParseTime(t *testing.T) {
+	expected := time.Date(2024, time.March, 13, 10, 30, 0, 0, time.UTC)
+	for _, s := range []string{
+		"2024-03-13T10:30:00Z",
+		"2024-03-13T11:30:00+01:00",
+		"2024-03-13 10:30:00",
+		"2024-03-13T10:30:00",
+		"2024-03-13 10:30",
+	} {
+		parsed, err := ParseTime(s)
+		require.NoError(t, err, s)
+		assert.True(t, expected.Equal(parsed), s)
+	}
+
+	parsed, err := ParseTime("2024-03-13")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 13, 0, 0, 0, 0, time.UTC), parsed)
+
+	_, err = ParseTime("yesterday")
+	assert.Error(t, err)
+}
@@ -0,0 +1,250 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/dbfactory"
+	"github.com/dolthub/dolt/go/libraries/utils/schedule"
+	"github.com/dolthub/dolt/go/store/blobstore"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+// Job is a scheduled backup of one database.
+type Job struct {
+	Database string
+	URL      string
+	// Spec is the schedule of the job as it was configured, and Schedule is
+	// the result of parsing it.
+	Spec      string
+	Schedule  schedule.Schedule
+	Retention int
+}
+
+// SyncFunc syncs |database| to the backup at |url|, and returns the root of
+// the backup afterward.
+type SyncFunc func(ctx context.Context, database, url string) (hash.Hash, error)
+
+// BlobstoreFunc returns the blobstore.Blobstore in which the restore points of
+// the backup at |url| are kept.
+type BlobstoreFunc func(ctx context.Context, url string) (blobstore.Blobstore, error)
+
+// DefaultBlobstoreFunc keeps the restore points of a backup alongside its
+// table files.
+func DefaultBlobstoreFunc(ctx context.Context, url string) (blobstore.Blobstore, error) {
+	return dbfactory.CreateBlobstore(ctx, url, nil)
+}
+
+// now returns the current time. Tests replace it to control the clock of the
+// Scheduler.
+var now = time.Now
+
+const (
+	resultSuccess = "success"
+	resultError   = "error: "
+)
+
+// Status is the state of one scheduled backup.
+type Status struct {
+	Database  string
+	URL       string
+	Schedule  string
+	Retention int
+	// NextSync is when the backup is next due, or the zero time if its
+	// schedule is never due.
+	NextSync      time.Time
+	LastSyncStart time.Time
+	LastDuration  time.Duration
+	// LastResult is "success" or "error: <error>" for the last sync, or "" if
+	// there hasn't been one.
+	LastResult string
+	// RestorePoints are the restore points of the backup, ordered from oldest
+	// to newest, as of the last sync or the time the Scheduler started.
+	RestorePoints []RestorePoint
+}
+
+// Scheduler backs up databases on their schedules. It is safe for concurrent
+// use.
+type Scheduler struct {
+	sync      SyncFunc
+	blobstore BlobstoreFunc
+	lgr       *logrus.Logger
+
+	mu     sync.Mutex
+	jobs   []Job
+	status []Status
+}
+
+// NewScheduler returns a Scheduler which syncs the databases of |jobs| with
+// |sync|, and keeps their restore points in the blobstores returned by |bs|.
+// Each job is first due at the next time its schedule is due after now.
+func NewScheduler(jobs []Job, sync SyncFunc, bs BlobstoreFunc, lgr *logrus.Logger) *Scheduler {
+	start := now()
+	status := make([]Status, len(jobs))
+	for i, j := range jobs {
+		status[i] = Status{
+			Database:  j.Database,
+			URL:       j.URL,
+			Schedule:  j.Spec,
+			Retention: j.Retention,
+			NextSync:  j.Schedule.Next(start),
+		}
+	}
+	return &Scheduler{
+		sync:      sync,
+		blobstore: bs,
+		lgr:       lgr,
+		jobs:      jobs,
+		status:    status,
+	}
+}
+
+// Run backs up the databases on their schedules until |ctx| is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	s.loadRestorePoints(ctx)
+
+	for {
+		next, ok := s.nextSync()
+		if !ok {
+			<-ctx.Done()
+			return
+		}
+
+		timer := time.NewTimer(next.Sub(now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.RunDue(ctx)
+	}
+}
+
+// RunDue runs every backup which is due now, one after the other, and
+// schedules the next run of each from the time its sync finished.
+func (s *Scheduler) RunDue(ctx context.Context) {
+	for i, j := range s.jobs {
+		if ctx.Err() != nil {
+			return
+		}
+		s.mu.Lock()
+		next := s.status[i].NextSync
+		s.mu.Unlock()
+		if next.IsZero() || next.After(now()) {
+			continue
+		}
+
+		finished := s.backup(ctx, i)
+
+		s.mu.Lock()
+		s.status[i].NextSync = j.Schedule.Next(finished)
+		s.mu.Unlock()
+	}
+}
+
+// backup syncs the database of the |i|th job to its backup and records a
+// restore point, stamped with the time the sync finished. Returns the time
+// the backup finished.
+func (s *Scheduler) backup(ctx context.Context, i int) time.Time {
+	j := s.jobs[i]
+	start := now()
+	points, err := s.syncAndRecord(ctx, j)
+	finished := now()
+	duration := finished.Sub(start)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st := &s.status[i]
+	st.LastSyncStart = start
+	st.LastDuration = duration
+	if err != nil {
+		st.LastResult = resultError + err.Error()
+		s.lgr.Warnf("backup: unable to back up database %s to %s: %s", j.Database, j.URL, err.Error())
+		return finished
+	}
+	st.LastResult = resultSuccess
+	st.RestorePoints = points
+	s.lgr.Infof("backup: backed up database %s to %s in %s", j.Database, j.URL, duration)
+	return finished
+}
+
+// syncAndRecord syncs the database of |j| to its backup, and records a
+// restore point of the synced root. The restore point is stamped once the
+// sync has succeeded, since the backup only holds the root from then on.
+func (s *Scheduler) syncAndRecord(ctx context.Context, j Job) ([]RestorePoint, error) {
+	root, err := s.sync(ctx, j.Database, j.URL)
+	if err != nil {
+		return nil, err
+	}
+	synced := now()
+	bs, err := s.blobstore(ctx, j.URL)
+	if err != nil {
+		return nil, err
+	}
+	return AddRestorePoint(ctx, bs, RestorePoint{Time: synced.UTC(), Root: root}, j.Retention)
+}
+
+// loadRestorePoints loads the existing restore points of every backup, so that
+// they are part of its Status before it is first synced.
+func (s *Scheduler) loadRestorePoints(ctx context.Context) {
+	for i, j := range s.jobs {
+		bs, err := s.blobstore(ctx, j.URL)
+		var points []RestorePoint
+		if err == nil {
+			points, err = LoadRestorePoints(ctx, bs)
+		}
+		if err != nil {
+			s.lgr.Warnf("backup: unable to load restore points of %s: %s", j.URL, err.Error())
+			continue
+		}
+		s.mu.Lock()
+		s.status[i].RestorePoints = points
+		s.mu.Unlock()
+	}
+}
+
+// nextSync returns the earliest time at which a backup is due, and false if
+// none is ever due.
+func (s *Scheduler) nextSync() (time.Time, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var next time.Time
+	for _, st := range s.status {
+		if !st.NextSync.IsZero() && (next.IsZero() || st.NextSync.Before(next)) {
+			next = st.NextSync
+		}
+	}
+	return next, !next.IsZero()
+}
+
+// Status returns the status of every scheduled backup, in the order they were
+// configured. A nil Scheduler has no status.
+func (s *Scheduler) Status() []Status {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	status := make([]Status, len(s.status))
+	copy(status, s.status)
+	return status
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/utils/schedule"
+	"github.com/dolthub/dolt/go/store/blobstore"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+func TestScheduler(t *testing.T) {
+	ctx := context.Background()
+
+	hourly, err := schedule.Parse("@every 1h")
+	require.NoError(t, err)
+	daily, err := schedule.Parse("@every 24h")
+	require.NoError(t, err)
+
+	blobstores := map[string]blobstore.Blobstore{
+		"mem://db1": blobstore.NewInMemoryBlobstore(""),
+		"mem://db2": blobstore.NewInMemoryBlobstore(""),
+	}
+	bsFunc := func(_ context.Context, url string) (blobstore.Blobstore, error) {
+		return blobstores[url], nil
+	}
+
+	clock := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	now = func() time.Time { return clock }
+	defer func() { now = time.Now }()
+
+	roots := make(map[string]int)
+	var syncErr error
+	syncFunc := func(_ context.Context, database, url string) (hash.Hash, error) {
+		if syncErr != nil {
+			return hash.Hash{}, syncErr
+		}
+		// Each sync takes ten minutes
+		clock = clock.Add(10 * time.Minute)
+		roots[database]++
+		return hash.Of([]byte{byte(roots[database])}), nil
+	}
+
+	s := NewScheduler([]Job{
+		{Database: "db1", URL: "mem://db1", Spec: "@every 1h", Schedule: hourly, Retention: 2},
+		{Database: "db2", URL: "mem://db2", Spec: "@every 24h", Schedule: daily, Retention: 2},
+	}, syncFunc, bsFunc, logrus.StandardLogger())
+
+	status := s.Status()
+	require.Len(t, status, 2)
+	assert.Equal(t, "", status[0].LastResult)
+	assert.False(t, status[0].NextSync.IsZero())
+
+	// Nothing is due yet
+	s.RunDue(ctx)
+	assert.Empty(t, roots)
+
+	clock = clock.Add(2 * time.Hour)
+	var synced time.Time
+	for i := 0; i < 3; i++ {
+		s.RunDue(ctx)
+		synced = clock
+		clock = clock.Add(time.Hour)
+	}
+	assert.Equal(t, map[string]int{"db1": 3}, roots)
+
+	// The restore point is stamped when the sync finished, and the next sync
+	// is scheduled from then
+	status = s.Status()
+	assert.Equal(t, "success", status[0].LastResult)
+	assert.Equal(t, synced.Add(-10*time.Minute), status[0].LastSyncStart)
+	assert.Equal(t, 10*time.Minute, status[0].LastDuration)
+	require.Len(t, status[0].RestorePoints, 2)
+	assert.Equal(t, hash.Of([]byte{3}), status[0].RestorePoints[1].Root)
+	assert.Equal(t, synced, status[0].RestorePoints[1].Time)
+	assert.Equal(t, synced.Add(time.Hour), status[0].NextSync)
+	assert.Equal(t, "", status[1].LastResult)
+
+	points, err := LoadRestorePoints(ctx, blobstores["mem://db1"])
+	require.NoError(t, err)
+	assert.Equal(t, status[0].RestorePoints, points)
+
+	syncErr = errors.New("no space left on device")
+	clock = clock.Add(24 * time.Hour)
+	s.RunDue(ctx)
+	status = s.Status()
+	assert.Equal(t, "error: no space left on device", status[0].LastResult)
+	assert.Equal(t, "error: no space left on device", status[1].LastResult)
+	assert.Len(t, status[0].RestorePoints, 2)
+	assert.Empty(t, status[1].RestorePoints)
+}
@@ -17,6 +17,7 @@ package branch_control
 import (
 	"fmt"
 	"math"
+	"sort"
 	"strings"
 	"sync"
 
@@ -36,14 +37,23 @@ const (
 	Permissions_None Permissions = 0 // Permissions_None represents a lack of permissions, which defaults to allowing reading
 )
 
+// AllTables is the table expression of entries that apply to the branch as a whole.
+const AllTables = "%"
+
 // Access contains all of the expressions that comprise the "dolt_branch_control" table, which handles write Access to
 // branches, along with write access to the branch control system tables.
+//
+// Entries whose table expression is AllTables grant their permissions on the branch. Entries with any other table
+// expression, or with a list of columns, are table entries, which narrow the write access to the tables that they
+// match. When table entries match a table that is being written to, then the longest matching entries decide whether
+// the write is allowed, but they never allow writing to a branch that the branch entries don't.
 type Access struct {
-	Root     *MatchNode
-	RWMutex  *sync.RWMutex
-	binlog   *Binlog
-	rows     []AccessRow
-	freeRows []uint32
+	Root      *MatchNode
+	TableRoot *MatchNode
+	RWMutex   *sync.RWMutex
+	binlog    *Binlog
+	rows      []AccessRow
+	freeRows  []uint32
 }
 
 // AccessRow contains the user-facing values of a particular row, along with the permissions for a row.
@@ -52,6 +62,8 @@ type AccessRow struct {
 	Branch      string
 	User        string
 	Host        string
+	Table       string
+	Columns     string // Columns is a comma-separated list of the writable columns, which is empty if all are writable
 	Permissions Permissions
 }
 
@@ -68,8 +80,8 @@ func newAccess() *Access {
 	}
 }
 
-// Match returns whether any entries match the given database, branch, user, and host, along with their permissions.
-// Requires external synchronization handling, therefore manually manage the RWMutex.
+// Match returns whether any branch entries match the given database, branch, user, and host, along with their
+// permissions. Requires external synchronization handling, therefore manually manage the RWMutex.
 func (tbl *Access) Match(database string, branch string, user string, host string) (bool, Permissions) {
 	results := tbl.Root.Match(database, branch, user, host)
 	// We use the result(s) with the longest length
@@ -85,17 +97,87 @@ func (tbl *Access) Match(database string, branch string, user string, host strin
 	return len(results) > 0, perms
 }
 
+// MatchTable returns whether any table entries match the given database, branch, user, host, and table, along with the
+// combined permissions of the longest matching entries. Also returns the columns that those entries allow writing to,
+// which is nil when any of them allow writing to every column. Requires external synchronization handling, therefore
+// manually manage the RWMutex.
+func (tbl *Access) MatchTable(database string, branch string, user string, host string, table string) (bool, Permissions, []string) {
+	results := tbl.TableRoot.match([]string{database, branch, user, host, table})
+	if len(results) == 0 {
+		return false, Permissions_None, nil
+	}
+	// We use the result(s) with the longest length
+	length := uint32(0)
+	for _, result := range results {
+		if result.Length > length {
+			length = result.Length
+		}
+	}
+	perms := Permissions_None
+	allColumns := false
+	var columns []string
+	for _, result := range results {
+		if result.Length != length {
+			continue
+		}
+		perms |= result.Permissions
+		if result.Permissions&(Permissions_Write|Permissions_Admin) == 0 {
+			continue
+		}
+		if rowColumns := tbl.rows[result.RowIndex].Columns; len(rowColumns) == 0 {
+			allColumns = true
+		} else {
+			columns = append(columns, strings.Split(rowColumns, ",")...)
+		}
+	}
+	if allColumns {
+		return true, perms, nil
+	}
+	return true, perms, columns
+}
+
+// Get returns the row with exactly the given expressions, if it exists. Folds all strings that are given. Requires
+// external synchronization handling, therefore manually manage the RWMutex.
+func (tbl *Access) Get(database string, branch string, user string, host string, table string) (AccessRow, bool) {
+	database, branch, user, host, table = foldAccessExpressions(database, branch, user, host, table)
+	iter := tbl.Iter()
+	for row, ok := iter.Next(); ok; row, ok = iter.Next() {
+		if row.Database == database && row.Branch == branch && row.User == user && row.Host == host && row.Table == table {
+			return row, true
+		}
+	}
+	return AccessRow{}, false
+}
+
 // GetBinlog returns the table's binlog.
 func (tbl *Access) GetBinlog() *Binlog {
 	return tbl.binlog
 }
 
-// Serialize returns the offset for the Access table written to the given builder.
-func (tbl *Access) Serialize(b *flatbuffers.Builder) flatbuffers.UOffsetT {
-	// Serialize the binlog
-	binlog := tbl.binlog.Serialize(b)
+// Serialize returns the offsets for the Access table written to the given builder. The branch entries and the table
+// entries are written separately, so that versions of Dolt that predate table entries don't mistake them for branch
+// entries. The offset of the table entries is zero if there are none, in which case it should not be written at all.
+func (tbl *Access) Serialize(b *flatbuffers.Builder) (branchOffset flatbuffers.UOffsetT, tableOffset flatbuffers.UOffsetT) {
+	var branchRows, tableRows []AccessRow
+	iter := tbl.Iter()
+	for row, ok := iter.Next(); ok; row, ok = iter.Next() {
+		if row.isTableEntry() {
+			tableRows = append(tableRows, row)
+		} else {
+			branchRows = append(branchRows, row)
+		}
+	}
+	if len(tableRows) > 0 {
+		tableOffset = serializeAccessBinlog(b, NewAccessBinlog(tableRows))
+	}
+	return serializeAccessBinlog(b, NewAccessBinlog(branchRows)), tableOffset
+}
+
+// serializeAccessBinlog returns the offset for an Access table that contains the given binlog.
+func serializeAccessBinlog(b *flatbuffers.Builder, binlog *Binlog) flatbuffers.UOffsetT {
+	binlogOffset := binlog.Serialize(b)
 	serial.BranchControlAccessStart(b)
-	serial.BranchControlAccessAddBinlog(b, binlog)
+	serial.BranchControlAccessAddBinlog(b, binlogOffset)
 	return serial.BranchControlAccessEnd(b)
 }
 
@@ -105,31 +187,50 @@ func (tbl *Access) reinit() {
 		Children:   make(map[int32]*MatchNode),
 		Data:       nil,
 	}
+	tbl.TableRoot = &MatchNode{
+		SortOrders: []int32{columnMarker},
+		Children:   make(map[int32]*MatchNode),
+		Data:       nil,
+	}
 	tbl.binlog = NewAccessBinlog(nil)
 	tbl.rows = nil
 	tbl.freeRows = nil
 }
 
-// Deserialize populates the table with the data from the flatbuffers representation.
-func (tbl *Access) Deserialize(fb *serial.BranchControlAccess) error {
-	// Read the binlog
-	fbBinlog, err := fb.TryBinlog(nil)
-	if err != nil {
-		return err
-	}
-	binlog := NewAccessBinlog(nil)
-	if err = binlog.Deserialize(fbBinlog); err != nil {
-		return err
+// Deserialize populates the table with the data from the flatbuffers representations of the branch entries and the
+// table entries. The table entries are nil for files that were written before they existed.
+func (tbl *Access) Deserialize(fb *serial.BranchControlAccess, tableFb *serial.BranchControlAccess) error {
+	binlogs := make([]*Binlog, 0, 2)
+	for _, accessFb := range []*serial.BranchControlAccess{fb, tableFb} {
+		if accessFb == nil {
+			continue
+		}
+		// Read the binlog
+		fbBinlog, err := accessFb.TryBinlog(nil)
+		if err != nil {
+			return err
+		}
+		binlog := NewAccessBinlog(nil)
+		if err = binlog.Deserialize(fbBinlog); err != nil {
+			return err
+		}
+		binlogs = append(binlogs, binlog)
 	}
 
 	tbl.reinit()
 
-	// Recreate the table from the binlog
-	for _, binlogRow := range binlog.rows {
-		if binlogRow.IsInsert {
-			tbl.Insert(binlogRow.Database, binlogRow.Branch, binlogRow.User, binlogRow.Host, Permissions(binlogRow.Permissions))
-		} else {
-			tbl.Delete(binlogRow.Database, binlogRow.Branch, binlogRow.User, binlogRow.Host)
+	// Recreate the table from the binlogs. Rows without a table expression were written for the branch as a whole.
+	for _, binlog := range binlogs {
+		for _, binlogRow := range binlog.rows {
+			table := binlogRow.Table
+			if len(table) == 0 {
+				table = AllTables
+			}
+			if binlogRow.IsInsert {
+				tbl.Insert(binlogRow.Database, binlogRow.Branch, binlogRow.User, binlogRow.Host, table, binlogRow.Columns, Permissions(binlogRow.Permissions))
+			} else {
+				tbl.Delete(binlogRow.Database, binlogRow.Branch, binlogRow.User, binlogRow.Host, table)
+			}
 		}
 	}
 	return nil
@@ -139,46 +240,44 @@ func (tbl *Access) Deserialize(fb *serial.BranchControlAccess) error {
 // modify any branch control tables. This was the default behavior of Dolt before the introduction of branch permissions.
 func (tbl *Access) insertDefaultRow() {
 	tbl.reinit()
-	tbl.Insert("%", "%", "%", "%", Permissions_Write)
+	tbl.Insert("%", "%", "%", "%", AllTables, "", Permissions_Write)
 }
 
 // Insert adds the given expressions to the table. This does not perform any sort of validation whatsoever, so it is
-// important to ensure that the expressions are valid before insertion. Folds all strings that are given. Overwrites any
-// existing entries with the new permissions. Requires external synchronization handling, therefore manually manage the
-// RWMutex.
-func (tbl *Access) Insert(database string, branch string, user string, host string, perms Permissions) {
-	// Database, Branch, and Host are case-insensitive, while User is case-sensitive
-	database = strings.ToLower(FoldExpression(database))
-	branch = strings.ToLower(FoldExpression(branch))
-	user = FoldExpression(user)
-	host = strings.ToLower(FoldExpression(host))
-	// Each expression is capped at 2¹⁶-1 values, so we truncate to 2¹⁶-2 and add the any-match character at the end if it's over
-	if len(database) > math.MaxUint16 {
-		database = string(append([]byte(database[:math.MaxUint16-1]), byte('%')))
-	}
-	if len(branch) > math.MaxUint16 {
-		branch = string(append([]byte(branch[:math.MaxUint16-1]), byte('%')))
-	}
-	if len(user) > math.MaxUint16 {
-		user = string(append([]byte(user[:math.MaxUint16-1]), byte('%')))
-	}
-	if len(host) > math.MaxUint16 {
-		host = string(append([]byte(host[:math.MaxUint16-1]), byte('%')))
-	}
+// important to ensure that the expressions are valid before insertion. Folds all strings that are given, and
+// normalizes the column list. Overwrites any existing entry with the new columns and permissions. Requires external
+// synchronization handling, therefore manually manage the RWMutex.
+func (tbl *Access) Insert(database string, branch string, user string, host string, table string, columns string, perms Permissions) {
+	database, branch, user, host, table = foldAccessExpressions(database, branch, user, host, table)
+	columns = NormalizeColumns(columns)
 	// Add the insertion entry to the binlog
-	tbl.binlog.Insert(database, branch, user, host, uint64(perms))
+	tbl.binlog.appendRow(BinlogRow{
+		IsInsert:    true,
+		Database:    database,
+		Branch:      branch,
+		User:        user,
+		Host:        host,
+		Permissions: uint64(perms),
+		Table:       table,
+		Columns:     columns,
+	})
+	// An existing entry would otherwise remain in the rows, so we free it before adding the new entry
+	tbl.remove(database, branch, user, host, table)
+	row := AccessRow{
+		Database:    database,
+		Branch:      branch,
+		User:        user,
+		Host:        host,
+		Table:       table,
+		Columns:     columns,
+		Permissions: perms,
+	}
 	// Add to the rows and grab the insertion index
 	var index uint32
 	if len(tbl.freeRows) > 0 {
 		index = tbl.freeRows[len(tbl.freeRows)-1]
 		tbl.freeRows = tbl.freeRows[:len(tbl.freeRows)-1]
-		tbl.rows[index] = AccessRow{
-			Database:    database,
-			Branch:      branch,
-			User:        user,
-			Host:        host,
-			Permissions: perms,
-		}
+		tbl.rows[index] = row
 	} else {
 		if len(tbl.rows) >= math.MaxUint32 {
 			// If someone has this many branches in Dolt then they're doing something very interesting, we'll probably
@@ -186,48 +285,49 @@ func (tbl *Access) Insert(database string, branch string, user string, host stri
 			panic(fmt.Errorf("branch control has a maximum limit of %d branches", math.MaxUint32-1))
 		}
 		index = uint32(len(tbl.rows))
-		tbl.rows = append(tbl.rows, AccessRow{
-			Database:    database,
-			Branch:      branch,
-			User:        user,
-			Host:        host,
-			Permissions: perms,
-		})
+		tbl.rows = append(tbl.rows, row)
 	}
-	// Add the entry to the root node
-	tbl.Root.Add(database, branch, user, host, MatchNodeData{
+	// Add the entry to the root nodes
+	data := MatchNodeData{
 		Permissions: perms,
 		RowIndex:    index,
-	})
+	}
+	if table == AllTables {
+		tbl.Root.Add(database, branch, user, host, data)
+	}
+	if row.isTableEntry() {
+		tbl.TableRoot.add([]string{database, branch, user, host, table}, data)
+	}
 }
 
 // Delete removes the given expressions from the table. This does not perform any sort of validation whatsoever, so it
 // is important to ensure that the expressions are valid before deletion. Folds all strings that are given. Requires
 // external synchronization handling, therefore manually manage the RWMutex.
-func (tbl *Access) Delete(database string, branch string, user string, host string) {
-	// Database, Branch, and Host are case-insensitive, while User is case-sensitive
-	database = strings.ToLower(FoldExpression(database))
-	branch = strings.ToLower(FoldExpression(branch))
-	user = FoldExpression(user)
-	host = strings.ToLower(FoldExpression(host))
-	// Each expression is capped at 2¹⁶-1 values, so we truncate to 2¹⁶-2 and add the any-match character at the end if it's over
-	if len(database) > math.MaxUint16 {
-		database = string(append([]byte(database[:math.MaxUint16-1]), byte('%')))
-	}
-	if len(branch) > math.MaxUint16 {
-		branch = string(append([]byte(branch[:math.MaxUint16-1]), byte('%')))
-	}
-	if len(user) > math.MaxUint16 {
-		user = string(append([]byte(user[:math.MaxUint16-1]), byte('%')))
+func (tbl *Access) Delete(database string, branch string, user string, host string, table string) {
+	database, branch, user, host, table = foldAccessExpressions(database, branch, user, host, table)
+	// Add the deletion entry to the binlog
+	tbl.binlog.appendRow(BinlogRow{
+		IsInsert:    false,
+		Database:    database,
+		Branch:      branch,
+		User:        user,
+		Host:        host,
+		Permissions: uint64(Permissions_None),
+		Table:       table,
+	})
+	tbl.remove(database, branch, user, host, table)
+}
+
+// remove removes the entry with the given folded expressions from the root nodes, and then from the rows.
+func (tbl *Access) remove(database string, branch string, user string, host string, table string) {
+	removedIndex := uint32(math.MaxUint32)
+	if table == AllTables {
+		removedIndex = tbl.Root.Remove(database, branch, user, host)
 	}
-	if len(host) > math.MaxUint16 {
-		host = string(append([]byte(host[:math.MaxUint16-1]), byte('%')))
+	// An entry for all tables is only in the table root when it has a list of columns, and shares its row either way
+	if tableIndex := tbl.TableRoot.remove([]string{database, branch, user, host, table}); tableIndex != math.MaxUint32 {
+		removedIndex = tableIndex
 	}
-	// Add the deletion entry to the binlog
-	tbl.binlog.Delete(database, branch, user, host, uint64(Permissions_None))
-	// Remove the entry from the root node
-	removedIndex := tbl.Root.Remove(database, branch, user, host)
-	// Remove from the rows
 	if removedIndex != math.MaxUint32 {
 		tbl.freeRows = append(tbl.freeRows, removedIndex)
 	}
@@ -258,3 +358,45 @@ OuterLoop:
 	}
 	return AccessRow{}, false
 }
+
+// isTableEntry returns whether the row narrows the write access to tables, which is the case when it has a table
+// expression other than AllTables, or a list of columns.
+func (row AccessRow) isTableEntry() bool {
+	return row.Table != AllTables || len(row.Columns) > 0
+}
+
+// NormalizeColumns returns the given comma-separated list of column names in the form that it is stored in. Column
+// names are case-insensitive, so they're lowercased, and they're also trimmed, deduplicated, and sorted.
+func NormalizeColumns(columns string) string {
+	seen := make(map[string]struct{})
+	var names []string
+	for _, name := range strings.Split(columns, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, ok := seen[name]; ok || len(name) == 0 {
+			continue
+		}
+		seen[name] = struct{}{}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
+
+// foldAccessExpressions folds the given expressions, and truncates them to their maximum length. Database, Branch,
+// Host, and Table are case-insensitive, while User is case-sensitive.
+func foldAccessExpressions(database, branch, user, host, table string) (string, string, string, string, string) {
+	return truncateExpression(strings.ToLower(FoldExpression(database))),
+		truncateExpression(strings.ToLower(FoldExpression(branch))),
+		truncateExpression(FoldExpression(user)),
+		truncateExpression(strings.ToLower(FoldExpression(host))),
+		truncateExpression(strings.ToLower(FoldExpression(table)))
+}
+
+// truncateExpression caps the expression at 2¹⁶-1 values, by truncating to 2¹⁶-2 and adding the any-match character
+// at the end if it's over.
+func truncateExpression(expr string) string {
+	if len(expr) > math.MaxUint16 {
+		return string(append([]byte(expr[:math.MaxUint16-1]), byte('%')))
+	}
+	return expr
+}
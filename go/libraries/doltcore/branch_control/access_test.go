@@ -0,0 +1,126 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package branch_control
+
+import (
+	"testing"
+
+	fb "github.com/dolthub/flatbuffers/v23/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/gen/fb/serial"
+)
+
+func TestAccessMatchTable(t *testing.T) {
+	tbl := newAccess()
+	tbl.reinit()
+	tbl.Insert("%", "main", "analyst", "%", AllTables, "", Permissions_Write)
+	tbl.Insert("%", "main", "analyst", "%", "Facts", "", Permissions_Read)
+	tbl.Insert("%", "main", "analyst", "%", "annotations", "Reviewer, note,note", Permissions_Write)
+
+	// Table entries don't change the permissions on the branch
+	ok, perms := tbl.Match("mydb", "main", "analyst", "localhost")
+	assert.True(t, ok)
+	assert.Equal(t, Permissions_Write, perms)
+
+	ok, perms, columns := tbl.MatchTable("mydb", "main", "analyst", "localhost", "facts")
+	assert.True(t, ok)
+	assert.Equal(t, Permissions_Read, perms)
+	assert.Nil(t, columns)
+
+	ok, perms, columns = tbl.MatchTable("mydb", "main", "analyst", "localhost", "annotations")
+	assert.True(t, ok)
+	assert.Equal(t, Permissions_Write, perms)
+	assert.Equal(t, []string{"note", "reviewer"}, columns)
+
+	// Branch entries aren't table entries
+	ok, _, _ = tbl.MatchTable("mydb", "main", "analyst", "localhost", "other")
+	assert.False(t, ok)
+
+	// Inserting the same expressions again replaces the entry
+	tbl.Insert("%", "main", "analyst", "%", "annotations", "", Permissions_Write)
+	ok, perms, columns = tbl.MatchTable("mydb", "main", "analyst", "localhost", "annotations")
+	assert.True(t, ok)
+	assert.Equal(t, Permissions_Write, perms)
+	assert.Nil(t, columns)
+
+	// A column list on a branch entry applies to every table
+	tbl.Insert("%", "main", "analyst", "%", AllTables, "note", Permissions_Write)
+	ok, perms = tbl.Match("mydb", "main", "analyst", "localhost")
+	assert.True(t, ok)
+	assert.Equal(t, Permissions_Write, perms)
+	ok, _, columns = tbl.MatchTable("mydb", "main", "analyst", "localhost", "other")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"note"}, columns)
+
+	tbl.Delete("%", "main", "analyst", "%", AllTables)
+	ok, _ = tbl.Match("mydb", "main", "analyst", "localhost")
+	assert.False(t, ok)
+	ok, _, _ = tbl.MatchTable("mydb", "main", "analyst", "localhost", "other")
+	assert.False(t, ok)
+	ok, _, _ = tbl.MatchTable("mydb", "main", "analyst", "localhost", "annotations")
+	assert.True(t, ok)
+}
+
+func TestAccessSerialization(t *testing.T) {
+	tbl := newAccess()
+	tbl.insertDefaultRow()
+	_, tableOffset := tbl.Serialize(fb.NewBuilder(0))
+	assert.Equal(t, fb.UOffsetT(0), tableOffset)
+
+	tbl.Insert("%", "main", "analyst", "%", AllTables, "", Permissions_Read)
+	tbl.Insert("%", "main", "analyst", "%", "annotations", "note", Permissions_Write)
+	tbl.Insert("%", "main", "analyst", "%", "scratch", "", Permissions_Write)
+	tbl.Delete("%", "main", "analyst", "%", "scratch")
+
+	b := fb.NewBuilder(0)
+	branchOffset, tableOffset := tbl.Serialize(b)
+	b.Finish(branchOffset)
+	branchEntries, err := serial.TryGetRootAsBranchControlAccess(b.FinishedBytes(), 0)
+	require.NoError(t, err)
+	b = fb.NewBuilder(0)
+	_, tableOffset = tbl.Serialize(b)
+	b.Finish(tableOffset)
+	tableEntries, err := serial.TryGetRootAsBranchControlAccess(b.FinishedBytes(), 0)
+	require.NoError(t, err)
+
+	// Branch entries are read the same with or without the table entries
+	loaded := newAccess()
+	require.NoError(t, loaded.Deserialize(branchEntries, nil))
+	assert.Equal(t, []AccessRow{
+		{Database: "%", Branch: "%", User: "%", Host: "%", Table: AllTables, Permissions: Permissions_Write},
+		{Database: "%", Branch: "main", User: "analyst", Host: "%", Table: AllTables, Permissions: Permissions_Read},
+	}, accessRows(loaded))
+
+	require.NoError(t, loaded.Deserialize(branchEntries, tableEntries))
+	assert.Equal(t, []AccessRow{
+		{Database: "%", Branch: "%", User: "%", Host: "%", Table: AllTables, Permissions: Permissions_Write},
+		{Database: "%", Branch: "main", User: "analyst", Host: "%", Table: AllTables, Permissions: Permissions_Read},
+		{Database: "%", Branch: "main", User: "analyst", Host: "%", Table: "annotations", Columns: "note", Permissions: Permissions_Write},
+	}, accessRows(loaded))
+	ok, _, columns := loaded.MatchTable("mydb", "main", "analyst", "localhost", "annotations")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"note"}, columns)
+}
+
+func accessRows(tbl *Access) []AccessRow {
+	var rows []AccessRow
+	iter := tbl.Iter()
+	for row, ok := iter.Next(); ok; row, ok = iter.Next() {
+		rows = append(rows, row)
+	}
+	return rows
+}
@@ -40,6 +40,9 @@ type BinlogRow struct {
 	User        string
 	Host        string
 	Permissions uint64
+	// Table and Columns are only set for rows of the Access table
+	Table   string
+	Columns string
 }
 
 // BinlogOverlay enables transactional use cases over Binlog. Unlike a Binlog, a BinlogOverlay requires external
@@ -61,6 +64,8 @@ func NewAccessBinlog(vals []AccessRow) *Binlog {
 			User:        val.User,
 			Host:        val.Host,
 			Permissions: uint64(val.Permissions),
+			Table:       val.Table,
+			Columns:     val.Columns,
 		}
 	}
 	return &Binlog{
@@ -137,6 +142,8 @@ func (binlog *Binlog) Deserialize(fb *serial.BranchControlBinlog) error {
 			User:        string(serialBinlogRow.User()),
 			Host:        string(serialBinlogRow.Host()),
 			Permissions: serialBinlogRow.Permissions(),
+			Table:       string(serialBinlogRow.TableName()),
+			Columns:     string(serialBinlogRow.Columns()),
 		}
 	}
 	return nil
@@ -172,6 +179,14 @@ func (binlog *Binlog) Delete(database string, branch string, user string, host s
 	})
 }
 
+// appendRow adds the given entry to the Binlog.
+func (binlog *Binlog) appendRow(row BinlogRow) {
+	binlog.RWMutex.Lock()
+	defer binlog.RWMutex.Unlock()
+
+	binlog.rows = append(binlog.rows, row)
+}
+
 // Rows returns the underlying rows.
 func (binlog *Binlog) Rows() []BinlogRow {
 	return binlog.rows
@@ -183,6 +198,15 @@ func (row *BinlogRow) Serialize(b *flatbuffers.Builder) flatbuffers.UOffsetT {
 	branch := b.CreateSharedString(row.Branch)
 	user := b.CreateSharedString(row.User)
 	host := b.CreateSharedString(row.Host)
+	// The table and columns are only written when they're set, so that the rows of the other tables and the branch
+	// entries of the Access table may still be read by versions of Dolt that predate them
+	var table, columns flatbuffers.UOffsetT
+	if len(row.Table) > 0 && row.Table != AllTables {
+		table = b.CreateSharedString(row.Table)
+	}
+	if len(row.Columns) > 0 {
+		columns = b.CreateSharedString(row.Columns)
+	}
 
 	serial.BranchControlBinlogRowStart(b)
 	serial.BranchControlBinlogRowAddIsInsert(b, row.IsInsert)
@@ -191,5 +215,11 @@ func (row *BinlogRow) Serialize(b *flatbuffers.Builder) flatbuffers.UOffsetT {
 	serial.BranchControlBinlogRowAddUser(b, user)
 	serial.BranchControlBinlogRowAddHost(b, host)
 	serial.BranchControlBinlogRowAddPermissions(b, row.Permissions)
+	if table != 0 {
+		serial.BranchControlBinlogRowAddTableName(b, table)
+	}
+	if columns != 0 {
+		serial.BranchControlBinlogRowAddColumns(b, columns)
+	}
 	return serial.BranchControlBinlogRowEnd(b)
 }
@@ -31,6 +31,8 @@ import (
 
 var (
 	ErrIncorrectPermissions  = errors.NewKind("`%s`@`%s` does not have the correct permissions on branch `%s`")
+	ErrIncorrectTablePerms   = errors.NewKind("`%s`@`%s` does not have the correct permissions on table `%s` of branch `%s`")
+	ErrRestrictedColumns     = errors.NewKind("`%s`@`%s` may only update the columns [%s] of table `%s` on branch `%s`")
 	ErrCannotCreateBranch    = errors.NewKind("`%s`@`%s` cannot create a branch named `%s`")
 	ErrCannotDeleteBranch    = errors.NewKind("`%s`@`%s` cannot delete the branch `%s`")
 	ErrExpressionsTooLong    = errors.NewKind("expressions are too long [%q, %q, %q, %q]")
@@ -139,6 +141,10 @@ func (controller *Controller) LoadData(ctx context.Context, data []byte, isFirst
 	if err != nil {
 		return err
 	}
+	tableEntries, err := bc.TryTableTbl(nil)
+	if err != nil {
+		return err
+	}
 
 	rollback := controller.Serialized.Load()
 
@@ -146,7 +152,7 @@ func (controller *Controller) LoadData(ctx context.Context, data []byte, isFirst
 	// |Access| in different views of the data here.
 
 	// The Deserialize functions acquire write locks, so we don't acquire them here
-	if err = controller.Access.Deserialize(access); err != nil {
+	if err = controller.Access.Deserialize(access, tableEntries); err != nil {
 		// TODO: More principaled rollback. Hopefully this does not fail.
 		controller.LoadData(ctx, *rollback, isFirstLoad)
 		return err
@@ -199,11 +205,16 @@ func (controller *Controller) SaveData(ctx context.Context, fs filesys.Filesys)
 
 	b := flatbuffers.NewBuilder(1024)
 	// The Serialize functions acquire read locks, so we don't acquire them here
-	accessOffset := controller.Access.Serialize(b)
+	accessOffset, tableEntriesOffset := controller.Access.Serialize(b)
 	namespaceOffset := controller.Namespace.Serialize(b)
 	serial.BranchControlStart(b)
 	serial.BranchControlAddAccessTbl(b, accessOffset)
 	serial.BranchControlAddNamespaceTbl(b, namespaceOffset)
+	// The table entries are only written once they have been used, so that older versions of Dolt may still read files
+	// that do not make use of them
+	if tableEntriesOffset != 0 {
+		serial.BranchControlAddTableTbl(b, tableEntriesOffset)
+	}
 	root := serial.BranchControlEnd(b)
 	// serial.FinishMessage() limits files to 2^24 bytes, so this works around it while maintaining read compatibility
 	b.Prep(1, flatbuffers.SizeInt32+4+serial.MessagePrefixSz)
@@ -338,7 +349,7 @@ func AddAdminForContext(ctx context.Context, branchName string) error {
 		return nil
 	}
 	controller.Access.RWMutex.Lock()
-	controller.Access.Insert(database, branchName, user, host, Permissions_Admin)
+	controller.Access.Insert(database, branchName, user, host, AllTables, "", Permissions_Admin)
 	controller.Access.RWMutex.Unlock()
 	return SaveData(ctx)
 }
@@ -23,7 +23,9 @@ import (
 
 var (
 	aiciSorter = sql.Collation_utf8mb4_0900_ai_ci.Sorter()
-	sortFuncs  = []func(r rune) int32{aiciSorter, aiciSorter, sql.Collation_utf8mb4_0900_bin.Sorter(), aiciSorter}
+	// sortFuncs are the sort functions of the database, branch, user, host, and table expressions, in that order. Only
+	// the table entries of the Access table make use of the table expression.
+	sortFuncs = []func(r rune) int32{aiciSorter, aiciSorter, sql.Collation_utf8mb4_0900_bin.Sorter(), aiciSorter, aiciSorter}
 )
 
 // MatchNode contains a collection of sort orders that allow for an optimized level of traversal compared to
@@ -72,7 +74,13 @@ var concatenatedSortOrderPool = &sync.Pool{
 // represent expressions, then this matches against all parsed expressions that are either duplicates or supersets of
 // the given expressions. This allows the user to "match" against new expressions to see if they are already covered.
 func (mn *MatchNode) Match(database, branch, user, host string) []MatchResult {
-	allSortOrders := mn.parseExpression(database, branch, user, host)
+	return mn.match([]string{database, branch, user, host})
+}
+
+// match returns a collection of results based on the given strings or expressions, which are given in the same order
+// as the sort functions.
+func (mn *MatchNode) match(exprs []string) []MatchResult {
+	allSortOrders := mn.parseExpression(exprs)
 	defer func() {
 		concatenatedSortOrderPool.Put(allSortOrders)
 	}()
@@ -184,8 +192,13 @@ func processMatch(matches []matchNodeCounted, node matchNodeCounted, sortOrder i
 // Add will add the given expressions to the node hierarchy. If the expressions already exists, then this overwrites
 // the pre-existing entry. Assumes that the given expressions have already been folded.
 func (mn *MatchNode) Add(databaseExpr, branchExpr, userExpr, hostExpr string, data MatchNodeData) {
+	mn.add([]string{databaseExpr, branchExpr, userExpr, hostExpr}, data)
+}
+
+// add will add the given expressions, which are given in the same order as the sort functions, to the node hierarchy.
+func (mn *MatchNode) add(exprs []string, data MatchNodeData) {
 	root := mn
-	allSortOrders := mn.parseExpression(databaseExpr, branchExpr, userExpr, hostExpr)
+	allSortOrders := mn.parseExpression(exprs)
 	defer func() {
 		concatenatedSortOrderPool.Put(allSortOrders)
 	}()
@@ -265,8 +278,14 @@ ParentLoop:
 // Remove will remove the given expressions to the node hierarchy. If the expressions do not exist, then nothing
 // happens. Assumes that the given expressions have already been folded.
 func (mn *MatchNode) Remove(databaseExpr, branchExpr, userExpr, hostExpr string) uint32 {
+	return mn.remove([]string{databaseExpr, branchExpr, userExpr, hostExpr})
+}
+
+// remove will remove the given expressions, which are given in the same order as the sort functions, from the node
+// hierarchy. Returns the row index of the removed expressions, or math.MaxUint32 if they did not exist.
+func (mn *MatchNode) remove(exprs []string) uint32 {
 	root := mn
-	allSortOrders := mn.parseExpression(databaseExpr, branchExpr, userExpr, hostExpr)
+	allSortOrders := mn.parseExpression(exprs)
 	defer func() {
 		concatenatedSortOrderPool.Put(allSortOrders)
 	}()
@@ -353,22 +372,12 @@ ParentLoop:
 // parseExpression parses expressions into a concatenated collection of sort orders. The returned slice belongs to the
 // pool, which, if possible, should be returned once it is no longer needed. As this function doesn't distinguish
 // between strings and expressions, it assumes any given expressions have already been folded.
-func (mn *MatchNode) parseExpression(database, branch, user, host string) []int32 {
-	if len(database) > math.MaxUint16 {
-		database = database[:math.MaxUint16]
-	}
-	if len(branch) > math.MaxUint16 {
-		branch = branch[:math.MaxUint16]
-	}
-	if len(user) > math.MaxUint16 {
-		user = user[:math.MaxUint16]
-	}
-	if len(host) > math.MaxUint16 {
-		host = host[:math.MaxUint16]
-	}
-
+func (mn *MatchNode) parseExpression(exprs []string) []int32 {
 	allSortOrders := concatenatedSortOrderPool.Get().([]int32)[:0]
-	for i, str := range []string{database, branch, user, host} {
+	for i, str := range exprs {
+		if len(str) > math.MaxUint16 {
+			str = str[:math.MaxUint16]
+		}
 		escaped := false
 		sortFunc := sortFuncs[i]
 		allSortOrders = append(allSortOrders, columnMarker)
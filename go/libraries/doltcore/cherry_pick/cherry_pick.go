@@ -17,19 +17,36 @@ package cherry_pick
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions/commitwalk"
 	"github.com/dolthub/dolt/go/libraries/doltcore/merge"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/store/hash"
 )
 
 // ErrCherryPickUncommittedChanges is returned when a cherry-pick is attempted without a clean working set.
 var ErrCherryPickUncommittedChanges = errors.New("cannot cherry-pick with uncommitted changes")
 
+// ErrCherryPickNoChanges is returned when cherry-picking a commit would not change the current HEAD.
+var ErrCherryPickNoChanges = errors.New("no changes were made, nothing to commit")
+
+// ErrCherryPickEmpty is returned when a commit makes changes, but none of them are left to commit once it's applied to
+// the current HEAD, e.g. when the working set only differs from HEAD in ignored tables.
+var ErrCherryPickEmpty = errors.New("nothing to commit")
+
+// ErrCherryPickInProgress is returned when a new cherry-pick is started while a cherry-pick of several commits is
+// still waiting for conflicts to be resolved.
+var ErrCherryPickInProgress = errors.New("error: a cherry-pick is already in progress, use --continue, --skip or --abort")
+
+// ErrNoCherryPickInProgress is returned when a cherry-pick is continued or skipped and none is in progress.
+var ErrNoCherryPickInProgress = errors.New("error: There is no cherry-pick in progress")
+
 // CherryPickOptions specifies optional parameters specifying how a cherry-pick is performed.
 type CherryPickOptions struct {
 	// Amend controls whether the commit at HEAD is amended and combined with the commit to be cherry-picked.
@@ -39,19 +56,179 @@ type CherryPickOptions struct {
 	CommitMessage string
 }
 
-// CherryPick replays a commit, specified by |options.Commit|, and applies it as a new commit to the current HEAD. If
+// cherryPickTarget is a commit to be cherry-picked, along with the spec that identified it.
+type cherryPickTarget struct {
+	commit  *doltdb.Commit
+	specStr string
+}
+
+// CherryPick replays a commit, specified by |commit|, and applies it as a new commit to the current HEAD. If
 // successful, the hash of the new commit is returned. If the cherry-pick results in merge conflicts, the merge result
 // is returned. If any unexpected error occur, it is returned.
 func CherryPick(ctx *sql.Context, commit string, options CherryPickOptions) (string, *merge.Result, error) {
+	return CherryPickCommits(ctx, []string{commit}, options)
+}
+
+// CherryPickCommits replays the commits specified by |commits|, in order, and applies each of them as a new commit to
+// the current HEAD. Each element of |commits| is either a single commit spec, or a range A..B identifying the commits
+// reachable from B but not from A, which are applied oldest first. When more than one commit is picked, commits that
+// make no changes are skipped. If successful, the hash of the last new commit is returned. If a commit results in
+// merge conflicts, the merge result is returned and the commits that haven't been applied yet are recorded in the
+// working set, so that the cherry-pick can be resumed with ContinueCherryPick or SkipCherryPick.
+func CherryPickCommits(ctx *sql.Context, commits []string, options CherryPickOptions) (string, *merge.Result, error) {
+	doltSession := dsess.DSessFromSess(ctx.Session)
+	dbName := ctx.GetCurrentDatabase()
+
+	ws, err := doltSession.WorkingSet(ctx, dbName)
+	if err != nil {
+		return "", nil, err
+	}
+	if ws.CherryPickActive() {
+		return "", nil, ErrCherryPickInProgress
+	}
+
+	targets, err := resolveCherryPickTargets(ctx, doltSession, dbName, commits)
+	if err != nil {
+		return "", nil, err
+	}
+
+	newCommitHash, mergeResult, err := applyCherryPicks(ctx, targets, options, len(targets) > 1)
+	if err != nil {
+		return "", mergeResult, err
+	}
+	if newCommitHash == "" && mergeResult == nil {
+		return "", nil, ErrCherryPickNoChanges
+	}
+	return newCommitHash, mergeResult, nil
+}
+
+// ContinueCherryPick resumes a cherry-pick that stopped because of conflicts. The staged changes, which must not
+// contain any unresolved conflicts, are committed with the message of the commit being cherry-picked, and then the
+// remaining commits are cherry-picked. The hash of the last new commit is returned, or the merge result if another
+// commit results in merge conflicts.
+func ContinueCherryPick(ctx *sql.Context, dbName string) (string, *merge.Result, error) {
+	doltSession := dsess.DSessFromSess(ctx.Session)
+
+	ws, err := doltSession.WorkingSet(ctx, dbName)
+	if err != nil {
+		return "", nil, fmt.Errorf("fatal: unable to load working set: %v", err)
+	}
+
+	cherryPickMergeActive := ws.MergeActive() && ws.MergeState().IsCherryPick()
+	if !cherryPickMergeActive && !ws.CherryPickActive() {
+		return "", nil, ErrNoCherryPickInProgress
+	}
+
+	var newCommitHash string
+	if cherryPickMergeActive {
+		newCommitHash, err = commitResolvedCherryPick(ctx, doltSession, dbName, ws.MergeState().Commit())
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	remaining, err := takeRemainingCherryPicks(ctx, doltSession, dbName)
+	if err != nil {
+		return "", nil, err
+	}
+
+	h, mergeResult, err := applyCherryPicks(ctx, remaining, CherryPickOptions{}, true)
+	if err != nil || mergeResult != nil {
+		return "", mergeResult, err
+	}
+	if h != "" {
+		newCommitHash = h
+	}
+	return newCommitHash, nil, nil
+}
+
+// SkipCherryPick resumes a cherry-pick that stopped because of conflicts, discarding the changes of the commit that
+// conflicted, and then cherry-picks the remaining commits. The hash of the last new commit is returned, or the merge
+// result if another commit results in merge conflicts.
+func SkipCherryPick(ctx *sql.Context, dbName string) (string, *merge.Result, error) {
+	doltSession := dsess.DSessFromSess(ctx.Session)
+
+	ws, err := doltSession.WorkingSet(ctx, dbName)
+	if err != nil {
+		return "", nil, fmt.Errorf("fatal: unable to load working set: %v", err)
+	}
+
+	cherryPickMergeActive := ws.MergeActive() && ws.MergeState().IsCherryPick()
+	if !cherryPickMergeActive && !ws.CherryPickActive() {
+		return "", nil, ErrNoCherryPickInProgress
+	}
+
+	if cherryPickMergeActive {
+		roots, ok := doltSession.GetRoots(ctx, dbName)
+		if !ok {
+			return "", nil, fmt.Errorf("fatal: unable to load roots for %s", dbName)
+		}
+
+		ws, err = merge.AbortMerge(ctx, ws, roots)
+		if err != nil {
+			return "", nil, fmt.Errorf("fatal: unable to abort merge: %v", err)
+		}
+
+		err = doltSession.SetWorkingSet(ctx, dbName, ws)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	remaining, err := takeRemainingCherryPicks(ctx, doltSession, dbName)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return applyCherryPicks(ctx, remaining, CherryPickOptions{}, true)
+}
+
+// applyCherryPicks cherry-picks each of |targets| in order. If |skipEmpty| is true, commits that make no changes, or
+// none that are left to commit, are skipped instead of returning an error. If a commit results in merge conflicts, the commits after it are recorded in
+// the working set and the merge result is returned. Otherwise, the hash of the last new commit is returned, which is
+// empty if no commit was created.
+func applyCherryPicks(ctx *sql.Context, targets []cherryPickTarget, options CherryPickOptions, skipEmpty bool) (string, *merge.Result, error) {
+	var newCommitHash string
+	for i, target := range targets {
+		h, mergeResult, err := cherryPickCommit(ctx, target, options)
+		if skipEmpty && (errors.Is(err, ErrCherryPickNoChanges) || errors.Is(err, ErrCherryPickEmpty)) {
+			continue
+		} else if err != nil {
+			return "", mergeResult, err
+		}
+
+		if mergeResult != nil {
+			if i+1 < len(targets) {
+				err = recordRemainingCherryPicks(ctx, targets[i+1:])
+				if err != nil {
+					return "", nil, err
+				}
+			}
+			return "", mergeResult, nil
+		}
+
+		newCommitHash = h
+	}
+
+	return newCommitHash, nil, nil
+}
+
+// cherryPickCommit replays the commit in |target| and applies it as a new commit to the current HEAD.
+func cherryPickCommit(ctx *sql.Context, target cherryPickTarget, options CherryPickOptions) (string, *merge.Result, error) {
 	doltSession := dsess.DSessFromSess(ctx.Session)
 	dbName := ctx.GetCurrentDatabase()
 
+	err := ensureTransaction(ctx, doltSession)
+	if err != nil {
+		return "", nil, err
+	}
+
 	roots, ok := doltSession.GetRoots(ctx, dbName)
 	if !ok {
 		return "", nil, fmt.Errorf("failed to get roots for current session")
 	}
 
-	mergeResult, commitMsg, err := cherryPick(ctx, doltSession, roots, dbName, commit)
+	mergeResult, commitMsg, err := cherryPick(ctx, doltSession, roots, dbName, target)
 	if err != nil {
 		return "", mergeResult, err
 	}
@@ -66,6 +243,9 @@ func CherryPick(ctx *sql.Context, commit string, options CherryPickOptions) (str
 	}
 
 	newWorkingRoot := mergeResult.Root
+	if err = dsess.CheckTableAccessUnchanged(ctx, dbName, roots.Working, newWorkingRoot); err != nil {
+		return "", nil, err
+	}
 	err = doltSession.SetWorkingRoot(ctx, dbName, newWorkingRoot)
 	if err != nil {
 		return "", nil, err
@@ -106,7 +286,7 @@ func CherryPick(ctx *sql.Context, commit string, options CherryPickOptions) (str
 		return "", nil, err
 	}
 	if pendingCommit == nil {
-		return "", nil, errors.New("nothing to commit")
+		return "", nil, ErrCherryPickEmpty
 	}
 
 	newCommit, err := doltSession.DoltCommit(ctx, dbName, doltSession.GetTransaction(), pendingCommit)
@@ -122,6 +302,221 @@ func CherryPick(ctx *sql.Context, commit string, options CherryPickOptions) (str
 	return h.String(), nil, nil
 }
 
+// commitResolvedCherryPick commits the staged changes of a cherry-pick of |cherryCommit| whose conflicts have been
+// resolved, using the message of |cherryCommit|, and returns the hash of the new commit.
+func commitResolvedCherryPick(ctx *sql.Context, doltSession *dsess.DoltSession, dbName string, cherryCommit *doltdb.Commit) (string, error) {
+	roots, ok := doltSession.GetRoots(ctx, dbName)
+	if !ok {
+		return "", fmt.Errorf("fatal: unable to load roots for %s", dbName)
+	}
+
+	headRootHash, err := roots.Head.HashOf()
+	if err != nil {
+		return "", err
+	}
+	stagedRootHash, err := roots.Staged.HashOf()
+	if err != nil {
+		return "", err
+	}
+	if headRootHash.Equal(stagedRootHash) {
+		return "", fmt.Errorf("error: no changes are staged, stage the resolved tables with dolt_add() or use --skip to skip this commit")
+	}
+
+	cherryCommitMeta, err := cherryCommit.GetCommitMeta(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	pendingCommit, err := doltSession.NewPendingCommit(ctx, dbName, roots, actions.CommitStagedProps{
+		Date:    ctx.QueryTime(),
+		Name:    ctx.Client().User,
+		Email:   fmt.Sprintf("%s@%s", ctx.Client().User, ctx.Client().Address),
+		Message: cherryCommitMeta.Description,
+	})
+	if err != nil {
+		return "", err
+	}
+	if pendingCommit == nil {
+		return "", errors.New("nothing to commit")
+	}
+
+	newCommit, err := doltSession.DoltCommit(ctx, dbName, doltSession.GetTransaction(), pendingCommit)
+	if err != nil {
+		return "", err
+	}
+
+	h, err := newCommit.HashOf()
+	if err != nil {
+		return "", err
+	}
+	return h.String(), nil
+}
+
+// recordRemainingCherryPicks records |targets| in the current working set as the commits still to be cherry-picked.
+func recordRemainingCherryPicks(ctx *sql.Context, targets []cherryPickTarget) error {
+	doltSession := dsess.DSessFromSess(ctx.Session)
+	dbName := ctx.GetCurrentDatabase()
+
+	ws, err := doltSession.WorkingSet(ctx, dbName)
+	if err != nil {
+		return err
+	}
+
+	remaining := make([]*doltdb.Commit, len(targets))
+	for i, target := range targets {
+		remaining[i] = target.commit
+	}
+	return doltSession.SetWorkingSet(ctx, dbName, ws.WithCherryPickState(remaining))
+}
+
+// takeRemainingCherryPicks returns the commits still to be cherry-picked that are recorded in the working set of
+// |dbName|, and clears them from the working set.
+func takeRemainingCherryPicks(ctx *sql.Context, doltSession *dsess.DoltSession, dbName string) ([]cherryPickTarget, error) {
+	err := ensureTransaction(ctx, doltSession)
+	if err != nil {
+		return nil, err
+	}
+
+	ws, err := doltSession.WorkingSet(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+	if !ws.CherryPickActive() {
+		return nil, nil
+	}
+
+	commits := ws.CherryPickState().RemainingCommits()
+	targets := make([]cherryPickTarget, len(commits))
+	for i, commit := range commits {
+		h, err := commit.HashOf()
+		if err != nil {
+			return nil, err
+		}
+		targets[i] = cherryPickTarget{commit: commit, specStr: h.String()}
+	}
+
+	err = doltSession.SetWorkingSet(ctx, dbName, ws.ClearCherryPick())
+	if err != nil {
+		return nil, err
+	}
+	return targets, nil
+}
+
+// ensureTransaction starts a new transaction if the session doesn't have one. Creating a commit commits the
+// session's transaction, so one needs to be started before each commit after the first is cherry-picked.
+func ensureTransaction(ctx *sql.Context, doltSession *dsess.DoltSession) error {
+	if doltSession.GetTransaction() != nil {
+		return nil
+	}
+	tx, err := doltSession.StartTransaction(ctx, sql.ReadWrite)
+	if err != nil {
+		return err
+	}
+	ctx.SetTransaction(tx)
+	return nil
+}
+
+// resolveCherryPickTargets resolves the commit specs and commit ranges in |commits| to the commits to cherry-pick.
+func resolveCherryPickTargets(ctx *sql.Context, dSess *dsess.DoltSession, dbName string, commits []string) ([]cherryPickTarget, error) {
+	doltDB, ok := dSess.GetDoltDB(ctx, dbName)
+	if !ok {
+		return nil, fmt.Errorf("failed to get DoltDB")
+	}
+
+	dbData, ok := dSess.GetDbData(ctx, dbName)
+	if !ok {
+		return nil, fmt.Errorf("failed to get dbData")
+	}
+
+	headRef, err := dbData.Rsr.CWBHeadRef()
+	if err != nil {
+		return nil, err
+	}
+
+	resolve := func(cherryStr string) (*doltdb.Commit, error) {
+		cherryCommitSpec, err := doltdb.NewCommitSpec(cherryStr)
+		if err != nil {
+			return nil, err
+		}
+		optCmt, err := doltDB.Resolve(ctx, cherryCommitSpec, headRef)
+		if err != nil {
+			return nil, err
+		}
+		cherryCommit, ok := optCmt.ToCommit()
+		if !ok {
+			return nil, doltdb.ErrGhostCommitEncountered
+		}
+		return cherryCommit, nil
+	}
+
+	var targets []cherryPickTarget
+	for _, cherryStr := range commits {
+		if !strings.Contains(cherryStr, "..") {
+			cherryCommit, err := resolve(cherryStr)
+			if err != nil {
+				return nil, err
+			}
+			targets = append(targets, cherryPickTarget{commit: cherryCommit, specStr: cherryStr})
+			continue
+		}
+
+		from, to, _ := strings.Cut(cherryStr, "..")
+		if from == "" || strings.HasPrefix(to, ".") {
+			return nil, fmt.Errorf("invalid commit range: %s", cherryStr)
+		}
+		if to == "" {
+			to = "HEAD"
+		}
+
+		fromCommit, err := resolve(from)
+		if err != nil {
+			return nil, err
+		}
+		toCommit, err := resolve(to)
+		if err != nil {
+			return nil, err
+		}
+		fromHash, err := fromCommit.HashOf()
+		if err != nil {
+			return nil, err
+		}
+		toHash, err := toCommit.HashOf()
+		if err != nil {
+			return nil, err
+		}
+
+		optCmts, err := commitwalk.GetDotDotRevisions(ctx, doltDB, []hash.Hash{toHash}, doltDB, []hash.Hash{fromHash}, -1)
+		if err != nil {
+			return nil, err
+		}
+
+		// The revisions are returned newest first, but they need to be applied oldest first.
+		for i := len(optCmts) - 1; i >= 0; i-- {
+			cherryCommit, ok := optCmts[i].ToCommit()
+			if !ok {
+				return nil, doltdb.ErrGhostCommitEncountered
+			}
+			h, err := cherryCommit.HashOf()
+			if err != nil {
+				return nil, err
+			}
+			targets = append(targets, cherryPickTarget{commit: cherryCommit, specStr: h.String()})
+		}
+	}
+
+	// Check every commit up front, so that a bad commit in a range doesn't stop the cherry-pick half way through.
+	for _, target := range targets {
+		if len(target.commit.DatasParents()) > 1 {
+			return nil, fmt.Errorf("cherry-picking a merge commit is not supported")
+		}
+		if len(target.commit.DatasParents()) == 0 {
+			return nil, fmt.Errorf("cherry-picking a commit without parents is not supported")
+		}
+	}
+
+	return targets, nil
+}
+
 func previousCommitMessage(ctx *sql.Context) (string, error) {
 	doltSession := dsess.DSessFromSess(ctx.Session)
 	headCommit, err := doltSession.GetHeadCommit(ctx, ctx.GetCurrentDatabase())
@@ -136,8 +531,9 @@ func previousCommitMessage(ctx *sql.Context) (string, error) {
 	return headCommitMeta.Description, nil
 }
 
-// AbortCherryPick aborts a cherry-pick merge, if one is in progress. If unable to abort for any reason
-// (e.g. if there is not cherry-pick merge in progress), an error is returned.
+// AbortCherryPick aborts a cherry-pick merge, if one is in progress, and discards any commits that were still to be
+// cherry-picked. Commits that were already cherry-picked are kept. If unable to abort for any reason (e.g. if there
+// is not cherry-pick in progress), an error is returned.
 func AbortCherryPick(ctx *sql.Context, dbName string) error {
 	doltSession := dsess.DSessFromSess(ctx.Session)
 
@@ -146,27 +542,28 @@ func AbortCherryPick(ctx *sql.Context, dbName string) error {
 		return fmt.Errorf("fatal: unable to load working set: %v", err)
 	}
 
-	if !ws.MergeActive() {
+	if !ws.MergeActive() && !ws.CherryPickActive() {
 		return fmt.Errorf("error: There is no cherry-pick merge to abort")
 	}
 
-	roots, ok := doltSession.GetRoots(ctx, dbName)
-	if !ok {
-		return fmt.Errorf("fatal: unable to load roots for %s", dbName)
-	}
+	if ws.MergeActive() {
+		roots, ok := doltSession.GetRoots(ctx, dbName)
+		if !ok {
+			return fmt.Errorf("fatal: unable to load roots for %s", dbName)
+		}
 
-	newWs, err := merge.AbortMerge(ctx, ws, roots)
-	if err != nil {
-		return fmt.Errorf("fatal: unable to abort merge: %v", err)
+		ws, err = merge.AbortMerge(ctx, ws, roots)
+		if err != nil {
+			return fmt.Errorf("fatal: unable to abort merge: %v", err)
+		}
 	}
 
-	return doltSession.SetWorkingSet(ctx, dbName, newWs)
+	return doltSession.SetWorkingSet(ctx, dbName, ws.ClearCherryPick())
 }
 
-// cherryPick checks that the current working set is clean, verifies the cherry-pick commit is not a merge commit
-// or a commit without parent commit, performs merge and returns the new working set root value and
+// cherryPick checks that the current working set is clean, performs merge and returns the new working set root value and
 // the commit message of cherry-picked commit as the commit message of the new commit created during this command.
-func cherryPick(ctx *sql.Context, dSess *dsess.DoltSession, roots doltdb.Roots, dbName, cherryStr string) (*merge.Result, string, error) {
+func cherryPick(ctx *sql.Context, dSess *dsess.DoltSession, roots doltdb.Roots, dbName string, target cherryPickTarget) (*merge.Result, string, error) {
 	// check for clean working set
 	wsOnlyHasIgnoredTables, err := diff.WorkingSetContainsOnlyIgnoredTables(ctx, roots)
 	if err != nil {
@@ -191,35 +588,7 @@ func cherryPick(ctx *sql.Context, dSess *dsess.DoltSession, roots doltdb.Roots,
 		return nil, "", fmt.Errorf("failed to get DoltDB")
 	}
 
-	dbData, ok := dSess.GetDbData(ctx, dbName)
-	if !ok {
-		return nil, "", fmt.Errorf("failed to get dbData")
-	}
-
-	cherryCommitSpec, err := doltdb.NewCommitSpec(cherryStr)
-	if err != nil {
-		return nil, "", err
-	}
-	headRef, err := dbData.Rsr.CWBHeadRef()
-	if err != nil {
-		return nil, "", err
-	}
-	optCmt, err := doltDB.Resolve(ctx, cherryCommitSpec, headRef)
-	if err != nil {
-		return nil, "", err
-	}
-	cherryCommit, ok := optCmt.ToCommit()
-	if !ok {
-		return nil, "", doltdb.ErrGhostCommitEncountered
-	}
-
-	if len(cherryCommit.DatasParents()) > 1 {
-		return nil, "", fmt.Errorf("cherry-picking a merge commit is not supported")
-	}
-	if len(cherryCommit.DatasParents()) == 0 {
-		return nil, "", fmt.Errorf("cherry-picking a commit without parents is not supported")
-	}
-
+	cherryCommit := target.commit
 	cherryRoot, err := cherryCommit.GetRootValue(ctx)
 	if err != nil {
 		return nil, "", err
@@ -227,7 +596,7 @@ func cherryPick(ctx *sql.Context, dSess *dsess.DoltSession, roots doltdb.Roots,
 
 	// When cherry-picking, we need to use the parent of the cherry-picked commit as the ancestor. This
 	// ensures that only the delta from the cherry-pick commit is applied.
-	optCmt, err = doltDB.ResolveParent(ctx, cherryCommit, 0)
+	optCmt, err := doltDB.ResolveParent(ctx, cherryCommit, 0)
 	if err != nil {
 		return nil, "", err
 	}
@@ -270,7 +639,7 @@ func cherryPick(ctx *sql.Context, dSess *dsess.DoltSession, roots doltdb.Roots,
 	}
 
 	if headRootHash.Equal(workingRootHash) {
-		return nil, "", fmt.Errorf("no changes were made, nothing to commit")
+		return nil, "", ErrCherryPickNoChanges
 	}
 
 	cherryCommitMeta, err := cherryCommit.GetCommitMeta(ctx)
@@ -286,7 +655,7 @@ func cherryPick(ctx *sql.Context, dSess *dsess.DoltSession, roots doltdb.Roots,
 			if err != nil {
 				return nil, "", err
 			}
-			newWorkingSet := ws.StartCherryPick(cherryCommit, cherryStr)
+			newWorkingSet := ws.StartCherryPick(cherryCommit, target.specStr)
 			err = dSess.SetWorkingSet(ctx, dbName, newWorkingSet)
 			if err != nil {
 				return nil, "", err
@@ -0,0 +1,81 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbfactory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/objectstorage"
+
+	"github.com/dolthub/dolt/go/libraries/utils/earl"
+	"github.com/dolthub/dolt/go/store/blobstore"
+)
+
+// ErrBlobstoreUnsupported is returned by CreateBlobstore for URLs whose databases are not kept in a file system or an
+// object store.
+var ErrBlobstoreUnsupported = errors.New("url scheme does not support blobs")
+
+// CreateBlobstore returns a blobstore.Blobstore for the location of the database at |urlStr|, in which metadata about
+// the database, such as the restore points of a backup, can be kept alongside its table files. Only file, localbs, gs,
+// oci and oss urls support this operation.
+func CreateBlobstore(ctx context.Context, urlStr string, params map[string]interface{}) (blobstore.Blobstore, error) {
+	urlObj, err := earl.Parse(urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(urlObj.Scheme) {
+	case FileScheme:
+		path, err := url.PathUnescape(urlObj.Path)
+		if err != nil {
+			return nil, err
+		}
+		return blobstore.NewLocalBlobstore(urlObj.Host + filepath.FromSlash(path)), nil
+	case LocalBSScheme:
+		absPath, err := filepath.Abs(filepath.Join(urlObj.Host, urlObj.Path))
+		if err != nil {
+			return nil, err
+		}
+		return blobstore.NewLocalBlobstore(absPath), nil
+	case GSScheme:
+		gcs, err := storage.NewClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return blobstore.NewGCSBlobstore(gcs, urlObj.Host, urlObj.Path), nil
+	case OCIScheme:
+		provider := common.DefaultConfigProvider()
+		client, err := objectstorage.NewObjectStorageClientWithConfigurationProvider(provider)
+		if err != nil {
+			return nil, err
+		}
+		return blobstore.NewOCIBlobstore(ctx, provider, client, urlObj.Host, urlObj.Path)
+	case OSSScheme:
+		ossClient, err := getOSSClient(ossConfigFromParams(params))
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize oss err: %s", err)
+		}
+		return blobstore.NewOSSBlobstore(ossClient, urlObj.Hostname(), urlObj.Path)
+	}
+
+	return nil, fmt.Errorf("%w: '%s'", ErrBlobstoreUnsupported, urlObj.Scheme)
+}
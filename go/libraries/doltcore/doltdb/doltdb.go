@@ -1412,6 +1412,12 @@ type ReplicationStatusController struct {
 	// circuit breakers, etc. and might feed into exposed replication
 	// metrics.
 	NotifyWaitFailed []func()
+
+	// There is an entry here for each function in Wait. It names the
+	// replica the wait is for, and must not be empty. Waits with the same
+	// name are acknowledged together when counting how many replicas
+	// acknowledged a write.
+	Replicas []string
 }
 
 // DatabaseUpdateListener allows callbacks on a registered listener when a database is created, dropped, or when
@@ -1765,6 +1771,18 @@ func (ddb *DoltDB) IsTableFileStore() bool {
 	return ok
 }
 
+// ChunksRequested returns the number of chunks which have been requested from the table files of this DoltDB since it
+// was opened, or 0 if it is not backed by table files. The count is database-wide: it includes the requests of every
+// session using the database.
+func (ddb *DoltDB) ChunksRequested() uint64 {
+	return nbs.ChunksRequested(datas.ChunkStoreFromDatabase(ddb.db))
+}
+
+// StorageStats returns statistics about the table files of this DoltDB, and false if it is not backed by table files.
+func (ddb *DoltDB) StorageStats() (nbs.StorageStats, bool) {
+	return nbs.GetStorageStats(datas.ChunkStoreFromDatabase(ddb.db))
+}
+
 // ChunkJournal returns the ChunkJournal for this DoltDB, if one is in use.
 func (ddb *DoltDB) ChunkJournal() *nbs.ChunkJournal {
 	tableFileStore, ok := datas.ChunkStoreFromDatabase(ddb.db).(chunks.TableFileStore)
@@ -16,6 +16,7 @@ package doltdb
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"sync"
 
@@ -50,6 +51,14 @@ type NotifyWaitFailedCommitHook interface {
 	NotifyWaitFailed()
 }
 
+// ReplicaCommitHook must be implemented by CommitHooks which return a wait
+// from |Execute|. The name identifies the replica that the wait is for. A
+// wait from a hook which doesn't implement it is dropped, and reported
+// through |HandleError|.
+type ReplicaCommitHook interface {
+	ReplicaName() string
+}
+
 func (db hooksDatabase) SetCommitHooks(ctx context.Context, postHooks []CommitHook) hooksDatabase {
 	db.postCommitHooks = make([]CommitHook, len(postHooks))
 	copy(db.postCommitHooks, postHooks)
@@ -82,6 +91,7 @@ func (db hooksDatabase) ExecuteCommitHooks(ctx context.Context, ds datas.Dataset
 		ioff = len(rsc.Wait)
 		rsc.Wait = append(rsc.Wait, make([]func(context.Context) error, len(db.postCommitHooks))...)
 		rsc.NotifyWaitFailed = append(rsc.NotifyWaitFailed, make([]func(), len(db.postCommitHooks))...)
+		rsc.Replicas = append(rsc.Replicas, make([]string, len(db.postCommitHooks))...)
 	}
 	for il, hook := range db.postCommitHooks {
 		if !onlyWS || hook.ExecuteForWorkingSets() {
@@ -94,6 +104,11 @@ func (db hooksDatabase) ExecuteCommitHooks(ctx context.Context, ds datas.Dataset
 				if err != nil {
 					hook.HandleError(ctx, err)
 				}
+				rh, ok := hook.(ReplicaCommitHook)
+				if f != nil && !ok {
+					hook.HandleError(ctx, fmt.Errorf("commit hook %T returned a replication wait without naming its replica", hook))
+					f = nil
+				}
 				if rsc != nil {
 					rsc.Wait[i+ioff] = f
 					if nf, ok := hook.(NotifyWaitFailedCommitHook); ok {
@@ -101,6 +116,9 @@ func (db hooksDatabase) ExecuteCommitHooks(ctx context.Context, ds datas.Dataset
 					} else {
 						rsc.NotifyWaitFailed[i+ioff] = func() {}
 					}
+					if f != nil {
+						rsc.Replicas[i+ioff] = rh.ReplicaName()
+					}
 				}
 			}()
 		}
@@ -112,11 +130,13 @@ func (db hooksDatabase) ExecuteCommitHooks(ctx context.Context, ds datas.Dataset
 			if rsc.Wait[i] != nil {
 				rsc.Wait[j] = rsc.Wait[i]
 				rsc.NotifyWaitFailed[j] = rsc.NotifyWaitFailed[i]
+				rsc.Replicas[j] = rsc.Replicas[i]
 				j++
 			}
 		}
 		rsc.Wait = rsc.Wait[:j]
 		rsc.NotifyWaitFailed = rsc.NotifyWaitFailed[:j]
+		rsc.Replicas = rsc.Replicas[:j]
 	}
 }
 
@@ -0,0 +1,156 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doltdb
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	gmstypes "github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema/typeinfo"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+const (
+	// RowPoliciesTableNameCol is the name of the table that a row policy applies to.
+	RowPoliciesTableNameCol = "table_name"
+	// RowPoliciesPolicyNameCol is the name of a row policy, which is unique for its table.
+	RowPoliciesPolicyNameCol = "policy_name"
+	// RowPoliciesGranteeCol is the name of the user or role that a row policy applies to, or '%' for every user.
+	RowPoliciesGranteeCol = "grantee"
+	// RowPoliciesCommandsCol is the set of statement types that a row policy applies to.
+	RowPoliciesCommandsCol = "commands"
+	// RowPoliciesPredicateCol is the SQL expression that rows must satisfy to be covered by a row policy.
+	RowPoliciesPredicateCol = "predicate"
+)
+
+// RowPolicyCommand is a bit set of the statement types that a row policy applies to. The bits follow the order of the
+// values of the commands column's SET type, so that the stored value of the column may be used as is.
+type RowPolicyCommand uint64
+
+const (
+	RowPolicySelect RowPolicyCommand = 1 << iota
+	RowPolicyInsert
+	RowPolicyUpdate
+	RowPolicyDelete
+)
+
+// rowPolicyCommandNames are the values of the commands column's SET type, in the order of their bits.
+var rowPolicyCommandNames = []string{"select", "insert", "update", "delete"}
+
+// String returns the name of the statement type, or the comma-separated names of every statement type in the set.
+func (c RowPolicyCommand) String() string {
+	var names []string
+	for i, name := range rowPolicyCommandNames {
+		if c&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+// RowPolicy is a row of the dolt_row_policies table. A table with any row policies only shows its rows to users, and
+// only lets them write rows, that satisfy the predicate of a policy which applies to them for that statement type.
+type RowPolicy struct {
+	Table     string
+	Name      string
+	Grantee   string
+	Commands  RowPolicyCommand
+	Predicate string
+}
+
+var RowPoliciesSchema schema.Schema
+
+func init() {
+	stringType := typeinfo.FromKind(types.StringKind)
+	commandsType, err := typeinfo.FromSqlType(gmstypes.MustCreateSetType(rowPolicyCommandNames, sql.Collation_Default))
+	if err != nil {
+		panic(err)
+	}
+	cols := make([]schema.Column, 0, 5)
+	for _, def := range []struct {
+		name string
+		tag  uint64
+		ti   typeinfo.TypeInfo
+		pk   bool
+	}{
+		{RowPoliciesTableNameCol, schema.DoltRowPoliciesTableNameTag, stringType, true},
+		{RowPoliciesPolicyNameCol, schema.DoltRowPoliciesPolicyNameTag, stringType, true},
+		{RowPoliciesGranteeCol, schema.DoltRowPoliciesGranteeTag, stringType, false},
+		{RowPoliciesCommandsCol, schema.DoltRowPoliciesCommandsTag, commandsType, false},
+		{RowPoliciesPredicateCol, schema.DoltRowPoliciesPredicateTag, stringType, false},
+	} {
+		col, err := schema.NewColumnWithTypeInfo(def.name, def.tag, def.ti, def.pk, "", false, "", schema.NotNullConstraint{})
+		if err != nil {
+			panic(err)
+		}
+		cols = append(cols, col)
+	}
+	RowPoliciesSchema = schema.MustSchemaFromCols(schema.NewColCollection(cols...))
+}
+
+// GetRowPolicies returns every row policy in the dolt_row_policies table of the given root.
+func GetRowPolicies(ctx context.Context, root RootValue) ([]RowPolicy, error) {
+	table, found, err := root.GetTable(ctx, TableName{Name: RowPoliciesTableName})
+	if err != nil {
+		return nil, err
+	}
+	if !found || table.Format() == types.Format_LD_1 {
+		// dolt_row_policies doesn't exist, or isn't supported for the legacy storage format, so there are no policies.
+		return nil, nil
+	}
+	index, err := table.GetRowData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sch, err := table.GetSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !schema.SchemasAreEqual(sch, RowPoliciesSchema) {
+		return nil, fmt.Errorf("%s had an unexpected schema, this should never happen", RowPoliciesTableName)
+	}
+	keyDesc, valueDesc := sch.GetMapDescriptors()
+
+	iter, err := durable.ProllyMapFromIndex(index).IterAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var policies []RowPolicy
+	for {
+		keyTuple, valueTuple, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		var policy RowPolicy
+		policy.Table, _ = keyDesc.GetString(0, keyTuple)
+		policy.Name, _ = keyDesc.GetString(1, keyTuple)
+		policy.Grantee, _ = valueDesc.GetString(0, valueTuple)
+		commands, _ := valueDesc.GetSet(1, valueTuple)
+		policy.Commands = RowPolicyCommand(commands)
+		policy.Predicate, _ = valueDesc.GetString(2, valueTuple)
+		policies = append(policies, policy)
+	}
+	return policies, nil
+}
@@ -162,6 +162,7 @@ var writeableSystemTables = []string{
 	SchemasTableName,
 	ProceduresTableName,
 	IgnoreTableName,
+	RowPoliciesTableName,
 	RebaseTableName,
 }
 
@@ -171,6 +172,7 @@ var persistedSystemTables = []string{
 	SchemasTableName,
 	ProceduresTableName,
 	IgnoreTableName,
+	RowPoliciesTableName,
 }
 
 var generatedSystemTables = []string{
@@ -311,11 +313,26 @@ const (
 
 	IgnoreTableName = "dolt_ignore"
 
+	// RowPoliciesTableName is the row policies system table name
+	RowPoliciesTableName = "dolt_row_policies"
+
 	// RebaseTableName is the rebase system table name.
 	RebaseTableName = "dolt_rebase"
 
 	// StatisticsTableName is the statistics system table name
 	StatisticsTableName = "dolt_statistics"
+
+	// SlowQueriesTableName is the slow query log system table name
+	SlowQueriesTableName = "dolt_slow_queries"
+
+	// GCStatusTableName is the automatic garbage collection status system table name
+	GCStatusTableName = "dolt_gc_status"
+
+	// BackupStatusTableName is the scheduled backup status system table name
+	BackupStatusTableName = "dolt_backup_status"
+
+	// ResourceLimitsTableName is the per-account resource limits system table name
+	ResourceLimitsTableName = "dolt_resource_limits"
 )
 
 const (
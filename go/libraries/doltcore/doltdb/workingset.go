@@ -31,11 +31,16 @@ import (
 
 // RebaseState tracks the state of an in-progress rebase action. It records the name of the branch being rebased, the
 // commit onto which the new commits will be rebased, and the root value of the previous working set, which is used if
-// the rebase is aborted and the working set needs to be restored to its previous state.
+// the rebase is aborted and the working set needs to be restored to its previous state. Once the rebase plan starts
+// being executed, it also records the last step of the plan that was attempted, so that a rebase that stopped before
+// the end of its plan can be resumed.
 type RebaseState struct {
 	preRebaseWorking RootValue
 	ontoCommit       *Commit
 	branch           string
+
+	lastAttemptedStep int64
+	rebasingStarted   bool
 }
 
 // Branch returns the name of the branch being actively rebased. This is the branch that will be updated to point
@@ -55,6 +60,42 @@ func (rs RebaseState) PreRebaseWorkingRoot() RootValue {
 	return rs.preRebaseWorking
 }
 
+// LastAttemptedStep returns the rebase order of the last step of the rebase plan that was attempted, in hundredths.
+func (rs RebaseState) LastAttemptedStep() int64 {
+	return rs.lastAttemptedStep
+}
+
+// RebasingStarted returns whether the rebase plan has started being executed.
+func (rs RebaseState) RebasingStarted() bool {
+	return rs.rebasingStarted
+}
+
+// WithLastAttemptedStep returns a copy of this rebase state that records |step| as the last attempted step of the
+// rebase plan. |step| is the rebase order of the step in hundredths.
+func (rs RebaseState) WithLastAttemptedStep(step int64) *RebaseState {
+	rs.lastAttemptedStep = step
+	return &rs
+}
+
+// WithRebasingStarted returns a copy of this rebase state that records whether the rebase plan has started being
+// executed.
+func (rs RebaseState) WithRebasingStarted(rebasingStarted bool) *RebaseState {
+	rs.rebasingStarted = rebasingStarted
+	return &rs
+}
+
+// CherryPickState tracks the state of an in-progress cherry-pick of several commits. When cherry-picking one of the
+// commits stops to let the user resolve conflicts, the commits that still need to be applied are recorded here so
+// that the cherry-pick can be resumed with --continue or --skip.
+type CherryPickState struct {
+	remainingCommits []*Commit
+}
+
+// RemainingCommits returns the commits that have not been cherry-picked yet, in the order they will be applied.
+func (cs CherryPickState) RemainingCommits() []*Commit {
+	return cs.remainingCommits
+}
+
 type MergeState struct {
 	// the source commit
 	commit *Commit
@@ -200,6 +241,8 @@ type WorkingSet struct {
 	stagedRoot  RootValue
 	mergeState  *MergeState
 	rebaseState *RebaseState
+
+	cherryPickState *CherryPickState
 }
 
 var _ Rootish = &WorkingSet{}
@@ -231,6 +274,17 @@ func (ws WorkingSet) WithRebaseState(rebaseState *RebaseState) *WorkingSet {
 	return &ws
 }
 
+// WithCherryPickState returns a copy of this working set that records |remainingCommits| as the commits still to be
+// applied by an in-progress cherry-pick. An empty |remainingCommits| clears any recorded cherry-pick state.
+func (ws WorkingSet) WithCherryPickState(remainingCommits []*Commit) *WorkingSet {
+	if len(remainingCommits) == 0 {
+		ws.cherryPickState = nil
+	} else {
+		ws.cherryPickState = &CherryPickState{remainingCommits: remainingCommits}
+	}
+	return &ws
+}
+
 func (ws WorkingSet) WithUnmergableTables(tables []string) *WorkingSet {
 	ws.mergeState.unmergableTables = tables
 	return &ws
@@ -312,6 +366,11 @@ func (ws WorkingSet) ClearRebase() *WorkingSet {
 	return &ws
 }
 
+func (ws WorkingSet) ClearCherryPick() *WorkingSet {
+	ws.cherryPickState = nil
+	return &ws
+}
+
 func (ws *WorkingSet) WorkingRoot() RootValue {
 	return ws.workingRoot
 }
@@ -328,6 +387,10 @@ func (ws *WorkingSet) RebaseState() *RebaseState {
 	return ws.rebaseState
 }
 
+func (ws *WorkingSet) CherryPickState() *CherryPickState {
+	return ws.cherryPickState
+}
+
 func (ws *WorkingSet) MergeActive() bool {
 	return ws.mergeState != nil
 }
@@ -336,6 +399,11 @@ func (ws *WorkingSet) RebaseActive() bool {
 	return ws.rebaseState != nil
 }
 
+// CherryPickActive returns true if a cherry-pick of several commits has stopped and has commits left to apply.
+func (ws *WorkingSet) CherryPickActive() bool {
+	return ws.cherryPickState != nil
+}
+
 // MergeCommitParents returns true if there is an active merge in progress and
 // the recorded commit being merged into the active branch should be included as
 // a second parent of the created commit. This is the expected behavior for a
@@ -472,22 +540,47 @@ func newWorkingSet(ctx context.Context, name string, vrw types.ValueReadWriter,
 		}
 
 		rebaseState = &RebaseState{
-			preRebaseWorking: preRebaseWorkingRoot,
-			ontoCommit:       ontoCommit,
-			branch:           dsws.RebaseState.Branch(ctx),
+			preRebaseWorking:  preRebaseWorkingRoot,
+			ontoCommit:        ontoCommit,
+			branch:            dsws.RebaseState.Branch(ctx),
+			lastAttemptedStep: dsws.RebaseState.LastAttemptedStep(ctx),
+			rebasingStarted:   dsws.RebaseState.RebasingStarted(ctx),
+		}
+	}
+
+	var cherryPickState *CherryPickState
+	if dsws.CherryPickState != nil {
+		addrs := dsws.CherryPickState.RemainingCommitAddrs()
+		remaining := make([]*Commit, len(addrs))
+		for i, addr := range addrs {
+			datasCommit, err := datas.LoadCommitAddr(ctx, vrw, addr)
+			if err != nil {
+				return nil, err
+			}
+
+			if datasCommit.IsGhost() {
+				return nil, ErrGhostCommitEncountered
+			}
+
+			remaining[i], err = NewCommit(ctx, vrw, ns, datasCommit)
+			if err != nil {
+				return nil, err
+			}
 		}
+		cherryPickState = &CherryPickState{remainingCommits: remaining}
 	}
 
 	addr, _ := ds.MaybeHeadAddr()
 
 	return &WorkingSet{
-		Name:        name,
-		meta:        meta,
-		addr:        &addr,
-		workingRoot: workingRoot,
-		stagedRoot:  stagedRoot,
-		mergeState:  mergeState,
-		rebaseState: rebaseState,
+		Name:            name,
+		meta:            meta,
+		addr:            &addr,
+		workingRoot:     workingRoot,
+		stagedRoot:      stagedRoot,
+		mergeState:      mergeState,
+		rebaseState:     rebaseState,
+		cherryPickState: cherryPickState,
 	}, nil
 }
 
@@ -570,14 +663,28 @@ func (ws *WorkingSet) writeValues(ctx context.Context, db *DoltDB, meta *datas.W
 			return nil, err
 		}
 
-		rebaseState = datas.NewRebaseState(preRebaseWorking.TargetHash(), dCommit.Addr(), ws.rebaseState.branch)
+		rebaseState = datas.NewRebaseState(preRebaseWorking.TargetHash(), dCommit.Addr(), ws.rebaseState.branch,
+			ws.rebaseState.lastAttemptedStep, ws.rebaseState.rebasingStarted)
+	}
+
+	var cherryPickState *datas.CherryPickState
+	if ws.cherryPickState != nil {
+		remaining := make([]hash.Hash, len(ws.cherryPickState.remainingCommits))
+		for i, commit := range ws.cherryPickState.remainingCommits {
+			remaining[i], err = commit.HashOf()
+			if err != nil {
+				return nil, err
+			}
+		}
+		cherryPickState = datas.NewCherryPickState(remaining)
 	}
 
 	return &datas.WorkingSetSpec{
-		Meta:        meta,
-		WorkingRoot: workingRoot,
-		StagedRoot:  stagedRoot,
-		MergeState:  mergeState,
-		RebaseState: rebaseState,
+		Meta:            meta,
+		WorkingRoot:     workingRoot,
+		StagedRoot:      stagedRoot,
+		MergeState:      mergeState,
+		RebaseState:     rebaseState,
+		CherryPickState: cherryPickState,
 	}, nil
 }
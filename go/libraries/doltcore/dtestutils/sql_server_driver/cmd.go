@@ -351,6 +351,13 @@ func (s *SqlServer) Restart(newargs *[]string, newenvs *[]string) error {
 	if err != nil {
 		return err
 	}
+	return s.Start(newargs, newenvs)
+}
+
+// Start runs the server again after it has been stopped with GracefulStop.
+// By default, it runs with the same arguments and environment as before.
+// |newargs| replaces the arguments and |newenvs| adds to the environment.
+func (s *SqlServer) Start(newargs *[]string, newenvs *[]string) error {
 	args := s.Cmd.Args[1:]
 	if newargs != nil {
 		args = append([]string{"sql-server"}, (*newargs)...)
@@ -34,6 +34,11 @@ type Connection struct {
 	Queries       []Query      `yaml:"queries"`
 	RestartServer *RestartArgs `yaml:"restart_server"`
 
+	// If true, the server is stopped after the queries are run. It is
+	// started again, with its previous arguments, before the next
+	// connection to it.
+	StopServer bool `yaml:"stop_server"`
+
 	// Rarely needed, allows the entire connection assertion to be retried
 	// on an assertion failure. Use this is only for idempotent connection
 	// interactions and only if the sql-server is prone to tear down the
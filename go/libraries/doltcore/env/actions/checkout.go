@@ -198,7 +198,7 @@ func CleanOldWorkingSet(
 	err = doltDb.UpdateWorkingSet(
 		ctx,
 		initialWs.Ref(),
-		initialWs.WithWorkingRoot(newRoots.Working).WithStagedRoot(newRoots.Staged).ClearMerge().ClearRebase(),
+		initialWs.WithWorkingRoot(newRoots.Working).WithStagedRoot(newRoots.Staged).ClearMerge().ClearRebase().ClearCherryPick(),
 		h,
 
 		&datas.WorkingSetMeta{
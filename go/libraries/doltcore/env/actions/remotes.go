@@ -901,7 +901,39 @@ func SyncRoots(ctx context.Context, srcDb, destDb *doltdb.DoltDB, tempTableDir s
 		// If clone is unsupported, we can fall back to pull.
 	}
 
-	err = destDb.PullChunks(ctx, tempTableDir, srcDb, []hash.Hash{srcRoot}, statsCh, nil)
+	return pullRoot(ctx, srcDb, destDb, srcRoot, destRoot, tempTableDir, statsCh)
+}
+
+// SyncRootsAt is like SyncRoots, but it sets the root of destDb to |srcRoot|, which may be an earlier root of srcDb,
+// rather than to the current root of srcDb. Only the chunks reachable from |srcRoot| which destDb does not already have
+// are copied. Used to restore a backup as of one of its restore points.
+func SyncRootsAt(ctx context.Context, srcDb, destDb *doltdb.DoltDB, srcRoot hash.Hash, tempTableDir string, progStarter ProgStarter, progStopper ProgStopper) error {
+	destRoot, err := destDb.NomsRoot(ctx)
+	if err != nil {
+		return err
+	}
+
+	if srcRoot == destRoot {
+		return pull.ErrDBUpToDate
+	}
+
+	newCtx, cancelFunc := context.WithCancel(ctx)
+	wg, statsCh := progStarter(newCtx)
+	defer func() {
+		progStopper(cancelFunc, wg, statsCh)
+		if err == nil {
+			cli.Println()
+		}
+	}()
+
+	err = pullRoot(ctx, srcDb, destDb, srcRoot, destRoot, tempTableDir, statsCh)
+	return err
+}
+
+// pullRoot pulls the chunks reachable from |srcRoot| into |destDb| and then sets its root, which is expected to be
+// |destRoot|, to |srcRoot|.
+func pullRoot(ctx context.Context, srcDb, destDb *doltdb.DoltDB, srcRoot, destRoot hash.Hash, tempTableDir string, statsCh chan pull.Stats) error {
+	err := destDb.PullChunks(ctx, tempTableDir, srcDb, []hash.Hash{srcRoot}, statsCh, nil)
 	if err != nil {
 		return err
 	}
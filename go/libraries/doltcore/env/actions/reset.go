@@ -178,7 +178,7 @@ func ResetHard(
 	}
 
 	// TODO - refactor this to ensure the update to the head and working set are transactional.
-	err = doltDb.UpdateWorkingSet(ctx, ws.Ref(), ws.WithWorkingRoot(roots.Working).WithStagedRoot(roots.Staged).ClearMerge().ClearRebase(), h, &datas.WorkingSetMeta{
+	err = doltDb.UpdateWorkingSet(ctx, ws.Ref(), ws.WithWorkingRoot(roots.Working).WithStagedRoot(roots.Staged).ClearMerge().ClearRebase().ClearCherryPick(), h, &datas.WorkingSetMeta{
 		Name:        username,
 		Email:       email,
 		Timestamp:   uint64(time.Now().Unix()),
@@ -57,6 +57,10 @@ const (
 
 	// ParquetFile is the format of a data location that is a .paquet file
 	ParquetFile DataFormat = ".parquet"
+
+	// FixedWidthFile is the format of a data location that is a fixed-width or delimited text file described by a
+	// separate spec file. It has no conventional extension, so it must be given explicitly.
+	FixedWidthFile DataFormat = "fixedwidth"
 )
 
 // ReadableStr returns a human readable string for a DataFormat
@@ -76,6 +80,8 @@ func (df DataFormat) ReadableStr() string {
 		return "sql file"
 	case ParquetFile:
 		return "parquet file"
+	case FixedWidthFile:
+		return "fixed-width file"
 	default:
 		return "invalid"
 	}
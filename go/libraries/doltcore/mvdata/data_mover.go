@@ -46,6 +46,15 @@ type JSONOptions struct {
 type ParquetOptions struct {
 	TableName string
 	SchFile   string
+	// InferSchema infers the schema from the parquet file when no schema file is given, rather than reading the
+	// schema of the existing table
+	InferSchema bool
+	// FlattenStructs imports each field of a struct column as its own column when inferring the schema
+	FlattenStructs bool
+}
+
+type FixedWidthOptions struct {
+	SpecFile string
 }
 
 type MoverOptions struct {
@@ -132,6 +141,12 @@ func InferSchema(ctx context.Context, root doltdb.RootValue, rd table.ReadCloser
 		return nil, err
 	}
 
+	return SchemaFromInferredCols(ctx, root, tableName, infCols, pks)
+}
+
+// SchemaFromInferredCols returns a schema for the new table |tableName| from columns inferred from an import file,
+// using |pks| as the primary key.
+func SchemaFromInferredCols(ctx context.Context, root doltdb.RootValue, tableName string, infCols *schema.ColCollection, pks []string) (schema.Schema, error) {
 	pkSet := set.NewStrSet(pks)
 	newCols := schema.MapColCollection(infCols, func(col schema.Column) schema.Column {
 		col.IsPartOfPK = pkSet.Contains(col.Name)
@@ -158,7 +173,7 @@ func InferSchema(ctx context.Context, root doltdb.RootValue, rd table.ReadCloser
 		}
 	}
 
-	newCols, err = doltdb.GenerateTagsForNewColColl(ctx, root, tableName, newCols)
+	newCols, err := doltdb.GenerateTagsForNewColColl(ctx, root, tableName, newCols)
 	if err != nil {
 		return nil, errhand.BuildDError("failed to generate new schema").AddCause(err).Build()
 	}
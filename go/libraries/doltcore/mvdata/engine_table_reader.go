@@ -22,6 +22,8 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/planbuilder"
+	"github.com/gocraft/dbr/v2"
+	"github.com/gocraft/dbr/v2/dialect"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/commands/engine"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
@@ -42,6 +44,17 @@ type sqlEngineTableReader struct {
 }
 
 func NewSqlEngineReader(ctx context.Context, dEnv *env.DoltEnv, tableName string) (*sqlEngineTableReader, error) {
+	root, err := dEnv.WorkingRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return NewSqlEngineReaderAsOf(ctx, dEnv, root, tableName, "")
+}
+
+// NewSqlEngineReaderAsOf returns a reader for the rows of |tableName| as of the revision given, such as a branch, tag
+// or commit, rather than in the working set. |root| must be the root value of that revision. An empty |asOf| reads
+// the working set.
+func NewSqlEngineReaderAsOf(ctx context.Context, dEnv *env.DoltEnv, root doltdb.RootValue, tableName, asOf string) (*sqlEngineTableReader, error) {
 	mrEnv, err := env.MultiEnvForDirectory(ctx, dEnv.Config.WriteableConfig(), dEnv.FS, dEnv.Version, dEnv)
 	if err != nil {
 		return nil, err
@@ -68,7 +81,11 @@ func NewSqlEngineReader(ctx context.Context, dEnv *env.DoltEnv, tableName string
 
 	sqlEngine := se.GetUnderlyingEngine()
 	binder := planbuilder.New(sqlCtx, sqlEngine.Analyzer.Catalog, sqlEngine.Parser)
-	ret, _, _, _, err := binder.Parse(fmt.Sprintf("show create table `%s`", tableName), false)
+	showCreate, err := asOfQuery("show create table ?", tableName, asOf)
+	if err != nil {
+		return nil, err
+	}
+	ret, _, _, _, err := binder.Parse(showCreate, false)
 	if err != nil {
 		return nil, err
 	}
@@ -78,12 +95,11 @@ func NewSqlEngineReader(ctx context.Context, dEnv *env.DoltEnv, tableName string
 		return nil, fmt.Errorf("expected *plan.ShowCreate table, found %T", ret)
 	}
 
-	_, iter, _, err := se.Query(sqlCtx, fmt.Sprintf("SELECT * FROM `%s`", tableName))
+	selectAll, err := asOfQuery("SELECT * FROM ?", tableName, asOf)
 	if err != nil {
 		return nil, err
 	}
-
-	root, err := dEnv.WorkingRoot(ctx)
+	_, iter, _, err := se.Query(sqlCtx, selectAll)
 	if err != nil {
 		return nil, err
 	}
@@ -122,6 +138,16 @@ func NewSqlEngineTableReaderWithEngine(sqlCtx *sql.Context, se *sqle.Engine, db
 	}, nil
 }
 
+// asOfQuery interpolates |tableName| into |query| and adds an AS OF clause for the revision given, if any
+func asOfQuery(query, tableName, asOf string) (string, error) {
+	params := []interface{}{dbr.I(tableName)}
+	if asOf != "" {
+		query += " AS OF ?"
+		params = append(params, asOf)
+	}
+	return dbr.InterpolateForDialect(query, params, dialect.MySQL)
+}
+
 func (s *sqlEngineTableReader) GetSchema() schema.Schema {
 	return s.sch
 }
@@ -28,6 +28,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/typed/fixedwidth"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/typed/json"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/typed/parquet"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/untyped/csv"
@@ -51,6 +52,8 @@ func DFFromString(dfStr string) DataFormat {
 		return SqlFile
 	case "parquet", ".parquet":
 		return ParquetFile
+	case "fixedwidth":
+		return FixedWidthFile
 	default:
 		return InvalidDataFormat
 	}
@@ -161,6 +164,11 @@ func (dl FileDataLocation) NewReader(ctx context.Context, dEnv *env.DoltEnv, opt
 				return nil, false, fmt.Errorf("table name '%s' from schema file %s does not match table arg '%s'", tn, parquetOpts.SchFile, parquetOpts.TableName)
 			}
 			tableSch = s
+		} else if parquetOpts.InferSchema {
+			tableSch, err = parquet.InferSchemaFromFile(dl.Path, parquetOpts.FlattenStructs)
+			if err != nil {
+				return nil, false, fmt.Errorf("An error occurred attempting to infer the schema of %s:\n%v", dl.Path, err.Error())
+			}
 		} else {
 			if opts == nil {
 				return nil, false, errors.New("Unable to determine table name on JSON import")
@@ -179,6 +187,18 @@ func (dl FileDataLocation) NewReader(ctx context.Context, dEnv *env.DoltEnv, opt
 		}
 		rd, rErr := parquet.OpenParquetReader(root.VRW(), dl.Path, tableSch)
 		return rd, false, rErr
+
+	case FixedWidthFile:
+		fwOpts, _ := opts.(FixedWidthOptions)
+		if fwOpts.SpecFile == "" {
+			return nil, false, errors.New("a spec file is required to read fixed-width files")
+		}
+		spec, err := fixedwidth.LoadSpec(fs, fwOpts.SpecFile)
+		if err != nil {
+			return nil, false, err
+		}
+		rd, err := fixedwidth.OpenFixedWidthReader(dl.Path, fs, spec)
+		return rd, false, err
 	}
 
 	return nil, false, errors.New("unsupported format")
@@ -204,6 +224,8 @@ func (dl FileDataLocation) NewCreatingWriter(ctx context.Context, mvOpts DataMov
 		}
 	case ParquetFile:
 		return parquet.NewParquetRowWriterForFile(outSch, mvOpts.DestName())
+	case FixedWidthFile:
+		return nil, errors.New("writing to fixed-width files is not supported")
 	}
 
 	panic("Invalid Data Format." + string(dl.Format))
@@ -17,6 +17,7 @@ package rebase
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/shopspring/decimal"
@@ -33,11 +34,17 @@ const (
 	RebaseActionFixup  = "fixup"
 	RebaseActionDrop   = "drop"
 	RebaseActionReword = "reword"
+	RebaseActionEdit   = "edit"
+	RebaseActionExec   = "exec"
 )
 
 // ErrInvalidRebasePlanSquashFixupWithoutPick is returned when a rebase plan attempts to squash or
 // fixup a commit without first picking or rewording a commit.
-var ErrInvalidRebasePlanSquashFixupWithoutPick = fmt.Errorf("invalid rebase plan: squash and fixup actions must appear after a pick or reword action")
+var ErrInvalidRebasePlanSquashFixupWithoutPick = fmt.Errorf("invalid rebase plan: squash and fixup actions must appear after a pick, reword or edit action")
+
+// ErrInvalidRebasePlanExecWithoutStatement is returned when a rebase plan contains an exec action without a SQL
+// statement to run.
+var ErrInvalidRebasePlanExecWithoutStatement = fmt.Errorf("invalid rebase plan: exec actions must specify a SQL statement in the commit_message column")
 
 // RebasePlanDatabase is a database that can save and load a rebase plan.
 type RebasePlanDatabase interface {
@@ -54,7 +61,8 @@ type RebasePlan struct {
 }
 
 // RebasePlanStep describes a single step in a rebase plan, such as dropping a
-// commit, squashing a commit into the previous commit, etc.
+// commit, squashing a commit into the previous commit, etc. Exec steps don't
+// refer to a commit; their CommitMsg is the SQL statement to run.
 type RebasePlanStep struct {
 	RebaseOrder decimal.Decimal
 	Action      string
@@ -101,10 +109,11 @@ func CreateDefaultRebasePlan(ctx *sql.Context, startCommit, upstreamCommit *dolt
 }
 
 // ValidateRebasePlan returns a validation error for invalid states in a rebase plan, such as
-// squash or fixup actions appearing in the plan before a pick or reword action.
+// squash or fixup actions appearing in the plan before a pick, reword or edit action.
 func ValidateRebasePlan(ctx *sql.Context, plan *RebasePlan) error {
 	seenPick := false
 	seenReword := false
+	seenEdit := false
 	for i, step := range plan.Steps {
 		// As a sanity check, make sure the rebase order is ascending. This shouldn't EVER happen because the
 		// results are sorted from the database query, but double check while we're validating the plan.
@@ -119,10 +128,19 @@ func ValidateRebasePlan(ctx *sql.Context, plan *RebasePlan) error {
 		case RebaseActionReword:
 			seenReword = true
 
+		case RebaseActionEdit:
+			seenEdit = true
+
 		case RebaseActionFixup, RebaseActionSquash:
-			if !seenPick && !seenReword {
+			if !seenPick && !seenReword && !seenEdit {
 				return ErrInvalidRebasePlanSquashFixupWithoutPick
 			}
+
+		case RebaseActionExec:
+			if strings.TrimSpace(step.CommitMsg) == "" {
+				return ErrInvalidRebasePlanExecWithoutStatement
+			}
+			continue
 		}
 
 		if err := validateCommit(ctx, step.CommitHash); err != nil {
@@ -417,7 +417,17 @@ func (gr *GetRange) GetDownloadFunc(ctx context.Context, stats StatsRecorder, he
 	if len(gr.Ranges) == 0 {
 		return func() error { return nil }
 	}
-	return func() error {
+	return func() (err error) {
+		ctx, span := tracer.Start(ctx, "remotestorage.downloadRange", trace.WithAttributes(
+			attribute.String("resource_path", gr.ResourcePath()),
+			attribute.Int("num_chunks", gr.NumChunks()),
+			attribute.Int64("bytes", int64(gr.RangeLen()))))
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+			}
+			span.End()
+		}()
 		urlF := func(lastError error) (string, error) {
 			url, err := pathToUrl(ctx, lastError, gr.ResourcePath())
 			if err != nil {
@@ -556,6 +566,14 @@ type RepoRequest interface {
 }
 
 func (dcs *DoltChunkStore) readChunksAndCache(ctx context.Context, hashes []hash.Hash, found func(context.Context, nbs.CompressedChunk)) (err error) {
+	ctx, span := tracer.Start(ctx, "remotestorage.readChunksAndCache", trace.WithAttributes(attribute.Int("num_hashes", len(hashes))))
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+		}
+		span.End()
+	}()
+
 	toSend := hash.NewHashSet(hashes...)
 
 	fetcher := dcs.ChunkFetcher(ctx)
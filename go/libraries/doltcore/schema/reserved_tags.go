@@ -115,3 +115,12 @@ const (
 	DoltIgnorePatternTag = iota + SystemTableReservedMin + uint64(8000)
 	DoltIgnoreIgnoredTag
 )
+
+// Tags for the dolt_row_policies table
+const (
+	DoltRowPoliciesTableNameTag = iota + SystemTableReservedMin + uint64(9000)
+	DoltRowPoliciesPolicyNameTag
+	DoltRowPoliciesGranteeTag
+	DoltRowPoliciesCommandsTag
+	DoltRowPoliciesPredicateTag
+)
@@ -19,9 +19,13 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/url"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/utils/schedule"
 )
 
 // LogLevel defines the available levels of logging for the server.
@@ -59,8 +63,42 @@ const (
 	DefaultUnixSocketFilePath      = "/tmp/mysql.sock"
 	DefaultMaxLoggedQueryLen       = 0
 	DefaultEncodeLoggedQuery       = false
+
+	DefaultClusterHeartbeatIntervalMillis = 1000
+	DefaultClusterFailoverTimeoutMillis   = 10000
+	DefaultClusterConnectTimeoutMillis    = 20000
+
+	DefaultSlowQueryThresholdMillis = 1000
+	DefaultSlowQueryMaxEntries      = 1000
+
+	DefaultTracingServiceName = "dolt-sql-server"
+	DefaultTracingSampleRatio = 1.0
+
+	DefaultAutoGCCheckIntervalMillis = 60000
+	DefaultAutoGCJournalSizeBytes    = 256 * 1024 * 1024
+	DefaultAutoGCNewTableFiles       = 64
+	DefaultAutoGCGrowthBytes         = 0
+	DefaultAutoGCKillConnections     = false
+
+	DefaultBackupRetention = 7
+
+	DefaultLDAPTimeoutMillis      = 10000
+	DefaultLDAPGroupFilter        = "(member={dn})"
+	DefaultLDAPGroupNameAttribute = "cn"
+	LDAPUserPlaceholder           = "{user}"
+	LDAPDNPlaceholder             = "{dn}"
+
+	DefaultAuditLogMaxSizeMB  = 100
+	DefaultAuditLogMaxFiles   = 10
+	DefaultAuditLogFailClosed = false
 )
 
+// AuditLogStatementClasses are the classes of statements which can be written to the audit log.
+var AuditLogStatementClasses = []string{"ddl", "dml", "dcl", "version_control", "read", "other"}
+
+// DefaultAuditLogStatementClasses are the classes of statements written to the audit log when none are configured.
+var DefaultAuditLogStatementClasses = []string{"ddl", "dml", "dcl", "version_control"}
+
 const (
 	IgnorePeristentGlobals = "ignore"
 	LoadPerisistentGlobals = "load"
@@ -95,6 +133,37 @@ type ClusterConfig interface {
 	BootstrapRole() string
 	BootstrapEpoch() int
 	RemotesAPIConfig() ClusterRemotesAPIConfig
+	// AutoFailoverConfig returns the configuration for automatic failover, or nil if automatic failover is not
+	// enabled.
+	AutoFailoverConfig() ClusterAutoFailoverConfig
+	// MinAcks is how many standbys must acknowledge a write before it returns, when
+	// @@dolt_cluster_ack_writes_timeout_secs is set. It is ClusterMinAcksAll, ClusterMinAcksMajority, or a
+	// number of standbys.
+	MinAcks() string
+}
+
+const (
+	// ClusterMinAcksAll waits for every standby to acknowledge a write.
+	ClusterMinAcksAll = "all"
+	// ClusterMinAcksMajority waits for a majority of the standbys to acknowledge a write.
+	ClusterMinAcksMajority = "majority"
+)
+
+// ClusterAutoFailoverConfig configures automatic failover. When it is enabled, the members of a cluster heartbeat
+// each other, and the standbys elect a new primary when the primary is unreachable for longer than the failover
+// timeout. A primary which cannot reach a majority of the cluster for longer than the failover timeout stops
+// accepting writes. Automatic failover needs at least three members, so that a majority survives the loss of any
+// one of them.
+type ClusterAutoFailoverConfig interface {
+	// HeartbeatIntervalMillis is how often each member sends a heartbeat to every other member.
+	HeartbeatIntervalMillis() uint64
+	// FailoverTimeoutMillis is how long a primary keeps accepting writes without reaching a majority of the
+	// cluster. A standby waits this long, plus two heartbeat intervals, without hearing from the primary before it
+	// attempts to become the primary.
+	FailoverTimeoutMillis() uint64
+	// ConnectTimeoutMillis is how long a member waits for a connection to another member to be established before
+	// it gives up on that attempt and tries again.
+	ConnectTimeoutMillis() uint64
 }
 
 type ClusterRemotesAPIConfig interface {
@@ -112,11 +181,207 @@ type ClusterStandbyRemoteConfig interface {
 	RemoteURLTemplate() string
 }
 
+// SlowQueryLogConfig configures the slow query log. Queries which take at least the threshold to run are written to
+// the log file, if there is one, and kept in memory for the dolt_slow_queries system table.
+type SlowQueryLogConfig interface {
+	// ThresholdMillis is how long a query must take, in milliseconds, before it is logged.
+	ThresholdMillis() uint64
+	// LogFile is the path of the file slow queries are appended to. "" if slow queries are only kept in memory.
+	LogFile() string
+	// MaxEntries is how many of the most recent slow queries are kept in memory.
+	MaxEntries() int
+}
+
+// TracingConfig configures the export of OpenTelemetry traces of the server's query execution over OTLP.
+type TracingConfig interface {
+	// OTLPEndpoint is the host:port of the OTLP gRPC collector traces are exported to.
+	OTLPEndpoint() string
+	// Insecure is true if traces are exported to the collector without TLS.
+	Insecure() bool
+	// ServiceName is the service.name resource attribute of the exported traces.
+	ServiceName() string
+	// SampleRatio is the fraction of traces started by the server which are sampled. Traces continued from a
+	// traceparent sent by the client follow the client's sampling decision.
+	SampleRatio() float64
+}
+
+// AutoGCConfig configures automatic garbage collection. Every check interval, the server collects the garbage of each
+// database which has crossed one of the thresholds. A threshold of 0 disables it.
+type AutoGCConfig interface {
+	// CheckIntervalMillis is how often, in milliseconds, the databases are checked.
+	CheckIntervalMillis() uint64
+	// JournalSizeBytes is the size of a database's chunk journal which triggers a GC.
+	JournalSizeBytes() uint64
+	// NewTableFiles is the number of table files in the new generation of a database, including its chunk journal,
+	// which triggers a GC.
+	NewTableFiles() int
+	// GrowthBytes is how much a database must grow, since it was last collected or the server started, to trigger a GC.
+	GrowthBytes() uint64
+	// KillConnections is whether a GC may close client connections. A full GC can't finish while any other session is
+	// open, so by default a database is only collected while no clients are connected. When this is true, a database
+	// is collected while no queries are running, and every connection still open when the GC finishes is closed.
+	//
+	// A server whose clients keep connections open, such as applications using a connection pool, is never idle, so
+	// without this no GC ever runs; the database shows up as deferred in dolt_gc_status. Such servers need this set
+	// to true, with clients that reconnect after their connection is closed.
+	KillConnections() bool
+}
+
+// BackupConfig configures a scheduled backup of one database. On its schedule, the server syncs the database to the
+// backup URL and records a restore point, keeping the most recent Retention restore points.
+type BackupConfig interface {
+	// Database is the name of the database which is backed up.
+	Database() string
+	// URL is the URL of the backup, which must be a location that can hold blobs, such as a file:// or gs:// URL.
+	URL() string
+	// Schedule is when the database is backed up, as a cron expression, a descriptor such as @hourly, or
+	// "@every <duration>".
+	Schedule() string
+	// Retention is the number of restore points which are kept.
+	Retention() int
+}
+
+// LDAPConfig configures the authentication_ldap_simple plugin, which authenticates a user by binding to an LDAP server
+// as the user with the password they logged in with.
+type LDAPConfig interface {
+	// URL is the ldap:// or ldaps:// URL of the LDAP server.
+	URL() string
+	// BindDNTemplate is the DN users bind as, in which {user} is replaced with their escaped user name. Users created
+	// with an authentication string bind as that DN instead.
+	BindDNTemplate() string
+	// StartTLS is true if connections to an ldap:// URL are upgraded to TLS with StartTLS before binding.
+	StartTLS() bool
+	// TLSCA is a path to the PEM-encoded certificates which the certificate of the LDAP server is verified with. "" to
+	// verify it with the system's certificates.
+	TLSCA() string
+	// InsecureSkipVerify is true if the certificate of the LDAP server is not verified.
+	InsecureSkipVerify() bool
+	// TimeoutMillis is how long, in milliseconds, to wait for the LDAP server to accept a connection or respond.
+	TimeoutMillis() uint64
+	// GroupSearch is the configuration for looking up the groups of users as they log in, or nil if groups are not
+	// looked up.
+	GroupSearch() LDAPGroupSearchConfig
+}
+
+// LDAPGroupSearchConfig configures how the groups of a user are looked up after they bind. The search is made as the
+// user.
+type LDAPGroupSearchConfig interface {
+	// BaseDN is the DN under which groups are searched for.
+	BaseDN() string
+	// Filter matches the groups of a user, in which {dn} is replaced with the DN the user bound as and {user} with
+	// their user name, both escaped.
+	Filter() string
+	// NameAttribute is the attribute of a group which holds its name.
+	NameAttribute() string
+	// RequiredGroups are the groups a user must be a member of at least one of to log in. Any user may log in when
+	// there are none.
+	RequiredGroups() []string
+	// GroupRoles maps the names of groups to the roles which their members are granted as they log in. Each role must
+	// already exist. Every role named in GroupRoles is revoked from users which are no longer members of a group
+	// mapped to it, so these roles should not also be granted by hand.
+	GroupRoles() map[string][]string
+}
+
+// AuditLogConfig configures the audit log, which records the statements run by each user as JSON lines appended to a
+// log file. The log file is rotated once it reaches its maximum size.
+type AuditLogConfig interface {
+	// LogFile is the path of the file audited statements are appended to.
+	LogFile() string
+	// MaxSizeMB is the size, in megabytes, the log file reaches before it is rotated.
+	MaxSizeMB() uint64
+	// MaxFiles is how many rotated log files are kept, in addition to the current one.
+	MaxFiles() int
+	// StatementClasses are the classes of statements which are audited, out of ddl, dml, dcl, version_control, read
+	// and other.
+	StatementClasses() []string
+	// Users are the users whose statements are audited. Every user's statements are audited when there are none.
+	Users() []string
+	// ExcludeUsers are the users whose statements are not audited.
+	ExcludeUsers() []string
+	// FailClosed is whether audited statements are refused once an entry couldn't be written to the log file, until
+	// the log file can be reopened. The statement whose entry couldn't be written has already run. Otherwise, failed
+	// writes are only logged as warnings, and statements keep running without being audited.
+	FailClosed() bool
+}
+
 type JwksConfig struct {
 	Name        string            `yaml:"name"`
 	LocationUrl string            `yaml:"location_url"`
 	Claims      map[string]string `yaml:"claims"`
 	FieldsToLog []string          `yaml:"fields_to_log"`
+	// ProvisionUsers_ is true if a user who logs in through an anonymous account identified with this JWKS, as in
+	// CREATE USER ''@'%' IDENTIFIED WITH authentication_dolt_jwt AS 'jwks=<name>', is given their own account on their
+	// first login.
+	ProvisionUsers_ *bool `yaml:"provision_users,omitempty" minver:"TBD"`
+	// ClaimMappings grant roles and branch permissions to the users whose tokens have matching claims. They are applied
+	// each time a user logs in, and the roles and branch permissions of mappings which no longer match are revoked.
+	ClaimMappings []JwksClaimMapping `yaml:"claim_mappings,omitempty" minver:"TBD"`
+}
+
+func (c JwksConfig) ProvisionUsers() bool {
+	if c.ProvisionUsers_ == nil {
+		return false
+	}
+	return *c.ProvisionUsers_
+}
+
+// JwksClaimMapping grants roles and branch permissions to the users whose tokens have a claim with a given value. A
+// claim which holds a list matches if any of its elements has the value. Claims nested in objects are named by their
+// dotted path, such as realm_access.roles.
+type JwksClaimMapping struct {
+	Claim_        *string                  `yaml:"claim,omitempty" minver:"TBD"`
+	Value_        *string                  `yaml:"value,omitempty" minver:"TBD"`
+	Roles         []string                 `yaml:"roles,omitempty" minver:"TBD"`
+	BranchControl []JwksBranchControlEntry `yaml:"branch_control,omitempty" minver:"TBD"`
+}
+
+func (m JwksClaimMapping) Claim() string {
+	if m.Claim_ == nil {
+		return ""
+	}
+	return *m.Claim_
+}
+
+func (m JwksClaimMapping) Value() string {
+	if m.Value_ == nil {
+		return ""
+	}
+	return *m.Value_
+}
+
+// JwksBranchControlEntry is an entry of dolt_branch_control which is granted to a user, for the host of their account.
+type JwksBranchControlEntry struct {
+	Database_    *string `yaml:"database,omitempty" minver:"TBD"`
+	Branch_      *string `yaml:"branch,omitempty" minver:"TBD"`
+	Permissions_ *string `yaml:"permissions,omitempty" minver:"TBD"`
+}
+
+// Database is the database expression of the entry, which matches every database by default.
+func (e JwksBranchControlEntry) Database() string {
+	if e.Database_ == nil {
+		return "%"
+	}
+	return *e.Database_
+}
+
+// Branch is the branch expression of the entry.
+func (e JwksBranchControlEntry) Branch() string {
+	if e.Branch_ == nil {
+		return ""
+	}
+	return *e.Branch_
+}
+
+// Permissions are the comma-separated permissions of the entry, out of admin, write and read.
+func (e JwksBranchControlEntry) Permissions() []string {
+	if e.Permissions_ == nil {
+		return nil
+	}
+	var perms []string
+	for _, perm := range strings.Split(*e.Permissions_, ",") {
+		perms = append(perms, strings.ToLower(strings.TrimSpace(perm)))
+	}
+	return perms
 }
 
 // ServerConfig contains all of the configurable options for the MySQL-compatible server.
@@ -200,6 +465,18 @@ type ServerConfig interface {
 	ClusterConfig() ClusterConfig
 	// EventSchedulerStatus is the configuration for enabling or disabling the event scheduler in this server.
 	EventSchedulerStatus() string
+	// SlowQueryLogConfig is the configuration for the slow query log, or nil if it is not enabled.
+	SlowQueryLogConfig() SlowQueryLogConfig
+	// TracingConfig is the configuration for exporting traces, or nil if tracing is not enabled.
+	TracingConfig() TracingConfig
+	// AutoGCConfig is the configuration for automatic garbage collection, or nil if it is not enabled.
+	AutoGCConfig() AutoGCConfig
+	// BackupConfigs are the configurations for scheduled backups.
+	BackupConfigs() []BackupConfig
+	// LDAPConfig is the configuration for the authentication_ldap_simple plugin, or nil if it is not configured.
+	LDAPConfig() LDAPConfig
+	// AuditLogConfig is the configuration for the audit log, or nil if it is not enabled.
+	AuditLogConfig() AuditLogConfig
 	// ValueSet returns whether the value string provided was explicitly set in the config
 	ValueSet(value string) bool
 }
@@ -269,9 +546,176 @@ func ValidateConfig(config ServerConfig) error {
 	if config.RequireSecureTransport() && config.TLSCert() == "" && config.TLSKey() == "" {
 		return fmt.Errorf("require_secure_transport can only be `true` when a tls_key and tls_cert are provided.")
 	}
+	if slowLog := config.SlowQueryLogConfig(); slowLog != nil && slowLog.MaxEntries() < 0 {
+		return fmt.Errorf("slow_query_log.max_entries must be non-negative: %v", slowLog.MaxEntries())
+	}
+	if tracing := config.TracingConfig(); tracing != nil {
+		if tracing.OTLPEndpoint() == "" {
+			return fmt.Errorf("tracing.otlp_endpoint is required when tracing is configured")
+		}
+		if tracing.SampleRatio() < 0 || tracing.SampleRatio() > 1 {
+			return fmt.Errorf("tracing.sample_ratio must be between 0 and 1: %v", tracing.SampleRatio())
+		}
+	}
+	if autoGC := config.AutoGCConfig(); autoGC != nil {
+		if autoGC.CheckIntervalMillis() == 0 {
+			return fmt.Errorf("auto_gc.check_interval_millis must be positive")
+		}
+		if autoGC.NewTableFiles() < 0 {
+			return fmt.Errorf("auto_gc.new_table_files must be non-negative: %v", autoGC.NewTableFiles())
+		}
+		if autoGC.JournalSizeBytes() == 0 && autoGC.NewTableFiles() == 0 && autoGC.GrowthBytes() == 0 {
+			return fmt.Errorf("auto_gc must have at least one of journal_size_bytes, new_table_files or growth_bytes set")
+		}
+	}
+	if err := ValidateBackupConfigs(config.BackupConfigs()); err != nil {
+		return err
+	}
+	if err := ValidateLDAPConfig(config.LDAPConfig()); err != nil {
+		return err
+	}
+	if err := ValidateJwksConfigs(config.JwksConfig()); err != nil {
+		return err
+	}
+	if err := ValidateAuditLogConfig(config.AuditLogConfig()); err != nil {
+		return err
+	}
 	return ValidateClusterConfig(config.ClusterConfig())
 }
 
+// ValidateBackupConfigs returns an error if any of |backups| is missing a database or a URL, has an invalid schedule or
+// retention, or backs up the same database to the same URL as another.
+func ValidateBackupConfigs(backups []BackupConfig) error {
+	type key struct{ database, url string }
+	seen := make(map[key]struct{})
+	for i, b := range backups {
+		if b.Database() == "" {
+			return fmt.Errorf("backups[%d].database is required", i)
+		}
+		if b.URL() == "" {
+			return fmt.Errorf("backups[%d].url is required", i)
+		}
+		if _, err := schedule.Parse(b.Schedule()); err != nil {
+			return fmt.Errorf("backups[%d].schedule: %w", i, err)
+		}
+		if b.Retention() < 1 {
+			return fmt.Errorf("backups[%d].retention must be positive: %v", i, b.Retention())
+		}
+		k := key{b.Database(), b.URL()}
+		if _, ok := seen[k]; ok {
+			return fmt.Errorf("backups[%d] backs up database %s to %s more than once", i, b.Database(), b.URL())
+		}
+		seen[k] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateAuditLogConfig returns an error if |config| has no log file, a maximum size of zero, a negative number of
+// rotated files or an unknown statement class.
+func ValidateAuditLogConfig(config AuditLogConfig) error {
+	if config == nil {
+		return nil
+	}
+	if config.LogFile() == "" {
+		return fmt.Errorf("audit_log.log_file is required when the audit log is configured")
+	}
+	if config.MaxSizeMB() == 0 {
+		return fmt.Errorf("audit_log.max_size_mb must be positive")
+	}
+	if config.MaxFiles() < 0 {
+		return fmt.Errorf("audit_log.max_files must be non-negative: %v", config.MaxFiles())
+	}
+	for _, class := range config.StatementClasses() {
+		found := false
+		for _, valid := range AuditLogStatementClasses {
+			if class == valid {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("audit_log.statement_classes must be out of %s: \"%s\"", strings.Join(AuditLogStatementClasses, ", "), class)
+		}
+	}
+	return nil
+}
+
+// ValidateJwksConfigs returns an error if any claim mapping of |configs| is missing its claim or value, or has a
+// branch_control entry without a branch or with invalid permissions.
+func ValidateJwksConfigs(configs []JwksConfig) error {
+	for i, c := range configs {
+		for j, m := range c.ClaimMappings {
+			prefix := fmt.Sprintf("jwks[%d].claim_mappings[%d]", i, j)
+			if m.Claim() == "" {
+				return fmt.Errorf("%s.claim is required", prefix)
+			}
+			if m.Value() == "" {
+				return fmt.Errorf("%s.value is required", prefix)
+			}
+			for _, role := range m.Roles {
+				if role == "" {
+					return fmt.Errorf("%s.roles must not include an empty role", prefix)
+				}
+			}
+			for k, e := range m.BranchControl {
+				if e.Branch() == "" {
+					return fmt.Errorf("%s.branch_control[%d].branch is required", prefix, k)
+				}
+				perms := e.Permissions()
+				if len(perms) == 0 {
+					return fmt.Errorf("%s.branch_control[%d].permissions is required", prefix, k)
+				}
+				for _, perm := range perms {
+					if perm != "admin" && perm != "write" && perm != "read" {
+						return fmt.Errorf("%s.branch_control[%d].permissions must be admin, write or read: \"%s\"", prefix, k, perm)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateLDAPConfig returns an error if |config| has an invalid URL or bind DN template, enables StartTLS over ldaps,
+// or has a group search without a base DN.
+func ValidateLDAPConfig(config LDAPConfig) error {
+	if config == nil {
+		return nil
+	}
+	u, err := url.Parse(config.URL())
+	if err != nil || (u.Scheme != "ldap" && u.Scheme != "ldaps") || u.Host == "" {
+		return fmt.Errorf("ldap.url must be an ldap:// or ldaps:// URL: \"%s\"", config.URL())
+	}
+	if config.StartTLS() && u.Scheme == "ldaps" {
+		return fmt.Errorf("ldap.start_tls can only be `true` for an ldap:// URL")
+	}
+	if !strings.Contains(config.BindDNTemplate(), LDAPUserPlaceholder) {
+		return fmt.Errorf("ldap.bind_dn_template must include the %s template parameter: \"%s\"", LDAPUserPlaceholder, config.BindDNTemplate())
+	}
+	if config.TimeoutMillis() == 0 {
+		return fmt.Errorf("ldap.timeout_millis must be positive")
+	}
+	if gs := config.GroupSearch(); gs != nil {
+		if gs.BaseDN() == "" {
+			return fmt.Errorf("ldap.group_search.base_dn is required")
+		}
+		if gs.NameAttribute() == "" {
+			return fmt.Errorf("ldap.group_search.name_attribute must not be empty")
+		}
+		if !strings.HasPrefix(gs.Filter(), "(") || !strings.HasSuffix(gs.Filter(), ")") {
+			return fmt.Errorf("ldap.group_search.filter must be enclosed in parentheses: \"%s\"", gs.Filter())
+		}
+		for group, roles := range gs.GroupRoles() {
+			for _, role := range roles {
+				if role == "" {
+					return fmt.Errorf("ldap.group_search.group_roles.%s must not include an empty role", group)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 const (
 	MaxConnectionsKey = "max_connections"
 	ReadTimeoutKey    = "net_read_timeout"
@@ -347,6 +791,26 @@ func ValidateClusterConfig(config ClusterConfig) error {
 	if config.RemotesAPIConfig().TLSKey() != "" && config.RemotesAPIConfig().TLSCert() == "" {
 		return fmt.Errorf("cluster: remotesapi: tls_cert: must supply a tls_cert if you supply a tls_key")
 	}
+	if af := config.AutoFailoverConfig(); af != nil {
+		if af.HeartbeatIntervalMillis() == 0 {
+			return fmt.Errorf("cluster: auto_failover: heartbeat_interval_millis: must be greater than 0")
+		}
+		if len(remotes) < 2 {
+			return fmt.Errorf("cluster: auto_failover: requires at least two standby_remotes. a cluster of two members cannot elect a new primary without risking two primaries when they lose contact with each other")
+		}
+		if af.FailoverTimeoutMillis() < 2*af.HeartbeatIntervalMillis() {
+			return fmt.Errorf("cluster: auto_failover: failover_timeout_millis: is %d but must be at least twice heartbeat_interval_millis (%d)", af.FailoverTimeoutMillis(), af.HeartbeatIntervalMillis())
+		}
+		if af.ConnectTimeoutMillis() == 0 {
+			return fmt.Errorf("cluster: auto_failover: connect_timeout_millis: must be greater than 0")
+		}
+	}
+	if minAcks := config.MinAcks(); minAcks != ClusterMinAcksAll && minAcks != ClusterMinAcksMajority {
+		n, err := strconv.Atoi(minAcks)
+		if err != nil || n < 1 || n > len(remotes) {
+			return fmt.Errorf("cluster: min_acks: is \"%s\" but must be \"%s\", \"%s\" or a number of standbys from 1 to %d", minAcks, ClusterMinAcksAll, ClusterMinAcksMajority, len(remotes))
+		}
+	}
 	return nil
 }
 
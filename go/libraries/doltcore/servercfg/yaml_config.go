@@ -138,10 +138,16 @@ type YAMLConfig struct {
 	PrivilegeFile     *string               `yaml:"privilege_file,omitempty"`
 	BranchControlFile *string               `yaml:"branch_control_file,omitempty"`
 	// TODO: Rename to UserVars_
-	Vars            []UserSessionVars      `yaml:"user_session_vars"`
-	SystemVars_     map[string]interface{} `yaml:"system_variables,omitempty" minver:"1.11.1"`
-	Jwks            []JwksConfig           `yaml:"jwks"`
-	GoldenMysqlConn *string                `yaml:"golden_mysql_conn,omitempty"`
+	Vars            []UserSessionVars       `yaml:"user_session_vars"`
+	SystemVars_     map[string]interface{}  `yaml:"system_variables,omitempty" minver:"1.11.1"`
+	Jwks            []JwksConfig            `yaml:"jwks"`
+	GoldenMysqlConn *string                 `yaml:"golden_mysql_conn,omitempty"`
+	SlowQueryLog    *SlowQueryLogYAMLConfig `yaml:"slow_query_log,omitempty" minver:"TBD"`
+	Tracing         *TracingYAMLConfig      `yaml:"tracing,omitempty" minver:"TBD"`
+	AutoGC          *AutoGCYAMLConfig       `yaml:"auto_gc,omitempty" minver:"TBD"`
+	Backups         []BackupYAMLConfig      `yaml:"backups,omitempty" minver:"TBD"`
+	LDAP            *LDAPYAMLConfig         `yaml:"ldap,omitempty" minver:"TBD"`
+	AuditLog        *AuditLogYAMLConfig     `yaml:"audit_log,omitempty" minver:"TBD"`
 }
 
 var _ ServerConfig = YAMLConfig{}
@@ -223,6 +229,109 @@ func ServerConfigAsYAMLConfig(cfg ServerConfig) *YAMLConfig {
 		SystemVars_:       systemVars,
 		Vars:              cfg.UserVars(),
 		Jwks:              cfg.JwksConfig(),
+		SlowQueryLog:      slowQueryLogConfigAsYAMLConfig(cfg.SlowQueryLogConfig()),
+		Tracing:           tracingConfigAsYAMLConfig(cfg.TracingConfig()),
+		AutoGC:            autoGCConfigAsYAMLConfig(cfg.AutoGCConfig()),
+		Backups:           backupConfigsAsYAMLConfig(cfg.BackupConfigs()),
+		LDAP:              ldapConfigAsYAMLConfig(cfg.LDAPConfig()),
+		AuditLog:          auditLogConfigAsYAMLConfig(cfg.AuditLogConfig()),
+	}
+}
+
+func auditLogConfigAsYAMLConfig(config AuditLogConfig) *AuditLogYAMLConfig {
+	if config == nil {
+		return nil
+	}
+
+	return &AuditLogYAMLConfig{
+		LogFile_:          ptr(config.LogFile()),
+		MaxSizeMB_:        ptr(config.MaxSizeMB()),
+		MaxFiles_:         ptr(config.MaxFiles()),
+		StatementClasses_: config.StatementClasses(),
+		Users_:            config.Users(),
+		ExcludeUsers_:     config.ExcludeUsers(),
+		FailClosed_:       ptr(config.FailClosed()),
+	}
+}
+
+func ldapConfigAsYAMLConfig(config LDAPConfig) *LDAPYAMLConfig {
+	if config == nil {
+		return nil
+	}
+
+	ldap := &LDAPYAMLConfig{
+		URL_:                nillableStrPtr(config.URL()),
+		BindDNTemplate_:     nillableStrPtr(config.BindDNTemplate()),
+		StartTLS_:           nillableBoolPtr(config.StartTLS()),
+		TLSCA_:              nillableStrPtr(config.TLSCA()),
+		InsecureSkipVerify_: nillableBoolPtr(config.InsecureSkipVerify()),
+		TimeoutMillis_:      ptr(config.TimeoutMillis()),
+	}
+	if gs := config.GroupSearch(); gs != nil {
+		ldap.GroupSearch_ = &LDAPGroupSearchYAMLConfig{
+			BaseDN_:         nillableStrPtr(gs.BaseDN()),
+			Filter_:         ptr(gs.Filter()),
+			NameAttribute_:  ptr(gs.NameAttribute()),
+			RequiredGroups_: gs.RequiredGroups(),
+			GroupRoles_:     gs.GroupRoles(),
+		}
+	}
+	return ldap
+}
+
+func backupConfigsAsYAMLConfig(configs []BackupConfig) []BackupYAMLConfig {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	backups := make([]BackupYAMLConfig, len(configs))
+	for i, config := range configs {
+		backups[i] = BackupYAMLConfig{
+			Database_:  nillableStrPtr(config.Database()),
+			URL_:       nillableStrPtr(config.URL()),
+			Schedule_:  nillableStrPtr(config.Schedule()),
+			Retention_: ptr(config.Retention()),
+		}
+	}
+	return backups
+}
+
+func autoGCConfigAsYAMLConfig(config AutoGCConfig) *AutoGCYAMLConfig {
+	if config == nil {
+		return nil
+	}
+
+	return &AutoGCYAMLConfig{
+		CheckIntervalMillis_: ptr(config.CheckIntervalMillis()),
+		JournalSizeBytes_:    ptr(config.JournalSizeBytes()),
+		NewTableFiles_:       ptr(config.NewTableFiles()),
+		GrowthBytes_:         ptr(config.GrowthBytes()),
+		KillConnections_:     ptr(config.KillConnections()),
+	}
+}
+
+func tracingConfigAsYAMLConfig(config TracingConfig) *TracingYAMLConfig {
+	if config == nil {
+		return nil
+	}
+
+	return &TracingYAMLConfig{
+		OTLPEndpoint_: ptr(config.OTLPEndpoint()),
+		Insecure_:     nillableBoolPtr(config.Insecure()),
+		ServiceName_:  ptr(config.ServiceName()),
+		SampleRatio_:  ptr(config.SampleRatio()),
+	}
+}
+
+func slowQueryLogConfigAsYAMLConfig(config SlowQueryLogConfig) *SlowQueryLogYAMLConfig {
+	if config == nil {
+		return nil
+	}
+
+	return &SlowQueryLogYAMLConfig{
+		ThresholdMillis_: ptr(config.ThresholdMillis()),
+		LogFile_:         nillableStrPtr(config.LogFile()),
+		MaxEntries_:      ptr(config.MaxEntries()),
 	}
 }
 
@@ -244,6 +353,27 @@ func clusterConfigAsYAMLConfig(config ClusterConfig) *ClusterYAMLConfig {
 			URLMatches: config.RemotesAPIConfig().ServerNameURLMatches(),
 			DNSMatches: config.RemotesAPIConfig().ServerNameDNSMatches(),
 		},
+		AutoFailover_: autoFailoverConfigAsYAMLConfig(config.AutoFailoverConfig()),
+		MinAcks_:      minAcksAsYAMLConfig(config.MinAcks()),
+	}
+}
+
+func minAcksAsYAMLConfig(minAcks string) *string {
+	if minAcks == ClusterMinAcksAll {
+		return nil
+	}
+	return &minAcks
+}
+
+func autoFailoverConfigAsYAMLConfig(config ClusterAutoFailoverConfig) *ClusterAutoFailoverYAMLConfig {
+	if config == nil {
+		return nil
+	}
+
+	return &ClusterAutoFailoverYAMLConfig{
+		HeartbeatIntervalMillis_: ptr(config.HeartbeatIntervalMillis()),
+		FailoverTimeoutMillis_:   ptr(config.FailoverTimeoutMillis()),
+		ConnectTimeoutMillis_:    ptr(config.ConnectTimeoutMillis()),
 	}
 }
 
@@ -592,11 +722,345 @@ func (cfg YAMLConfig) EventSchedulerStatus() string {
 	}
 }
 
+func (cfg YAMLConfig) SlowQueryLogConfig() SlowQueryLogConfig {
+	if cfg.SlowQueryLog == nil {
+		return nil
+	}
+	return cfg.SlowQueryLog
+}
+
+type SlowQueryLogYAMLConfig struct {
+	ThresholdMillis_ *uint64 `yaml:"threshold_millis,omitempty" minver:"TBD"`
+	LogFile_         *string `yaml:"log_file,omitempty" minver:"TBD"`
+	MaxEntries_      *int    `yaml:"max_entries,omitempty" minver:"TBD"`
+}
+
+func (c *SlowQueryLogYAMLConfig) ThresholdMillis() uint64 {
+	if c.ThresholdMillis_ == nil {
+		return DefaultSlowQueryThresholdMillis
+	}
+	return *c.ThresholdMillis_
+}
+
+func (c *SlowQueryLogYAMLConfig) LogFile() string {
+	if c.LogFile_ == nil {
+		return ""
+	}
+	return *c.LogFile_
+}
+
+func (c *SlowQueryLogYAMLConfig) MaxEntries() int {
+	if c.MaxEntries_ == nil {
+		return DefaultSlowQueryMaxEntries
+	}
+	return *c.MaxEntries_
+}
+
+func (cfg YAMLConfig) TracingConfig() TracingConfig {
+	if cfg.Tracing == nil {
+		return nil
+	}
+	return cfg.Tracing
+}
+
+type TracingYAMLConfig struct {
+	OTLPEndpoint_ *string  `yaml:"otlp_endpoint,omitempty" minver:"TBD"`
+	Insecure_     *bool    `yaml:"insecure,omitempty" minver:"TBD"`
+	ServiceName_  *string  `yaml:"service_name,omitempty" minver:"TBD"`
+	SampleRatio_  *float64 `yaml:"sample_ratio,omitempty" minver:"TBD"`
+}
+
+func (c *TracingYAMLConfig) OTLPEndpoint() string {
+	if c.OTLPEndpoint_ == nil {
+		return ""
+	}
+	return *c.OTLPEndpoint_
+}
+
+func (c *TracingYAMLConfig) Insecure() bool {
+	if c.Insecure_ == nil {
+		return false
+	}
+	return *c.Insecure_
+}
+
+func (c *TracingYAMLConfig) ServiceName() string {
+	if c.ServiceName_ == nil {
+		return DefaultTracingServiceName
+	}
+	return *c.ServiceName_
+}
+
+func (c *TracingYAMLConfig) SampleRatio() float64 {
+	if c.SampleRatio_ == nil {
+		return DefaultTracingSampleRatio
+	}
+	return *c.SampleRatio_
+}
+
+func (cfg YAMLConfig) AutoGCConfig() AutoGCConfig {
+	if cfg.AutoGC == nil {
+		return nil
+	}
+	return cfg.AutoGC
+}
+
+type AutoGCYAMLConfig struct {
+	CheckIntervalMillis_ *uint64 `yaml:"check_interval_millis,omitempty" minver:"TBD"`
+	JournalSizeBytes_    *uint64 `yaml:"journal_size_bytes,omitempty" minver:"TBD"`
+	NewTableFiles_       *int    `yaml:"new_table_files,omitempty" minver:"TBD"`
+	GrowthBytes_         *uint64 `yaml:"growth_bytes,omitempty" minver:"TBD"`
+	KillConnections_     *bool   `yaml:"kill_connections,omitempty" minver:"TBD"`
+}
+
+func (c *AutoGCYAMLConfig) CheckIntervalMillis() uint64 {
+	if c.CheckIntervalMillis_ == nil {
+		return DefaultAutoGCCheckIntervalMillis
+	}
+	return *c.CheckIntervalMillis_
+}
+
+func (c *AutoGCYAMLConfig) JournalSizeBytes() uint64 {
+	if c.JournalSizeBytes_ == nil {
+		return DefaultAutoGCJournalSizeBytes
+	}
+	return *c.JournalSizeBytes_
+}
+
+func (c *AutoGCYAMLConfig) NewTableFiles() int {
+	if c.NewTableFiles_ == nil {
+		return DefaultAutoGCNewTableFiles
+	}
+	return *c.NewTableFiles_
+}
+
+func (c *AutoGCYAMLConfig) GrowthBytes() uint64 {
+	if c.GrowthBytes_ == nil {
+		return DefaultAutoGCGrowthBytes
+	}
+	return *c.GrowthBytes_
+}
+
+func (c *AutoGCYAMLConfig) KillConnections() bool {
+	if c.KillConnections_ == nil {
+		return DefaultAutoGCKillConnections
+	}
+	return *c.KillConnections_
+}
+
+func (cfg YAMLConfig) BackupConfigs() []BackupConfig {
+	backups := make([]BackupConfig, len(cfg.Backups))
+	for i := range cfg.Backups {
+		backups[i] = &cfg.Backups[i]
+	}
+	return backups
+}
+
+type BackupYAMLConfig struct {
+	Database_  *string `yaml:"database,omitempty" minver:"TBD"`
+	URL_       *string `yaml:"url,omitempty" minver:"TBD"`
+	Schedule_  *string `yaml:"schedule,omitempty" minver:"TBD"`
+	Retention_ *int    `yaml:"retention,omitempty" minver:"TBD"`
+}
+
+func (c *BackupYAMLConfig) Database() string {
+	if c.Database_ == nil {
+		return ""
+	}
+	return *c.Database_
+}
+
+func (c *BackupYAMLConfig) URL() string {
+	if c.URL_ == nil {
+		return ""
+	}
+	return *c.URL_
+}
+
+func (c *BackupYAMLConfig) Schedule() string {
+	if c.Schedule_ == nil {
+		return ""
+	}
+	return *c.Schedule_
+}
+
+func (c *BackupYAMLConfig) Retention() int {
+	if c.Retention_ == nil {
+		return DefaultBackupRetention
+	}
+	return *c.Retention_
+}
+
+func (cfg YAMLConfig) LDAPConfig() LDAPConfig {
+	if cfg.LDAP == nil {
+		return nil
+	}
+	return cfg.LDAP
+}
+
+type LDAPYAMLConfig struct {
+	URL_                *string                    `yaml:"url,omitempty" minver:"TBD"`
+	BindDNTemplate_     *string                    `yaml:"bind_dn_template,omitempty" minver:"TBD"`
+	StartTLS_           *bool                      `yaml:"start_tls,omitempty" minver:"TBD"`
+	TLSCA_              *string                    `yaml:"tls_ca,omitempty" minver:"TBD"`
+	InsecureSkipVerify_ *bool                      `yaml:"insecure_skip_verify,omitempty" minver:"TBD"`
+	TimeoutMillis_      *uint64                    `yaml:"timeout_millis,omitempty" minver:"TBD"`
+	GroupSearch_        *LDAPGroupSearchYAMLConfig `yaml:"group_search,omitempty" minver:"TBD"`
+}
+
+func (c *LDAPYAMLConfig) URL() string {
+	if c.URL_ == nil {
+		return ""
+	}
+	return *c.URL_
+}
+
+func (c *LDAPYAMLConfig) BindDNTemplate() string {
+	if c.BindDNTemplate_ == nil {
+		return ""
+	}
+	return *c.BindDNTemplate_
+}
+
+func (c *LDAPYAMLConfig) StartTLS() bool {
+	if c.StartTLS_ == nil {
+		return false
+	}
+	return *c.StartTLS_
+}
+
+func (c *LDAPYAMLConfig) TLSCA() string {
+	if c.TLSCA_ == nil {
+		return ""
+	}
+	return *c.TLSCA_
+}
+
+func (c *LDAPYAMLConfig) InsecureSkipVerify() bool {
+	if c.InsecureSkipVerify_ == nil {
+		return false
+	}
+	return *c.InsecureSkipVerify_
+}
+
+func (c *LDAPYAMLConfig) TimeoutMillis() uint64 {
+	if c.TimeoutMillis_ == nil {
+		return DefaultLDAPTimeoutMillis
+	}
+	return *c.TimeoutMillis_
+}
+
+func (c *LDAPYAMLConfig) GroupSearch() LDAPGroupSearchConfig {
+	if c.GroupSearch_ == nil {
+		return nil
+	}
+	return c.GroupSearch_
+}
+
+type LDAPGroupSearchYAMLConfig struct {
+	BaseDN_         *string             `yaml:"base_dn,omitempty" minver:"TBD"`
+	Filter_         *string             `yaml:"filter,omitempty" minver:"TBD"`
+	NameAttribute_  *string             `yaml:"name_attribute,omitempty" minver:"TBD"`
+	RequiredGroups_ []string            `yaml:"required_groups,omitempty" minver:"TBD"`
+	GroupRoles_     map[string][]string `yaml:"group_roles,omitempty" minver:"TBD"`
+}
+
+func (c *LDAPGroupSearchYAMLConfig) BaseDN() string {
+	if c.BaseDN_ == nil {
+		return ""
+	}
+	return *c.BaseDN_
+}
+
+func (c *LDAPGroupSearchYAMLConfig) Filter() string {
+	if c.Filter_ == nil {
+		return DefaultLDAPGroupFilter
+	}
+	return *c.Filter_
+}
+
+func (c *LDAPGroupSearchYAMLConfig) NameAttribute() string {
+	if c.NameAttribute_ == nil {
+		return DefaultLDAPGroupNameAttribute
+	}
+	return *c.NameAttribute_
+}
+
+func (c *LDAPGroupSearchYAMLConfig) RequiredGroups() []string {
+	return c.RequiredGroups_
+}
+
+func (c *LDAPGroupSearchYAMLConfig) GroupRoles() map[string][]string {
+	return c.GroupRoles_
+}
+
+func (cfg YAMLConfig) AuditLogConfig() AuditLogConfig {
+	if cfg.AuditLog == nil {
+		return nil
+	}
+	return cfg.AuditLog
+}
+
+type AuditLogYAMLConfig struct {
+	LogFile_          *string  `yaml:"log_file,omitempty" minver:"TBD"`
+	MaxSizeMB_        *uint64  `yaml:"max_size_mb,omitempty" minver:"TBD"`
+	MaxFiles_         *int     `yaml:"max_files,omitempty" minver:"TBD"`
+	StatementClasses_ []string `yaml:"statement_classes,omitempty" minver:"TBD"`
+	Users_            []string `yaml:"users,omitempty" minver:"TBD"`
+	ExcludeUsers_     []string `yaml:"exclude_users,omitempty" minver:"TBD"`
+	FailClosed_       *bool    `yaml:"fail_closed,omitempty" minver:"TBD"`
+}
+
+func (c *AuditLogYAMLConfig) LogFile() string {
+	if c.LogFile_ == nil {
+		return ""
+	}
+	return *c.LogFile_
+}
+
+func (c *AuditLogYAMLConfig) MaxSizeMB() uint64 {
+	if c.MaxSizeMB_ == nil {
+		return DefaultAuditLogMaxSizeMB
+	}
+	return *c.MaxSizeMB_
+}
+
+func (c *AuditLogYAMLConfig) MaxFiles() int {
+	if c.MaxFiles_ == nil {
+		return DefaultAuditLogMaxFiles
+	}
+	return *c.MaxFiles_
+}
+
+func (c *AuditLogYAMLConfig) StatementClasses() []string {
+	if c.StatementClasses_ == nil {
+		return DefaultAuditLogStatementClasses
+	}
+	return c.StatementClasses_
+}
+
+func (c *AuditLogYAMLConfig) Users() []string {
+	return c.Users_
+}
+
+func (c *AuditLogYAMLConfig) ExcludeUsers() []string {
+	return c.ExcludeUsers_
+}
+
+func (c *AuditLogYAMLConfig) FailClosed() bool {
+	if c.FailClosed_ == nil {
+		return DefaultAuditLogFailClosed
+	}
+	return *c.FailClosed_
+}
+
 type ClusterYAMLConfig struct {
-	StandbyRemotes_ []StandbyRemoteYAMLConfig   `yaml:"standby_remotes"`
-	BootstrapRole_  string                      `yaml:"bootstrap_role"`
-	BootstrapEpoch_ int                         `yaml:"bootstrap_epoch"`
-	RemotesAPI      ClusterRemotesAPIYAMLConfig `yaml:"remotesapi"`
+	StandbyRemotes_ []StandbyRemoteYAMLConfig      `yaml:"standby_remotes"`
+	BootstrapRole_  string                         `yaml:"bootstrap_role"`
+	BootstrapEpoch_ int                            `yaml:"bootstrap_epoch"`
+	RemotesAPI      ClusterRemotesAPIYAMLConfig    `yaml:"remotesapi"`
+	AutoFailover_   *ClusterAutoFailoverYAMLConfig `yaml:"auto_failover,omitempty" minver:"TBD"`
+	MinAcks_        *string                        `yaml:"min_acks,omitempty" minver:"TBD"`
 }
 
 type StandbyRemoteYAMLConfig struct {
@@ -632,6 +1096,47 @@ func (c *ClusterYAMLConfig) RemotesAPIConfig() ClusterRemotesAPIConfig {
 	return c.RemotesAPI
 }
 
+func (c *ClusterYAMLConfig) AutoFailoverConfig() ClusterAutoFailoverConfig {
+	if c.AutoFailover_ == nil {
+		return nil
+	}
+	return c.AutoFailover_
+}
+
+func (c *ClusterYAMLConfig) MinAcks() string {
+	if c.MinAcks_ == nil {
+		return ClusterMinAcksAll
+	}
+	return *c.MinAcks_
+}
+
+type ClusterAutoFailoverYAMLConfig struct {
+	HeartbeatIntervalMillis_ *uint64 `yaml:"heartbeat_interval_millis,omitempty" minver:"TBD"`
+	FailoverTimeoutMillis_   *uint64 `yaml:"failover_timeout_millis,omitempty" minver:"TBD"`
+	ConnectTimeoutMillis_    *uint64 `yaml:"connect_timeout_millis,omitempty" minver:"TBD"`
+}
+
+func (c *ClusterAutoFailoverYAMLConfig) HeartbeatIntervalMillis() uint64 {
+	if c.HeartbeatIntervalMillis_ == nil {
+		return DefaultClusterHeartbeatIntervalMillis
+	}
+	return *c.HeartbeatIntervalMillis_
+}
+
+func (c *ClusterAutoFailoverYAMLConfig) FailoverTimeoutMillis() uint64 {
+	if c.FailoverTimeoutMillis_ == nil {
+		return DefaultClusterFailoverTimeoutMillis
+	}
+	return *c.FailoverTimeoutMillis_
+}
+
+func (c *ClusterAutoFailoverYAMLConfig) ConnectTimeoutMillis() uint64 {
+	if c.ConnectTimeoutMillis_ == nil {
+		return DefaultClusterConnectTimeoutMillis
+	}
+	return *c.ConnectTimeoutMillis_
+}
+
 type ClusterRemotesAPIYAMLConfig struct {
 	Addr_      string   `yaml:"address"`
 	Port_      int      `yaml:"port"`
@@ -179,6 +179,474 @@ cluster:
 	require.Equal(t, 0, config.ClusterConfig().BootstrapEpoch())
 	require.Equal(t, "standby", config.ClusterConfig().StandbyRemotes()[0].Name())
 	require.Equal(t, "http://doltdb-1.doltdb:50051/{database}", config.ClusterConfig().StandbyRemotes()[0].RemoteURLTemplate())
+	require.Nil(t, config.ClusterConfig().AutoFailoverConfig())
+}
+
+func TestUnmarshallClusterAutoFailover(t *testing.T) {
+	testStr := `
+cluster:
+  standby_remotes:
+  - name: standby
+    remote_url_template: http://doltdb-1.doltdb:50051/{database}
+  remotesapi:
+    port: 50051
+  auto_failover:
+    failover_timeout_millis: 5000
+`
+	config, err := NewYamlConfig([]byte(testStr))
+	require.NoError(t, err)
+	af := config.ClusterConfig().AutoFailoverConfig()
+	require.NotNil(t, af)
+	require.Equal(t, uint64(DefaultClusterHeartbeatIntervalMillis), af.HeartbeatIntervalMillis())
+	require.Equal(t, uint64(5000), af.FailoverTimeoutMillis())
+	require.Equal(t, uint64(DefaultClusterConnectTimeoutMillis), af.ConnectTimeoutMillis())
+
+	config, err = NewYamlConfig([]byte(testStr + "    connect_timeout_millis: 3000\n"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3000), config.ClusterConfig().AutoFailoverConfig().ConnectTimeoutMillis())
+}
+
+func TestUnmarshallClusterMinAcks(t *testing.T) {
+	testStr := `
+cluster:
+  standby_remotes:
+  - name: standby
+    remote_url_template: http://doltdb-1.doltdb:50051/{database}
+  remotesapi:
+    port: 50051
+`
+	config, err := NewYamlConfig([]byte(testStr))
+	require.NoError(t, err)
+	require.Equal(t, ClusterMinAcksAll, config.ClusterConfig().MinAcks())
+
+	config, err = NewYamlConfig([]byte(testStr + "  min_acks: 1\n"))
+	require.NoError(t, err)
+	require.Equal(t, "1", config.ClusterConfig().MinAcks())
+
+	config, err = NewYamlConfig([]byte(testStr + "  min_acks: majority\n"))
+	require.NoError(t, err)
+	require.Equal(t, ClusterMinAcksMajority, config.ClusterConfig().MinAcks())
+}
+
+func TestUnmarshallSlowQueryLog(t *testing.T) {
+	config, err := NewYamlConfig([]byte(`
+log_level: info
+`))
+	require.NoError(t, err)
+	require.Nil(t, config.SlowQueryLogConfig())
+
+	config, err = NewYamlConfig([]byte(`
+slow_query_log:
+  threshold_millis: 250
+  log_file: slow.log
+`))
+	require.NoError(t, err)
+	slowLog := config.SlowQueryLogConfig()
+	require.NotNil(t, slowLog)
+	require.Equal(t, uint64(250), slowLog.ThresholdMillis())
+	require.Equal(t, "slow.log", slowLog.LogFile())
+	require.Equal(t, DefaultSlowQueryMaxEntries, slowLog.MaxEntries())
+
+	config, err = NewYamlConfig([]byte(`
+slow_query_log: {}
+`))
+	require.NoError(t, err)
+	slowLog = config.SlowQueryLogConfig()
+	require.NotNil(t, slowLog)
+	require.Equal(t, uint64(DefaultSlowQueryThresholdMillis), slowLog.ThresholdMillis())
+	require.Equal(t, "", slowLog.LogFile())
+}
+
+func TestUnmarshallTracing(t *testing.T) {
+	config, err := NewYamlConfig([]byte(`
+log_level: info
+`))
+	require.NoError(t, err)
+	require.Nil(t, config.TracingConfig())
+
+	config, err = NewYamlConfig([]byte(`
+tracing:
+  otlp_endpoint: localhost:4317
+  insecure: true
+  sample_ratio: 0.25
+`))
+	require.NoError(t, err)
+	tracing := config.TracingConfig()
+	require.NotNil(t, tracing)
+	require.Equal(t, "localhost:4317", tracing.OTLPEndpoint())
+	require.True(t, tracing.Insecure())
+	require.Equal(t, 0.25, tracing.SampleRatio())
+	require.Equal(t, DefaultTracingServiceName, tracing.ServiceName())
+	require.NoError(t, ValidateConfig(config))
+
+	config, err = NewYamlConfig([]byte(`
+tracing:
+  otlp_endpoint: localhost:4317
+  sample_ratio: 2
+`))
+	require.NoError(t, err)
+	require.Error(t, ValidateConfig(config))
+
+	config, err = NewYamlConfig([]byte(`
+tracing:
+  service_name: dolt-primary
+`))
+	require.NoError(t, err)
+	require.Equal(t, "dolt-primary", config.TracingConfig().ServiceName())
+	require.Equal(t, DefaultTracingSampleRatio, config.TracingConfig().SampleRatio())
+	require.Error(t, ValidateConfig(config))
+}
+
+func TestUnmarshallAutoGC(t *testing.T) {
+	config, err := NewYamlConfig([]byte(`
+log_level: info
+`))
+	require.NoError(t, err)
+	require.Nil(t, config.AutoGCConfig())
+
+	config, err = NewYamlConfig([]byte(`
+auto_gc: {}
+`))
+	require.NoError(t, err)
+	autoGC := config.AutoGCConfig()
+	require.NotNil(t, autoGC)
+	require.Equal(t, uint64(DefaultAutoGCCheckIntervalMillis), autoGC.CheckIntervalMillis())
+	require.Equal(t, uint64(DefaultAutoGCJournalSizeBytes), autoGC.JournalSizeBytes())
+	require.Equal(t, DefaultAutoGCNewTableFiles, autoGC.NewTableFiles())
+	require.Equal(t, uint64(DefaultAutoGCGrowthBytes), autoGC.GrowthBytes())
+	require.False(t, autoGC.KillConnections())
+	require.NoError(t, ValidateConfig(config))
+
+	config, err = NewYamlConfig([]byte(`
+auto_gc:
+  check_interval_millis: 5000
+  journal_size_bytes: 0
+  new_table_files: 0
+  growth_bytes: 1073741824
+  kill_connections: true
+`))
+	require.NoError(t, err)
+	autoGC = config.AutoGCConfig()
+	require.Equal(t, uint64(5000), autoGC.CheckIntervalMillis())
+	require.Equal(t, uint64(0), autoGC.JournalSizeBytes())
+	require.Equal(t, 0, autoGC.NewTableFiles())
+	require.Equal(t, uint64(1073741824), autoGC.GrowthBytes())
+	require.True(t, autoGC.KillConnections())
+	require.NoError(t, ValidateConfig(config))
+
+	config, err = NewYamlConfig([]byte(`
+auto_gc:
+  journal_size_bytes: 0
+  new_table_files: 0
+`))
+	require.NoError(t, err)
+	require.Error(t, ValidateConfig(config))
+
+	config, err = NewYamlConfig([]byte(`
+auto_gc:
+  check_interval_millis: 0
+`))
+	require.NoError(t, err)
+	require.Error(t, ValidateConfig(config))
+}
+
+func TestUnmarshallBackups(t *testing.T) {
+	config, err := NewYamlConfig([]byte(`
+log_level: info
+`))
+	require.NoError(t, err)
+	require.Empty(t, config.BackupConfigs())
+
+	config, err = NewYamlConfig([]byte(`
+backups:
+- database: db1
+  url: file:///backups/db1
+  schedule: "@hourly"
+- database: db2
+  url: gs://bucket/db2
+  schedule: "*/15 * * * *"
+  retention: 48
+`))
+	require.NoError(t, err)
+	backups := config.BackupConfigs()
+	require.Len(t, backups, 2)
+	require.Equal(t, "db1", backups[0].Database())
+	require.Equal(t, "file:///backups/db1", backups[0].URL())
+	require.Equal(t, "@hourly", backups[0].Schedule())
+	require.Equal(t, DefaultBackupRetention, backups[0].Retention())
+	require.Equal(t, "db2", backups[1].Database())
+	require.Equal(t, "gs://bucket/db2", backups[1].URL())
+	require.Equal(t, "*/15 * * * *", backups[1].Schedule())
+	require.Equal(t, 48, backups[1].Retention())
+	require.NoError(t, ValidateConfig(config))
+
+	for _, invalid := range []string{`
+backups:
+- url: file:///backups/db1
+  schedule: "@hourly"
+`, `
+backups:
+- database: db1
+  schedule: "@hourly"
+`, `
+backups:
+- database: db1
+  url: file:///backups/db1
+  schedule: "every hour"
+`, `
+backups:
+- database: db1
+  url: file:///backups/db1
+  schedule: "@hourly"
+  retention: 0
+`, `
+backups:
+- database: db1
+  url: file:///backups/db1
+  schedule: "@hourly"
+- database: db1
+  url: file:///backups/db1
+  schedule: "@daily"
+`} {
+		config, err = NewYamlConfig([]byte(invalid))
+		require.NoError(t, err)
+		require.Error(t, ValidateConfig(config), invalid)
+	}
+}
+
+func TestUnmarshallLDAP(t *testing.T) {
+	config, err := NewYamlConfig([]byte(`
+log_level: info
+`))
+	require.NoError(t, err)
+	require.Nil(t, config.LDAPConfig())
+
+	config, err = NewYamlConfig([]byte(`
+ldap:
+  url: ldap://ldap.example.com
+  bind_dn_template: uid={user},ou=people,dc=example,dc=com
+`))
+	require.NoError(t, err)
+	ldap := config.LDAPConfig()
+	require.NotNil(t, ldap)
+	require.Equal(t, "ldap://ldap.example.com", ldap.URL())
+	require.Equal(t, "uid={user},ou=people,dc=example,dc=com", ldap.BindDNTemplate())
+	require.False(t, ldap.StartTLS())
+	require.Equal(t, uint64(DefaultLDAPTimeoutMillis), ldap.TimeoutMillis())
+	require.Nil(t, ldap.GroupSearch())
+	require.NoError(t, ValidateConfig(config))
+
+	config, err = NewYamlConfig([]byte(`
+ldap:
+  url: ldap://ldap.example.com:389
+  bind_dn_template: uid={user},ou=people,dc=example,dc=com
+  start_tls: true
+  tls_ca: /etc/ssl/ldap-ca.pem
+  timeout_millis: 2000
+  group_search:
+    base_dn: ou=groups,dc=example,dc=com
+    required_groups: [engineering, analysts]
+    group_roles:
+      engineering: [developer]
+      analysts: [reader, reporter]
+`))
+	require.NoError(t, err)
+	ldap = config.LDAPConfig()
+	require.True(t, ldap.StartTLS())
+	require.Equal(t, "/etc/ssl/ldap-ca.pem", ldap.TLSCA())
+	require.Equal(t, uint64(2000), ldap.TimeoutMillis())
+	gs := ldap.GroupSearch()
+	require.NotNil(t, gs)
+	require.Equal(t, "ou=groups,dc=example,dc=com", gs.BaseDN())
+	require.Equal(t, DefaultLDAPGroupFilter, gs.Filter())
+	require.Equal(t, DefaultLDAPGroupNameAttribute, gs.NameAttribute())
+	require.Equal(t, []string{"engineering", "analysts"}, gs.RequiredGroups())
+	require.Equal(t, map[string][]string{
+		"engineering": {"developer"},
+		"analysts":    {"reader", "reporter"},
+	}, gs.GroupRoles())
+	require.NoError(t, ValidateConfig(config))
+
+	for _, invalid := range []string{`
+ldap:
+  bind_dn_template: uid={user},dc=example,dc=com
+`, `
+ldap:
+  url: http://ldap.example.com
+  bind_dn_template: uid={user},dc=example,dc=com
+`, `
+ldap:
+  url: ldap://ldap.example.com
+  bind_dn_template: uid=alice,dc=example,dc=com
+`, `
+ldap:
+  url: ldaps://ldap.example.com
+  bind_dn_template: uid={user},dc=example,dc=com
+  start_tls: true
+`, `
+ldap:
+  url: ldap://ldap.example.com
+  bind_dn_template: uid={user},dc=example,dc=com
+  group_search:
+    filter: (member={dn})
+`, `
+ldap:
+  url: ldap://ldap.example.com
+  bind_dn_template: uid={user},dc=example,dc=com
+  group_search:
+    base_dn: dc=example,dc=com
+    filter: member={dn}
+`} {
+		config, err = NewYamlConfig([]byte(invalid))
+		require.NoError(t, err)
+		require.Error(t, ValidateConfig(config), invalid)
+	}
+}
+
+func TestUnmarshallAuditLog(t *testing.T) {
+	config, err := NewYamlConfig([]byte(`
+log_level: info
+`))
+	require.NoError(t, err)
+	require.Nil(t, config.AuditLogConfig())
+
+	config, err = NewYamlConfig([]byte(`
+audit_log:
+  log_file: /var/log/dolt/audit.log
+`))
+	require.NoError(t, err)
+	audit := config.AuditLogConfig()
+	require.NotNil(t, audit)
+	require.Equal(t, "/var/log/dolt/audit.log", audit.LogFile())
+	require.Equal(t, uint64(DefaultAuditLogMaxSizeMB), audit.MaxSizeMB())
+	require.Equal(t, DefaultAuditLogMaxFiles, audit.MaxFiles())
+	require.Equal(t, DefaultAuditLogStatementClasses, audit.StatementClasses())
+	require.Empty(t, audit.Users())
+	require.Empty(t, audit.ExcludeUsers())
+	require.False(t, audit.FailClosed())
+	require.NoError(t, ValidateConfig(config))
+
+	config, err = NewYamlConfig([]byte(`
+audit_log:
+  log_file: audit.log
+  max_size_mb: 10
+  max_files: 0
+  statement_classes: [dml, version_control]
+  users: [alice, bob]
+  exclude_users: [replicator]
+  fail_closed: true
+`))
+	require.NoError(t, err)
+	audit = config.AuditLogConfig()
+	require.Equal(t, uint64(10), audit.MaxSizeMB())
+	require.Equal(t, 0, audit.MaxFiles())
+	require.Equal(t, []string{"dml", "version_control"}, audit.StatementClasses())
+	require.Equal(t, []string{"alice", "bob"}, audit.Users())
+	require.Equal(t, []string{"replicator"}, audit.ExcludeUsers())
+	require.True(t, audit.FailClosed())
+	require.NoError(t, ValidateConfig(config))
+
+	for _, invalid := range []string{`
+audit_log:
+  max_size_mb: 10
+`, `
+audit_log:
+  log_file: audit.log
+  max_size_mb: 0
+`, `
+audit_log:
+  log_file: audit.log
+  max_files: -1
+`, `
+audit_log:
+  log_file: audit.log
+  statement_classes: [dml, writes]
+`} {
+		config, err = NewYamlConfig([]byte(invalid))
+		require.NoError(t, err)
+		require.Error(t, ValidateConfig(config), invalid)
+	}
+}
+
+func TestUnmarshallJwksClaimMappings(t *testing.T) {
+	config, err := NewYamlConfig([]byte(`
+jwks:
+  - name: idp
+    location_url: https://idp.example.com/.well-known/jwks.json
+    claims:
+      alg: RS256
+    fields_to_log: [sub]
+    provision_users: true
+    claim_mappings:
+      - claim: groups
+        value: engineering
+        roles: [developer]
+        branch_control:
+          - branch: feature/%
+            permissions: admin
+          - database: prod
+            branch: main
+            permissions: Write, Read
+`))
+	require.NoError(t, err)
+	jwks := config.JwksConfig()
+	require.Len(t, jwks, 1)
+	require.True(t, jwks[0].ProvisionUsers())
+	require.Len(t, jwks[0].ClaimMappings, 1)
+	m := jwks[0].ClaimMappings[0]
+	require.Equal(t, "groups", m.Claim())
+	require.Equal(t, "engineering", m.Value())
+	require.Equal(t, []string{"developer"}, m.Roles)
+	require.Len(t, m.BranchControl, 2)
+	require.Equal(t, "%", m.BranchControl[0].Database())
+	require.Equal(t, "feature/%", m.BranchControl[0].Branch())
+	require.Equal(t, []string{"admin"}, m.BranchControl[0].Permissions())
+	require.Equal(t, "prod", m.BranchControl[1].Database())
+	require.Equal(t, []string{"write", "read"}, m.BranchControl[1].Permissions())
+	require.NoError(t, ValidateConfig(config))
+
+	config, err = NewYamlConfig([]byte(`
+jwks:
+  - name: idp
+    location_url: https://idp.example.com/.well-known/jwks.json
+`))
+	require.NoError(t, err)
+	require.False(t, config.JwksConfig()[0].ProvisionUsers())
+
+	for _, invalid := range []string{`
+jwks:
+  - name: idp
+    claim_mappings:
+      - value: engineering
+        roles: [developer]
+`, `
+jwks:
+  - name: idp
+    claim_mappings:
+      - claim: groups
+        roles: [developer]
+`, `
+jwks:
+  - name: idp
+    claim_mappings:
+      - claim: groups
+        value: engineering
+        branch_control:
+          - permissions: write
+`, `
+jwks:
+  - name: idp
+    claim_mappings:
+      - claim: groups
+        value: engineering
+        branch_control:
+          - branch: main
+            permissions: owner
+`} {
+		config, err = NewYamlConfig([]byte(invalid))
+		require.NoError(t, err)
+		require.Error(t, ValidateConfig(config), invalid)
+	}
 }
 
 func TestValidateClusterConfig(t *testing.T) {
@@ -259,6 +727,160 @@ cluster:
   bootstrap_epoch: 0
   remotesapi:
     port: 50051
+`,
+			Error: true,
+		},
+		{
+			Name: "auto_failover with defaults",
+			Config: `
+cluster:
+  standby_remotes:
+  - name: standby1
+    remote_url_template: http://localhost:50051/{database}
+  - name: standby2
+    remote_url_template: http://localhost:50052/{database}
+  bootstrap_role: primary
+  bootstrap_epoch: 0
+  remotesapi:
+    port: 50051
+  auto_failover: {}
+`,
+			Error: false,
+		},
+		{
+			Name: "auto_failover with two members",
+			Config: `
+cluster:
+  standby_remotes:
+  - name: standby
+    remote_url_template: http://localhost:50051/{database}
+  bootstrap_role: primary
+  bootstrap_epoch: 0
+  remotesapi:
+    port: 50050
+  auto_failover: {}
+`,
+			Error: true,
+		},
+		{
+			Name: "auto_failover timeout shorter than two heartbeats",
+			Config: `
+cluster:
+  standby_remotes:
+  - name: standby1
+    remote_url_template: http://localhost:50051/{database}
+  - name: standby2
+    remote_url_template: http://localhost:50052/{database}
+  bootstrap_role: primary
+  bootstrap_epoch: 0
+  remotesapi:
+    port: 50051
+  auto_failover:
+    heartbeat_interval_millis: 1000
+    failover_timeout_millis: 1500
+`,
+			Error: true,
+		},
+		{
+			Name: "auto_failover zero connect timeout",
+			Config: `
+cluster:
+  standby_remotes:
+  - name: standby1
+    remote_url_template: http://localhost:50051/{database}
+  - name: standby2
+    remote_url_template: http://localhost:50052/{database}
+  bootstrap_role: primary
+  bootstrap_epoch: 0
+  remotesapi:
+    port: 50051
+  auto_failover:
+    connect_timeout_millis: 0
+`,
+			Error: true,
+		},
+		{
+			Name: "min_acks majority",
+			Config: `
+cluster:
+  standby_remotes:
+  - name: standby1
+    remote_url_template: http://localhost:50051/{database}
+  - name: standby2
+    remote_url_template: http://localhost:50052/{database}
+  bootstrap_role: primary
+  bootstrap_epoch: 0
+  remotesapi:
+    port: 50050
+  min_acks: majority
+`,
+			Error: false,
+		},
+		{
+			Name: "min_acks count",
+			Config: `
+cluster:
+  standby_remotes:
+  - name: standby1
+    remote_url_template: http://localhost:50051/{database}
+  - name: standby2
+    remote_url_template: http://localhost:50052/{database}
+  bootstrap_role: primary
+  bootstrap_epoch: 0
+  remotesapi:
+    port: 50050
+  min_acks: 2
+`,
+			Error: false,
+		},
+		{
+			Name: "min_acks more than the number of standbys",
+			Config: `
+cluster:
+  standby_remotes:
+  - name: standby1
+    remote_url_template: http://localhost:50051/{database}
+  - name: standby2
+    remote_url_template: http://localhost:50052/{database}
+  bootstrap_role: primary
+  bootstrap_epoch: 0
+  remotesapi:
+    port: 50050
+  min_acks: 3
+`,
+			Error: true,
+		},
+		{
+			Name: "min_acks zero",
+			Config: `
+cluster:
+  standby_remotes:
+  - name: standby1
+    remote_url_template: http://localhost:50051/{database}
+  - name: standby2
+    remote_url_template: http://localhost:50052/{database}
+  bootstrap_role: primary
+  bootstrap_epoch: 0
+  remotesapi:
+    port: 50050
+  min_acks: 0
+`,
+			Error: true,
+		},
+		{
+			Name: "min_acks invalid",
+			Config: `
+cluster:
+  standby_remotes:
+  - name: standby1
+    remote_url_template: http://localhost:50051/{database}
+  - name: standby2
+    remote_url_template: http://localhost:50052/{database}
+  bootstrap_role: primary
+  bootstrap_epoch: 0
+  remotesapi:
+    port: 50050
+  min_acks: some
 `,
 			Error: true,
 		},
@@ -0,0 +1,143 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package auditlog implements the sql-server audit log. The statements run
+// by each user are classified, and the ones in the audited classes are
+// appended to a log file as JSON lines, recording who ran them, from where,
+// against which database and branch, and with what result. The log file is
+// rotated once it reaches a maximum size.
+package auditlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Entry is a single statement recorded in the audit log.
+type Entry struct {
+	// Time is when the statement started running.
+	Time         time.Time `json:"time"`
+	ConnectionID uint32    `json:"connection_id"`
+	User         string    `json:"user"`
+	Host         string    `json:"host"`
+	// Database and Branch are the session's current database and branch when the statement started running.
+	Database  string `json:"database,omitempty"`
+	Branch    string `json:"branch,omitempty"`
+	Class     Class  `json:"class"`
+	Statement string `json:"statement"`
+	// Parameters are the values bound to the placeholders of a prepared statement, in order, as SQL literals.
+	Parameters []string `json:"parameters,omitempty"`
+	// RowsAffected is the number of rows the statement inserted, updated or deleted.
+	RowsAffected uint64 `json:"rows_affected"`
+	// Commit is the last commit the statement created or moved the head of a branch to, if it did. Commits made by other
+	// sessions while the statement ran are not recorded.
+	Commit string `json:"commit,omitempty"`
+	// Error is the error the statement failed with, if it failed.
+	Error string `json:"error,omitempty"`
+}
+
+// Filter determines which statements are audited.
+type Filter struct {
+	// Classes are the classes of statements which are audited.
+	Classes []Class
+	// Users are the users whose statements are audited. Every user's statements are audited when there are none.
+	Users []string
+	// ExcludeUsers are the users whose statements are not audited.
+	ExcludeUsers []string
+}
+
+// Audits returns whether statements of |class| run by |user| are audited.
+func (f Filter) Audits(user string, class Class) bool {
+	if !contains(f.Classes, class) {
+		return false
+	}
+	if len(f.Users) > 0 && !contains(f.Users, user) {
+		return false
+	}
+	return !contains(f.ExcludeUsers, user)
+}
+
+func contains[T comparable](vals []T, val T) bool {
+	for _, v := range vals {
+		if v == val {
+			return true
+		}
+	}
+	return false
+}
+
+// Log is an audit log. It is safe for concurrent use.
+type Log struct {
+	filter Filter
+
+	mu sync.Mutex
+	f  *rotatingFile
+	// failed is the error the last write to |f| failed with, or nil if it succeeded.
+	failed error
+}
+
+// Open returns a Log which audits the statements allowed by |filter|, appending them to the file at |path|. The file
+// is rotated before it would grow past |maxSize| bytes, and |maxFiles| rotated files are kept.
+func Open(path string, maxSize int64, maxFiles int, filter Filter) (*Log, error) {
+	f, err := openRotatingFile(path, maxSize, maxFiles)
+	if err != nil {
+		return nil, err
+	}
+	return &Log{filter: filter, f: f}, nil
+}
+
+// Audits returns whether statements of |class| run by |user| are audited.
+func (l *Log) Audits(user string, class Class) bool {
+	return l.filter.Audits(user, class)
+}
+
+// Record appends |e| to the log file.
+func (l *Log) Record(e Entry) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, err = l.f.Write(line)
+	l.failed = err
+	return err
+}
+
+// Check returns nil if the last write to the log file succeeded. Otherwise, it reopens the log file to recover from
+// failures such as a rotation which didn't finish, and returns an error if that fails too.
+func (l *Log) Check() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.failed == nil {
+		return nil
+	}
+	l.f.Close()
+	if err := l.f.open(); err != nil {
+		return fmt.Errorf("%w; reopening it failed: %s", l.failed, err.Error())
+	}
+	l.failed = nil
+	return nil
+}
+
+// Close closes the log file.
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.f.Close()
+}
@@ -0,0 +1,174 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditlog
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected Class
+	}{
+		{"select * from t", ClassRead},
+		{"select 1 union select 2", ClassRead},
+		{"show tables", ClassRead},
+		{"explain select * from t", ClassRead},
+		{"insert into t values (1)", ClassDML},
+		{"replace into t values (1)", ClassDML},
+		{"with x as (select 1) delete from t", ClassDML},
+		{"update t set a = 1", ClassDML},
+		{"load data infile '/tmp/t.csv' into table t", ClassDML},
+		{"call my_procedure()", ClassDML},
+		{"create table t (a int primary key)", ClassDDL},
+		{"alter table t add column b int", ClassDDL},
+		{"truncate t", ClassDDL},
+		{"create database d", ClassDDL},
+		{"create procedure p() begin delete from t; end", ClassDDL},
+		{"create user alice identified by 'password'", ClassDCL},
+		{"alter user alice identified by 'password'", ClassDCL},
+		{"grant select on *.* to alice", ClassDCL},
+		{"revoke select on *.* from alice", ClassDCL},
+		{"drop role reader", ClassDCL},
+		{"call dolt_reset('--hard')", ClassVersionControl},
+		{"CALL DOLT_BRANCH('-D', 'feature')", ClassVersionControl},
+		{"call mydb.dolt_push('--force', 'origin', 'main')", ClassVersionControl},
+		{"set autocommit = 0", ClassOther},
+		{"use mydb", ClassOther},
+		{"begin", ClassOther},
+		{"not a statement", ClassOther},
+		{"prepare s from 'delete from t'", ClassDML},
+		{"execute s", ClassOther},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			assert.Equal(t, test.expected, Classify(test.query))
+		})
+	}
+}
+
+func TestClassifierPreparedStatements(t *testing.T) {
+	var c Classifier
+	assert.Equal(t, ClassDML, c.Classify("prepare del from 'delete from t where a = ?'"))
+	assert.Equal(t, ClassVersionControl, c.Classify("PREPARE vc FROM 'call dolt_reset(''--hard'')'"))
+	assert.Equal(t, ClassDML, c.Classify("execute DEL using @a"))
+	assert.Equal(t, ClassVersionControl, c.Classify("execute vc"))
+	assert.Equal(t, ClassOther, c.Classify("deallocate prepare del"))
+	assert.Equal(t, ClassOther, c.Classify("execute del using @a"))
+}
+
+func TestFilter(t *testing.T) {
+	f := Filter{Classes: []Class{ClassDML, ClassVersionControl}}
+	assert.True(t, f.Audits("alice", ClassDML))
+	assert.True(t, f.Audits("bob", ClassVersionControl))
+	assert.False(t, f.Audits("alice", ClassRead))
+
+	f.Users = []string{"alice", "bob"}
+	f.ExcludeUsers = []string{"bob"}
+	assert.True(t, f.Audits("alice", ClassDML))
+	assert.False(t, f.Audits("bob", ClassDML))
+	assert.False(t, f.Audits("carol", ClassDML))
+}
+
+func TestLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	// Each entry is a little over 200 bytes, so the file is rotated every two entries
+	log, err := Open(path, 500, 2, Filter{Classes: []Class{ClassDML}})
+	require.NoError(t, err)
+
+	start := time.Date(2024, 8, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 7; i++ {
+		require.NoError(t, log.Record(Entry{
+			Time:         start.Add(time.Duration(i) * time.Second),
+			ConnectionID: 1,
+			User:         "alice",
+			Host:         "127.0.0.1",
+			Database:     "mydb",
+			Branch:       "main",
+			Class:        ClassDML,
+			Statement:    "insert into t values (" + strings.Repeat("1", i+1) + ")",
+			RowsAffected: 1,
+			Commit:       "p5sh6t2fmhj0o5hl7jlr5lbg4i43bpkv",
+		}))
+	}
+	require.NoError(t, log.Close())
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(fileMode), info.Mode().Perm())
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "insert into t values (1111111)", entries[0].Statement)
+	assert.Equal(t, start.Add(6*time.Second), entries[0].Time)
+	assert.Equal(t, "alice", entries[0].User)
+	assert.Equal(t, ClassDML, entries[0].Class)
+	assert.Equal(t, uint64(1), entries[0].RowsAffected)
+	assert.Empty(t, entries[0].Error)
+
+	assert.Len(t, readEntries(t, path+".1"), 2)
+	assert.Equal(t, "insert into t values (111)", readEntries(t, path+".2")[0].Statement)
+	assert.NoFileExists(t, path+".3")
+}
+
+func TestLogCheck(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	log, err := Open(path, 1024, 1, Filter{Classes: []Class{ClassDML}})
+	require.NoError(t, err)
+	defer log.Close()
+	require.NoError(t, log.Check())
+
+	// A rotation which didn't finish leaves the file closed
+	require.NoError(t, log.f.Close())
+	require.Error(t, log.Record(Entry{Statement: "insert into t values (1)"}))
+	require.Error(t, log.Record(Entry{Statement: "insert into t values (2)"}))
+
+	require.NoError(t, log.Check())
+	require.NoError(t, log.Record(Entry{Statement: "insert into t values (3)"}))
+	entries := readEntries(t, path)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "insert into t values (3)", entries[0].Statement)
+
+	// The file can't be reopened while its directory is missing
+	require.NoError(t, log.f.Close())
+	require.Error(t, log.Record(Entry{Statement: "insert into t values (4)"}))
+	require.NoError(t, os.RemoveAll(filepath.Dir(path)))
+	require.Error(t, log.Check())
+}
+
+func readEntries(t *testing.T, path string) []Entry {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var entries []Entry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e Entry
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &e))
+		entries = append(entries, e)
+	}
+	require.NoError(t, scanner.Err())
+	return entries
+}
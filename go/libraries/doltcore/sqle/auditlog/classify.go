@@ -0,0 +1,109 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditlog
+
+import (
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// Class is the class of a statement, which determines whether it is audited.
+type Class string
+
+const (
+	// ClassDDL statements change the schema, such as CREATE TABLE, ALTER TABLE and TRUNCATE.
+	ClassDDL Class = "ddl"
+	// ClassDML statements change data, such as INSERT, UPDATE, DELETE and LOAD DATA. Calls to stored procedures which
+	// are not Dolt procedures are DML, as the statements they run are not classified on their own.
+	ClassDML Class = "dml"
+	// ClassDCL statements change accounts and privileges, such as CREATE USER, ALTER USER, GRANT and REVOKE.
+	ClassDCL Class = "dcl"
+	// ClassVersionControl statements are calls to Dolt procedures, such as DOLT_COMMIT, DOLT_RESET and DOLT_PUSH.
+	ClassVersionControl Class = "version_control"
+	// ClassRead statements only read data, such as SELECT and SHOW.
+	ClassRead Class = "read"
+	// ClassOther statements are the rest, such as SET, USE and BEGIN, along with statements which do not parse.
+	ClassOther Class = "other"
+)
+
+// Classifier classifies the statements run on a single connection. It remembers the statements prepared with PREPARE,
+// so that running them with EXECUTE is classified as the statement which was prepared. It is not safe for concurrent
+// use.
+type Classifier struct {
+	prepared map[string]Class
+}
+
+// Classify returns the class of |query|.
+func (c *Classifier) Classify(query string) Class {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return ClassOther
+	}
+	switch stmt := stmt.(type) {
+	case *sqlparser.Prepare:
+		class := Classify(stmt.Expr)
+		if c.prepared == nil {
+			c.prepared = make(map[string]Class)
+		}
+		c.prepared[strings.ToLower(stmt.Name)] = class
+		return class
+	case *sqlparser.Execute:
+		if class, ok := c.prepared[strings.ToLower(stmt.Name)]; ok {
+			return class
+		}
+		return ClassOther
+	case *sqlparser.Deallocate:
+		delete(c.prepared, strings.ToLower(stmt.Name))
+		return ClassOther
+	default:
+		return classifyStatement(stmt)
+	}
+}
+
+// Classify returns the class of |query|. A PREPARE statement is classified as the statement it prepares, while an
+// EXECUTE statement is ClassOther, since the statement it runs is not known.
+func Classify(query string) Class {
+	var c Classifier
+	return c.Classify(query)
+}
+
+func classifyStatement(stmt sqlparser.Statement) Class {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select, *sqlparser.SetOp, *sqlparser.ParenSelect, *sqlparser.Show, *sqlparser.Explain,
+		*sqlparser.OtherRead, *sqlparser.ShowGrants, *sqlparser.ShowPrivileges:
+		return ClassRead
+	case *sqlparser.Insert, *sqlparser.Update, *sqlparser.Delete, *sqlparser.Load:
+		return ClassDML
+	case *sqlparser.CreateUser, *sqlparser.RenameUser, *sqlparser.DropUser, *sqlparser.CreateRole,
+		*sqlparser.DropRole, *sqlparser.GrantPrivilege, *sqlparser.GrantRole, *sqlparser.GrantProxy,
+		*sqlparser.RevokePrivilege, *sqlparser.RevokeAllPrivileges, *sqlparser.RevokeRole, *sqlparser.RevokeProxy:
+		return ClassDCL
+	case *sqlparser.DDL:
+		if stmt.Authentication != nil || !stmt.User.IsEmpty() {
+			return ClassDCL
+		}
+		return ClassDDL
+	case *sqlparser.AlterTable, *sqlparser.DBDDL, *sqlparser.CreateSpatialRefSys:
+		return ClassDDL
+	case *sqlparser.Call:
+		if strings.HasPrefix(strings.ToLower(stmt.ProcName.Name.String()), "dolt_") {
+			return ClassVersionControl
+		}
+		return ClassDML
+	default:
+		return ClassOther
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package autogc implements automatic garbage collection for sql-server. A
+// Scheduler periodically checks the storage of every database the server
+// serves, and collects the garbage of a database once its chunk journal, the
+// number of table files in its new generation, or its growth since it was last
+// collected crosses a configured threshold.
+package autogc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/store/nbs"
+)
+
+// Config configures when a Scheduler collects garbage. A threshold of 0
+// disables the corresponding trigger.
+type Config struct {
+	// CheckInterval is how often the databases are checked.
+	CheckInterval time.Duration
+	// JournalSize triggers a GC once the chunk journal of a database is at
+	// least this many bytes.
+	JournalSize uint64
+	// NewTableFiles triggers a GC once the new generation of a database has at
+	// least this many table files, including the chunk journal.
+	NewTableFiles int
+	// Growth triggers a GC once a database has grown by at least this many
+	// bytes since it was last collected, or since the Scheduler first checked
+	// it if it hasn't been collected yet.
+	Growth uint64
+}
+
+// ErrBusy is returned, possibly wrapped with the reason, by a GCFunc which did
+// not collect a database's garbage because the server was busy. The database
+// is checked again at the next interval.
+var ErrBusy = errors.New("server busy")
+
+// DatabasesFunc returns the databases whose garbage is collected, by name.
+type DatabasesFunc func(ctx context.Context) (map[string]*doltdb.DoltDB, error)
+
+// GCFunc collects the garbage of |database|.
+type GCFunc func(ctx context.Context, database string) error
+
+const (
+	resultSuccess  = "success"
+	resultDeferred = "deferred: "
+	resultError    = "error: "
+)
+
+// Status is the state of automatic garbage collection for one database.
+type Status struct {
+	Database string
+	// Stats are the storage statistics of the database as of LastCheck.
+	Stats     nbs.StorageStats
+	LastCheck time.Time
+	// SizeAfterLastGC is the size of the database after it was last collected,
+	// or when it was first checked if it hasn't been collected yet.
+	SizeAfterLastGC uint64
+	// GCCount is the number of times the database has been collected.
+	GCCount uint64
+	// LastTrigger describes the threshold which triggered the last GC attempt,
+	// or is "" if there hasn't been one.
+	LastTrigger  string
+	LastGCStart  time.Time
+	LastDuration time.Duration
+	// LastResult is "success", "deferred: <reason>" or "error: <error>" for
+	// the last GC attempt, or "" if there hasn't been one.
+	LastResult string
+}
+
+// Scheduler periodically collects the garbage of the databases of a
+// sql-server. It is safe for concurrent use.
+type Scheduler struct {
+	cfg Config
+	dbs DatabasesFunc
+	gc  GCFunc
+	lgr *logrus.Logger
+
+	mu     sync.Mutex
+	status map[string]*Status
+}
+
+// NewScheduler returns a Scheduler which checks the databases returned by
+// |dbs| as configured by |cfg|, and collects their garbage with |gc|.
+func NewScheduler(cfg Config, dbs DatabasesFunc, gc GCFunc, lgr *logrus.Logger) *Scheduler {
+	return &Scheduler{
+		cfg:    cfg,
+		dbs:    dbs,
+		gc:     gc,
+		lgr:    lgr,
+		status: make(map[string]*Status),
+	}
+}
+
+// Run checks the databases every check interval until |ctx| is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.CheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Check(ctx); err != nil && ctx.Err() == nil {
+				s.lgr.Warnf("auto gc: unable to check databases: %s", err.Error())
+			}
+		}
+	}
+}
+
+// Check checks every database once, and collects the garbage of the ones
+// which have crossed a threshold.
+func (s *Scheduler) Check(ctx context.Context) error {
+	dbs, err := s.dbs(ctx)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(dbs))
+	for name := range dbs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	s.forgetDroppedDatabases(dbs)
+
+	for _, name := range names {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		s.checkDatabase(ctx, name, dbs[name])
+	}
+	return nil
+}
+
+func (s *Scheduler) checkDatabase(ctx context.Context, name string, ddb *doltdb.DoltDB) {
+	stats, ok := ddb.StorageStats()
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	st, ok := s.status[name]
+	if !ok {
+		st = &Status{Database: name, SizeAfterLastGC: stats.Size}
+		s.status[name] = st
+	}
+	st.Stats = stats
+	st.LastCheck = time.Now()
+	trigger := s.trigger(stats, st.SizeAfterLastGC)
+	s.mu.Unlock()
+
+	if trigger == "" {
+		return
+	}
+
+	start := time.Now()
+	err := s.gc(ctx, name)
+	duration := time.Since(start)
+
+	after, _ := ddb.StorageStats()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st.LastTrigger = trigger
+	st.LastGCStart = start
+	st.LastDuration = duration
+	switch {
+	case err == nil:
+		st.GCCount++
+		st.Stats = after
+		st.SizeAfterLastGC = after.Size
+		st.LastResult = resultSuccess
+		s.lgr.Infof("auto gc: collected garbage of database %s in %s (%s); size went from %d to %d bytes",
+			name, duration, trigger, stats.Size, after.Size)
+	case errors.Is(err, ErrBusy):
+		st.LastResult = resultDeferred + err.Error()
+	default:
+		st.LastResult = resultError + err.Error()
+		s.lgr.Warnf("auto gc: unable to collect garbage of database %s: %s", name, err.Error())
+	}
+}
+
+// trigger returns a description of the first threshold |stats| crosses, or ""
+// if it doesn't cross any.
+func (s *Scheduler) trigger(stats nbs.StorageStats, sizeAfterLastGC uint64) string {
+	switch {
+	case s.cfg.JournalSize > 0 && stats.JournalSize >= s.cfg.JournalSize:
+		return fmt.Sprintf("journal size %d >= %d", stats.JournalSize, s.cfg.JournalSize)
+	case s.cfg.NewTableFiles > 0 && stats.NewGenTableFiles >= s.cfg.NewTableFiles:
+		return fmt.Sprintf("new table files %d >= %d", stats.NewGenTableFiles, s.cfg.NewTableFiles)
+	case s.cfg.Growth > 0 && stats.Size >= sizeAfterLastGC+s.cfg.Growth:
+		return fmt.Sprintf("growth %d >= %d", stats.Size-sizeAfterLastGC, s.cfg.Growth)
+	}
+	return ""
+}
+
+func (s *Scheduler) forgetDroppedDatabases(dbs map[string]*doltdb.DoltDB) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for name := range s.status {
+		if _, ok := dbs[name]; !ok {
+			delete(s.status, name)
+		}
+	}
+}
+
+// Status returns the status of every database which has been checked, ordered
+// by database name. A nil Scheduler has no status.
+func (s *Scheduler) Status() []Status {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	status := make([]Status, 0, len(s.status))
+	for _, st := range s.status {
+		status = append(status, *st)
+	}
+	sort.Slice(status, func(i, j int) bool {
+		return status[i].Database < status[j].Database
+	})
+	return status
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autogc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/dtestutils"
+)
+
+func TestScheduler(t *testing.T) {
+	ctx := context.Background()
+	dEnv := dtestutils.CreateTestEnvForLocalFilesystem()
+	defer dEnv.DoltDB.Close()
+
+	stats, ok := dEnv.DoltDB.StorageStats()
+	require.True(t, ok)
+	require.Greater(t, stats.NewGenTableFiles, 0)
+
+	dbs := func(context.Context) (map[string]*doltdb.DoltDB, error) {
+		return map[string]*doltdb.DoltDB{"db": dEnv.DoltDB}, nil
+	}
+	var collected []string
+	var gcErr error
+	gc := func(_ context.Context, database string) error {
+		collected = append(collected, database)
+		return gcErr
+	}
+
+	t.Run("below thresholds", func(t *testing.T) {
+		collected = nil
+		s := NewScheduler(Config{
+			CheckInterval: time.Minute,
+			JournalSize:   stats.JournalSize + 1<<30,
+			NewTableFiles: stats.NewGenTableFiles + 1,
+			Growth:        1 << 30,
+		}, dbs, gc, logrus.StandardLogger())
+		require.NoError(t, s.Check(ctx))
+		assert.Empty(t, collected)
+
+		status := s.Status()
+		require.Len(t, status, 1)
+		assert.Equal(t, "db", status[0].Database)
+		assert.Equal(t, stats.Size, status[0].SizeAfterLastGC)
+		assert.Equal(t, "", status[0].LastResult)
+		assert.False(t, status[0].LastCheck.IsZero())
+	})
+
+	t.Run("table file threshold", func(t *testing.T) {
+		collected = nil
+		gcErr = nil
+		s := NewScheduler(Config{CheckInterval: time.Minute, NewTableFiles: stats.NewGenTableFiles}, dbs, gc, logrus.StandardLogger())
+		require.NoError(t, s.Check(ctx))
+		require.NoError(t, s.Check(ctx))
+		assert.Equal(t, []string{"db", "db"}, collected)
+
+		status := s.Status()
+		require.Len(t, status, 1)
+		assert.Equal(t, uint64(2), status[0].GCCount)
+		assert.Equal(t, resultSuccess, status[0].LastResult)
+		assert.Contains(t, status[0].LastTrigger, "new table files")
+	})
+
+	t.Run("deferred and failed collections", func(t *testing.T) {
+		collected = nil
+		s := NewScheduler(Config{CheckInterval: time.Minute, NewTableFiles: 1}, dbs, gc, logrus.StandardLogger())
+
+		gcErr = fmt.Errorf("%w: clients were connected", ErrBusy)
+		require.NoError(t, s.Check(ctx))
+		status := s.Status()
+		assert.Equal(t, uint64(0), status[0].GCCount)
+		assert.Equal(t, "deferred: server busy: clients were connected", status[0].LastResult)
+
+		gcErr = errors.New("no space left on device")
+		require.NoError(t, s.Check(ctx))
+		status = s.Status()
+		assert.Equal(t, uint64(0), status[0].GCCount)
+		assert.Equal(t, "error: no space left on device", status[0].LastResult)
+		assert.Equal(t, []string{"db", "db"}, collected)
+	})
+
+	t.Run("dropped databases", func(t *testing.T) {
+		s := NewScheduler(Config{CheckInterval: time.Minute, Growth: 1 << 30}, dbs, gc, logrus.StandardLogger())
+		require.NoError(t, s.Check(ctx))
+		require.Len(t, s.Status(), 1)
+
+		s.dbs = func(context.Context) (map[string]*doltdb.DoltDB, error) {
+			return nil, nil
+		}
+		require.NoError(t, s.Check(ctx))
+		assert.Empty(t, s.Status())
+	})
+}
@@ -15,12 +15,15 @@
 package binlogreplication
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+	"github.com/dolthub/go-mysql-server/sql/plan"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
 )
@@ -32,6 +35,14 @@ const replicationRunningStateDirectory = ".doltcfg"
 // replicaRunningFilename holds the name of the file that indicates replication was running on a replica server.
 const replicaRunningFilename = "replica-running"
 
+// replicaFiltersFilename holds the name of the file that stores the replication filter configuration when
+// @@dolt_binlog_replica_persist_filters is enabled.
+const replicaFiltersFilename = "replica-filters.json"
+
+// replicaSourceInfoFilePrefix is the prefix of the names of the files that store the replication source configuration
+// of channels other than the default channel.
+const replicaSourceInfoFilePrefix = "replica-source-info-"
+
 // replicaRunningState indicates if a replica was actively running replication.
 type replicaRunningState int
 
@@ -40,23 +51,47 @@ const (
 	notRunning
 )
 
-// persistReplicationConfiguration saves the specified |replicaSourceInfo| to the "mysql"
-// database |mysqlDb|. If any problems are encountered while saving to disk, an error is returned.
-func persistReplicationConfiguration(ctx *sql.Context, replicaSourceInfo *mysql_db.ReplicaSourceInfo, mysqlDb *mysql_db.MySQLDb) error {
+// persistReplicationConfiguration saves the specified |replicaSourceInfo| for |channel|. The configuration of the
+// default channel is saved to the "mysql" database |mysqlDb|, and the configuration of any other channel is saved to a
+// "replica-source-info-<channel>.json" file in the .doltcfg directory, since the "mysql" database only holds a single
+// replication source. If any problems are encountered while saving to disk, an error is returned.
+func persistReplicationConfiguration(ctx *sql.Context, channel string, replicaSourceInfo *mysql_db.ReplicaSourceInfo, mysqlDb *mysql_db.MySQLDb) error {
+	if channel != defaultChannel {
+		doltSession := dsess.DSessFromSess(ctx.Session)
+		filesys := doltSession.Provider().FileSystem()
+
+		// The .doltcfg dir may not exist yet, so create it if necessary.
+		if err := createDoltCfgDir(filesys); err != nil {
+			return err
+		}
+
+		sourceInfoFilepath, err := filesys.Abs(filepath.Join(replicationRunningStateDirectory, sourceInfoFilename(channel)))
+		if err != nil {
+			return err
+		}
+
+		data, err := replicaSourceInfo.ToJson(ctx)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(sourceInfoFilepath, []byte(data), 0600)
+	}
+
 	ed := mysqlDb.Editor()
 	defer ed.Close()
 	ed.PutReplicaSourceInfo(replicaSourceInfo)
 	return mysqlDb.Persist(ctx, ed)
 }
 
-// loadReplicationRunningState loads the replication running state from disk by looking for a "replica-running" file
-// in the .doltcfg directory. An error is returned if any problems were encountered loading the state from disk.
-func loadReplicationRunningState(ctx *sql.Context) (replicaRunningState, error) {
+// loadReplicationRunningState loads the replication running state of |channel| from disk by looking for a
+// "replica-running" file, or a "replica-running-<channel>" file for channels other than the default channel, in the
+// .doltcfg directory. An error is returned if any problems were encountered loading the state from disk.
+func loadReplicationRunningState(ctx *sql.Context, channel string) (replicaRunningState, error) {
 	doltSession := dsess.DSessFromSess(ctx.Session)
 	filesys := doltSession.Provider().FileSystem()
 
 	replicationRunningStateFilepath, err := filesys.Abs(
-		filepath.Join(replicationRunningStateDirectory, replicaRunningFilename))
+		filepath.Join(replicationRunningStateDirectory, channelFilename(replicaRunningFilename, channel)))
 	if err != nil {
 		return notRunning, err
 	}
@@ -68,9 +103,10 @@ func loadReplicationRunningState(ctx *sql.Context) (replicaRunningState, error)
 	}
 }
 
-// persistReplicaRunningState records the running |state| of a replica to disk by creating a "replica-running" empty
-// file in the .doltcfg directory. An error is returned if any problems were encountered saving the state to disk.
-func persistReplicaRunningState(ctx *sql.Context, state replicaRunningState) error {
+// persistReplicaRunningState records the running |state| of a replica's |channel| to disk by creating a
+// "replica-running" empty file, or a "replica-running-<channel>" file for channels other than the default channel, in
+// the .doltcfg directory. An error is returned if any problems were encountered saving the state to disk.
+func persistReplicaRunningState(ctx *sql.Context, channel string, state replicaRunningState) error {
 	doltSession := dsess.DSessFromSess(ctx.Session)
 	filesys := doltSession.Provider().FileSystem()
 
@@ -81,7 +117,7 @@ func persistReplicaRunningState(ctx *sql.Context, state replicaRunningState) err
 	}
 
 	replicationRunningStateFilepath, err := filesys.Abs(
-		filepath.Join(replicationRunningStateDirectory, replicaRunningFilename))
+		filepath.Join(replicationRunningStateDirectory, channelFilename(replicaRunningFilename, channel)))
 	if err != nil {
 		return err
 	}
@@ -102,9 +138,28 @@ func persistReplicaRunningState(ctx *sql.Context, state replicaRunningState) err
 	}
 }
 
-// loadReplicationConfiguration loads the replication configuration for default channel ("") from
-// the "mysql" database, |mysqlDb|.
-func loadReplicationConfiguration(_ *sql.Context, mysqlDb *mysql_db.MySQLDb) (*mysql_db.ReplicaSourceInfo, error) {
+// loadReplicationConfiguration loads the replication configuration for |channel|. The configuration of the default
+// channel is loaded from the "mysql" database, |mysqlDb|, and the configuration of any other channel is loaded from its
+// file in the .doltcfg directory. If |channel| has not been configured, nil is returned.
+func loadReplicationConfiguration(ctx *sql.Context, mysqlDb *mysql_db.MySQLDb, channel string) (*mysql_db.ReplicaSourceInfo, error) {
+	if channel != defaultChannel {
+		doltSession := dsess.DSessFromSess(ctx.Session)
+		filesys := doltSession.Provider().FileSystem()
+
+		sourceInfoFilepath, err := filesys.Abs(filepath.Join(replicationRunningStateDirectory, sourceInfoFilename(channel)))
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := os.ReadFile(sourceInfoFilepath)
+		if os.IsNotExist(err) {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+		return (&mysql_db.ReplicaSourceInfo{}).FromJson(ctx, string(data))
+	}
+
 	rd := mysqlDb.Reader()
 	defer rd.Close()
 
@@ -118,9 +173,50 @@ func loadReplicationConfiguration(_ *sql.Context, mysqlDb *mysql_db.MySQLDb) (*m
 	return nil, nil
 }
 
-// deleteReplicationConfiguration deletes all replication configuration for the default channel ("")
-// from the specified "mysql" database, |mysqlDb|.
-func deleteReplicationConfiguration(ctx *sql.Context, mysqlDb *mysql_db.MySQLDb) error {
+// loadReplicationChannelNames returns the names of all channels with replication configuration, including the default
+// channel if it has been configured.
+func loadReplicationChannelNames(ctx *sql.Context, mysqlDb *mysql_db.MySQLDb) ([]string, error) {
+	var channels []string
+	if rsi, err := loadReplicationConfiguration(ctx, mysqlDb, defaultChannel); err != nil {
+		return nil, err
+	} else if rsi != nil {
+		channels = append(channels, defaultChannel)
+	}
+
+	doltSession := dsess.DSessFromSess(ctx.Session)
+	filesys := doltSession.Provider().FileSystem()
+	if exists, isDir := filesys.Exists(replicationRunningStateDirectory); !exists || !isDir {
+		return channels, nil
+	}
+
+	err := filesys.Iter(replicationRunningStateDirectory, false, func(path string, _ int64, isDir bool) bool {
+		name := filepath.Base(path)
+		if !isDir && strings.HasPrefix(name, replicaSourceInfoFilePrefix) && strings.HasSuffix(name, ".json") {
+			channels = append(channels, strings.TrimSuffix(strings.TrimPrefix(name, replicaSourceInfoFilePrefix), ".json"))
+		}
+		return false
+	})
+	return channels, err
+}
+
+// deleteReplicationConfiguration deletes all replication configuration for |channel|.
+func deleteReplicationConfiguration(ctx *sql.Context, mysqlDb *mysql_db.MySQLDb, channel string) error {
+	if channel != defaultChannel {
+		doltSession := dsess.DSessFromSess(ctx.Session)
+		filesys := doltSession.Provider().FileSystem()
+
+		sourceInfoFilepath, err := filesys.Abs(filepath.Join(replicationRunningStateDirectory, sourceInfoFilename(channel)))
+		if err != nil {
+			return err
+		}
+
+		err = os.Remove(sourceInfoFilepath)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
 	ed := mysqlDb.Editor()
 	defer ed.Close()
 
@@ -129,15 +225,155 @@ func deleteReplicationConfiguration(ctx *sql.Context, mysqlDb *mysql_db.MySQLDb)
 	return mysqlDb.Persist(ctx, ed)
 }
 
-// persistSourceUuid saves the specified |sourceUuid| to the "mysql" database, |mysqlDb|.
-func persistSourceUuid(ctx *sql.Context, sourceUuid string, mysqlDb *mysql_db.MySQLDb) error {
-	replicaSourceInfo, err := loadReplicationConfiguration(ctx, mysqlDb)
+// sourceInfoFilename returns the name of the file in the .doltcfg directory that holds the replication source
+// configuration of |channel|, a channel other than the default channel.
+func sourceInfoFilename(channel string) string {
+	return replicaSourceInfoFilePrefix + channel + ".json"
+}
+
+// persistSourceUuid saves the specified |sourceUuid| of |channel|'s source to the "mysql" database, |mysqlDb|.
+func persistSourceUuid(ctx *sql.Context, channel, sourceUuid string, mysqlDb *mysql_db.MySQLDb) error {
+	replicaSourceInfo, err := loadReplicationConfiguration(ctx, mysqlDb, channel)
 	if err != nil {
 		return err
 	}
 
 	replicaSourceInfo.Uuid = sourceUuid
-	return persistReplicationConfiguration(ctx, replicaSourceInfo, mysqlDb)
+	return persistReplicationConfiguration(ctx, channel, replicaSourceInfo, mysqlDb)
+}
+
+// persistedReplicationFilters is the JSON representation of a filterConfiguration stored in the
+// .doltcfg/replica-filters.json file.
+type persistedReplicationFilters struct {
+	DoDatabases      []string          `json:"do_databases,omitempty"`
+	IgnoreDatabases  []string          `json:"ignore_databases,omitempty"`
+	DoTables         []string          `json:"do_tables,omitempty"`
+	IgnoreTables     []string          `json:"ignore_tables,omitempty"`
+	WildDoTables     []string          `json:"wild_do_tables,omitempty"`
+	WildIgnoreTables []string          `json:"wild_ignore_tables,omitempty"`
+	RewriteDatabases []databaseRewrite `json:"rewrite_databases,omitempty"`
+}
+
+// persistReplicationFilters saves |filters| to the "replica-filters.json" file in the .doltcfg directory, so that they
+// can be restored when the server restarts. MySQL does not persist filters set with CHANGE REPLICATION FILTER, so this
+// only happens when @@dolt_binlog_replica_persist_filters is enabled. Otherwise, any previously persisted filters are
+// removed so that they are not restored later. An error is returned if any problems were encountered.
+func persistReplicationFilters(ctx *sql.Context, filters *filterConfiguration) error {
+	if !shouldPersistReplicationFilters() {
+		return deleteReplicationFilters(ctx)
+	}
+
+	doltSession := dsess.DSessFromSess(ctx.Session)
+	filesys := doltSession.Provider().FileSystem()
+
+	// The .doltcfg dir may not exist yet, so create it if necessary.
+	if err := createDoltCfgDir(filesys); err != nil {
+		return err
+	}
+
+	filtersFilepath, err := filesys.Abs(filepath.Join(replicationRunningStateDirectory, replicaFiltersFilename))
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(persistedReplicationFilters{
+		DoDatabases:      filters.getDoDatabases(),
+		IgnoreDatabases:  filters.getIgnoreDatabases(),
+		DoTables:         filters.getDoTables(),
+		IgnoreTables:     filters.getIgnoreTables(),
+		WildDoTables:     filters.getWildDoTables(),
+		WildIgnoreTables: filters.getWildIgnoreTables(),
+		RewriteDatabases: filters.getRewriteDatabases(),
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filtersFilepath, data, 0666)
+}
+
+// loadReplicationFilters applies any replication filters stored in the "replica-filters.json" file in the .doltcfg
+// directory to |filters|. Filters are only loaded when @@dolt_binlog_replica_persist_filters is enabled. An error is
+// returned if the file cannot be read or holds invalid filters.
+func loadReplicationFilters(ctx *sql.Context, filters *filterConfiguration) error {
+	if !shouldPersistReplicationFilters() {
+		return nil
+	}
+
+	doltSession := dsess.DSessFromSess(ctx.Session)
+	filesys := doltSession.Provider().FileSystem()
+
+	filtersFilepath, err := filesys.Abs(filepath.Join(replicationRunningStateDirectory, replicaFiltersFilename))
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(filtersFilepath)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var persisted persistedReplicationFilters
+	if err = json.Unmarshal(data, &persisted); err != nil {
+		return fmt.Errorf("unable to load replication filters from %s: %w", filtersFilepath, err)
+	}
+
+	doTables, err := parseQualifiedTableNames(persisted.DoTables)
+	if err != nil {
+		return err
+	}
+	ignoreTables, err := parseQualifiedTableNames(persisted.IgnoreTables)
+	if err != nil {
+		return err
+	}
+
+	for _, apply := range []func() error{
+		func() error { return filters.setDoDatabases(persisted.DoDatabases) },
+		func() error { return filters.setIgnoreDatabases(persisted.IgnoreDatabases) },
+		func() error { return filters.setDoTables(doTables) },
+		func() error { return filters.setIgnoreTables(ignoreTables) },
+		func() error { return filters.setWildDoTables(persisted.WildDoTables) },
+		func() error { return filters.setWildIgnoreTables(persisted.WildIgnoreTables) },
+		func() error { return filters.setRewriteDatabases(persisted.RewriteDatabases) },
+	} {
+		if err = apply(); err != nil {
+			return fmt.Errorf("unable to load replication filters from %s: %w", filtersFilepath, err)
+		}
+	}
+
+	return nil
+}
+
+// deleteReplicationFilters removes the "replica-filters.json" file from the .doltcfg directory, if it exists.
+func deleteReplicationFilters(ctx *sql.Context) error {
+	doltSession := dsess.DSessFromSess(ctx.Session)
+	filesys := doltSession.Provider().FileSystem()
+
+	filtersFilepath, err := filesys.Abs(filepath.Join(replicationRunningStateDirectory, replicaFiltersFilename))
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(filtersFilepath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// parseQualifiedTableNames converts |names|, each of the form "db.table", into unresolved tables.
+func parseQualifiedTableNames(names []string) ([]sql.UnresolvedTable, error) {
+	urts := make([]sql.UnresolvedTable, len(names))
+	for i, name := range names {
+		db, table, ok := strings.Cut(name, ".")
+		if !ok {
+			db, table = "", name
+		}
+		urts[i] = plan.NewUnresolvedTable(table, db)
+	}
+	return urts, verifyAllTablesAreQualified(urts)
 }
 
 // createEmptyFile creates an empty file at |fullFilepath| if a file does not exist already. If a file does exist
@@ -39,13 +39,14 @@ type binlogPositionStore struct {
 	mu sync.Mutex
 }
 
-// Load loads a mysql.Position instance from the .doltcfg/binlog-position file at the root of the specified |filesystem|.
-// This file MUST be stored at the root of the provider's filesystem, and NOT inside a nested database's .doltcfg directory,
-// since the binlog position contains events that cover all databases in a SQL server. The returned mysql.Position
-// represents the set of GTIDs that have been successfully executed and applied on this replica. Currently only the
-// default binlog channel ("") is supported. If no .doltcfg/binlog-position file is stored, this method returns a nil
-// mysql.Position and a nil error. If any errors are encountered, a nil mysql.Position and an error are returned.
-func (store *binlogPositionStore) Load(filesys filesys.Filesys) (*mysql.Position, error) {
+// Load loads a mysql.Position instance for the replication |channel| from the .doltcfg/binlog-position file at the root
+// of the specified |filesystem|. This file MUST be stored at the root of the provider's filesystem, and NOT inside a
+// nested database's .doltcfg directory, since the binlog position contains events that cover all databases in a SQL
+// server. The returned mysql.Position represents the set of GTIDs that have been successfully executed and applied on
+// this replica. Channels other than the default binlog channel ("") store their position in a file named
+// binlog-position-<channel>. If no position file is stored, this method returns a nil mysql.Position and a nil error.
+// If any errors are encountered, a nil mysql.Position and an error are returned.
+func (store *binlogPositionStore) Load(filesys filesys.Filesys, channel string) (*mysql.Position, error) {
 	store.mu.Lock()
 	defer store.mu.Unlock()
 
@@ -54,12 +55,13 @@ func (store *binlogPositionStore) Load(filesys filesys.Filesys) (*mysql.Position
 		return nil, nil
 	}
 
-	positionFileExists, _ := filesys.Exists(filepath.Join(binlogPositionDirectory, binlogPositionFilename))
+	positionFilename := channelFilename(binlogPositionFilename, channel)
+	positionFileExists, _ := filesys.Exists(filepath.Join(binlogPositionDirectory, positionFilename))
 	if !positionFileExists {
 		return nil, nil
 	}
 
-	filePath, err := filesys.Abs(filepath.Join(binlogPositionDirectory, binlogPositionFilename))
+	filePath, err := filesys.Abs(filepath.Join(binlogPositionDirectory, positionFilename))
 	if err != nil {
 		return nil, err
 	}
@@ -84,13 +86,13 @@ func (store *binlogPositionStore) Load(filesys filesys.Filesys) (*mysql.Position
 	return &position, nil
 }
 
-// Save saves the specified |position| to disk in the .doltcfg/binlog-position file at the root of the provider's
-// filesystem. This file MUST be stored at the root of the provider's filesystem, and NOT inside a nested database's
-// .doltcfg directory, since the binlog position contains events that cover all databases in a SQL server. |position|
-// represents the set of GTIDs that have been successfully executed and applied on this replica. Currently only the
-// default binlog channel ("") is supported. If any errors are encountered persisting the position to disk, an
-// error is returned.
-func (store *binlogPositionStore) Save(ctx *sql.Context, position *mysql.Position) error {
+// Save saves the specified |position| for the replication |channel| to disk in the .doltcfg/binlog-position file at the
+// root of the provider's filesystem. This file MUST be stored at the root of the provider's filesystem, and NOT inside
+// a nested database's .doltcfg directory, since the binlog position contains events that cover all databases in a SQL
+// server. |position| represents the set of GTIDs that have been successfully executed and applied on this replica.
+// Channels other than the default binlog channel ("") store their position in a file named binlog-position-<channel>.
+// If any errors are encountered persisting the position to disk, an error is returned.
+func (store *binlogPositionStore) Save(ctx *sql.Context, channel string, position *mysql.Position) error {
 	if position == nil {
 		return fmt.Errorf("unable to save binlog position: nil position passed")
 	}
@@ -106,7 +108,7 @@ func (store *binlogPositionStore) Save(ctx *sql.Context, position *mysql.Positio
 		return err
 	}
 
-	filePath, err := filesys.Abs(filepath.Join(binlogPositionDirectory, binlogPositionFilename))
+	filePath, err := filesys.Abs(filepath.Join(binlogPositionDirectory, channelFilename(binlogPositionFilename, channel)))
 	if err != nil {
 		return err
 	}
@@ -115,17 +117,17 @@ func (store *binlogPositionStore) Save(ctx *sql.Context, position *mysql.Positio
 	return os.WriteFile(filePath, []byte(encodedPosition), 0666)
 }
 
-// Delete deletes the stored mysql.Position information stored in .doltcfg/binlog-position in the root of the provider's
-// filesystem. This is useful for the "RESET REPLICA" command, since it clears out the current replication state. If
-// any errors are encountered removing the position file, an error is returned.
-func (store *binlogPositionStore) Delete(ctx *sql.Context) error {
+// Delete deletes the stored mysql.Position information for the replication |channel| stored in .doltcfg/binlog-position
+// in the root of the provider's filesystem. This is useful for the "RESET REPLICA" command, since it clears out the
+// current replication state. If any errors are encountered removing the position file, an error is returned.
+func (store *binlogPositionStore) Delete(ctx *sql.Context, channel string) error {
 	store.mu.Lock()
 	defer store.mu.Unlock()
 
 	doltSession := dsess.DSessFromSess(ctx.Session)
 	filesys := doltSession.Provider().FileSystem()
 
-	return filesys.Delete(filepath.Join(binlogPositionDirectory, binlogPositionFilename), false)
+	return filesys.Delete(filepath.Join(binlogPositionDirectory, channelFilename(binlogPositionFilename, channel)), false)
 }
 
 // createDoltCfgDir creates the .doltcfg directory if it doesn't already exist.
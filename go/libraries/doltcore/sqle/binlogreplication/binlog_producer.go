@@ -192,7 +192,7 @@ func (b *binlogProducer) initializeGtidPosition(fs filesys.Filesys) error {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
-	position, err := positionStore.Load(fs)
+	position, err := positionStore.Load(fs, defaultChannel)
 	if err != nil {
 		return err
 	}
@@ -261,7 +261,7 @@ func (b *binlogProducer) createGtidEvent(ctx *sql.Context) (mysql.BinlogEvent, e
 
 	// Store the latest executed GTID to disk
 	b.gtidPosition.GTIDSet = b.gtidPosition.GTIDSet.AddGTID(gtid)
-	err = positionStore.Save(ctx, b.gtidPosition)
+	err = positionStore.Save(ctx, defaultChannel, b.gtidPosition)
 	if err != nil {
 		return nil, fmt.Errorf("unable to store GTID executed metadata to disk: %s", err.Error())
 	}
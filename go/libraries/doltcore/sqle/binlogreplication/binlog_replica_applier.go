@@ -52,9 +52,10 @@ const (
 
 // binlogReplicaApplier represents the process that applies updates from a binlog connection.
 //
-// This type is NOT used concurrently – there is currently only one single applier process running to process binlog
-// events, so the state in this type is NOT protected with a mutex.
+// This type is NOT used concurrently – each replication channel runs a single applier process to process binlog
+// events from its source, so the state in this type is NOT protected with a mutex.
 type binlogReplicaApplier struct {
+	channel               *replicaChannel
 	format                *mysql.BinlogFormat
 	tableMapsById         map[uint64]*mysql.TableMap
 	stopReplicationChan   chan struct{}
@@ -64,10 +65,19 @@ type binlogReplicaApplier struct {
 	filters               *filterConfiguration
 	running               atomic.Bool
 	engine                *gms.Engine
+	// commitBatch holds the replicated transactions that have not been included in a Dolt commit yet
+	commitBatch doltCommitBatch
+	// inTransaction is true while the events of a replicated transaction are being applied
+	inTransaction bool
+	// inExplicitTransaction is true between the BEGIN query event of a replicated transaction and the XID event or
+	// COMMIT query event that ends it. The statements in between are part of the transaction, not transactions of
+	// their own.
+	inExplicitTransaction bool
 }
 
-func newBinlogReplicaApplier(filters *filterConfiguration) *binlogReplicaApplier {
+func newBinlogReplicaApplier(channel *replicaChannel, filters *filterConfiguration) *binlogReplicaApplier {
 	return &binlogReplicaApplier{
+		channel:             channel,
 		tableMapsById:       make(map[uint64]*mysql.TableMap),
 		stopReplicationChan: make(chan struct{}),
 		filters:             filters,
@@ -93,7 +103,7 @@ func (a *binlogReplicaApplier) Go(ctx *sql.Context) {
 		a.running.Store(false)
 		if err != nil {
 			ctx.GetLogger().Errorf("unexpected error of type %T: '%v'", err, err.Error())
-			DoltBinlogReplicaController.setSqlError(mysql.ERUnknownError, err.Error())
+			a.channel.setSqlError(mysql.ERUnknownError, err.Error())
 		}
 	}()
 }
@@ -108,7 +118,7 @@ func (a *binlogReplicaApplier) IsRunning() bool {
 func (a *binlogReplicaApplier) connectAndStartReplicationEventStream(ctx *sql.Context) (*mysql.Conn, error) {
 	var maxConnectionAttempts uint64
 	var connectRetryDelay uint32
-	DoltBinlogReplicaController.updateStatus(func(status *binlogreplication.ReplicaStatus) {
+	a.channel.updateStatus(func(status *binlogreplication.ReplicaStatus) {
 		status.ReplicaIoRunning = binlogreplication.ReplicaIoConnecting
 		status.ReplicaSqlRunning = binlogreplication.ReplicaSqlRunning
 		maxConnectionAttempts = status.SourceRetryCount
@@ -118,21 +128,21 @@ func (a *binlogReplicaApplier) connectAndStartReplicationEventStream(ctx *sql.Co
 	var conn *mysql.Conn
 	var err error
 	for connectionAttempts := uint64(0); ; connectionAttempts++ {
-		replicaSourceInfo, err := loadReplicationConfiguration(ctx, a.engine.Analyzer.Catalog.MySQLDb)
+		replicaSourceInfo, err := loadReplicationConfiguration(ctx, a.engine.Analyzer.Catalog.MySQLDb, a.channel.name)
 
 		if replicaSourceInfo == nil {
 			err = ErrServerNotConfiguredAsReplica
-			DoltBinlogReplicaController.setIoError(ERFatalReplicaError, err.Error())
+			a.channel.setIoError(ERFatalReplicaError, err.Error())
 			return nil, err
 		} else if replicaSourceInfo.Uuid != "" {
 			a.replicationSourceUuid = replicaSourceInfo.Uuid
 		}
 
 		if replicaSourceInfo.Host == "" {
-			DoltBinlogReplicaController.setIoError(ERFatalReplicaError, ErrEmptyHostname.Error())
+			a.channel.setIoError(ERFatalReplicaError, ErrEmptyHostname.Error())
 			return nil, ErrEmptyHostname
 		} else if replicaSourceInfo.User == "" {
-			DoltBinlogReplicaController.setIoError(ERFatalReplicaError, ErrEmptyUsername.Error())
+			a.channel.setIoError(ERFatalReplicaError, ErrEmptyUsername.Error())
 			return nil, ErrEmptyUsername
 		}
 
@@ -176,7 +186,7 @@ func (a *binlogReplicaApplier) connectAndStartReplicationEventStream(ctx *sql.Co
 		return nil, err
 	}
 
-	DoltBinlogReplicaController.updateStatus(func(status *binlogreplication.ReplicaStatus) {
+	a.channel.updateStatus(func(status *binlogreplication.ReplicaStatus) {
 		status.ReplicaIoRunning = binlogreplication.ReplicaIoRunning
 	})
 
@@ -194,7 +204,7 @@ func (a *binlogReplicaApplier) startReplicationEventStream(ctx *sql.Context, con
 	doltSession := dsess.DSessFromSess(ctx.Session)
 	filesys := doltSession.Provider().FileSystem()
 
-	position, err := positionStore.Load(filesys)
+	position, err := positionStore.Load(filesys, a.channel.name)
 	if err != nil {
 		return err
 	}
@@ -239,6 +249,7 @@ func (a *binlogReplicaApplier) startReplicationEventStream(ctx *sql.Context, con
 	}
 
 	a.currentPosition = position
+	a.channel.setExecutedGtids(position.GTIDSet)
 
 	// Clear out the format description in case we're reconnecting, so that we don't use the old format description
 	// to interpret any event messages before we receive the new format description from the new stream.
@@ -265,6 +276,11 @@ func (a *binlogReplicaApplier) replicaBinlogEventHandler(ctx *sql.Context) error
 	var conn *mysql.Conn
 	var eventProducer *binlogEventProducer
 
+	// When Dolt commits are batched by time, pending transactions are checked periodically, so that they are
+	// committed even if the source server stops sending new transactions.
+	commitTicker := time.NewTicker(time.Second)
+	defer commitTicker.Stop()
+
 	// Process binlog events
 	for {
 		if conn == nil {
@@ -288,7 +304,7 @@ func (a *binlogReplicaApplier) replicaBinlogEventHandler(ctx *sql.Context) error
 			err := a.processBinlogEvent(ctx, engine, event)
 			if err != nil {
 				ctx.GetLogger().Errorf("unexpected error of type %T: '%v'", err, err.Error())
-				DoltBinlogReplicaController.setSqlError(mysql.ERUnknownError, err.Error())
+				a.channel.setSqlError(mysql.ERUnknownError, err.Error())
 			}
 
 		case err := <-eventProducer.ErrorChan():
@@ -296,7 +312,7 @@ func (a *binlogReplicaApplier) replicaBinlogEventHandler(ctx *sql.Context) error
 				badConnection := sqlError.Message == io.EOF.Error() ||
 					strings.HasPrefix(sqlError.Message, io.ErrUnexpectedEOF.Error())
 				if badConnection {
-					DoltBinlogReplicaController.updateStatus(func(status *binlogreplication.ReplicaStatus) {
+					a.channel.updateStatus(func(status *binlogreplication.ReplicaStatus) {
 						status.LastIoError = sqlError.Message
 						status.LastIoErrNumber = ERNetReadError
 						currentTime := time.Now()
@@ -310,12 +326,20 @@ func (a *binlogReplicaApplier) replicaBinlogEventHandler(ctx *sql.Context) error
 			} else {
 				// otherwise, log the error if it's something we don't expect and continue
 				ctx.GetLogger().Errorf("unexpected error of type %T: '%v'", err, err.Error())
-				DoltBinlogReplicaController.setIoError(mysql.ERUnknownError, err.Error())
+				a.channel.setIoError(mysql.ERUnknownError, err.Error())
+			}
+
+		case <-commitTicker.C:
+			if !a.inTransaction && a.commitBatch.isDue(time.Now(), getCommitBatchSize(), getCommitInterval()) {
+				a.createDoltCommits(ctx, engine)
 			}
 
 		case <-a.stopReplicationChan:
 			ctx.GetLogger().Trace("received stop replication signal")
 			eventProducer.Stop()
+			if !a.inTransaction && (getCommitBatchSize() > 0 || getCommitInterval() > 0) {
+				a.createDoltCommits(ctx, engine)
+			}
 			return nil
 		}
 	}
@@ -340,7 +364,7 @@ func (a *binlogReplicaApplier) processBinlogEvent(ctx *sql.Context, engine *gms.
 		if err != nil {
 			msg := fmt.Sprintf("unable to strip checksum from binlog event: '%v'", err.Error())
 			ctx.GetLogger().Error(msg)
-			DoltBinlogReplicaController.setSqlError(mysql.ERUnknownError, msg)
+			a.channel.setSqlError(mysql.ERUnknownError, msg)
 		}
 	}
 
@@ -415,9 +439,16 @@ func (a *binlogReplicaApplier) processBinlogEvent(ctx *sql.Context, engine *gms.
 			ctx.SetSessionVariable(ctx, "unique_checks", 1)
 		}
 
-		ctx.SetCurrentDatabase(query.Database)
-		executeQueryWithEngine(ctx, engine, query.SQL)
-		createCommit = strings.ToLower(query.SQL) != "begin"
+		// Database filters and rewrites apply to the default database of a statement, as they do in MySQL. Statements
+		// that qualify tables with a different database are not rewritten. Transaction control statements are always
+		// executed, so that transaction boundaries are preserved.
+		var isTransactionControl bool
+		createCommit, isTransactionControl = a.queryEndsTransaction(query.SQL)
+		if !isTransactionControl && a.filters.isDatabaseFilteredOut(ctx, query.Database) {
+			break
+		}
+		ctx.SetCurrentDatabase(a.filters.rewriteDatabase(query.Database))
+		a.executeQueryWithEngine(ctx, engine, query.SQL)
 
 	case event.IsRotate():
 		// When a binary log file exceeds the configured size limit, a ROTATE_EVENT is written at the end of the file,
@@ -467,10 +498,11 @@ func (a *binlogReplicaApplier) processBinlogEvent(ctx *sql.Context, engine *gms.
 			"isBegin": isBegin,
 		}).Trace("Received binlog event: GTID")
 		a.currentGtid = gtid
+		a.inTransaction = true
 		// if the source's UUID hasn't been set yet, set it and persist it
 		if a.replicationSourceUuid == "" {
 			uuid := fmt.Sprintf("%v", gtid.SourceServer())
-			err = persistSourceUuid(ctx, uuid, a.engine.Analyzer.Catalog.MySQLDb)
+			err = persistSourceUuid(ctx, a.channel.name, uuid, a.engine.Analyzer.Catalog.MySQLDb)
 			if err != nil {
 				return err
 			}
@@ -512,7 +544,7 @@ func (a *binlogReplicaApplier) processBinlogEvent(ctx *sql.Context, engine *gms.
 			if flags != 0 {
 				msg := fmt.Sprintf("unsupported binlog protocol message: TableMap event with unsupported flags '%x'", flags)
 				ctx.GetLogger().Errorf(msg)
-				DoltBinlogReplicaController.setSqlError(mysql.ERUnknownError, msg)
+				a.channel.setSqlError(mysql.ERUnknownError, msg)
 			}
 			a.tableMapsById[tableId] = tableMap
 		}
@@ -541,38 +573,84 @@ func (a *binlogReplicaApplier) processBinlogEvent(ctx *sql.Context, engine *gms.
 	}
 
 	if createCommit {
-		var databasesToCommit []string
+		// The transaction is committed and its GTID is recorded as executed together, so that the stored position
+		// never includes a transaction that was only partly applied.
+		a.inExplicitTransaction = false
 		if commitToAllDatabases {
-			databasesToCommit = getAllUserDatabaseNames(ctx, engine)
-			for _, database := range databasesToCommit {
-				executeQueryWithEngine(ctx, engine, "use `"+database+"`;")
-				executeQueryWithEngine(ctx, engine, "commit;")
+			for _, database := range getAllUserDatabaseNames(ctx, engine) {
+				a.executeQueryWithEngine(ctx, engine, "use `"+database+"`;")
+				a.executeQueryWithEngine(ctx, engine, "commit;")
 			}
 		}
 
 		// Record the last GTID processed after the commit
 		a.currentPosition.GTIDSet = a.currentPosition.GTIDSet.AddGTID(a.currentGtid)
-		err := sql.SystemVariables.AssignValues(map[string]interface{}{"gtid_executed": a.currentPosition.GTIDSet.String()})
+		a.channel.setExecutedGtids(a.currentPosition.GTIDSet)
+		gtidExecuted, err := DoltBinlogReplicaController.executedGtids()
+		if err == nil {
+			err = sql.SystemVariables.AssignValues(map[string]interface{}{"gtid_executed": gtidExecuted.String()})
+		}
 		if err != nil {
 			ctx.GetLogger().Errorf("unable to set @@GLOBAL.gtid_executed: %s", err.Error())
 		}
-		err = positionStore.Save(ctx, a.currentPosition)
+		err = positionStore.Save(ctx, a.channel.name, a.currentPosition)
 		if err != nil {
 			return fmt.Errorf("unable to store GTID executed metadata to disk: %s", err.Error())
 		}
 
-		// For now, create a Dolt commit from every data update. Eventually, we'll want to make this configurable.
-		ctx.GetLogger().Trace("Creating Dolt commit(s)")
-		for _, database := range databasesToCommit {
-			executeQueryWithEngine(ctx, engine, "use `"+database+"`;")
-			executeQueryWithEngine(ctx, engine,
-				fmt.Sprintf("call dolt_commit('-Am', 'Dolt binlog replica commit: GTID %s');", a.currentGtid))
+		// Create a Dolt commit for this transaction, or add it to the current batch of transactions if Dolt commits
+		// are batched by transaction count or time.
+		a.inTransaction = false
+		var sourceTime time.Time
+		if timestamp := event.Timestamp(); timestamp > 0 {
+			sourceTime = time.Unix(int64(timestamp), 0)
+		}
+		now := time.Now()
+		a.commitBatch.add(a.currentGtid, sourceTime, now)
+		if a.commitBatch.isDue(now, getCommitBatchSize(), getCommitInterval()) {
+			a.createDoltCommits(ctx, engine)
 		}
 	}
 
 	return nil
 }
 
+// queryEndsTransaction returns whether the query event with the statement |query| ends the replicated transaction it
+// is part of, and whether it is a transaction control statement. A COMMIT or ROLLBACK ends the transaction started by
+// a BEGIN, and a statement outside of one, such as DDL, is a transaction of its own. The statements in between a BEGIN
+// and the end of its transaction are not, since committing after them would commit part of the transaction.
+func (a *binlogReplicaApplier) queryEndsTransaction(query string) (endsTransaction bool, isTransactionControl bool) {
+	switch strings.ToLower(strings.TrimSpace(query)) {
+	case "begin":
+		a.inExplicitTransaction = true
+		return false, true
+	case "commit", "rollback":
+		return true, true
+	default:
+		return !a.inExplicitTransaction, false
+	}
+}
+
+// createDoltCommits creates a Dolt commit in each user database for the replicated transactions in the current
+// batch. The commit message identifies the GTIDs of the transactions, and the commit date is the source timestamp of
+// the last transaction, so that dolt_log and the dolt_history tables reflect changes on the source server.
+func (a *binlogReplicaApplier) createDoltCommits(ctx *sql.Context, engine *gms.Engine) {
+	if a.commitBatch.isEmpty() {
+		return
+	}
+
+	ctx.GetLogger().Trace("Creating Dolt commit(s)")
+	commitCall := fmt.Sprintf("call dolt_commit('-Am', '%s');", a.commitBatch.commitMessage())
+	if date := a.commitBatch.commitDate(); date != "" {
+		commitCall = fmt.Sprintf("call dolt_commit('-Am', '%s', '--date', '%s');", a.commitBatch.commitMessage(), date)
+	}
+	for _, database := range getAllUserDatabaseNames(ctx, engine) {
+		a.executeQueryWithEngine(ctx, engine, "use `"+database+"`;")
+		a.executeQueryWithEngine(ctx, engine, commitCall)
+	}
+	a.commitBatch.reset()
+}
+
 // processRowEvent processes a WriteRows, DeleteRows, or UpdateRows binlog event and returns an error if any problems
 // were encountered.
 func (a *binlogReplicaApplier) processRowEvent(ctx *sql.Context, event mysql.BinlogEvent, engine *gms.Engine) error {
@@ -598,6 +676,7 @@ func (a *binlogReplicaApplier) processRowEvent(ctx *sql.Context, event mysql.Bin
 	if a.filters.isTableFilteredOut(ctx, tableMap) {
 		return nil
 	}
+	databaseName := a.filters.rewriteDatabase(tableMap.Database)
 
 	rows, err := event.Rows(*a.format, tableMap)
 	if err != nil {
@@ -621,9 +700,9 @@ func (a *binlogReplicaApplier) processRowEvent(ctx *sql.Context, event mysql.Bin
 	if flags != 0 {
 		msg := fmt.Sprintf("unsupported binlog protocol message: row event with unsupported flags '%x'", flags)
 		ctx.GetLogger().Errorf(msg)
-		DoltBinlogReplicaController.setSqlError(mysql.ERUnknownError, msg)
+		a.channel.setSqlError(mysql.ERUnknownError, msg)
 	}
-	schema, tableName, err := getTableSchema(ctx, engine, tableMap.Name, tableMap.Database)
+	schema, tableName, err := getTableSchema(ctx, engine, tableMap.Name, databaseName)
 	if err != nil {
 		return err
 	}
@@ -637,7 +716,7 @@ func (a *binlogReplicaApplier) processRowEvent(ctx *sql.Context, event mysql.Bin
 		ctx.GetLogger().Tracef(" - Inserted Rows (table: %s)", tableMap.Name)
 	}
 
-	writeSession, tableWriter, err := getTableWriter(ctx, engine, tableName, tableMap.Database, foreignKeyChecksDisabled)
+	writeSession, tableWriter, err := getTableWriter(ctx, engine, tableName, databaseName, foreignKeyChecksDisabled)
 	if err != nil {
 		return err
 	}
@@ -674,7 +753,7 @@ func (a *binlogReplicaApplier) processRowEvent(ctx *sql.Context, event mysql.Bin
 
 	}
 
-	err = closeWriteSession(ctx, engine, tableMap.Database, writeSession)
+	err = closeWriteSession(ctx, engine, databaseName, writeSession)
 	if err != nil {
 		return err
 	}
@@ -946,7 +1025,7 @@ func loadReplicaServerId() (uint32, error) {
 	return serverId, nil
 }
 
-func executeQueryWithEngine(ctx *sql.Context, engine *gms.Engine, query string) {
+func (a *binlogReplicaApplier) executeQueryWithEngine(ctx *sql.Context, engine *gms.Engine, query string) {
 	// Create a sub-context when running queries against the engine, so that we get an accurate query start time.
 	queryCtx := sql.NewContext(ctx, sql.WithSession(ctx.Session))
 
@@ -965,7 +1044,7 @@ func executeQueryWithEngine(ctx *sql.Context, engine *gms.Engine, query string)
 				"query": query,
 			}).Errorf("Error executing query")
 			msg := fmt.Sprintf("Error executing query: %v", err.Error())
-			DoltBinlogReplicaController.setSqlError(mysql.ERUnknownError, msg)
+			a.channel.setSqlError(mysql.ERUnknownError, msg)
 		}
 		return
 	}
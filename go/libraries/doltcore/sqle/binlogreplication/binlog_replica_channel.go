@@ -0,0 +1,255 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlogreplication
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/binlogreplication"
+	"github.com/dolthub/vitess/go/mysql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+)
+
+// defaultChannel is the name of the replication channel used when no channel is selected.
+const defaultChannel = ""
+
+// maxChannelNameLength is the longest channel name MySQL allows.
+const maxChannelNameLength = 64
+
+// validChannelName matches channel names that can be safely used in the names of the files that hold a channel's
+// replication metadata.
+var validChannelName = regexp.MustCompile(`^[a-z0-9_-]*$`)
+
+// replicaChannel holds the state of a single replication channel. Each channel replicates from its own source server,
+// and has its own status, position and applier, so that a failure in one channel does not affect any other channel.
+type replicaChannel struct {
+	name    string
+	status  binlogreplication.ReplicaStatus
+	applier *binlogReplicaApplier
+
+	// ctx is the execution context used by the applier of a channel other than the default channel
+	ctx *sql.Context
+
+	// executedGtids holds the GTIDs from this channel's source server that have been applied on this replica.
+	executedGtids mysql.GTIDSet
+
+	// statusMutex blocks concurrent access to the ReplicaStatus struct and executedGtids
+	statusMutex *sync.Mutex
+}
+
+// newReplicaChannel creates a new replicaChannel named |name|, whose applier applies changes that pass |filters|.
+func newReplicaChannel(name string, filters *filterConfiguration) *replicaChannel {
+	channel := &replicaChannel{
+		name:        name,
+		statusMutex: &sync.Mutex{},
+	}
+	channel.status.ConnectRetry = 60
+	channel.status.SourceRetryCount = 86400
+	channel.status.AutoPosition = true
+	channel.status.ReplicaIoRunning = binlogreplication.ReplicaIoNotRunning
+	channel.status.ReplicaSqlRunning = binlogreplication.ReplicaSqlNotRunning
+	channel.applier = newBinlogReplicaApplier(channel, filters)
+	return channel
+}
+
+// normalizeChannelName returns the lower-cased form of |name|, since channel names are not case-sensitive, and returns
+// an error if |name| is not a valid channel name.
+func normalizeChannelName(name string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if len(name) > maxChannelNameLength {
+		return "", fmt.Errorf("invalid replication channel name '%s'; "+
+			"channel names may be at most %d characters long", name, maxChannelNameLength)
+	}
+	if !validChannelName.MatchString(name) {
+		return "", fmt.Errorf("invalid replication channel name '%s'; "+
+			"channel names may only contain letters, digits, underscores and hyphens", name)
+	}
+	return name, nil
+}
+
+// selectedChannelName returns the name of the replication channel that the replication statement executed by the
+// session of |ctx| applies to. That is the channel named by the statement's FOR CHANNEL clause, if it has one, and
+// otherwise the channel set by @@dolt_binlog_replica_channel.
+func selectedChannelName(ctx *sql.Context) (string, error) {
+	if ctx == nil || ctx.Session == nil {
+		return defaultChannel, nil
+	}
+	if channel, ok := pendingChannels.LoadAndDelete(ctx.Session.ID()); ok {
+		return channel.(string), nil
+	}
+
+	value, err := ctx.GetSessionVariable(ctx, dsess.BinlogReplicaChannel)
+	if err != nil {
+		return defaultChannel, nil
+	}
+
+	name, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("invalid value for @@%s: %v", dsess.BinlogReplicaChannel, value)
+	}
+	return normalizeChannelName(name)
+}
+
+// channelFilename returns the name of the file in the .doltcfg directory that holds the metadata named |filename| for
+// |channel|. The default channel uses |filename| as is, so that existing replicas keep their metadata.
+func channelFilename(filename, channel string) string {
+	if channel == defaultChannel {
+		return filename
+	}
+	return filename + "-" + channel
+}
+
+// updateStatus allows the caller to safely update the channel's status. The channel locks its mutex before the
+// specified function |f| is called, and unlocks it after |f| is finished running. The current status is passed into
+// the callback function |f| and the caller can safely update or copy any fields they need.
+func (c *replicaChannel) updateStatus(f func(status *binlogreplication.ReplicaStatus)) {
+	c.statusMutex.Lock()
+	defer c.statusMutex.Unlock()
+	f(&c.status)
+}
+
+// setIoError updates the channel's status with the specific |errno| and |message| to describe an IO error.
+func (c *replicaChannel) setIoError(errno uint, message string) {
+	c.statusMutex.Lock()
+	defer c.statusMutex.Unlock()
+
+	// truncate the message to avoid errors when reporting replica status
+	if len(message) > 256 {
+		message = message[:256]
+	}
+
+	currentTime := time.Now()
+	c.status.LastIoErrorTimestamp = &currentTime
+	c.status.LastIoErrNumber = errno
+	c.status.LastIoError = message
+}
+
+// setSqlError updates the channel's status with the specific |errno| and |message| to describe an SQL error.
+func (c *replicaChannel) setSqlError(errno uint, message string) {
+	c.statusMutex.Lock()
+	defer c.statusMutex.Unlock()
+
+	// truncate the message to avoid errors when reporting replica status
+	if len(message) > 256 {
+		message = message[:256]
+	}
+
+	currentTime := time.Now()
+	c.status.LastSqlErrorTimestamp = &currentTime
+	c.status.LastSqlErrNumber = errno
+	c.status.LastSqlError = message
+}
+
+// setExecutedGtids records |gtids| as the set of GTIDs from this channel's source that have been applied.
+func (c *replicaChannel) setExecutedGtids(gtids mysql.GTIDSet) {
+	c.statusMutex.Lock()
+	defer c.statusMutex.Unlock()
+	c.executedGtids = gtids
+}
+
+// getStatus returns a copy of the channel's current status.
+func (c *replicaChannel) getStatus() binlogreplication.ReplicaStatus {
+	c.statusMutex.Lock()
+	defer c.statusMutex.Unlock()
+
+	status := c.status
+	if c.executedGtids != nil {
+		status.ExecutedGtidSet = c.executedGtids.String()
+		status.RetrievedGtidSet = status.ExecutedGtidSet
+	}
+	return status
+}
+
+// getExecutedGtids returns the set of GTIDs from this channel's source that have been applied.
+func (c *replicaChannel) getExecutedGtids() mysql.GTIDSet {
+	c.statusMutex.Lock()
+	defer c.statusMutex.Unlock()
+	return c.executedGtids
+}
+
+// gtidInterval is an inclusive range of GTID sequence numbers.
+type gtidInterval struct {
+	start, end int64
+}
+
+// unionGtidSets returns the set of GTIDs that are in |a|, |b| or both. The intervals of a Mysql56GTIDSet are not
+// exported and this version of vitess has no Mysql56GTIDSet.Union, so the intervals of both sets are read from their
+// string forms, merged, and parsed back into a new set.
+func unionGtidSets(a, b mysql.Mysql56GTIDSet) (mysql.Mysql56GTIDSet, error) {
+	intervals := make(map[mysql.SID][]gtidInterval)
+	for _, set := range []mysql.Mysql56GTIDSet{a, b} {
+		for sid := range set {
+			// The string form of a single source's GTIDs is uuid:interval[:interval]...
+			parts := strings.Split((mysql.Mysql56GTIDSet{sid: set[sid]}).String(), ":")
+			for _, part := range parts[1:] {
+				bounds := strings.SplitN(part, "-", 2)
+				start, err := strconv.ParseInt(bounds[0], 10, 64)
+				if err != nil {
+					return nil, err
+				}
+				end := start
+				if len(bounds) == 2 {
+					end, err = strconv.ParseInt(bounds[1], 10, 64)
+					if err != nil {
+						return nil, err
+					}
+				}
+				intervals[sid] = append(intervals[sid], gtidInterval{start: start, end: end})
+			}
+		}
+	}
+
+	sb := strings.Builder{}
+	for sid, ivs := range intervals {
+		sort.Slice(ivs, func(i, j int) bool { return ivs[i].start < ivs[j].start })
+		merged := ivs[:1]
+		for _, iv := range ivs[1:] {
+			last := &merged[len(merged)-1]
+			if iv.start <= last.end+1 {
+				if iv.end > last.end {
+					last.end = iv.end
+				}
+			} else {
+				merged = append(merged, iv)
+			}
+		}
+
+		if sb.Len() > 0 {
+			sb.WriteString(",")
+		}
+		sb.WriteString(sid.String())
+		for _, iv := range merged {
+			if iv.start == iv.end {
+				sb.WriteString(fmt.Sprintf(":%d", iv.start))
+			} else {
+				sb.WriteString(fmt.Sprintf(":%d-%d", iv.start, iv.end))
+			}
+		}
+	}
+
+	union, err := mysql.ParseMysql56GTIDSet(sb.String())
+	if err != nil {
+		return nil, err
+	}
+	return union.(mysql.Mysql56GTIDSet), nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlogreplication
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNormalizeChannelName tests that channel names are lower-cased and validated.
+func TestNormalizeChannelName(t *testing.T) {
+	name, err := normalizeChannelName(" Source_1 ")
+	require.NoError(t, err)
+	require.Equal(t, "source_1", name)
+
+	name, err = normalizeChannelName("")
+	require.NoError(t, err)
+	require.Equal(t, defaultChannel, name)
+
+	_, err = normalizeChannelName("../source")
+	require.ErrorContains(t, err, "may only contain letters, digits, underscores and hyphens")
+
+	_, err = normalizeChannelName(strings.Repeat("a", maxChannelNameLength+1))
+	require.ErrorContains(t, err, "may be at most 64 characters long")
+}
+
+// TestChannelFilename tests that the default channel keeps the original metadata filenames.
+func TestChannelFilename(t *testing.T) {
+	require.Equal(t, "binlog-position", channelFilename("binlog-position", defaultChannel))
+	require.Equal(t, "binlog-position-source_1", channelFilename("binlog-position", "source_1"))
+}
+
+// TestExecutedGtids tests that the executed GTIDs of all channels are merged into a single GTID set.
+func TestExecutedGtids(t *testing.T) {
+	controller := newDoltBinlogReplicaController()
+
+	gtids, err := mysql.ParseMysql56GTIDSet("3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5")
+	require.NoError(t, err)
+	controller.getChannel(defaultChannel).setExecutedGtids(gtids)
+
+	gtids, err = mysql.ParseMysql56GTIDSet("3e11fa47-71ca-11e1-9e33-c80aa9429562:1-3:7-9," +
+		"4f22ab58-71ca-11e1-9e33-c80aa9429562:1-10")
+	require.NoError(t, err)
+	controller.getChannel("source_2").setExecutedGtids(gtids)
+
+	executed, err := controller.executedGtids()
+	require.NoError(t, err)
+	require.Equal(t, "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5:7-9,"+
+		"4f22ab58-71ca-11e1-9e33-c80aa9429562:1-10", executed.String())
+	require.Equal(t, "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-3:7-9,"+
+		"4f22ab58-71ca-11e1-9e33-c80aa9429562:1-10", controller.getChannel("source_2").getStatus().ExecutedGtidSet)
+}
+
+// TestUnionGtidSets tests that GTID sets are merged into a set containing the GTIDs of both.
+func TestUnionGtidSets(t *testing.T) {
+	parse := func(s string) mysql.Mysql56GTIDSet {
+		set, err := mysql.ParseMysql56GTIDSet(s)
+		require.NoError(t, err)
+		return set.(mysql.Mysql56GTIDSet)
+	}
+
+	tests := []struct {
+		a, b     string
+		expected string
+	}{
+		{"", "", ""},
+		{"3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5", "", "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5"},
+		{"3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5", "3e11fa47-71ca-11e1-9e33-c80aa9429562:3-8",
+			"3e11fa47-71ca-11e1-9e33-c80aa9429562:1-8"},
+		{"3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5", "3e11fa47-71ca-11e1-9e33-c80aa9429562:6:10-12",
+			"3e11fa47-71ca-11e1-9e33-c80aa9429562:1-6:10-12"},
+		{"3e11fa47-71ca-11e1-9e33-c80aa9429562:1-3:8-9", "3e11fa47-71ca-11e1-9e33-c80aa9429562:5",
+			"3e11fa47-71ca-11e1-9e33-c80aa9429562:1-3:5:8-9"},
+		{"3e11fa47-71ca-11e1-9e33-c80aa9429562:1-3", "4f22ab58-71ca-11e1-9e33-c80aa9429562:1-10",
+			"3e11fa47-71ca-11e1-9e33-c80aa9429562:1-3,4f22ab58-71ca-11e1-9e33-c80aa9429562:1-10"},
+	}
+	for _, tt := range tests {
+		union, err := unionGtidSets(parse(tt.a), parse(tt.b))
+		require.NoError(t, err)
+		require.True(t, parse(tt.expected).Equal(union), "%s ∪ %s = %s", tt.a, tt.b, union.String())
+	}
+}
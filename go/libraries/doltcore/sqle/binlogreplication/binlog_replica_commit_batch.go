@@ -0,0 +1,118 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlogreplication
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+)
+
+// commitDateLayout is the format of the commit date passed to dolt_commit for the source timestamp of a batch.
+const commitDateLayout = "2006-01-02T15:04:05Z07:00"
+
+// doltCommitBatch tracks the replicated transactions that have been applied to the working set, but have not been
+// included in a Dolt commit yet. Depending on @@dolt_binlog_replica_commit_batch_size and
+// @@dolt_binlog_replica_commit_interval_secs, a Dolt commit is created for every replicated transaction, or for a
+// batch of replicated transactions.
+type doltCommitBatch struct {
+	// gtids holds the GTIDs of the transactions in this batch
+	gtids mysql.GTIDSet
+	// lastGtid is the GTID of the last transaction added to this batch
+	lastGtid mysql.GTID
+	// transactionCount is the number of transactions in this batch
+	transactionCount int64
+	// startTime is the time, on this replica, when the first transaction in this batch was applied
+	startTime time.Time
+	// sourceTime is the time, on the source server, of the last transaction in this batch
+	sourceTime time.Time
+}
+
+// add records that the transaction identified by |gtid|, with the source timestamp |sourceTime|, was applied at |now|.
+// A transaction is only counted the first time it is added.
+func (b *doltCommitBatch) add(gtid mysql.GTID, sourceTime time.Time, now time.Time) {
+	if b.transactionCount == 0 {
+		b.startTime = now
+	}
+	if !sourceTime.IsZero() {
+		b.sourceTime = sourceTime
+	}
+	if gtid == nil || gtid == b.lastGtid {
+		if b.transactionCount == 0 {
+			b.transactionCount = 1
+		}
+		return
+	}
+
+	if b.gtids == nil {
+		b.gtids = gtid.GTIDSet()
+	} else {
+		b.gtids = b.gtids.AddGTID(gtid)
+	}
+	b.lastGtid = gtid
+	b.transactionCount++
+}
+
+// isEmpty returns true if no transactions have been added to this batch.
+func (b *doltCommitBatch) isEmpty() bool {
+	return b.transactionCount == 0
+}
+
+// isDue returns true if a Dolt commit should be created for this batch at |now|, because it holds at least
+// |batchSize| transactions or its first transaction was applied at least |interval| ago. A zero |batchSize| or
+// |interval| disables that limit, and when both are zero, a batch is never due.
+func (b *doltCommitBatch) isDue(now time.Time, batchSize int64, interval time.Duration) bool {
+	if b.isEmpty() {
+		return false
+	}
+	if batchSize > 0 && b.transactionCount >= batchSize {
+		return true
+	}
+	return interval > 0 && now.Sub(b.startTime) >= interval
+}
+
+// commitMessage returns the message for the Dolt commit created for this batch, which identifies the GTIDs and the
+// source timestamp of the replicated transactions it contains.
+func (b *doltCommitBatch) commitMessage() string {
+	var msg string
+	switch {
+	case b.gtids == nil:
+		msg = "Dolt binlog replica commit"
+	case b.transactionCount == 1:
+		msg = fmt.Sprintf("Dolt binlog replica commit: GTID %s", b.lastGtid)
+	default:
+		msg = fmt.Sprintf("Dolt binlog replica commit: GTIDs %s (%d transactions)", b.gtids, b.transactionCount)
+	}
+
+	if !b.sourceTime.IsZero() {
+		msg += fmt.Sprintf(" at source timestamp %s", b.commitDate())
+	}
+	return msg
+}
+
+// commitDate returns the source timestamp of the last transaction in this batch, formatted for dolt_commit's --date
+// option, or an empty string if the source timestamp is not known.
+func (b *doltCommitBatch) commitDate() string {
+	if b.sourceTime.IsZero() {
+		return ""
+	}
+	return b.sourceTime.UTC().Format(commitDateLayout)
+}
+
+// reset clears this batch after a Dolt commit has been created for it.
+func (b *doltCommitBatch) reset() {
+	*b = doltCommitBatch{}
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlogreplication
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDoltCommitBatch tests that replicated transactions are batched by transaction count and time, and that the
+// commit message identifies the GTIDs and source timestamp of the batch.
+func TestDoltCommitBatch(t *testing.T) {
+	sid, err := mysql.ParseSID("3e11fa47-71ca-11e1-9e33-c80aa9429562")
+	require.NoError(t, err)
+	start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	sourceTime := time.Date(2024, 5, 1, 11, 59, 58, 0, time.UTC)
+
+	t.Run("single transaction", func(t *testing.T) {
+		batch := doltCommitBatch{}
+		require.False(t, batch.isDue(start, 1, 0))
+
+		batch.add(mysql.Mysql56GTID{Server: sid, Sequence: 7}, sourceTime, start)
+		require.True(t, batch.isDue(start, 1, 0))
+		require.Equal(t, "Dolt binlog replica commit: GTID 3e11fa47-71ca-11e1-9e33-c80aa9429562:7 "+
+			"at source timestamp 2024-05-01T11:59:58Z", batch.commitMessage())
+		require.Equal(t, "2024-05-01T11:59:58Z", batch.commitDate())
+
+		batch.reset()
+		require.True(t, batch.isEmpty())
+	})
+
+	t.Run("batched by transaction count", func(t *testing.T) {
+		batch := doltCommitBatch{}
+		batch.add(mysql.Mysql56GTID{Server: sid, Sequence: 1}, sourceTime, start)
+		batch.add(mysql.Mysql56GTID{Server: sid, Sequence: 1}, sourceTime, start)
+		batch.add(mysql.Mysql56GTID{Server: sid, Sequence: 2}, sourceTime, start)
+		require.False(t, batch.isDue(start, 3, 0))
+
+		batch.add(mysql.Mysql56GTID{Server: sid, Sequence: 3}, sourceTime.Add(time.Second), start)
+		require.True(t, batch.isDue(start, 3, 0))
+		require.Equal(t, "Dolt binlog replica commit: GTIDs 3e11fa47-71ca-11e1-9e33-c80aa9429562:1-3 "+
+			"(3 transactions) at source timestamp 2024-05-01T11:59:59Z", batch.commitMessage())
+	})
+
+	t.Run("batched by time", func(t *testing.T) {
+		batch := doltCommitBatch{}
+		batch.add(mysql.Mysql56GTID{Server: sid, Sequence: 1}, time.Time{}, start)
+		batch.add(mysql.Mysql56GTID{Server: sid, Sequence: 2}, time.Time{}, start.Add(5*time.Second))
+		require.False(t, batch.isDue(start.Add(9*time.Second), 0, 10*time.Second))
+		require.True(t, batch.isDue(start.Add(10*time.Second), 0, 10*time.Second))
+		require.True(t, batch.isDue(start.Add(time.Second), 2, 10*time.Second))
+		require.False(t, batch.isDue(start.Add(time.Hour), 0, 0))
+		require.Equal(t, "", batch.commitDate())
+	})
+}
+
+// TestQueryEndsTransaction tests that only the statements which end a replicated transaction, and not the statements
+// inside of one, create a commit.
+func TestQueryEndsTransaction(t *testing.T) {
+	applier := &binlogReplicaApplier{}
+
+	// A statement outside of an explicit transaction, such as DDL, is a transaction of its own
+	endsTransaction, isTransactionControl := applier.queryEndsTransaction("create table t (pk int primary key)")
+	require.True(t, endsTransaction)
+	require.False(t, isTransactionControl)
+
+	endsTransaction, isTransactionControl = applier.queryEndsTransaction("BEGIN")
+	require.False(t, endsTransaction)
+	require.True(t, isTransactionControl)
+
+	// Statement-based replication sends each statement of the transaction as its own query event
+	endsTransaction, isTransactionControl = applier.queryEndsTransaction("insert into t values (1)")
+	require.False(t, endsTransaction)
+	require.False(t, isTransactionControl)
+	endsTransaction, _ = applier.queryEndsTransaction("insert into t values (2)")
+	require.False(t, endsTransaction)
+
+	endsTransaction, isTransactionControl = applier.queryEndsTransaction("COMMIT")
+	require.True(t, endsTransaction)
+	require.True(t, isTransactionControl)
+
+	// The applier resets the explicit transaction once it is committed
+	applier.inExplicitTransaction = false
+	endsTransaction, _ = applier.queryEndsTransaction("insert into t values (3)")
+	require.True(t, endsTransaction)
+
+	applier.queryEndsTransaction("BEGIN")
+	endsTransaction, isTransactionControl = applier.queryEndsTransaction("ROLLBACK")
+	require.True(t, endsTransaction)
+	require.True(t, isTransactionControl)
+}
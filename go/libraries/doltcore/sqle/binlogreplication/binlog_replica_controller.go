@@ -19,8 +19,8 @@ import (
 	"fmt"
 	"strings"
 	"sync"
-	"time"
 
+	"github.com/dolthub/vitess/go/mysql"
 	"github.com/sirupsen/logrus"
 
 	sqle "github.com/dolthub/go-mysql-server"
@@ -54,16 +54,24 @@ var ErrReplicationStopped = fmt.Errorf("replication stop requested")
 // doltBinlogReplicaController implements the BinlogReplicaController interface for a Dolt database in order to
 // provide support for a Dolt server to be a replica of a MySQL primary.
 //
+// A replica can replicate from several sources at once, each through its own named replication channel. Replication
+// statements apply to the channel named by their FOR CHANNEL clause, which replicaStatementParser parses, or otherwise
+// to the channel selected by the session's @@dolt_binlog_replica_channel system variable, which is empty by default,
+// selecting the default channel. BinlogReplicaController.GetReplicaStatus returns a single status, so SHOW REPLICA
+// STATUS without FOR CHANNEL reports only the selected channel rather than one row per channel.
+//
 // This type is used concurrently – multiple sessions on the DB can call this interface concurrently,
 // so all state that the controller tracks MUST be protected with a mutex.
 type doltBinlogReplicaController struct {
-	status  binlogreplication.ReplicaStatus
-	filters *filterConfiguration
-	applier *binlogReplicaApplier
-	ctx     *sql.Context
+	filters  *filterConfiguration
+	channels map[string]*replicaChannel
+	ctx      *sql.Context
+
+	// ctxFactory creates the execution contexts used by the appliers of channels other than the default channel
+	ctxFactory func() (*sql.Context, error)
 
-	// statusMutex blocks concurrent access to the ReplicaStatus struct
-	statusMutex *sync.Mutex
+	// channelsMutex blocks concurrent access to the channels map
+	channelsMutex *sync.Mutex
 
 	// operationMutex blocks concurrent access to the START/STOP/RESET REPLICA operations
 	operationMutex *sync.Mutex
@@ -76,27 +84,110 @@ var _ binlogreplication.BinlogReplicaController = (*doltBinlogReplicaController)
 func newDoltBinlogReplicaController() *doltBinlogReplicaController {
 	controller := doltBinlogReplicaController{
 		filters:        newFilterConfiguration(),
-		statusMutex:    &sync.Mutex{},
+		channels:       make(map[string]*replicaChannel),
+		channelsMutex:  &sync.Mutex{},
 		operationMutex: &sync.Mutex{},
 	}
-	controller.status.ConnectRetry = 60
-	controller.status.SourceRetryCount = 86400
-	controller.status.AutoPosition = true
-	controller.status.ReplicaIoRunning = binlogreplication.ReplicaIoNotRunning
-	controller.status.ReplicaSqlRunning = binlogreplication.ReplicaSqlNotRunning
-	controller.applier = newBinlogReplicaApplier(controller.filters)
+	controller.channels[defaultChannel] = newReplicaChannel(defaultChannel, controller.filters)
 	return &controller
 }
 
+// getChannel returns the replication channel named |name|, creating it if it does not exist yet.
+func (d *doltBinlogReplicaController) getChannel(name string) *replicaChannel {
+	d.channelsMutex.Lock()
+	defer d.channelsMutex.Unlock()
+
+	channel, ok := d.channels[name]
+	if !ok {
+		channel = newReplicaChannel(name, d.filters)
+		channel.applier.engine = d.engine
+		d.channels[name] = channel
+	}
+	return channel
+}
+
+// sessionChannel returns the replication channel selected by the session of |ctx|.
+func (d *doltBinlogReplicaController) sessionChannel(ctx *sql.Context) (*replicaChannel, error) {
+	name, err := selectedChannelName(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return d.getChannel(name), nil
+}
+
+// allChannels returns every replication channel the controller knows about.
+func (d *doltBinlogReplicaController) allChannels() []*replicaChannel {
+	d.channelsMutex.Lock()
+	defer d.channelsMutex.Unlock()
+
+	channels := make([]*replicaChannel, 0, len(d.channels))
+	for _, channel := range d.channels {
+		channels = append(channels, channel)
+	}
+	return channels
+}
+
+// executedGtids returns the set of GTIDs that have been applied on this replica from all channels, which is the union
+// of the GTIDs each channel has applied.
+func (d *doltBinlogReplicaController) executedGtids() (mysql.GTIDSet, error) {
+	executed := mysql.Mysql56GTIDSet{}
+	for _, channel := range d.allChannels() {
+		gtids, ok := channel.getExecutedGtids().(mysql.Mysql56GTIDSet)
+		if !ok {
+			continue
+		}
+		var err error
+		executed, err = unionGtidSets(executed, gtids)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return executed, nil
+}
+
+// executionContext returns the context that |channel|'s applier uses to apply changes. The default channel uses the
+// context set with SetExecutionContext, and each other channel uses a new context from the context factory, since
+// appliers run concurrently and cannot share a session.
+func (d *doltBinlogReplicaController) executionContext(channel *replicaChannel) (*sql.Context, error) {
+	if channel.name == defaultChannel {
+		if d.ctx == nil {
+			return nil, fmt.Errorf("no execution context set for the replica controller")
+		}
+		return d.ctx, nil
+	}
+
+	if channel.ctx == nil {
+		if d.ctxFactory == nil {
+			return nil, fmt.Errorf("unable to start replication channel '%s': "+
+				"no execution context factory set for the replica controller", channel.name)
+		}
+		ctx, err := d.ctxFactory()
+		if err != nil {
+			return nil, err
+		}
+		channel.ctx = ctx
+	}
+	return channel.ctx, nil
+}
+
 // StartReplica implements the BinlogReplicaController interface.
 func (d *doltBinlogReplicaController) StartReplica(ctx *sql.Context) error {
+	channel, err := d.sessionChannel(ctx)
+	if err != nil {
+		return err
+	}
+	return d.startReplica(ctx, channel)
+}
+
+// startReplica starts replication for |channel|.
+func (d *doltBinlogReplicaController) startReplica(ctx *sql.Context, channel *replicaChannel) error {
 	d.operationMutex.Lock()
 	defer d.operationMutex.Unlock()
 
 	// START REPLICA may be called multiple times, but if replication is already running,
 	// it will log a warning and not start up new threads.
-	if d.applier.IsRunning() {
-		ctx.Warn(3083, "Replication thread(s) for channel '' are already running.")
+	if channel.applier.IsRunning() {
+		ctx.Warn(3083, "Replication thread(s) for channel '%s' are already running.", channel.name)
 		return nil
 	}
 
@@ -116,21 +207,22 @@ func (d *doltBinlogReplicaController) StartReplica(ctx *sql.Context) error {
 		return fmt.Errorf("unable to start replication: %s", err.Error())
 	}
 
-	configuration, err := loadReplicationConfiguration(ctx, d.engine.Analyzer.Catalog.MySQLDb)
+	configuration, err := loadReplicationConfiguration(ctx, d.engine.Analyzer.Catalog.MySQLDb, channel.name)
 	if err != nil {
 		return err
 	} else if configuration == nil {
 		return ErrServerNotConfiguredAsReplica
 	} else if configuration.Host == "" {
-		DoltBinlogReplicaController.setIoError(ERFatalReplicaError, ErrEmptyHostname.Error())
+		channel.setIoError(ERFatalReplicaError, ErrEmptyHostname.Error())
 		return ErrEmptyHostname
 	} else if configuration.User == "" {
-		DoltBinlogReplicaController.setIoError(ERFatalReplicaError, ErrEmptyUsername.Error())
+		channel.setIoError(ERFatalReplicaError, ErrEmptyUsername.Error())
 		return ErrEmptyUsername
 	}
 
-	if d.ctx == nil {
-		return fmt.Errorf("no execution context set for the replica controller")
+	executionCtx, err := d.executionContext(channel)
+	if err != nil {
+		return err
 	}
 
 	err = d.configureReplicationUser(ctx)
@@ -139,17 +231,17 @@ func (d *doltBinlogReplicaController) StartReplica(ctx *sql.Context) error {
 	}
 
 	// Set execution context's user to the binlog replication user
-	d.ctx.SetClient(sql.Client{
+	executionCtx.SetClient(sql.Client{
 		User:    binlogApplierUser,
 		Address: "localhost",
 	})
 
-	ctx.GetLogger().Info("starting binlog replication...")
-	d.applier.Go(d.ctx)
+	ctx.GetLogger().Infof("starting binlog replication for channel '%s'...", channel.name)
+	channel.applier.Go(executionCtx)
 
 	// Attempt to record that the replica has started replication so that it will
 	// start automatically the next time the replica server is started.
-	if err := persistReplicaRunningState(ctx, running); err != nil {
+	if err := persistReplicaRunningState(ctx, channel.name, running); err != nil {
 		ctx.GetLogger().Errorf("unable to persist replica running state: %s", err.Error())
 	}
 
@@ -186,37 +278,53 @@ func (d *doltBinlogReplicaController) configureReplicationUser(ctx *sql.Context)
 	return nil
 }
 
-// SetExecutionContext sets the unique |ctx| for the replica's applier to use when applying changes from binlog events
-// to a database. The applier cannot reuse any existing context, because it executes in a separate routine and would
-// cause race conditions.
+// SetExecutionContext sets the unique |ctx| for the default channel's applier to use when applying changes from binlog
+// events to a database. The applier cannot reuse any existing context, because it executes in a separate routine and
+// would cause race conditions.
 func (d *doltBinlogReplicaController) SetExecutionContext(ctx *sql.Context) {
 	d.ctx = ctx
 }
 
+// SetExecutionContextFactory sets the function used to create a unique execution context, with its own session, for
+// the applier of each replication channel other than the default channel.
+func (d *doltBinlogReplicaController) SetExecutionContextFactory(factory func() (*sql.Context, error)) {
+	d.ctxFactory = factory
+}
+
 // SetEngine sets the SQL engine this replica will use when running replicated statements and
 // when loading the Catalog to find the "mysql" database.
 func (d *doltBinlogReplicaController) SetEngine(engine *sqle.Engine) {
+	d.channelsMutex.Lock()
+	defer d.channelsMutex.Unlock()
+
 	d.engine = engine
-	d.applier.engine = engine
+	for _, channel := range d.channels {
+		channel.applier.engine = engine
+	}
 }
 
 // StopReplica implements the BinlogReplicaController interface.
 func (d *doltBinlogReplicaController) StopReplica(ctx *sql.Context) error {
-	if d.applier.IsRunning() == false {
-		ctx.Warn(3084, "Replication thread(s) for channel '' are already stopped.")
+	channel, err := d.sessionChannel(ctx)
+	if err != nil {
+		return err
+	}
+
+	if channel.applier.IsRunning() == false {
+		ctx.Warn(3084, "Replication thread(s) for channel '%s' are already stopped.", channel.name)
 		return nil
 	}
 
-	d.applier.stopReplicationChan <- struct{}{}
+	channel.applier.stopReplicationChan <- struct{}{}
 
-	d.updateStatus(func(status *binlogreplication.ReplicaStatus) {
+	channel.updateStatus(func(status *binlogreplication.ReplicaStatus) {
 		status.ReplicaIoRunning = binlogreplication.ReplicaIoNotRunning
 		status.ReplicaSqlRunning = binlogreplication.ReplicaSqlNotRunning
 	})
 
 	// Attempt to record that the replica has stopped replication so that it will not
 	// start automatically the next time the replica server is started.
-	if err := persistReplicaRunningState(ctx, notRunning); err != nil {
+	if err := persistReplicaRunningState(ctx, channel.name, notRunning); err != nil {
 		ctx.GetLogger().Errorf("unable to persist replica running state: %s", err.Error())
 	}
 
@@ -225,7 +333,12 @@ func (d *doltBinlogReplicaController) StopReplica(ctx *sql.Context) error {
 
 // SetReplicationSourceOptions implements the BinlogReplicaController interface.
 func (d *doltBinlogReplicaController) SetReplicationSourceOptions(ctx *sql.Context, options []binlogreplication.ReplicationOption) error {
-	replicaSourceInfo, err := loadReplicationConfiguration(ctx, d.engine.Analyzer.Catalog.MySQLDb)
+	channel, err := d.sessionChannel(ctx)
+	if err != nil {
+		return err
+	}
+
+	replicaSourceInfo, err := loadReplicationConfiguration(ctx, d.engine.Analyzer.Catalog.MySQLDb, channel.name)
 	if err != nil {
 		return err
 	}
@@ -286,13 +399,31 @@ func (d *doltBinlogReplicaController) SetReplicationSourceOptions(ctx *sql.Conte
 	}
 
 	// Persist the updated replica source configuration to disk
-	return persistReplicationConfiguration(ctx, replicaSourceInfo, d.engine.Analyzer.Catalog.MySQLDb)
+	return persistReplicationConfiguration(ctx, channel.name, replicaSourceInfo, d.engine.Analyzer.Catalog.MySQLDb)
 }
 
 // SetReplicationFilterOptions implements the BinlogReplicaController interface.
-func (d *doltBinlogReplicaController) SetReplicationFilterOptions(_ *sql.Context, options []binlogreplication.ReplicationOption) error {
+func (d *doltBinlogReplicaController) SetReplicationFilterOptions(ctx *sql.Context, options []binlogreplication.ReplicationOption) error {
 	for _, option := range options {
 		switch strings.ToUpper(option.Name) {
+		case "REPLICATE_DO_DB":
+			value, err := getOptionValueAsDatabaseNames(option)
+			if err != nil {
+				return err
+			}
+			err = d.filters.setDoDatabases(value)
+			if err != nil {
+				return err
+			}
+		case "REPLICATE_IGNORE_DB":
+			value, err := getOptionValueAsDatabaseNames(option)
+			if err != nil {
+				return err
+			}
+			err = d.filters.setIgnoreDatabases(value)
+			if err != nil {
+				return err
+			}
 		case "REPLICATE_DO_TABLE":
 			value, err := getOptionValueAsTableNames(option)
 			if err != nil {
@@ -311,31 +442,58 @@ func (d *doltBinlogReplicaController) SetReplicationFilterOptions(_ *sql.Context
 			if err != nil {
 				return err
 			}
+		case "REPLICATE_WILD_DO_TABLE":
+			value, err := getOptionValueAsTablePatterns(option)
+			if err != nil {
+				return err
+			}
+			err = d.filters.setWildDoTables(value)
+			if err != nil {
+				return err
+			}
+		case "REPLICATE_WILD_IGNORE_TABLE":
+			value, err := getOptionValueAsTablePatterns(option)
+			if err != nil {
+				return err
+			}
+			err = d.filters.setWildIgnoreTables(value)
+			if err != nil {
+				return err
+			}
+		case "REPLICATE_REWRITE_DB":
+			value, err := getOptionValueAsDatabaseRewrites(option)
+			if err != nil {
+				return err
+			}
+			err = d.filters.setRewriteDatabases(value)
+			if err != nil {
+				return err
+			}
 		default:
 			return fmt.Errorf("unsupported replication filter option: %s", option.Name)
 		}
 	}
 
-	// TODO: Consider persisting filter settings. MySQL doesn't actually do this... unlike CHANGE REPLICATION SOURCE,
-	//       CHANGE REPLICATION FILTER requires users to re-apply the filter options every time a server is restarted,
-	//       or to pass them to mysqld on the command line or in configuration. Since we don't want to force users
-	//       to specify these on the command line, we should consider diverging from MySQL behavior here slightly and
-	//       persisting the filter configuration options if customers want this.
-
-	return nil
+	// MySQL doesn't persist filter settings; unlike CHANGE REPLICATION SOURCE, CHANGE REPLICATION FILTER requires
+	// users to re-apply the filter options every time a server is restarted, or to pass them to mysqld on the command
+	// line or in configuration. Since Dolt doesn't support filters in its configuration, users can opt in to
+	// persisting them by enabling @@dolt_binlog_replica_persist_filters.
+	return persistReplicationFilters(ctx, d.filters)
 }
 
 // GetReplicaStatus implements the BinlogReplicaController interface
 func (d *doltBinlogReplicaController) GetReplicaStatus(ctx *sql.Context) (*binlogreplication.ReplicaStatus, error) {
-	replicaSourceInfo, err := loadReplicationConfiguration(ctx, d.engine.Analyzer.Catalog.MySQLDb)
+	channel, err := d.sessionChannel(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	// Lock to read status consistently
-	d.statusMutex.Lock()
-	defer d.statusMutex.Unlock()
-	var copy = d.status
+	replicaSourceInfo, err := loadReplicationConfiguration(ctx, d.engine.Analyzer.Catalog.MySQLDb, channel.name)
+	if err != nil {
+		return nil, err
+	}
+
+	var copy = channel.getStatus()
 
 	if replicaSourceInfo == nil {
 		return &copy, nil
@@ -350,25 +508,25 @@ func (d *doltBinlogReplicaController) GetReplicaStatus(ctx *sql.Context) (*binlo
 	copy.ReplicateDoTables = d.filters.getDoTables()
 	copy.ReplicateIgnoreTables = d.filters.getIgnoreTables()
 
-	if d.applier.currentPosition != nil {
-		copy.ExecutedGtidSet = d.applier.currentPosition.GTIDSet.String()
-		copy.RetrievedGtidSet = copy.ExecutedGtidSet
-	}
-
 	return &copy, nil
 }
 
 // ResetReplica implements the BinlogReplicaController interface
 func (d *doltBinlogReplicaController) ResetReplica(ctx *sql.Context, resetAll bool) error {
+	channel, err := d.sessionChannel(ctx)
+	if err != nil {
+		return err
+	}
+
 	d.operationMutex.Lock()
 	defer d.operationMutex.Unlock()
 
-	if d.applier.IsRunning() {
+	if channel.applier.IsRunning() {
 		return fmt.Errorf("unable to reset replica while replication is running; stop replication and try again")
 	}
 
 	// Reset error status
-	d.updateStatus(func(status *binlogreplication.ReplicaStatus) {
+	channel.updateStatus(func(status *binlogreplication.ReplicaStatus) {
 		status.LastIoErrNumber = 0
 		status.LastSqlErrNumber = 0
 		status.LastIoErrorTimestamp = nil
@@ -378,76 +536,68 @@ func (d *doltBinlogReplicaController) ResetReplica(ctx *sql.Context, resetAll bo
 	})
 
 	if resetAll {
-		err := deleteReplicationConfiguration(ctx, d.engine.Analyzer.Catalog.MySQLDb)
+		err := deleteReplicationConfiguration(ctx, d.engine.Analyzer.Catalog.MySQLDb, channel.name)
 		if err != nil {
 			return err
 		}
 
-		d.filters = newFilterConfiguration()
-	}
-
-	return nil
-}
-
-// updateStatus allows the caller to safely update the replica controller's status. The controller locks it's mutex
-// before the specified function |f| is called, and unlocks it after |f| is finished running. The current status is
-// passed into the callback function |f| and the caller can safely update or copy any fields they need.
-func (d *doltBinlogReplicaController) updateStatus(f func(status *binlogreplication.ReplicaStatus)) {
-	d.statusMutex.Lock()
-	defer d.statusMutex.Unlock()
-	f(&d.status)
-}
-
-// setIoError updates the current replication status with the specific |errno| and |message| to describe an IO error.
-func (d *doltBinlogReplicaController) setIoError(errno uint, message string) {
-	d.statusMutex.Lock()
-	defer d.statusMutex.Unlock()
-
-	// truncate the message to avoid errors when reporting replica status
-	if len(message) > 256 {
-		message = message[:256]
-	}
-
-	currentTime := time.Now()
-	d.status.LastIoErrorTimestamp = &currentTime
-	d.status.LastIoErrNumber = errno
-	d.status.LastIoError = message
-}
-
-// setSqlError updates the current replication status with the specific |errno| and |message| to describe an SQL error.
-func (d *doltBinlogReplicaController) setSqlError(errno uint, message string) {
-	d.statusMutex.Lock()
-	defer d.statusMutex.Unlock()
+		d.filters.reset()
+		err = deleteReplicationFilters(ctx)
+		if err != nil {
+			return err
+		}
 
-	// truncate the message to avoid errors when reporting replica status
-	if len(message) > 256 {
-		message = message[:256]
+		// Channels other than the default channel no longer exist once their configuration is removed
+		if channel.name != defaultChannel {
+			d.channelsMutex.Lock()
+			delete(d.channels, channel.name)
+			d.channelsMutex.Unlock()
+		}
 	}
 
-	currentTime := time.Now()
-	d.status.LastSqlErrorTimestamp = &currentTime
-	d.status.LastSqlErrNumber = errno
-	d.status.LastSqlError = message
+	return nil
 }
 
-// AutoStart starts up replication if replication was running before the server was shutdown. If
+// AutoStart starts up replication for each channel that was running before the server was shutdown. If
 // replication is not configured, hasn't been started, or has been stopped before the server was
 // shutdown, then this method will not start replication. This method should only be called during
 // the server startup process and should not be invoked after that.
 func (d *doltBinlogReplicaController) AutoStart(_ context.Context) error {
-	runningState, err := loadReplicationRunningState(d.ctx)
+	// Restore any persisted filters first, so that replication never runs without them
+	if err := loadReplicationFilters(d.ctx, d.filters); err != nil {
+		logrus.Errorf("Unable to load replication filters: %s", err.Error())
+		return err
+	}
+
+	channelNames, err := loadReplicationChannelNames(d.ctx, d.engine.Analyzer.Catalog.MySQLDb)
 	if err != nil {
-		logrus.Errorf("Unable to load replication running state: %s", err.Error())
+		logrus.Errorf("Unable to load replication channels: %s", err.Error())
 		return err
 	}
 
-	if runningState == notRunning {
-		logrus.Trace("no previous replication running state; not auto starting replication")
-		return nil
+	// Each channel is started independently, so that a problem with one channel doesn't stop the others
+	var startErr error
+	for _, channelName := range channelNames {
+		runningState, err := loadReplicationRunningState(d.ctx, channelName)
+		if err != nil {
+			logrus.Errorf("Unable to load replication running state for channel '%s': %s", channelName, err.Error())
+			startErr = err
+			continue
+		}
+
+		if runningState == notRunning {
+			logrus.Tracef("no previous replication running state for channel '%s'; not auto starting replication", channelName)
+			continue
+		}
+
+		logrus.Infof("auto-starting binlog replication from source for channel '%s'...", channelName)
+		if err = d.startReplica(d.ctx, d.getChannel(channelName)); err != nil {
+			logrus.Errorf("Unable to start replication for channel '%s': %s", channelName, err.Error())
+			startErr = err
+		}
 	}
 
-	logrus.Info("auto-starting binlog replication from source...")
-	return d.StartReplica(d.ctx)
+	return startErr
 }
 
 //
@@ -484,6 +634,90 @@ func getOptionValueAsTableNames(option binlogreplication.ReplicationOption) ([]s
 		"but expected a list of tables", option.Name, option.Value.GetValue())
 }
 
+// getOptionValueAsDatabaseNames returns the database names held by |option|, either as a list of unqualified names,
+// such as (db1, db2), or as a comma separated string, such as 'db1,db2'.
+func getOptionValueAsDatabaseNames(option binlogreplication.ReplicationOption) ([]string, error) {
+	switch value := option.Value.(type) {
+	case binlogreplication.TableNamesReplicationOptionValue:
+		urts := value.GetValueAsTableList()
+		dbs := make([]string, len(urts))
+		for i, urt := range urts {
+			if urt.Database().Name() != "" {
+				return nil, fmt.Errorf("invalid database name '%s.%s' for option %q",
+					urt.Database().Name(), urt.Name(), option.Name)
+			}
+			dbs[i] = urt.Name()
+		}
+		return dbs, nil
+	case binlogreplication.StringReplicationOptionValue:
+		return splitOptionList(value.GetValueAsString()), nil
+	}
+
+	return nil, fmt.Errorf("unsupported value type for option %q; found %T, "+
+		"but expected a list of databases", option.Name, option.Value.GetValue())
+}
+
+// getOptionValueAsTablePatterns returns the "db.table" patterns held by |option|, either as a list of table names
+// or as a comma separated string, such as 'db%.t1,db2.%'.
+func getOptionValueAsTablePatterns(option binlogreplication.ReplicationOption) ([]string, error) {
+	switch value := option.Value.(type) {
+	case binlogreplication.TableNamesReplicationOptionValue:
+		urts := value.GetValueAsTableList()
+		if err := verifyAllTablesAreQualified(urts); err != nil {
+			return nil, err
+		}
+		patterns := make([]string, len(urts))
+		for i, urt := range urts {
+			patterns[i] = urt.Database().Name() + "." + urt.Name()
+		}
+		return patterns, nil
+	case binlogreplication.StringReplicationOptionValue:
+		return splitOptionList(value.GetValueAsString()), nil
+	}
+
+	return nil, fmt.Errorf("unsupported value type for option %q; found %T, "+
+		"but expected a list of table patterns", option.Name, option.Value.GetValue())
+}
+
+// getOptionValueAsDatabaseRewrites returns the database rewrite rules held by |option|, a string of the form
+// '(from_db, to_db), (from_db2, to_db2)'.
+func getOptionValueAsDatabaseRewrites(option binlogreplication.ReplicationOption) ([]databaseRewrite, error) {
+	value, err := getOptionValueAsString(option)
+	if err != nil {
+		return nil, err
+	}
+
+	var rewrites []databaseRewrite
+	for _, pair := range strings.Split(value, ")") {
+		pair = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(pair), ","))
+		if pair == "" {
+			continue
+		}
+
+		dbs := splitOptionList(strings.TrimPrefix(pair, "("))
+		if !strings.HasPrefix(pair, "(") || len(dbs) != 2 {
+			return nil, fmt.Errorf("invalid database rewrite '%s)' for option %q; "+
+				"expected (source_db, replica_db)", pair, option.Name)
+		}
+		rewrites = append(rewrites, databaseRewrite{From: dbs[0], To: dbs[1]})
+	}
+	return rewrites, nil
+}
+
+// splitOptionList splits the comma separated |value| into its elements, removing whitespace and any quotes around
+// each element.
+func splitOptionList(value string) []string {
+	var elements []string
+	for _, element := range strings.Split(value, ",") {
+		element = strings.TrimSpace(element)
+		element = strings.Trim(element, "'\"`")
+		if element != "" {
+			elements = append(elements, element)
+		}
+	}
+	return elements
+}
+
 func verifyAllTablesAreQualified(urts []sql.UnresolvedTable) error {
 	for _, urt := range urts {
 		if urt.Database().Name() == "" {
@@ -16,6 +16,8 @@ package binlogreplication
 
 import (
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
 	"sync"
 
@@ -25,21 +27,76 @@ import (
 
 // filterConfiguration defines the binlog filtering rules applied on the replica.
 type filterConfiguration struct {
+	// doDatabases holds the names of databases that SHOULD be replicated.
+	doDatabases map[string]struct{}
+	// ignoreDatabases holds the names of databases that should NOT be replicated.
+	ignoreDatabases map[string]struct{}
 	// doTables holds a map of database name to map of table names, indicating tables that SHOULD be replicated.
 	doTables map[string]map[string]struct{}
 	// ignoreTables holds a map of database name to map of table names, indicating tables that should NOT be replicated.
 	ignoreTables map[string]map[string]struct{}
+	// wildDoTables holds patterns, such as "db%.t_", matching tables that SHOULD be replicated.
+	wildDoTables []*wildTablePattern
+	// wildIgnoreTables holds patterns, such as "db%.t_", matching tables that should NOT be replicated.
+	wildIgnoreTables []*wildTablePattern
+	// rewriteDatabases holds a map of source database name to the name of the database on the replica that the
+	// source database's changes are applied to.
+	rewriteDatabases map[string]string
 	// mu guards against concurrent access to the filter configuration data.
 	mu *sync.Mutex
 }
 
 // newFilterConfiguration creates a new filterConfiguration instance and initializes members.
 func newFilterConfiguration() *filterConfiguration {
-	return &filterConfiguration{
-		doTables:     make(map[string]map[string]struct{}),
-		ignoreTables: make(map[string]map[string]struct{}),
-		mu:           &sync.Mutex{},
+	fc := &filterConfiguration{mu: &sync.Mutex{}}
+	fc.clear()
+	return fc
+}
+
+// reset removes all filtering rules.
+func (fc *filterConfiguration) reset() {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.clear()
+}
+
+// clear removes all filtering rules. Callers must hold |fc.mu|, unless |fc| has not been shared yet.
+func (fc *filterConfiguration) clear() {
+	fc.doDatabases = make(map[string]struct{})
+	fc.ignoreDatabases = make(map[string]struct{})
+	fc.doTables = make(map[string]map[string]struct{})
+	fc.ignoreTables = make(map[string]map[string]struct{})
+	fc.wildDoTables = nil
+	fc.wildIgnoreTables = nil
+	fc.rewriteDatabases = make(map[string]string)
+}
+
+// setDoDatabases sets the databases that are allowed to replicate. If any DoDatabases were previously configured,
+// they are cleared out before the new databases are set.
+func (fc *filterConfiguration) setDoDatabases(dbs []string) error {
+	dbMap, err := newDatabaseSet(dbs)
+	if err != nil {
+		return err
 	}
+
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.doDatabases = dbMap
+	return nil
+}
+
+// setIgnoreDatabases sets the databases that are NOT allowed to replicate. If any IgnoreDatabases were previously
+// configured, they are cleared out before the new databases are set.
+func (fc *filterConfiguration) setIgnoreDatabases(dbs []string) error {
+	dbMap, err := newDatabaseSet(dbs)
+	if err != nil {
+		return err
+	}
+
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.ignoreDatabases = dbMap
+	return nil
 }
 
 // setDoTables sets the tables that are allowed to replicate and returns an error if any problems were
@@ -55,17 +112,7 @@ func (fc *filterConfiguration) setDoTables(urts []sql.UnresolvedTable) error {
 	defer fc.mu.Unlock()
 
 	// Setting new replication filters clears out any existing filters
-	fc.doTables = make(map[string]map[string]struct{})
-
-	for _, urt := range urts {
-		table := strings.ToLower(urt.Name())
-		db := strings.ToLower(urt.Database().Name())
-		if fc.doTables[db] == nil {
-			fc.doTables[db] = make(map[string]struct{})
-		}
-		tableMap := fc.doTables[db]
-		tableMap[table] = struct{}{}
-	}
+	fc.doTables = newTableSet(urts)
 	return nil
 }
 
@@ -82,20 +129,116 @@ func (fc *filterConfiguration) setIgnoreTables(urts []sql.UnresolvedTable) error
 	defer fc.mu.Unlock()
 
 	// Setting new replication filters clears out any existing filters
-	fc.ignoreTables = make(map[string]map[string]struct{})
+	fc.ignoreTables = newTableSet(urts)
+	return nil
+}
 
-	for _, urt := range urts {
-		table := strings.ToLower(urt.Name())
-		db := strings.ToLower(urt.Database().Name())
-		if fc.ignoreTables[db] == nil {
-			fc.ignoreTables[db] = make(map[string]struct{})
+// setWildDoTables sets the table name patterns that are allowed to replicate and returns an error if any of the
+// |patterns| are invalid. If any WildDoTables were previously configured, they are cleared out before the new
+// patterns are set.
+func (fc *filterConfiguration) setWildDoTables(patterns []string) error {
+	wildTables, err := newWildTablePatterns(patterns)
+	if err != nil {
+		return err
+	}
+
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.wildDoTables = wildTables
+	return nil
+}
+
+// setWildIgnoreTables sets the table name patterns that are NOT allowed to replicate and returns an error if any of
+// the |patterns| are invalid. If any WildIgnoreTables were previously configured, they are cleared out before the new
+// patterns are set.
+func (fc *filterConfiguration) setWildIgnoreTables(patterns []string) error {
+	wildTables, err := newWildTablePatterns(patterns)
+	if err != nil {
+		return err
+	}
+
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.wildIgnoreTables = wildTables
+	return nil
+}
+
+// setRewriteDatabases sets the database rewrite rules, each of which maps a database name on the source server to
+// the name of the database on this replica that its changes are applied to. If any RewriteDatabases were previously
+// configured, they are cleared out before the new rules are set.
+func (fc *filterConfiguration) setRewriteDatabases(rewrites []databaseRewrite) error {
+	rewriteMap := make(map[string]string, len(rewrites))
+	for _, rewrite := range rewrites {
+		if rewrite.From == "" || rewrite.To == "" {
+			return fmt.Errorf("invalid database rewrite (%s, %s); both database names must be specified",
+				rewrite.From, rewrite.To)
 		}
-		tableMap := fc.ignoreTables[db]
-		tableMap[table] = struct{}{}
+		from := strings.ToLower(rewrite.From)
+		if _, ok := rewriteMap[from]; ok {
+			return fmt.Errorf("database '%s' may only be rewritten once", rewrite.From)
+		}
+		rewriteMap[from] = rewrite.To
 	}
+
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.rewriteDatabases = rewriteMap
 	return nil
 }
 
+// rewriteDatabase returns the name of the database on this replica that changes to the source database named
+// |database| are applied to. If no rewrite rule matches |database|, it is returned unchanged.
+func (fc *filterConfiguration) rewriteDatabase(database string) string {
+	if fc == nil || database == "" {
+		return database
+	}
+
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	return fc.rewriteDatabaseName(database)
+}
+
+// rewriteDatabaseName applies the rewrite rules to |database|. Callers must hold |fc.mu|.
+func (fc *filterConfiguration) rewriteDatabaseName(database string) string {
+	if rewritten, ok := fc.rewriteDatabases[strings.ToLower(database)]; ok {
+		return rewritten
+	}
+	return database
+}
+
+// isDatabaseFilteredOut returns true if statements executed with the source database |database| selected should not
+// be applied on this replica. Rewrite rules are applied to |database| before it is checked against the database
+// filters, as they are for row events.
+func (fc *filterConfiguration) isDatabaseFilteredOut(ctx *sql.Context, database string) bool {
+	if fc == nil || database == "" {
+		return false
+	}
+
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	db := strings.ToLower(fc.rewriteDatabaseName(database))
+	if fc.databaseFilteredOut(db) {
+		ctx.GetLogger().Tracef("skipping statement for database %s (database filtered out)", database)
+		return true
+	}
+	return false
+}
+
+// databaseFilteredOut returns true if the lower-cased database name |db| is excluded by the doDatabases or
+// ignoreDatabases rules. Callers must hold |fc.mu|.
+func (fc *filterConfiguration) databaseFilteredOut(db string) bool {
+	// If any doDatabases are specified, then a database MUST be listed for it to be replicated.
+	// https://dev.mysql.com/doc/refman/8.0/en/replication-rules-db-options.html
+	if len(fc.doDatabases) > 0 {
+		if _, ok := fc.doDatabases[db]; !ok {
+			return true
+		}
+	}
+	_, ok := fc.ignoreDatabases[db]
+	return ok
+}
+
 // isTableFilteredOut returns true if the table identified by |tableMap| has been filtered out on this replica and
 // should not have any updates applied from binlog messages.
 func (fc *filterConfiguration) isTableFilteredOut(ctx *sql.Context, tableMap *mysql.TableMap) bool {
@@ -103,38 +246,92 @@ func (fc *filterConfiguration) isTableFilteredOut(ctx *sql.Context, tableMap *my
 		return false
 	}
 
-	table := strings.ToLower(tableMap.Name)
-	db := strings.ToLower(tableMap.Database)
-
 	fc.mu.Lock()
 	defer fc.mu.Unlock()
 
-	// If any filter doTable options are specified, then a table MUST be listed in the set
-	// for it to be replicated. doTables options are processed BEFORE ignoreTables options.
-	// If a table appears in both doTable and ignoreTables, it is ignored.
+	// Database rewrites are applied before any other filters are checked, so filters always refer to the name of
+	// the database on the replica.
+	table := strings.ToLower(tableMap.Name)
+	db := strings.ToLower(fc.rewriteDatabaseName(tableMap.Database))
+
+	// Database filter options are processed before table filter options.
+	if fc.databaseFilteredOut(db) {
+		ctx.GetLogger().Tracef("skipping table %s.%s (database filtered out)", tableMap.Database, tableMap.Name)
+		return true
+	}
+
+	// If a table matches both a do option and an ignore option, it is ignored, so the ignore options are checked
+	// first. If any doTables or wildDoTables options are specified, then a table MUST match one of them for it to
+	// be replicated.
 	// https://dev.mysql.com/doc/refman/8.0/en/replication-rules-table-options.html
-	if len(fc.doTables) > 0 {
-		if doTables, ok := fc.doTables[db]; ok {
-			if _, ok := doTables[table]; !ok {
-				ctx.GetLogger().Tracef("skipping table %s.%s (not in doTables) ", tableMap.Database, tableMap.Name)
-				return true
-			}
+	if ignoredTables, ok := fc.ignoreTables[db]; ok {
+		if _, ok := ignoredTables[table]; ok {
+			// If this table is being ignored, don't process any further
+			ctx.GetLogger().Tracef("skipping table %s.%s (in ignoreTables)", tableMap.Database, tableMap.Name)
+			return true
 		}
 	}
 
-	if len(fc.ignoreTables) > 0 {
-		if ignoredTables, ok := fc.ignoreTables[db]; ok {
-			if _, ok := ignoredTables[table]; ok {
-				// If this table is being ignored, don't process any further
-				ctx.GetLogger().Tracef("skipping table %s.%s (in ignoreTables)", tableMap.Database, tableMap.Name)
-				return true
-			}
+	if matchesAnyWildTablePattern(fc.wildIgnoreTables, db, table) {
+		ctx.GetLogger().Tracef("skipping table %s.%s (in wildIgnoreTables)", tableMap.Database, tableMap.Name)
+		return true
+	}
+
+	doTables, hasDoTables := fc.doTables[db]
+	if hasDoTables || len(fc.wildDoTables) > 0 {
+		if _, ok := doTables[table]; ok {
+			return false
 		}
+		if matchesAnyWildTablePattern(fc.wildDoTables, db, table) {
+			return false
+		}
+		ctx.GetLogger().Tracef("skipping table %s.%s (not in doTables or wildDoTables)", tableMap.Database, tableMap.Name)
+		return true
 	}
 
 	return false
 }
 
+// getDoDatabases returns a slice of database names that are configured to be replicated.
+func (fc *filterConfiguration) getDoDatabases() []string {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	return sortedKeys(fc.doDatabases)
+}
+
+// getIgnoreDatabases returns a slice of database names that are configured to be filtered out of replication.
+func (fc *filterConfiguration) getIgnoreDatabases() []string {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	return sortedKeys(fc.ignoreDatabases)
+}
+
+// getWildDoTables returns the table name patterns that are configured to be replicated.
+func (fc *filterConfiguration) getWildDoTables() []string {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	return wildTablePatternStrings(fc.wildDoTables)
+}
+
+// getWildIgnoreTables returns the table name patterns that are configured to be filtered out of replication.
+func (fc *filterConfiguration) getWildIgnoreTables() []string {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	return wildTablePatternStrings(fc.wildIgnoreTables)
+}
+
+// getRewriteDatabases returns the configured database rewrite rules, ordered by source database name.
+func (fc *filterConfiguration) getRewriteDatabases() []databaseRewrite {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	rewrites := make([]databaseRewrite, 0, len(fc.rewriteDatabases))
+	for _, from := range sortedKeys(fc.rewriteDatabases) {
+		rewrites = append(rewrites, databaseRewrite{From: from, To: fc.rewriteDatabases[from]})
+	}
+	return rewrites
+}
+
 // getDoTables returns a slice of qualified table names that are configured to be replicated.
 func (fc *filterConfiguration) getDoTables() []string {
 	fc.mu.Lock()
@@ -166,3 +363,114 @@ func convertFilterMapToStringSlice(filterMap map[string]map[string]struct{}) []s
 	}
 	return tableNames
 }
+
+// databaseRewrite maps the database named From on the source server to the database named To on the replica.
+type databaseRewrite struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// wildTablePattern is a "db.table" pattern that may use the "%" and "_" wildcards, with the same meaning they have
+// in a LIKE expression, in both the database name and the table name.
+type wildTablePattern struct {
+	pattern string
+	db      *regexp.Regexp
+	table   *regexp.Regexp
+}
+
+// newWildTablePatterns parses |patterns| and returns an error if any pattern is not qualified with a database name.
+func newWildTablePatterns(patterns []string) ([]*wildTablePattern, error) {
+	wildTables := make([]*wildTablePattern, 0, len(patterns))
+	for _, pattern := range patterns {
+		dbPattern, tablePattern, ok := strings.Cut(pattern, ".")
+		if !ok || dbPattern == "" || tablePattern == "" {
+			return nil, fmt.Errorf("invalid table pattern '%s'; "+
+				"all filter table patterns must be of the form 'db_pattern.table_pattern'", pattern)
+		}
+		wildTables = append(wildTables, &wildTablePattern{
+			pattern: strings.ToLower(pattern),
+			db:      likePatternToRegexp(strings.ToLower(dbPattern)),
+			table:   likePatternToRegexp(strings.ToLower(tablePattern)),
+		})
+	}
+	return wildTables, nil
+}
+
+// likePatternToRegexp converts a LIKE |pattern|, where "%" matches any sequence of characters, "_" matches any single
+// character and "\" escapes the character that follows it, to an anchored regular expression.
+func likePatternToRegexp(pattern string) *regexp.Regexp {
+	var sb strings.Builder
+	sb.WriteString("^")
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '%':
+			sb.WriteString(".*")
+		case r == '_':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	if escaped {
+		sb.WriteString(regexp.QuoteMeta("\\"))
+	}
+	sb.WriteString("$")
+	return regexp.MustCompile(sb.String())
+}
+
+// matchesAnyWildTablePattern returns true if the lower-cased |db| and |table| names match any of |patterns|.
+func matchesAnyWildTablePattern(patterns []*wildTablePattern, db, table string) bool {
+	for _, pattern := range patterns {
+		if pattern.db.MatchString(db) && pattern.table.MatchString(table) {
+			return true
+		}
+	}
+	return false
+}
+
+func wildTablePatternStrings(patterns []*wildTablePattern) []string {
+	strs := make([]string, len(patterns))
+	for i, pattern := range patterns {
+		strs[i] = pattern.pattern
+	}
+	return strs
+}
+
+// newDatabaseSet returns a set of the lower-cased names in |dbs|, and returns an error if any name is empty.
+func newDatabaseSet(dbs []string) (map[string]struct{}, error) {
+	dbMap := make(map[string]struct{}, len(dbs))
+	for _, db := range dbs {
+		if db == "" {
+			return nil, fmt.Errorf("empty database name specified in replication filter")
+		}
+		dbMap[strings.ToLower(db)] = struct{}{}
+	}
+	return dbMap, nil
+}
+
+// newTableSet returns a map of lower-cased database name to set of lower-cased table names for the qualified
+// tables in |urts|.
+func newTableSet(urts []sql.UnresolvedTable) map[string]map[string]struct{} {
+	tableSet := make(map[string]map[string]struct{})
+	for _, urt := range urts {
+		table := strings.ToLower(urt.Name())
+		db := strings.ToLower(urt.Database().Name())
+		if tableSet[db] == nil {
+			tableSet[db] = make(map[string]struct{})
+		}
+		tableSet[db][table] = struct{}{}
+	}
+	return tableSet
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	ks := keys(m)
+	sort.Strings(ks)
+	return ks
+}
@@ -0,0 +1,139 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlogreplication
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/binlogreplication"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFilterConfiguration_databaseFilters tests that doDatabases and ignoreDatabases filter out all tables in a
+// database, and that they are applied before table filters.
+func TestFilterConfiguration_databaseFilters(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	fc := newFilterConfiguration()
+
+	require.NoError(t, fc.setDoDatabases([]string{"DB01", "db02"}))
+	require.NoError(t, fc.setIgnoreDatabases([]string{"db02"}))
+	require.NoError(t, fc.setDoTables([]sql.UnresolvedTable{plan.NewUnresolvedTable("t1", "db03")}))
+
+	require.False(t, fc.isTableFilteredOut(ctx, tableMap("db01", "t1")))
+	require.True(t, fc.isTableFilteredOut(ctx, tableMap("db02", "t1")))
+	require.True(t, fc.isTableFilteredOut(ctx, tableMap("db03", "t1")))
+
+	require.False(t, fc.isDatabaseFilteredOut(ctx, "Db01"))
+	require.True(t, fc.isDatabaseFilteredOut(ctx, "db02"))
+	require.True(t, fc.isDatabaseFilteredOut(ctx, "db03"))
+	require.False(t, fc.isDatabaseFilteredOut(ctx, ""))
+
+	require.Equal(t, []string{"db01", "db02"}, fc.getDoDatabases())
+	require.Equal(t, []string{"db02"}, fc.getIgnoreDatabases())
+	require.Error(t, fc.setDoDatabases([]string{""}))
+}
+
+// TestFilterConfiguration_wildTables tests that wildDoTables and wildIgnoreTables match tables with LIKE patterns,
+// and that they are combined with doTables and ignoreTables.
+func TestFilterConfiguration_wildTables(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	fc := newFilterConfiguration()
+
+	require.NoError(t, fc.setWildDoTables([]string{"db%.t_", "other.log\\_%"}))
+	require.NoError(t, fc.setWildIgnoreTables([]string{"db02.%"}))
+	require.NoError(t, fc.setDoTables([]sql.UnresolvedTable{plan.NewUnresolvedTable("users", "db01")}))
+	require.NoError(t, fc.setIgnoreTables([]sql.UnresolvedTable{plan.NewUnresolvedTable("t2", "db01")}))
+
+	require.False(t, fc.isTableFilteredOut(ctx, tableMap("db01", "t1")))
+	require.False(t, fc.isTableFilteredOut(ctx, tableMap("DB05", "T9")))
+	require.False(t, fc.isTableFilteredOut(ctx, tableMap("db01", "users")))
+	require.False(t, fc.isTableFilteredOut(ctx, tableMap("other", "log_2024")))
+	require.True(t, fc.isTableFilteredOut(ctx, tableMap("other", "logs2024")))
+	require.True(t, fc.isTableFilteredOut(ctx, tableMap("db01", "t10")))
+	require.True(t, fc.isTableFilteredOut(ctx, tableMap("db01", "t2")))
+	require.True(t, fc.isTableFilteredOut(ctx, tableMap("db02", "t1")))
+	require.True(t, fc.isTableFilteredOut(ctx, tableMap("mydb", "t1")))
+
+	require.Equal(t, []string{"db%.t_", "other.log\\_%"}, fc.getWildDoTables())
+	require.Equal(t, []string{"db02.%"}, fc.getWildIgnoreTables())
+
+	err := fc.setWildDoTables([]string{"t%"})
+	require.ErrorContains(t, err, "must be of the form 'db_pattern.table_pattern'")
+}
+
+// TestFilterConfiguration_rewriteDatabases tests that database rewrites are applied before the database and table
+// filters are checked.
+func TestFilterConfiguration_rewriteDatabases(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	fc := newFilterConfiguration()
+
+	require.NoError(t, fc.setRewriteDatabases([]databaseRewrite{{From: "Shared", To: "analytics"}}))
+	require.NoError(t, fc.setDoDatabases([]string{"analytics"}))
+	require.NoError(t, fc.setIgnoreTables([]sql.UnresolvedTable{plan.NewUnresolvedTable("tmp", "analytics")}))
+
+	require.Equal(t, "analytics", fc.rewriteDatabase("shared"))
+	require.Equal(t, "db01", fc.rewriteDatabase("db01"))
+	require.False(t, fc.isTableFilteredOut(ctx, tableMap("shared", "t1")))
+	require.True(t, fc.isTableFilteredOut(ctx, tableMap("shared", "tmp")))
+	require.True(t, fc.isTableFilteredOut(ctx, tableMap("db01", "t1")))
+	require.False(t, fc.isDatabaseFilteredOut(ctx, "shared"))
+
+	require.Equal(t, []databaseRewrite{{From: "shared", To: "analytics"}}, fc.getRewriteDatabases())
+
+	err := fc.setRewriteDatabases([]databaseRewrite{{From: "a", To: "b"}, {From: "A", To: "c"}})
+	require.ErrorContains(t, err, "may only be rewritten once")
+
+	fc.reset()
+	require.Equal(t, "shared", fc.rewriteDatabase("shared"))
+	require.False(t, fc.isTableFilteredOut(ctx, tableMap("db01", "t1")))
+}
+
+// TestFilterOptionValues tests parsing the values of the database, pattern and rewrite filter options.
+func TestFilterOptionValues(t *testing.T) {
+	dbs, err := getOptionValueAsDatabaseNames(*binlogreplication.NewReplicationOption("REPLICATE_DO_DB",
+		binlogreplication.TableNamesReplicationOptionValue{Value: []sql.UnresolvedTable{
+			plan.NewUnresolvedTable("db01", ""),
+			plan.NewUnresolvedTable("db02", ""),
+		}}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"db01", "db02"}, dbs)
+
+	_, err = getOptionValueAsDatabaseNames(*binlogreplication.NewReplicationOption("REPLICATE_DO_DB",
+		binlogreplication.TableNamesReplicationOptionValue{Value: []sql.UnresolvedTable{
+			plan.NewUnresolvedTable("t1", "db01"),
+		}}))
+	require.ErrorContains(t, err, "invalid database name 'db01.t1'")
+
+	patterns, err := getOptionValueAsTablePatterns(*binlogreplication.NewReplicationOption("REPLICATE_WILD_DO_TABLE",
+		binlogreplication.StringReplicationOptionValue{Value: "'db%.t1', db2.%"}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"db%.t1", "db2.%"}, patterns)
+
+	rewrites, err := getOptionValueAsDatabaseRewrites(*binlogreplication.NewReplicationOption("REPLICATE_REWRITE_DB",
+		binlogreplication.StringReplicationOptionValue{Value: "(shared, analytics), (db1, db2)"}))
+	require.NoError(t, err)
+	require.Equal(t, []databaseRewrite{{From: "shared", To: "analytics"}, {From: "db1", To: "db2"}}, rewrites)
+
+	_, err = getOptionValueAsDatabaseRewrites(*binlogreplication.NewReplicationOption("REPLICATE_REWRITE_DB",
+		binlogreplication.StringReplicationOptionValue{Value: "(shared)"}))
+	require.ErrorContains(t, err, "expected (source_db, replica_db)")
+}
+
+func tableMap(database, name string) *mysql.TableMap {
+	return &mysql.TableMap{Database: database, Name: name}
+}
@@ -0,0 +1,254 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlogreplication
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	ast "github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// changeReplicationFilterRegex matches the start of a CHANGE REPLICATION FILTER statement.
+var changeReplicationFilterRegex = regexp.MustCompile(`(?i)^\s*change\s+replication\s+filter\s+`)
+
+// replicaStatementRegex matches the start of the replication statements that accept a FOR CHANNEL clause.
+var replicaStatementRegex = regexp.MustCompile(`(?i)^\s*(change\s+replication\s+(source|filter)|(start|stop|reset)\s+replica|show\s+replica\s+status)\b`)
+
+// forChannelRegex matches the FOR CHANNEL clause at the end of a replication statement.
+var forChannelRegex = regexp.MustCompile("(?i)\\s+for\\s+channel\\s+('[^']*'|\"[^\"]*\"|`[^`]*`|[a-z0-9_-]+)\\s*$")
+
+// pendingChannels holds, keyed by session ID, the channel named by the FOR CHANNEL clause of the last replication
+// statement parsed for a session, until the statement is executed and selectedChannelName consumes it.
+var pendingChannels = &sync.Map{}
+
+// extendedFilterOptions are the replication filter options that the grammar of the SQL parser doesn't support, and
+// which replicaStatementParser parses instead.
+var extendedFilterOptions = map[string]struct{}{
+	"REPLICATE_DO_DB":             {},
+	"REPLICATE_IGNORE_DB":         {},
+	"REPLICATE_WILD_DO_TABLE":     {},
+	"REPLICATE_WILD_IGNORE_TABLE": {},
+	"REPLICATE_REWRITE_DB":        {},
+}
+
+// replicaStatementParser wraps the parser of the engine to support the replication filter options in
+// extendedFilterOptions, and the FOR CHANNEL clause of replication statements. CHANGE REPLICATION FILTER statements
+// that use any of the extended options are parsed here, and all other statements are parsed by the wrapped parser.
+// The grammar has nowhere to put a channel, so the FOR CHANNEL clause is removed before parsing, and the channel is
+// recorded for the session in pendingChannels instead.
+type replicaStatementParser struct {
+	sql.Parser
+}
+
+var _ sql.Parser = replicaStatementParser{}
+
+// NewReplicaStatementParser returns a parser that parses the replication statements supported by the Dolt binlog
+// replica controller, and delegates all other statements to |parser|.
+func NewReplicaStatementParser(parser sql.Parser) sql.Parser {
+	return replicaStatementParser{Parser: parser}
+}
+
+// ParseSimple implements the sql.Parser interface.
+func (p replicaStatementParser) ParseSimple(query string) (ast.Statement, error) {
+	query, err := extractChannel(context.Background(), query)
+	if err != nil {
+		return nil, err
+	}
+	stmt, end, ok, err := p.parseFilterStatement(query)
+	if !ok {
+		return p.Parser.ParseSimple(query)
+	} else if err == nil && strings.TrimSpace(query[end:]) != "" {
+		err = fmt.Errorf("syntax error: unexpected statement after CHANGE REPLICATION FILTER: %s", query[end:])
+	}
+	return stmt, err
+}
+
+// Parse implements the sql.Parser interface.
+func (p replicaStatementParser) Parse(ctx *sql.Context, query string, multi bool) (ast.Statement, string, string, error) {
+	return p.ParseWithOptions(ctx, query, ';', multi, sql.LoadSqlMode(ctx).ParserOptions())
+}
+
+// ParseWithOptions implements the sql.Parser interface.
+func (p replicaStatementParser) ParseWithOptions(ctx context.Context, query string, delimiter rune, multi bool, options ast.ParserOptions) (ast.Statement, string, string, error) {
+	query, err := extractChannel(ctx, query)
+	if err != nil {
+		return nil, "", "", err
+	}
+	s := sql.RemoveSpaceAndDelimiter(query, delimiter)
+	stmt, end, ok, err := p.parseFilterStatement(s)
+	if !ok {
+		return p.Parser.ParseWithOptions(ctx, query, delimiter, multi, options)
+	} else if err != nil {
+		return nil, "", "", err
+	}
+
+	if end >= len(s) {
+		return stmt, s, "", nil
+	} else if !multi {
+		return nil, "", "", fmt.Errorf("syntax error: unexpected statement after CHANGE REPLICATION FILTER: %s", s[end:])
+	}
+	return stmt, sql.RemoveSpaceAndDelimiter(s[:end], delimiter), s[end:], nil
+}
+
+// ParseOneWithOptions implements the sql.Parser interface.
+func (p replicaStatementParser) ParseOneWithOptions(ctx context.Context, query string, options ast.ParserOptions) (ast.Statement, int, error) {
+	stripped, err := extractChannel(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+	stmt, end, ok, err := p.parseFilterStatement(stripped)
+	if !ok {
+		stmt, end, err = p.Parser.ParseOneWithOptions(ctx, stripped, options)
+	}
+	// The FOR CHANNEL clause ends the first statement, so the end of the statement in |query| is after the clause
+	if err == nil {
+		end += len(query) - len(stripped)
+	}
+	return stmt, end, err
+}
+
+// extractChannel removes the FOR CHANNEL clause from the replication statement at the start of |query|, if there is
+// one, and records its channel for the session of |ctx| in pendingChannels. Any other replication statement clears
+// the pending channel of the session, so that it uses the channel selected by @@dolt_binlog_replica_channel. Returns
+// |query| without the clause.
+func extractChannel(ctx context.Context, query string) (string, error) {
+	prefix := replicaStatementRegex.FindStringSubmatchIndex(query)
+	if prefix == nil {
+		return query, nil
+	}
+
+	var sessionID uint32
+	sqlCtx, hasSession := ctx.(*sql.Context)
+	if hasSession && sqlCtx.Session != nil {
+		sessionID = sqlCtx.Session.ID()
+	} else {
+		hasSession = false
+	}
+
+	_, end := splitTopLevel(query, prefix[1])
+	statement := strings.TrimSuffix(query[:end], ";")
+	clause := forChannelRegex.FindStringSubmatchIndex(statement)
+	if clause == nil {
+		if hasSession {
+			pendingChannels.Delete(sessionID)
+		}
+		return query, nil
+	}
+
+	if strings.EqualFold(strings.Join(strings.Fields(query[prefix[2]:prefix[3]]), " "), "change replication filter") {
+		return "", fmt.Errorf("FOR CHANNEL is not supported for CHANGE REPLICATION FILTER; " +
+			"replication filters apply to all channels")
+	}
+	if !hasSession {
+		return "", fmt.Errorf("FOR CHANNEL can only be used in statements executed by a session")
+	}
+
+	name := statement[clause[2]:clause[3]]
+	if name[0] == '\'' || name[0] == '"' || name[0] == '`' {
+		name = name[1 : len(name)-1]
+	}
+	channel, err := normalizeChannelName(name)
+	if err != nil {
+		return "", err
+	}
+	pendingChannels.Store(sessionID, channel)
+	return query[:clause[0]] + query[clause[1]:], nil
+}
+
+// parseFilterStatement parses |query| if it starts with a CHANGE REPLICATION FILTER statement that uses any of the
+// extendedFilterOptions, and returns the statement and the index in |query| after its end. Returns false if |query|
+// must be parsed by the wrapped parser instead. The values of the extended options are passed on as strings, with the
+// outer parentheses removed, and the values of all other options are parsed by the wrapped parser.
+func (p replicaStatementParser) parseFilterStatement(query string) (stmt ast.Statement, end int, ok bool, err error) {
+	prefix := changeReplicationFilterRegex.FindStringIndex(query)
+	if prefix == nil {
+		return nil, 0, false, nil
+	}
+
+	optionTexts, end := splitTopLevel(query, prefix[1])
+	extended := false
+	for _, optionText := range optionTexts {
+		name, _, _ := strings.Cut(optionText, "=")
+		if _, found := extendedFilterOptions[strings.ToUpper(strings.TrimSpace(name))]; found {
+			extended = true
+		}
+	}
+	if !extended {
+		return nil, 0, false, nil
+	}
+
+	filter := &ast.ChangeReplicationFilter{}
+	for _, optionText := range optionTexts {
+		name, value, _ := strings.Cut(optionText, "=")
+		name = strings.ToUpper(strings.TrimSpace(name))
+		value = strings.TrimSpace(value)
+
+		if _, found := extendedFilterOptions[name]; !found {
+			parsed, err := p.Parser.ParseSimple("CHANGE REPLICATION FILTER " + optionText)
+			if err != nil {
+				return nil, 0, true, err
+			}
+			filter.Options = append(filter.Options, parsed.(*ast.ChangeReplicationFilter).Options...)
+			continue
+		}
+
+		if !strings.HasPrefix(value, "(") || !strings.HasSuffix(value, ")") {
+			return nil, 0, true, fmt.Errorf("syntax error: expected a parenthesized list of values for option %s", name)
+		}
+		filter.Options = append(filter.Options, &ast.ReplicationOption{
+			Name:  name,
+			Value: strings.TrimSpace(value[1 : len(value)-1]),
+		})
+	}
+	return filter, end, true, nil
+}
+
+// splitTopLevel splits |query|, starting at |start|, on the commas that are not inside parentheses or quotes, up to
+// the first semicolon outside of them. Returns the trimmed elements and the index in |query| after the semicolon, or
+// the length of |query| if there is none.
+func splitTopLevel(query string, start int) (elements []string, end int) {
+	depth := 0
+	var quote byte
+	elementStart := start
+	for i := start; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote != '`' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == ',' && depth == 0:
+			elements = append(elements, strings.TrimSpace(query[elementStart:i]))
+			elementStart = i + 1
+		case c == ';' && depth == 0:
+			elements = append(elements, strings.TrimSpace(query[elementStart:i]))
+			return elements, i + 1
+		}
+	}
+	return append(elements, strings.TrimSpace(query[elementStart:])), len(query)
+}
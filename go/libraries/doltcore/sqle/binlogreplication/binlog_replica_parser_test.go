@@ -0,0 +1,146 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlogreplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/binlogreplication"
+	ast "github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReplicaStatementParser tests parsing the replication filter options that the SQL grammar doesn't support.
+func TestReplicaStatementParser(t *testing.T) {
+	parser := NewReplicaStatementParser(sql.NewMysqlParser())
+
+	stmt, err := parser.ParseSimple("CHANGE REPLICATION FILTER REPLICATE_DO_DB = (db01, `db02`), " +
+		"replicate_wild_ignore_table=('db%.t1', 'db2.%'), REPLICATE_REWRITE_DB=((shared, analytics), (db1, db2)), " +
+		"REPLICATE_IGNORE_TABLE=(db01.t1)")
+	require.NoError(t, err)
+	filter, ok := stmt.(*ast.ChangeReplicationFilter)
+	require.True(t, ok)
+	require.Len(t, filter.Options, 4)
+	require.Equal(t, &ast.ReplicationOption{Name: "REPLICATE_DO_DB", Value: "db01, `db02`"}, filter.Options[0])
+	require.Equal(t, &ast.ReplicationOption{Name: "REPLICATE_WILD_IGNORE_TABLE", Value: "'db%.t1', 'db2.%'"}, filter.Options[1])
+	require.Equal(t, &ast.ReplicationOption{Name: "REPLICATE_REWRITE_DB", Value: "(shared, analytics), (db1, db2)"}, filter.Options[2])
+	require.Equal(t, "REPLICATE_IGNORE_TABLE", filter.Options[3].Name)
+	require.IsType(t, ast.TableNames{}, filter.Options[3].Value)
+
+	// The values are in the form that the replica controller expects
+	dbs, err := getOptionValueAsDatabaseNames(*stringOption(filter.Options[0]))
+	require.NoError(t, err)
+	require.Equal(t, []string{"db01", "db02"}, dbs)
+	rewrites, err := getOptionValueAsDatabaseRewrites(*stringOption(filter.Options[2]))
+	require.NoError(t, err)
+	require.Equal(t, []databaseRewrite{{From: "shared", To: "analytics"}, {From: "db1", To: "db2"}}, rewrites)
+
+	// Statements that the SQL grammar supports are left to the wrapped parser
+	stmt, err = parser.ParseSimple("CHANGE REPLICATION FILTER REPLICATE_DO_TABLE=(db01.t1)")
+	require.NoError(t, err)
+	require.IsType(t, &ast.ChangeReplicationFilter{}, stmt)
+	stmt, err = parser.ParseSimple("select 'REPLICATE_DO_DB=(db01)'")
+	require.NoError(t, err)
+	require.IsType(t, &ast.Select{}, stmt)
+
+	// Each statement of a multi-statement query is parsed on its own
+	stmt, parsed, remainder, err := parser.ParseWithOptions(context.Background(),
+		"CHANGE REPLICATION FILTER REPLICATE_IGNORE_DB=(db01); select 1;", ';', true, ast.ParserOptions{})
+	require.NoError(t, err)
+	require.IsType(t, &ast.ChangeReplicationFilter{}, stmt)
+	require.Equal(t, "CHANGE REPLICATION FILTER REPLICATE_IGNORE_DB=(db01)", parsed)
+	require.Equal(t, " select 1", remainder)
+
+	_, _, _, err = parser.ParseWithOptions(context.Background(),
+		"CHANGE REPLICATION FILTER REPLICATE_IGNORE_DB=(db01); select 1;", ';', false, ast.ParserOptions{})
+	require.Error(t, err)
+
+	_, err = parser.ParseSimple("CHANGE REPLICATION FILTER REPLICATE_DO_DB=db01")
+	require.ErrorContains(t, err, "expected a parenthesized list of values for option REPLICATE_DO_DB")
+}
+
+// TestReplicaStatementParserForChannel tests parsing the FOR CHANNEL clause of replication statements, which the SQL
+// grammar doesn't support.
+func TestReplicaStatementParserForChannel(t *testing.T) {
+	parser := NewReplicaStatementParser(sql.NewMysqlParser())
+	ctx := sql.NewEmptyContext()
+
+	tests := []struct {
+		query    string
+		stmt     ast.Statement
+		expected string
+	}{
+		{query: "START REPLICA FOR CHANNEL Source_1", stmt: &ast.StartReplica{}, expected: "source_1"},
+		{query: "stop replica for channel 'source_2';", stmt: &ast.StopReplica{}, expected: "source_2"},
+		{query: "SHOW REPLICA STATUS\n  FOR CHANNEL `source-3`", stmt: &ast.Show{Type: "REPLICA STATUS"}, expected: "source-3"},
+		{query: "RESET REPLICA ALL FOR CHANNEL \"source_4\"", stmt: &ast.ResetReplica{All: true}, expected: "source_4"},
+		{query: "START REPLICA", stmt: &ast.StartReplica{}, expected: defaultChannel},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			stmt, _, _, err := parser.Parse(ctx, test.query, false)
+			require.NoError(t, err)
+			require.Equal(t, test.stmt, stmt)
+			channel, err := selectedChannelName(ctx)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, channel)
+
+			// The channel only applies to the statement it was parsed from
+			channel, err = selectedChannelName(ctx)
+			require.NoError(t, err)
+			require.Equal(t, defaultChannel, channel)
+		})
+	}
+
+	stmt, _, _, err := parser.Parse(ctx, "CHANGE REPLICATION SOURCE TO SOURCE_HOST='for channel', SOURCE_PORT=3306 "+
+		"FOR CHANNEL source_1", false)
+	require.NoError(t, err)
+	source, ok := stmt.(*ast.ChangeReplicationSource)
+	require.True(t, ok)
+	require.Len(t, source.Options, 2)
+	require.Equal(t, &ast.ReplicationOption{Name: "SOURCE_HOST", Value: "for channel"}, source.Options[0])
+
+	// A replication statement without the clause clears the channel of a statement that wasn't executed
+	_, _, _, err = parser.Parse(ctx, "STOP REPLICA", false)
+	require.NoError(t, err)
+	channel, err := selectedChannelName(ctx)
+	require.NoError(t, err)
+	require.Equal(t, defaultChannel, channel)
+
+	// Each statement of a multi-statement query is parsed on its own
+	stmt, parsed, remainder, err := parser.Parse(ctx, "START REPLICA FOR CHANNEL source_1; select 1;", true)
+	require.NoError(t, err)
+	require.IsType(t, &ast.StartReplica{}, stmt)
+	require.Equal(t, "START REPLICA", parsed)
+	require.Equal(t, " select 1", remainder)
+	query := "START REPLICA FOR CHANNEL source_1; select 1;"
+	_, end, err := parser.ParseOneWithOptions(ctx, query, ast.ParserOptions{})
+	require.NoError(t, err)
+	require.Equal(t, " select 1;", query[end:])
+
+	_, err = parser.ParseSimple("START REPLICA FOR CHANNEL source_1")
+	require.ErrorContains(t, err, "FOR CHANNEL can only be used in statements executed by a session")
+	_, _, _, err = parser.Parse(ctx, "CHANGE REPLICATION FILTER REPLICATE_DO_DB=(db01) FOR CHANNEL source_1", false)
+	require.ErrorContains(t, err, "replication filters apply to all channels")
+	_, _, _, err = parser.Parse(ctx, "START REPLICA FOR CHANNEL '../source'", false)
+	require.ErrorContains(t, err, "invalid replication channel name")
+}
+
+func stringOption(option *ast.ReplicationOption) *binlogreplication.ReplicationOption {
+	return binlogreplication.NewReplicationOption(option.Name,
+		binlogreplication.StringReplicationOptionValue{Value: option.Value.(string)})
+}
@@ -189,3 +189,131 @@ func TestBinlogReplicationFilters_errorCases(t *testing.T) {
 	require.Error(t, err)
 	require.ErrorContains(t, err, "no database specified for table")
 }
+
+// TestBinlogReplicationFilters_doAndIgnoreDatabases tests that the doDatabases and ignoreDatabases replication
+// filtering options are correctly applied and honored.
+func TestBinlogReplicationFilters_doAndIgnoreDatabases(t *testing.T) {
+	defer teardown(t)
+	startSqlServersWithDoltSystemVars(t, doltReplicaSystemVars)
+	startReplicationAndCreateTestDb(t, mySqlPort)
+
+	primaryDatabase.MustExec("CREATE DATABASE db02;")
+	primaryDatabase.MustExec("CREATE DATABASE db03;")
+	for _, db := range []string{"db01", "db02", "db03"} {
+		primaryDatabase.MustExec(fmt.Sprintf("CREATE TABLE %s.t1 (pk INT PRIMARY KEY);", db))
+	}
+	waitForReplicaToCatchUp(t)
+
+	// Database names are case-insensitive
+	replicaDatabase.MustExec("CHANGE REPLICATION FILTER REPLICATE_DO_DB=(db01, DB02), REPLICATE_IGNORE_DB=(db02);")
+
+	// Make changes on the primary
+	for i := 1; i < 12; i++ {
+		for _, db := range []string{"db01", "db02", "db03"} {
+			primaryDatabase.MustExec(fmt.Sprintf("INSERT INTO %s.t1 VALUES (%d);", db, i))
+		}
+	}
+	primaryDatabase.MustExec("DELETE FROM db01.t1 WHERE pk = 11;")
+	primaryDatabase.MustExec("DELETE FROM db02.t1 WHERE pk = 11;")
+
+	// Pause to let the replica catch up
+	waitForReplicaToCatchUp(t)
+
+	// Only the changes to db01 are applied, since db02 is also ignored and db03 isn't in the do databases
+	requireReplicaResults(t, "SELECT COUNT(pk) FROM db01.t1;", [][]any{{"10"}})
+	requireReplicaResults(t, "SELECT COUNT(pk) FROM db02.t1;", [][]any{{"0"}})
+	requireReplicaResults(t, "SELECT COUNT(pk) FROM db03.t1;", [][]any{{"0"}})
+}
+
+// TestBinlogReplicationFilters_wildTables tests that the wildDoTables and wildIgnoreTables replication filtering
+// options are correctly applied and honored.
+func TestBinlogReplicationFilters_wildTables(t *testing.T) {
+	defer teardown(t)
+	startSqlServersWithDoltSystemVars(t, doltReplicaSystemVars)
+	startReplicationAndCreateTestDb(t, mySqlPort)
+
+	primaryDatabase.MustExec("CREATE DATABASE db02;")
+	waitForReplicaToCatchUp(t)
+	replicaDatabase.MustExec("CHANGE REPLICATION FILTER REPLICATE_WILD_DO_TABLE=('db01.%', 'db02.do%'), " +
+		"REPLICATE_WILD_IGNORE_TABLE=('db01.ignored%');")
+
+	// Make changes on the primary
+	tables := []string{"db01.t1", "db01.ignored_t2", "db02.do_t3", "db02.t4"}
+	for _, table := range tables {
+		primaryDatabase.MustExec(fmt.Sprintf("CREATE TABLE %s (pk INT PRIMARY KEY);", table))
+		for i := 1; i < 6; i++ {
+			primaryDatabase.MustExec(fmt.Sprintf("INSERT INTO %s VALUES (%d);", table, i))
+		}
+	}
+
+	// Pause to let the replica catch up
+	waitForReplicaToCatchUp(t)
+
+	// Only the changes to tables matching a do pattern, and not an ignore pattern, are applied
+	requireReplicaResults(t, "SELECT COUNT(pk) FROM db01.t1;", [][]any{{"5"}})
+	requireReplicaResults(t, "SELECT COUNT(pk) FROM db01.ignored_t2;", [][]any{{"0"}})
+	requireReplicaResults(t, "SELECT COUNT(pk) FROM db02.do_t3;", [][]any{{"5"}})
+	requireReplicaResults(t, "SELECT COUNT(pk) FROM db02.t4;", [][]any{{"0"}})
+}
+
+// TestBinlogReplicationFilters_rewriteDatabase tests that the rewriteDatabases replication filtering option applies
+// the changes to a database on the primary to a differently named database on the replica.
+func TestBinlogReplicationFilters_rewriteDatabase(t *testing.T) {
+	defer teardown(t)
+	startSqlServersWithDoltSystemVars(t, doltReplicaSystemVars)
+	startReplicationAndCreateTestDb(t, mySqlPort)
+
+	replicaDatabase.MustExec("CREATE DATABASE analytics;")
+	replicaDatabase.MustExec("CHANGE REPLICATION FILTER REPLICATE_REWRITE_DB=((db01, analytics));")
+
+	// Make changes on the primary. The rewrite applies to the default database of statements, as it does in MySQL,
+	// so the DDL doesn't qualify the table name.
+	primaryDatabase.MustExec("CREATE TABLE t1 (pk INT PRIMARY KEY, c1 VARCHAR(20));")
+	primaryDatabase.MustExec("INSERT INTO db01.t1 VALUES (1, 'one'), (2, 'two'), (3, 'three');")
+	primaryDatabase.MustExec("UPDATE db01.t1 SET c1 = 'TWO' WHERE pk = 2;")
+	primaryDatabase.MustExec("DELETE FROM db01.t1 WHERE pk = 3;")
+
+	// Pause to let the replica catch up
+	waitForReplicaToCatchUp(t)
+
+	requireReplicaResults(t, "SELECT * FROM analytics.t1 ORDER BY pk;", [][]any{{"1", "one"}, {"2", "TWO"}})
+	requireReplicaResults(t, "SELECT COUNT(*) FROM information_schema.tables "+
+		"WHERE table_schema = 'db01' AND table_name = 't1';", [][]any{{"0"}})
+}
+
+// TestBinlogReplicationFilters_persisted tests that replication filters are restored after a restart when
+// @@dolt_binlog_replica_persist_filters is enabled.
+func TestBinlogReplicationFilters_persisted(t *testing.T) {
+	defer teardown(t)
+	startSqlServersWithDoltSystemVars(t, map[string]string{
+		"server_id":                           "42",
+		"dolt_binlog_replica_persist_filters": "1",
+	})
+	startReplicationAndCreateTestDb(t, mySqlPort)
+
+	primaryDatabase.MustExec("CREATE DATABASE db02;")
+	primaryDatabase.MustExec("CREATE TABLE db01.t1 (pk INT PRIMARY KEY);")
+	primaryDatabase.MustExec("CREATE TABLE db02.t1 (pk INT PRIMARY KEY);")
+	waitForReplicaToCatchUp(t)
+	replicaDatabase.MustExec("CHANGE REPLICATION FILTER REPLICATE_IGNORE_DB=(db02), REPLICATE_IGNORE_TABLE=(db01.t2);")
+
+	// Restart the replica
+	stopDoltSqlServer(t)
+	var err error
+	doltPort, doltProcess, err = startDoltSqlServer(testDir, nil)
+	require.NoError(t, err)
+	status := showReplicaStatus(t)
+	require.Equal(t, "db01.t2", status["Replicate_Ignore_Table"])
+	replicaDatabase.MustExec("set @@global.server_id=123;")
+	replicaDatabase.MustExec("START REPLICA;")
+
+	// Make changes on the primary
+	primaryDatabase.MustExec("INSERT INTO db01.t1 VALUES (1), (2);")
+	primaryDatabase.MustExec("INSERT INTO db02.t1 VALUES (1), (2);")
+
+	// Pause to let the replica catch up
+	waitForReplicaToCatchUp(t)
+
+	requireReplicaResults(t, "SELECT COUNT(pk) FROM db01.t1;", [][]any{{"2"}})
+	requireReplicaResults(t, "SELECT COUNT(pk) FROM db02.t1;", [][]any{{"0"}})
+}
@@ -148,6 +148,13 @@ func showReplicaStatus(t *testing.T) map[string]interface{} {
 	return convertMapScanResultToStrings(readNextRow(t, rows))
 }
 
+func showReplicaStatusForChannel(t *testing.T, channel string) map[string]interface{} {
+	rows, err := replicaDatabase.Queryx(fmt.Sprintf("show replica status for channel %s;", channel))
+	require.NoError(t, err)
+	defer rows.Close()
+	return convertMapScanResultToStrings(readNextRow(t, rows))
+}
+
 func configureToxiProxy(t *testing.T) {
 	toxiproxyPort := findFreePort()
 
@@ -16,6 +16,7 @@ package binlogreplication
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"io"
 	"net"
@@ -330,6 +331,50 @@ func TestShowReplicaStatus(t *testing.T) {
 	require.Equal(t, longHostname, status["Source_Host"])
 }
 
+// TestForChannel tests that the FOR CHANNEL clause of the replication statements selects the replication channel they
+// apply to, and that the other channels are left alone.
+func TestForChannel(t *testing.T) {
+	defer teardown(t)
+	startSqlServersWithDoltSystemVars(t, doltReplicaSystemVars)
+
+	// Configure and start a second channel with bad connection params
+	replicaDatabase.MustExec("CHANGE REPLICATION SOURCE TO SOURCE_HOST='doesnotexist', SOURCE_PORT=111, " +
+		"SOURCE_USER='nobody' FOR CHANNEL source_2;")
+	replicaDatabase.MustExec("START REPLICA FOR CHANNEL 'Source_2';")
+	time.Sleep(200 * time.Millisecond)
+	status := showReplicaStatusForChannel(t, "source_2")
+	require.Equal(t, "doesnotexist", status["Source_Host"])
+	require.Equal(t, "Connecting", status["Replica_IO_Running"])
+	require.Equal(t, "Yes", status["Replica_SQL_Running"])
+
+	// The default channel is not affected
+	status = showReplicaStatus(t)
+	require.NotEqual(t, "doesnotexist", status["Source_Host"])
+	require.Equal(t, "No", status["Replica_IO_Running"])
+	require.Equal(t, "No", status["Replica_SQL_Running"])
+
+	// Replication through the default channel works while the second channel is running
+	startReplicationAndCreateTestDb(t, mySqlPort)
+	primaryDatabase.MustExec("create table t (pk int primary key);")
+	primaryDatabase.MustExec("insert into t values (1), (2);")
+	waitForReplicaToCatchUp(t)
+	requireReplicaResults(t, "select * from db01.t;", [][]any{{"1"}, {"2"}})
+
+	// STOP REPLICA FOR CHANNEL stops only that channel
+	replicaDatabase.MustExec("STOP REPLICA FOR CHANNEL `source_2`;")
+	status = showReplicaStatusForChannel(t, "source_2")
+	require.Equal(t, "No", status["Replica_IO_Running"])
+	require.Equal(t, "No", status["Replica_SQL_Running"])
+	replicaDatabase.MustExec("STOP REPLICA FOR CHANNEL source_2;")
+	assertWarning(t, replicaDatabase, 3084, "Replication thread(s) for channel 'source_2' are already stopped.")
+	status = showReplicaStatus(t)
+	require.Equal(t, "Yes", status["Replica_SQL_Running"])
+
+	// Replication filters apply to all channels
+	_, err := replicaDatabase.Exec("CHANGE REPLICATION FILTER REPLICATE_DO_DB=(db01) FOR CHANNEL source_2;")
+	require.ErrorContains(t, err, "replication filters apply to all channels")
+}
+
 // TestStopReplica tests that STOP REPLICA correctly stops the replication process, and that
 // warnings are logged when STOP REPLICA is invoked when replication is not running.
 func TestStopReplica(t *testing.T) {
@@ -451,6 +496,49 @@ func TestDoltCommits(t *testing.T) {
 	require.Equal(t, 5, len(allRows)) // 4 transactions + 1 initial commit
 }
 
+// TestDoltCommitBatches tests that replicated transactions are batched into Dolt commits on transaction boundaries,
+// including transactions sent as separate statements by statement-based replication.
+func TestDoltCommitBatches(t *testing.T) {
+	defer teardown(t)
+	startSqlServersWithDoltSystemVars(t, doltReplicaSystemVars)
+	startReplicationAndCreateTestDb(t, mySqlPort)
+	waitForReplicaToCatchUp(t)
+	replicaDatabase.MustExec("SET @@GLOBAL.dolt_binlog_replica_commit_batch_size=2;")
+
+	// First transaction (DDL)
+	primaryDatabase.MustExec("create table t (pk int primary key, c int);")
+
+	// Second transaction, sent as a query event for each statement. The session system variables must be set on the
+	// connection running the transaction, so a dedicated connection is used.
+	conn, err := primaryDatabase.Connx(context.Background())
+	require.NoError(t, err)
+	for _, query := range []string{
+		"set session binlog_format='STATEMENT';",
+		"start transaction;",
+		"insert into db01.t values (1, 1);",
+		"insert into db01.t values (2, 2);",
+		"insert into db01.t values (3, 3);",
+		"commit;",
+	} {
+		_, err = conn.ExecContext(context.Background(), query)
+		require.NoError(t, err)
+	}
+	require.NoError(t, conn.Close())
+
+	// Third transaction, which waits for another transaction to complete its batch
+	primaryDatabase.MustExec("insert into t values (4, 4);")
+
+	// The first two transactions are committed together, with all the rows of the second transaction
+	waitForReplicaToCatchUp(t)
+	rows, err := replicaDatabase.Queryx("select message from db01.dolt_log limit 1;")
+	require.NoError(t, err)
+	row := convertMapScanResultToStrings(readNextRow(t, rows))
+	require.Contains(t, row["message"], "(2 transactions)")
+	require.NoError(t, rows.Close())
+	requireReplicaResults(t, "select count(*) from db01.t as of 'HEAD';", [][]any{{"3"}})
+	requireReplicaResults(t, "select count(*) from db01.t;", [][]any{{"4"}})
+}
+
 // TestForeignKeyChecks tests that foreign key constraints replicate correctly when foreign key checks are
 // enabled and disabled.
 func TestForeignKeyChecks(t *testing.T) {
@@ -17,8 +17,11 @@ package binlogreplication
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
 )
 
 // getServerId returns the @@server_id global system variable value. If the value of @@server_id is 0 or is not a
@@ -56,3 +59,32 @@ func getServerUuid(_ context.Context) (string, error) {
 
 	return "", fmt.Errorf("@@server_uuid is not a string – must be set to a valid UUID")
 }
+
+// shouldPersistReplicationFilters returns true if the @@dolt_binlog_replica_persist_filters global system variable
+// is enabled, meaning replication filters should be saved to disk and restored when the server restarts.
+func shouldPersistReplicationFilters() bool {
+	_, enabled, _ := sql.SystemVariables.GetGlobal(dsess.BinlogReplicaPersistFilters)
+	return enabled == int8(1)
+}
+
+// getCommitBatchSize returns the @@dolt_binlog_replica_commit_batch_size global system variable value, which is the
+// number of replicated transactions included in each Dolt commit. Zero means Dolt commits are not created based on
+// the number of replicated transactions.
+func getCommitBatchSize() int64 {
+	_, value, _ := sql.SystemVariables.GetGlobal(dsess.BinlogReplicaCommitBatchSize)
+	if i, ok := value.(int64); ok && i > 0 {
+		return i
+	}
+	return 0
+}
+
+// getCommitInterval returns the @@dolt_binlog_replica_commit_interval_secs global system variable value, which is
+// the longest a replicated transaction waits to be included in a Dolt commit. Zero means Dolt commits are not created
+// based on time.
+func getCommitInterval() time.Duration {
+	_, value, _ := sql.SystemVariables.GetGlobal(dsess.BinlogReplicaCommitIntervalSecs)
+	if i, ok := value.(int64); ok && i > 0 {
+		return time.Duration(i) * time.Second
+	}
+	return 0
+}
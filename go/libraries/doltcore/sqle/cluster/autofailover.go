@@ -0,0 +1,437 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	replicationapi "github.com/dolthub/dolt/go/gen/proto/dolt/services/replicationapi/v1alpha1"
+)
+
+// autoFailover implements automatic failover for a cluster which is
+// configured with |auto_failover|. Every member of the cluster sends a
+// Heartbeat to every other member once per heartbeat interval.
+//
+// A standby which does not hear from a primary for longer than the failover
+// timeout, plus a grace period of two heartbeat intervals, becomes a
+// candidate. It picks the next epoch, votes for itself, and asks its peers to
+// vote for it with RequestVote. A member votes for at most one candidate at
+// each epoch, and only if it is a standby which has not heard from a primary
+// within that same period itself. A candidate which collects votes from a
+// majority of the whole cluster, counting the unreachable primary, becomes the
+// primary at its new epoch.
+//
+// A primary holds a lease on its role. Each Heartbeat round which reaches a
+// majority of the cluster renews the lease from the time the round was sent.
+// A primary whose lease is older than the failover timeout keeps its role but
+// makes its databases read only until it reaches a majority again. Since any
+// majority which elects a new primary shares a member with the last majority
+// the old primary reached, and that member waits out the failover timeout and
+// the grace period before it votes, the old primary stops accepting writes
+// before a new one is elected.
+//
+// Epochs fence stale primaries. A member which learns of a higher epoch from
+// one of its peers becomes a standby at that epoch, so a primary which comes
+// back after a failover demotes itself on its first exchange with the rest of
+// the cluster. A member which starts up as a primary starts without a lease,
+// and keeps its databases read only until it reaches a majority of the
+// cluster and none of them is at a higher epoch.
+type autoFailover struct {
+	lgr *logrus.Entry
+
+	peers             []*replicationServiceClient
+	heartbeatInterval time.Duration
+	failoverTimeout   time.Duration
+	// identifies this member in the vote requests it sends.
+	candidate string
+
+	roleAndEpoch    func() (Role, int)
+	setRoleAndEpoch func(role Role, epoch int) error
+	// Makes the databases writable when we are primary and hold the
+	// lease, and read only when we are primary and do not. Both are
+	// no-ops when nothing changes.
+	confirmPrimary func()
+	fencePrimary   func()
+
+	mu sync.Mutex
+	// The last time we heard from a primary at our epoch or higher.
+	lastPrimaryContact time.Time
+	// When we become a candidate if we are a standby and have not heard
+	// from a primary by then.
+	electionDeadline time.Time
+	// When our lease on the primary role expires. Zero when we do not hold
+	// it, including when we start up as a primary.
+	leaseExpiry time.Time
+	// The role and epoch at which we last checked our lease. A primary at
+	// a new epoch starts with a fresh lease, since it was just elected or
+	// assumed the role explicitly.
+	leaseRole  Role
+	leaseEpoch int
+	// The highest epoch we have voted at, and the candidate we voted for.
+	votedEpoch int
+	votedFor   string
+
+	stop chan struct{}
+}
+
+func newAutoFailover(lgr *logrus.Entry, peers []*replicationServiceClient, heartbeatInterval, failoverTimeout time.Duration, candidate string, startRole Role) *autoFailover {
+	ret := &autoFailover{
+		lgr:               lgr,
+		peers:             peers,
+		heartbeatInterval: heartbeatInterval,
+		failoverTimeout:   failoverTimeout,
+		candidate:         candidate,
+		stop:              make(chan struct{}),
+	}
+	// We give the primary a full failover timeout to show up after we start.
+	ret.recordPrimaryContactAt(time.Now())
+	// A primary starts without a lease. It has to reach a majority of the
+	// cluster before it accepts writes.
+	ret.leaseRole = startRole
+	ret.leaseEpoch = -1
+	return ret
+}
+
+func (f *autoFailover) Run() {
+	ticker := time.NewTicker(f.heartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-f.stop:
+			return
+		case <-ticker.C:
+			f.tick()
+		}
+	}
+}
+
+func (f *autoFailover) GracefulStop() {
+	close(f.stop)
+}
+
+func (f *autoFailover) tick() {
+	f.checkLease()
+	f.heartbeat()
+	role, epoch := f.roleAndEpoch()
+	if role != RoleStandby {
+		f.checkLease()
+		return
+	}
+	f.mu.Lock()
+	due := !time.Now().Before(f.electionDeadline)
+	f.mu.Unlock()
+	if due {
+		f.runElection(epoch)
+	}
+}
+
+// Sends a Heartbeat to all of our peers and processes their responses.
+func (f *autoFailover) heartbeat() {
+	role, epoch := f.roleAndEpoch()
+	sent := time.Now()
+	req := &replicationapi.HeartbeatRequest{
+		Role:  string(role),
+		Epoch: int64(epoch),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), f.heartbeatInterval)
+	defer cancel()
+	resps := make([]*replicationapi.HeartbeatResponse, len(f.peers))
+	var wg sync.WaitGroup
+	for i, p := range f.peers {
+		i, p := i, p
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := p.client.Heartbeat(ctx, req)
+			if err != nil {
+				f.lgr.Tracef("cluster: auto failover: heartbeat to %s failed: %v", p.remote, err)
+				return
+			}
+			resps[i] = resp
+		}()
+	}
+	wg.Wait()
+	f.processHeartbeatResponses(role, epoch, sent, resps)
+}
+
+// Processes the responses to the Heartbeats sent at |role| and |epoch| at time
+// |sent|. A nil entry in |resps| is a peer which did not respond.
+func (f *autoFailover) processHeartbeatResponses(role Role, epoch int, sent time.Time, resps []*replicationapi.HeartbeatResponse) {
+	highest := epoch
+	sawPrimary := false
+	// We count ourselves.
+	reached := 1
+	for _, resp := range resps {
+		if resp == nil {
+			continue
+		}
+		reached += 1
+		if int(resp.Epoch) > highest {
+			highest = int(resp.Epoch)
+		}
+		if Role(resp.Role) == RolePrimary && int(resp.Epoch) >= epoch {
+			sawPrimary = true
+		}
+	}
+
+	if highest > epoch {
+		// Someone has moved on to a higher epoch, so there may be a new
+		// primary that we have not heard from yet. We become a standby at
+		// that epoch and give the new primary time to reach us.
+		if role == RolePrimary {
+			f.lgr.Warnf("cluster: auto failover: this server is primary at epoch %d. a peer is at epoch %d. force transitioning to standby.", epoch, highest)
+		}
+		if err := f.setRoleAndEpoch(RoleStandby, highest); err != nil {
+			f.lgr.Warnf("cluster: auto failover: failed to transition to standby at epoch %d: %v", highest, err)
+			return
+		}
+		f.recordPrimaryContactAt(time.Now())
+		return
+	}
+
+	switch role {
+	case RoleStandby:
+		if sawPrimary {
+			f.recordPrimaryContactAt(time.Now())
+		}
+	case RolePrimary:
+		if reached >= f.majority() {
+			f.renewLease(role, epoch, sent)
+		}
+	}
+}
+
+// Renews our lease on the primary role at |epoch| from time |from|, and makes
+// our databases writable if they were not.
+func (f *autoFailover) renewLease(role Role, epoch int, from time.Time) {
+	f.mu.Lock()
+	f.resetLeaseOnRoleChange(role, epoch)
+	if expiry := from.Add(f.failoverTimeout); expiry.After(f.leaseExpiry) {
+		f.leaseExpiry = expiry
+	}
+	f.mu.Unlock()
+	f.confirmPrimary()
+}
+
+// Makes our databases read only if we are primary and our lease has expired.
+func (f *autoFailover) checkLease() {
+	role, epoch := f.roleAndEpoch()
+	if role != RolePrimary {
+		f.mu.Lock()
+		f.resetLeaseOnRoleChange(role, epoch)
+		f.mu.Unlock()
+		return
+	}
+	f.mu.Lock()
+	f.resetLeaseOnRoleChange(role, epoch)
+	expired := !time.Now().Before(f.leaseExpiry)
+	f.mu.Unlock()
+	if expired {
+		f.fencePrimary()
+	}
+}
+
+// Called with |mu| held. A primary which has changed epochs since we last
+// looked, without winning an election, was assigned the role with
+// dolt_assume_cluster_role. It starts with a fresh lease.
+func (f *autoFailover) resetLeaseOnRoleChange(role Role, epoch int) {
+	if role == f.leaseRole && epoch == f.leaseEpoch {
+		return
+	}
+	if role == RolePrimary && f.leaseEpoch != -1 {
+		f.leaseExpiry = time.Now().Add(f.failoverTimeout)
+	} else {
+		f.leaseExpiry = time.Time{}
+	}
+	f.leaseRole, f.leaseEpoch = role, epoch
+}
+
+// Asks our peers to vote for us to become primary at the next epoch after
+// |epoch|, and becomes primary if a majority of them do.
+func (f *autoFailover) runElection(epoch int) {
+	f.mu.Lock()
+	newEpoch := epoch + 1
+	if f.votedEpoch >= newEpoch {
+		// We already voted at this epoch, possibly for ourselves in an
+		// election which did not succeed.
+		newEpoch = f.votedEpoch + 1
+	}
+	f.votedEpoch = newEpoch
+	f.votedFor = f.candidate
+	f.mu.Unlock()
+
+	f.lgr.Warnf("cluster: auto failover: no contact from a primary for %v. requesting votes to become primary at epoch %d.", f.electionTimeout(), newEpoch)
+
+	req := &replicationapi.RequestVoteRequest{
+		Epoch:     int64(newEpoch),
+		Candidate: f.candidate,
+	}
+	sent := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), f.heartbeatInterval)
+	defer cancel()
+	resps := make([]*replicationapi.RequestVoteResponse, len(f.peers))
+	var wg sync.WaitGroup
+	for i, p := range f.peers {
+		i, p := i, p
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := p.client.RequestVote(ctx, req)
+			if err != nil {
+				f.lgr.Tracef("cluster: auto failover: vote request to %s failed: %v", p.remote, err)
+				return
+			}
+			resps[i] = resp
+		}()
+	}
+	wg.Wait()
+	f.processVoteResponses(epoch, newEpoch, sent, resps)
+}
+
+// Processes the responses to the RequestVotes sent for |newEpoch| at time
+// |sent| while we were a standby at |epoch|. A nil entry in |resps| is a peer
+// which did not respond.
+func (f *autoFailover) processVoteResponses(epoch, newEpoch int, sent time.Time, resps []*replicationapi.RequestVoteResponse) {
+	votes := 1
+	highest := epoch
+	for _, resp := range resps {
+		if resp == nil {
+			continue
+		}
+		if resp.Granted {
+			votes += 1
+		}
+		if int(resp.Epoch) > highest {
+			highest = int(resp.Epoch)
+		}
+	}
+
+	if highest >= newEpoch {
+		// Someone else is already at our new epoch or beyond it.
+		if err := f.setRoleAndEpoch(RoleStandby, highest); err != nil {
+			f.lgr.Warnf("cluster: auto failover: failed to transition to standby at epoch %d: %v", highest, err)
+		}
+		f.recordPrimaryContactAt(time.Now())
+		return
+	}
+
+	if votes < f.majority() {
+		f.lgr.Warnf("cluster: auto failover: received %d of %d votes needed to become primary at epoch %d.", votes, f.majority(), newEpoch)
+		f.mu.Lock()
+		f.electionDeadline = time.Now().Add(f.heartbeatInterval + f.jitter(f.failoverTimeout/2))
+		f.mu.Unlock()
+		return
+	}
+
+	if role, cur := f.roleAndEpoch(); role != RoleStandby || cur != epoch {
+		// Our role changed while we were collecting votes.
+		return
+	}
+	// The members which voted for us treat their vote as contact with a
+	// primary, so we hold the lease from the time we asked for them.
+	f.mu.Lock()
+	f.leaseRole, f.leaseEpoch = RolePrimary, newEpoch
+	f.leaseExpiry = sent.Add(f.failoverTimeout)
+	f.mu.Unlock()
+	if err := f.setRoleAndEpoch(RolePrimary, newEpoch); err != nil {
+		f.lgr.Warnf("cluster: auto failover: failed to transition to primary at epoch %d: %v", newEpoch, err)
+		return
+	}
+	f.lgr.Warnf("cluster: auto failover: received %d votes. this server is now primary at epoch %d.", votes, newEpoch)
+}
+
+// The number of members, including ourselves, which make up a majority of the
+// cluster. A candidate needs this many votes to become primary, and a primary
+// needs to reach this many members to hold its lease. The primary we lost
+// contact with counts as a member, so a cluster of two members can never fail
+// over; ValidateClusterConfig rejects auto_failover for one.
+func (f *autoFailover) majority() int {
+	return (len(f.peers)+1)/2 + 1
+}
+
+// How long a standby waits without hearing from a primary before it runs an
+// election or votes in one. The grace period beyond the failover timeout
+// covers the time a primary takes to notice that its lease has expired.
+func (f *autoFailover) electionTimeout() time.Duration {
+	return f.failoverTimeout + 2*f.heartbeatInterval
+}
+
+// Handles a Heartbeat from one of our peers. Returns our role and epoch,
+// which may have just been updated by the serverinterceptor based on the
+// peer's request headers.
+func (f *autoFailover) handleHeartbeat(req *replicationapi.HeartbeatRequest) *replicationapi.HeartbeatResponse {
+	role, epoch := f.roleAndEpoch()
+	if Role(req.Role) == RolePrimary && int(req.Epoch) >= epoch {
+		f.recordPrimaryContactAt(time.Now())
+	}
+	return &replicationapi.HeartbeatResponse{
+		Role:  string(role),
+		Epoch: int64(epoch),
+	}
+}
+
+// Handles a RequestVote from a candidate. We grant the vote if we are a
+// standby behind the requested epoch, we have not heard from a primary within
+// the election timeout, and we have not already voted at the requested epoch,
+// or we voted for this same candidate.
+func (f *autoFailover) handleRequestVote(req *replicationapi.RequestVoteRequest) *replicationapi.RequestVoteResponse {
+	role, epoch := f.roleAndEpoch()
+	reqEpoch := int(req.Epoch)
+	now := time.Now()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	// A candidate which asks again for a vote we already gave it gets it
+	// again, even though we have counted that vote as primary contact.
+	regrant := reqEpoch == f.votedEpoch && req.Candidate == f.votedFor
+	granted := role == RoleStandby &&
+		reqEpoch > epoch &&
+		(regrant || (reqEpoch > f.votedEpoch && now.Sub(f.lastPrimaryContact) >= f.electionTimeout()))
+	if granted && !regrant {
+		f.votedEpoch = reqEpoch
+		f.votedFor = req.Candidate
+		// The candidate may become primary and take its lease from
+		// this vote, so we count the vote as contact with a primary.
+		// That keeps us from voting for anyone else, or running an
+		// election of our own, until its lease would have expired.
+		f.lastPrimaryContact = now
+		f.electionDeadline = now.Add(f.electionTimeout() + f.jitter(f.heartbeatInterval))
+		f.lgr.Infof("cluster: auto failover: voted for %s to become primary at epoch %d.", req.Candidate, reqEpoch)
+	}
+	return &replicationapi.RequestVoteResponse{
+		Granted: granted,
+		Epoch:   int64(epoch),
+	}
+}
+
+func (f *autoFailover) recordPrimaryContactAt(t time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.lastPrimaryContact = t
+	// Jitter keeps the standbys from all becoming candidates at once.
+	f.electionDeadline = t.Add(f.electionTimeout() + f.jitter(f.heartbeatInterval))
+}
+
+func (f *autoFailover) jitter(max time.Duration) time.Duration {
+	if max <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(max)))
+}
@@ -0,0 +1,273 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	replicationapi "github.com/dolthub/dolt/go/gen/proto/dolt/services/replicationapi/v1alpha1"
+)
+
+// failoverPeer is a replicationapi.ReplicationServiceClient which answers
+// Heartbeat and RequestVote with canned responses. A nil response fails the
+// request as if the peer were unreachable.
+type failoverPeer struct {
+	replicationapi.ReplicationServiceClient
+	heartbeat *replicationapi.HeartbeatResponse
+	vote      *replicationapi.RequestVoteResponse
+}
+
+func (p *failoverPeer) Heartbeat(context.Context, *replicationapi.HeartbeatRequest, ...grpc.CallOption) (*replicationapi.HeartbeatResponse, error) {
+	if p.heartbeat == nil {
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	}
+	return p.heartbeat, nil
+}
+
+func (p *failoverPeer) RequestVote(context.Context, *replicationapi.RequestVoteRequest, ...grpc.CallOption) (*replicationapi.RequestVoteResponse, error) {
+	if p.vote == nil {
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	}
+	return p.vote, nil
+}
+
+// testFailover is an autoFailover whose role and epoch are held in memory.
+type testFailover struct {
+	*autoFailover
+	role   Role
+	epoch  int
+	fenced bool
+}
+
+func newTestFailover(role Role, epoch int, peers ...*failoverPeer) *testFailover {
+	clients := make([]*replicationServiceClient, len(peers))
+	for i, p := range peers {
+		clients[i] = &replicationServiceClient{remote: "peer", client: p}
+	}
+	ret := &testFailover{role: role, epoch: epoch, fenced: role == RolePrimary}
+	ret.autoFailover = newAutoFailover(lgr, clients, 10*time.Millisecond, 100*time.Millisecond, "candidate", role)
+	ret.autoFailover.roleAndEpoch = func() (Role, int) {
+		return ret.role, ret.epoch
+	}
+	ret.autoFailover.setRoleAndEpoch = func(role Role, epoch int) error {
+		ret.role, ret.epoch = role, epoch
+		ret.fenced = false
+		return nil
+	}
+	ret.autoFailover.confirmPrimary = func() {
+		ret.fenced = false
+	}
+	ret.autoFailover.fencePrimary = func() {
+		if ret.role == RolePrimary {
+			ret.fenced = true
+		}
+	}
+	return ret
+}
+
+// Makes it look like we last heard from a primary long enough ago to run an
+// election.
+func (f *testFailover) losePrimary() {
+	f.recordPrimaryContactAt(time.Now().Add(-time.Hour))
+}
+
+// Makes it look like our lease on the primary role expired.
+func (f *testFailover) expireLease() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.leaseExpiry = time.Now().Add(-time.Millisecond)
+}
+
+func TestAutoFailoverMajority(t *testing.T) {
+	// The primary we lost contact with counts as a member of the cluster.
+	for peers, needed := range map[int]int{1: 2, 2: 2, 3: 3, 4: 3} {
+		f := newTestFailover(RoleStandby, 1, make([]*failoverPeer, peers)...)
+		assert.Equal(t, needed, f.majority(), "with %d peers", peers)
+	}
+}
+
+func TestAutoFailoverElection(t *testing.T) {
+	t.Run("TwoMembers", func(t *testing.T) {
+		// The only peer is the primary we lost, and our own vote is
+		// not a majority.
+		f := newTestFailover(RoleStandby, 1, &failoverPeer{})
+		f.losePrimary()
+		f.tick()
+		assert.Equal(t, RoleStandby, f.role)
+		assert.Equal(t, 1, f.epoch)
+	})
+	t.Run("PartitionedFromEveryone", func(t *testing.T) {
+		f := newTestFailover(RoleStandby, 1, &failoverPeer{}, &failoverPeer{})
+		f.losePrimary()
+		f.tick()
+		assert.Equal(t, RoleStandby, f.role)
+		assert.Equal(t, 1, f.epoch)
+	})
+	t.Run("WinsWithMajority", func(t *testing.T) {
+		f := newTestFailover(RoleStandby, 1,
+			&failoverPeer{},
+			&failoverPeer{
+				heartbeat: &replicationapi.HeartbeatResponse{Role: string(RoleStandby), Epoch: 1},
+				vote:      &replicationapi.RequestVoteResponse{Granted: true, Epoch: 1},
+			})
+		f.losePrimary()
+		f.tick()
+		assert.Equal(t, RolePrimary, f.role)
+		assert.Equal(t, 2, f.epoch)
+		// The new primary holds the lease from its election.
+		f.tick()
+		assert.False(t, f.fenced)
+	})
+	t.Run("LosesWithoutMajority", func(t *testing.T) {
+		f := newTestFailover(RoleStandby, 1,
+			&failoverPeer{},
+			&failoverPeer{
+				heartbeat: &replicationapi.HeartbeatResponse{Role: string(RoleStandby), Epoch: 1},
+				vote:      &replicationapi.RequestVoteResponse{Granted: false, Epoch: 1},
+			})
+		f.losePrimary()
+		f.tick()
+		assert.Equal(t, RoleStandby, f.role)
+		assert.Equal(t, 1, f.epoch)
+		// The next attempt is at a higher epoch, since we already voted
+		// for ourselves at epoch 2.
+		f.runElection(1)
+		assert.Equal(t, 3, f.votedEpoch)
+	})
+	t.Run("AbortsOnHigherEpoch", func(t *testing.T) {
+		f := newTestFailover(RoleStandby, 1,
+			&failoverPeer{},
+			&failoverPeer{
+				vote: &replicationapi.RequestVoteResponse{Granted: false, Epoch: 3},
+			})
+		f.losePrimary()
+		f.tick()
+		assert.Equal(t, RoleStandby, f.role)
+		assert.Equal(t, 3, f.epoch)
+	})
+	t.Run("NoElectionWhilePrimaryIsReachable", func(t *testing.T) {
+		f := newTestFailover(RoleStandby, 1,
+			&failoverPeer{
+				heartbeat: &replicationapi.HeartbeatResponse{Role: string(RolePrimary), Epoch: 1},
+			})
+		f.losePrimary()
+		f.tick()
+		assert.Equal(t, RoleStandby, f.role)
+		assert.Equal(t, 1, f.epoch)
+	})
+}
+
+func TestAutoFailoverRequestVote(t *testing.T) {
+	vote := func(f *testFailover, epoch int, candidate string) bool {
+		return f.handleRequestVote(&replicationapi.RequestVoteRequest{Epoch: int64(epoch), Candidate: candidate}).Granted
+	}
+	t.Run("RecentPrimaryContact", func(t *testing.T) {
+		f := newTestFailover(RoleStandby, 1)
+		assert.False(t, vote(f, 2, "a"))
+	})
+	t.Run("OneVotePerEpoch", func(t *testing.T) {
+		f := newTestFailover(RoleStandby, 1)
+		f.losePrimary()
+		assert.True(t, vote(f, 2, "a"))
+		assert.True(t, vote(f, 2, "a"))
+		assert.False(t, vote(f, 2, "b"))
+		// Our vote counts as contact with a primary until its lease
+		// would have expired.
+		assert.False(t, vote(f, 3, "b"))
+		f.losePrimary()
+		assert.True(t, vote(f, 3, "b"))
+	})
+	t.Run("StaleEpoch", func(t *testing.T) {
+		f := newTestFailover(RoleStandby, 2)
+		f.losePrimary()
+		assert.False(t, vote(f, 2, "a"))
+		assert.False(t, vote(f, 1, "a"))
+	})
+	t.Run("Primary", func(t *testing.T) {
+		f := newTestFailover(RolePrimary, 1)
+		f.losePrimary()
+		assert.False(t, vote(f, 2, "a"))
+	})
+	t.Run("HeartbeatFromPrimaryPreventsVote", func(t *testing.T) {
+		f := newTestFailover(RoleStandby, 1)
+		f.losePrimary()
+		f.handleHeartbeat(&replicationapi.HeartbeatRequest{Role: string(RolePrimary), Epoch: 1})
+		assert.False(t, vote(f, 2, "a"))
+	})
+}
+
+func TestAutoFailoverStalePrimary(t *testing.T) {
+	standby := &replicationapi.HeartbeatResponse{Role: string(RoleStandby), Epoch: 2}
+	t.Run("DemotesOnHigherEpoch", func(t *testing.T) {
+		f := newTestFailover(RolePrimary, 1,
+			&failoverPeer{
+				heartbeat: &replicationapi.HeartbeatResponse{Role: string(RolePrimary), Epoch: 2},
+			},
+			&failoverPeer{})
+		f.tick()
+		assert.Equal(t, RoleStandby, f.role)
+		assert.Equal(t, 2, f.epoch)
+	})
+	t.Run("ConfirmedByMajority", func(t *testing.T) {
+		f := newTestFailover(RolePrimary, 2, &failoverPeer{heartbeat: standby}, &failoverPeer{})
+		assert.True(t, f.fenced)
+		f.tick()
+		assert.Equal(t, RolePrimary, f.role)
+		assert.False(t, f.fenced)
+	})
+	t.Run("NotConfirmedWithoutMajority", func(t *testing.T) {
+		f := newTestFailover(RolePrimary, 2, &failoverPeer{}, &failoverPeer{})
+		f.tick()
+		f.tick()
+		assert.Equal(t, RolePrimary, f.role)
+		assert.True(t, f.fenced)
+	})
+}
+
+func TestAutoFailoverPartitionedPrimary(t *testing.T) {
+	standby := &replicationapi.HeartbeatResponse{Role: string(RoleStandby), Epoch: 2}
+	peer1, peer2 := &failoverPeer{heartbeat: standby}, &failoverPeer{heartbeat: standby}
+	f := newTestFailover(RolePrimary, 2, peer1, peer2)
+	f.tick()
+	assert.False(t, f.fenced)
+
+	// Losing one standby still leaves us with a majority.
+	peer1.heartbeat = nil
+	f.expireLease()
+	f.tick()
+	assert.False(t, f.fenced)
+
+	// Losing both does not fence us until the lease runs out.
+	peer2.heartbeat = nil
+	f.tick()
+	assert.False(t, f.fenced)
+	f.expireLease()
+	f.tick()
+	assert.Equal(t, RolePrimary, f.role)
+	assert.True(t, f.fenced)
+
+	// And we accept writes again once we reach a majority.
+	peer2.heartbeat = standby
+	f.tick()
+	assert.Equal(t, RolePrimary, f.role)
+	assert.False(t, f.fenced)
+}
@@ -241,12 +241,14 @@ func (p *branchControlReplication) UpdateBranchControlContents(ctx context.Conte
 		j = len(rsc.Wait)
 		rsc.Wait = append(rsc.Wait, make([]func(ctx context.Context) error, len(p.replicas))...)
 		rsc.NotifyWaitFailed = append(rsc.NotifyWaitFailed, make([]func(), len(p.replicas))...)
+		rsc.Replicas = append(rsc.Replicas, make([]string, len(p.replicas))...)
 	}
 	for i, r := range p.replicas {
 		w := r.UpdateContents(p.current, p.version)
 		if rsc != nil {
 			rsc.Wait[i+j] = w
 			rsc.NotifyWaitFailed[i+j] = func() {}
+			rsc.Replicas[i+j] = r.client.remote
 		}
 	}
 }
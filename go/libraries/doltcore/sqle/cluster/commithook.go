@@ -33,6 +33,7 @@ import (
 
 var _ doltdb.CommitHook = (*commithook)(nil)
 var _ doltdb.NotifyWaitFailedCommitHook = (*commithook)(nil)
+var _ doltdb.ReplicaCommitHook = (*commithook)(nil)
 
 type commithook struct {
 	rootLgr              *logrus.Entry
@@ -52,6 +53,10 @@ type commithook struct {
 	currentError         *string
 	cancelReplicate      func()
 
+	// As a primary, the last time a push or a heartbeat to the standby
+	// succeeded.
+	lastContact time.Time
+
 	// waitNotify is set by controller when it needs to track whether the
 	// commithooks are caught up with replicating to the standby.
 	waitNotify func()
@@ -258,8 +263,11 @@ func (h *commithook) attemptHeartbeat(ctx context.Context) {
 	h.mu.Unlock()
 	datasDB := doltdb.HackDatasDatabaseFromDoltDB(destDB)
 	cs := datas.ChunkStoreFromDatabase(datasDB)
-	cs.Commit(ctx, head, head)
+	_, err := cs.Commit(ctx, head, head)
 	h.mu.Lock()
+	if err == nil && h.role == RolePrimary {
+		h.lastContact = time.Now()
+	}
 }
 
 // Called by the replicate thread to push the nextHead to the destDB and set
@@ -330,6 +338,7 @@ func (h *commithook) attemptReplicate(ctx context.Context) {
 			lgr.Tracef("cluster/commithook: successfully Committed chunks on destDB")
 			h.lastPushedHead = toPush
 			h.lastSuccess = incomingTime
+			h.lastContact = time.Now()
 			h.nextPushAttempt = time.Time{}
 			h.progressNotifier.RecordSuccess(attempt)
 		} else {
@@ -345,10 +354,12 @@ func (h *commithook) attemptReplicate(ctx context.Context) {
 	}
 }
 
-func (h *commithook) status() (replicationLag *time.Duration, lastUpdate *time.Time, currentErr *string) {
+func (h *commithook) status() (replicationLag *time.Duration, lastUpdate *time.Time, currentErr *string, lagging *bool) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 	if h.role == RolePrimary {
+		lagging = new(bool)
+		*lagging = !h.isCaughtUp()
 		if h.lastPushedHead != (hash.Hash{}) {
 			replicationLag = new(time.Duration)
 			if h.nextHead != h.lastPushedHead {
@@ -376,6 +387,33 @@ func (h *commithook) status() (replicationLag *time.Duration, lastUpdate *time.T
 	return
 }
 
+// As a primary, returns the last time we heard from the standby, the current
+// replication lag and the current replication error, if any. |lastContact| is
+// the zero time if we have not yet successfully pushed to the standby since
+// becoming primary.
+func (h *commithook) standbyHealth() (lastContact time.Time, replicationLag time.Duration, currentErr *string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	lastContact = h.lastContact
+	if !h.isCaughtUp() && h.lastPushedHead != (hash.Hash{}) {
+		replicationLag = time.Now().Sub(h.lastSuccess)
+	}
+	currentErr = h.currentError
+	return
+}
+
+// As a standby, returns the last time the primary updated our root, either
+// with a new write or with a heartbeat. Returns the zero time if we have not
+// heard from the primary since becoming a standby.
+func (h *commithook) lastApplied() time.Time {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.role != RoleStandby {
+		return time.Time{}
+	}
+	return h.lastSuccess
+}
+
 func (h *commithook) logger() *logrus.Entry {
 	return h.lgr.Load().(*logrus.Entry)
 }
@@ -425,6 +463,7 @@ func (h *commithook) setRole(role Role) {
 	h.nextHead = hash.Hash{}
 	h.lastPushedHead = hash.Hash{}
 	h.lastSuccess = time.Time{}
+	h.lastContact = time.Time{}
 	h.nextPushAttempt = time.Time{}
 	h.role = role
 	h.lgr.Store(h.rootLgr.WithField(logFieldRole, string(role)))
@@ -491,6 +530,10 @@ func (h *commithook) Execute(ctx context.Context, ds datas.Dataset, db datas.Dat
 	return waitF, nil
 }
 
+func (h *commithook) ReplicaName() string {
+	return h.remotename
+}
+
 func (h *commithook) NotifyWaitFailed() {
 	h.mu.Lock()
 	defer h.mu.Unlock()
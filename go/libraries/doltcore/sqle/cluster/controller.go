@@ -36,6 +36,7 @@ import (
 	gmstypes "github.com/dolthub/go-mysql-server/sql/types"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	grpcbackoff "google.golang.org/grpc/backoff"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/status"
@@ -110,6 +111,18 @@ type Controller struct {
 	dropDatabase             func(*sql.Context, string) error
 	outstandingDropDatabases map[string]*databaseDropReplication
 	remoteSrvDBCache         remotesrv.DBCache
+
+	// Closed and replaced whenever a standby database applies an update
+	// from the primary. See CheckReplicationLag.
+	applied chan struct{}
+
+	// Non-nil when automatic failover is configured.
+	autoFailover *autoFailover
+	// True when automatic failover is configured and this server is a
+	// primary without a lease on its role, either because it just started
+	// up or because it lost contact with a majority of the cluster. The
+	// databases stay read only until it reaches a majority again.
+	awaitingPeerConfirmation bool
 }
 
 type sqlvars interface {
@@ -147,6 +160,7 @@ func NewController(lgr *logrus.Logger, cfg servercfg.ClusterConfig, pCfg config.
 		epoch:         epoch,
 		commithooks:   make([]*commithook, 0),
 		lgr:           lgr,
+		applied:       make(chan struct{}),
 	}
 	roleSetter := func(role string, epoch int) {
 		ret.setRoleAndEpoch(role, epoch, roleTransitionOptions{
@@ -204,6 +218,27 @@ func NewController(lgr *logrus.Logger, cfg servercfg.ClusterConfig, pCfg config.
 
 	ret.outstandingDropDatabases = make(map[string]*databaseDropReplication)
 
+	if afCfg := cfg.AutoFailoverConfig(); afCfg != nil {
+		ret.autoFailover = newAutoFailover(
+			lgr.WithFields(logrus.Fields{"component": "auto-failover"}),
+			ret.replicationClients,
+			time.Duration(afCfg.HeartbeatIntervalMillis())*time.Millisecond,
+			time.Duration(afCfg.FailoverTimeoutMillis())*time.Millisecond,
+			keyIDStr,
+			role,
+		)
+		ret.autoFailover.roleAndEpoch = ret.roleAndEpoch
+		ret.autoFailover.setRoleAndEpoch = func(role Role, epoch int) error {
+			_, err := ret.setRoleAndEpoch(string(role), epoch, roleTransitionOptions{
+				graceful: false,
+			})
+			return err
+		}
+		ret.autoFailover.confirmPrimary = ret.confirmPrimary
+		ret.autoFailover.fencePrimary = ret.fencePrimary
+		ret.awaitingPeerConfirmation = role == RolePrimary
+	}
+
 	return ret, nil
 }
 
@@ -224,6 +259,13 @@ func (c *Controller) Run() {
 		defer wg.Done()
 		c.bcReplication.Run()
 	}()
+	if c.autoFailover != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.autoFailover.Run()
+		}()
+	}
 	wg.Wait()
 	for _, client := range c.replicationClients {
 		client.closer()
@@ -234,6 +276,9 @@ func (c *Controller) GracefulStop() error {
 	c.jwks.GracefulStop()
 	c.mysqlDbPersister.GracefulStop()
 	c.bcReplication.GracefulStop()
+	if c.autoFailover != nil {
+		c.autoFailover.GracefulStop()
+	}
 	return nil
 }
 
@@ -277,7 +322,7 @@ func (c *Controller) SetIsStandbyCallback(callback IsStandbyCallback) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	c.standbyCallback = callback
-	c.setProviderIsStandby(c.role != RolePrimary)
+	c.setProviderIsStandby(c.role != RolePrimary || c.awaitingPeerConfirmation)
 }
 
 func (c *Controller) ManageQueryConnections(iterSessions IterSessions, killQuery func(uint32), killConnection func(uint32) error) {
@@ -475,6 +520,13 @@ func (c *Controller) refreshSystemVars() {
 			Type:    gmstypes.NewSystemIntType(dsess.DoltClusterRoleEpochVariable, 0, 9223372036854775807, false),
 			Default: epoch,
 		},
+		&sql.MysqlSystemVariable{
+			Name:    dsess.DoltClusterAckWritesMinAcks,
+			Dynamic: false,
+			Scope:   sql.GetMysqlScope(sql.SystemVariableScope_Global),
+			Type:    gmstypes.NewSystemStringType(dsess.DoltClusterAckWritesMinAcks),
+			Default: c.cfg.MinAcks(),
+		},
 	}
 	c.systemVars.AddSystemVariables(vars)
 }
@@ -612,6 +664,15 @@ func (c *Controller) setRoleAndEpoch(role string, epoch int, opts roleTransition
 		}
 	}
 
+	// Any explicit or forced role change supersedes waiting for our peers
+	// to confirm our role at startup.
+	if c.awaitingPeerConfirmation {
+		c.awaitingPeerConfirmation = false
+		if !changedrole && role == string(RolePrimary) {
+			c.setProviderIsStandby(false)
+		}
+	}
+
 	c.role = Role(role)
 	c.epoch = epoch
 
@@ -624,6 +685,7 @@ func (c *Controller) setRoleAndEpoch(role string, epoch int, opts roleTransition
 		}
 		c.mysqlDbPersister.setRole(c.role)
 		c.bcReplication.setRole(c.role)
+		c.notifyApplied()
 	}
 	_ = c.persistVariables()
 	return roleTransitionResult{
@@ -632,6 +694,37 @@ func (c *Controller) setRoleAndEpoch(role string, epoch int, opts roleTransition
 	}, nil
 }
 
+// Called by autoFailover once a majority of the cluster confirms that this
+// server is still the primary. Makes the databases writable.
+func (c *Controller) confirmPrimary() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.awaitingPeerConfirmation {
+		return
+	}
+	c.awaitingPeerConfirmation = false
+	if c.role == RolePrimary {
+		c.lgr.Infof("cluster: auto failover: confirmed this server is primary at epoch %d. accepting writes.", c.epoch)
+		c.setProviderIsStandby(false)
+	}
+}
+
+// Called by autoFailover when this server is the primary and has not reached
+// a majority of the cluster within the failover timeout. The rest of the
+// cluster may be electing a new primary, so we make the databases read only
+// and kill running queries until confirmPrimary.
+func (c *Controller) fencePrimary() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.awaitingPeerConfirmation || c.role != RolePrimary {
+		return
+	}
+	c.lgr.Warnf("cluster: auto failover: this server is primary at epoch %d but has not reached a majority of the cluster. no longer accepting writes.", c.epoch)
+	c.awaitingPeerConfirmation = true
+	c.setProviderIsStandby(true)
+	c.killRunningQueries(-1)
+}
+
 func (c *Controller) roleAndEpoch() (Role, int) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -655,7 +748,7 @@ func (c *Controller) GetClusterStatus() []clusterdb.ReplicaStatus {
 	c.mu.Unlock()
 	ret := make([]clusterdb.ReplicaStatus, len(commithooks))
 	for i, c := range commithooks {
-		lag, lastUpdate, currentErrorStr := c.status()
+		lag, lastUpdate, currentErrorStr, lagging := c.status()
 		ret[i] = clusterdb.ReplicaStatus{
 			Database:       c.dbname,
 			Remote:         c.remotename,
@@ -664,6 +757,7 @@ func (c *Controller) GetClusterStatus() []clusterdb.ReplicaStatus {
 			ReplicationLag: lag,
 			LastUpdate:     lastUpdate,
 			CurrentError:   currentErrorStr,
+			Lagging:        lagging,
 		}
 	}
 	return ret
@@ -680,6 +774,9 @@ func (c *Controller) recordSuccessfulRemoteSrvCommit(name string) {
 			c.recordSuccessfulRemoteSrvCommit()
 		}
 	}
+	c.mu.Lock()
+	c.notifyApplied()
+	c.mu.Unlock()
 }
 
 func (c *Controller) RemoteSrvServerArgs(ctxFactory func(context.Context) (*sql.Context, error), args remotesrv.ServerArgs) (remotesrv.ServerArgs, error) {
@@ -761,6 +858,7 @@ func (c *Controller) RegisterGrpcServices(ctxFactory func(context.Context) (*sql
 		branchControl:        c.branchControlController,
 		branchControlFilesys: c.branchControlFilesys,
 		dropDatabase:         c.dropDatabase,
+		failover:             c.autoFailover,
 		lgr:                  c.lgr.WithFields(logrus.Fields{}),
 	})
 }
@@ -1144,6 +1242,18 @@ func (c *Controller) replicationServiceDialOptions() []grpc.DialOption {
 
 	ret = append(ret, grpc.WithPerRPCCredentials(c.grpcCreds))
 
+	if af := c.cfg.AutoFailoverConfig(); af != nil {
+		// A primary needs to notice within a heartbeat or two that a
+		// peer it could not reach before is back, or it may give up
+		// its lease while a majority is reachable.
+		bc := grpcbackoff.DefaultConfig
+		bc.MaxDelay = time.Duration(af.HeartbeatIntervalMillis()) * time.Millisecond
+		ret = append(ret, grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff:           bc,
+			MinConnectTimeout: time.Duration(af.ConnectTimeoutMillis()) * time.Millisecond,
+		}))
+	}
+
 	return ret
 }
 
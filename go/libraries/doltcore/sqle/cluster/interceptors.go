@@ -36,11 +36,19 @@ const clusterRoleEpochHeader = "x-dolt-cluster-role-epoch"
 
 var writeEndpoints map[string]bool
 
+// failoverEndpoints are called between members of a cluster in any role, in
+// order to implement automatic failover.
+var failoverEndpoints map[string]bool
+
 func init() {
 	writeEndpoints = make(map[string]bool)
 	writeEndpoints["/dolt.services.remotesapi.v1alpha1.ChunkStoreService/Commit"] = true
 	writeEndpoints["/dolt.services.remotesapi.v1alpha1.ChunkStoreService/AddTableFiles"] = true
 	writeEndpoints["/dolt.services.remotesapi.v1alpha1.ChunkStoreService/GetUploadLocations"] = true
+
+	failoverEndpoints = make(map[string]bool)
+	failoverEndpoints["/dolt.services.replicationapi.v1alpha1.ReplicationService/Heartbeat"] = true
+	failoverEndpoints["/dolt.services.replicationapi.v1alpha1.ReplicationService/RequestVote"] = true
 }
 
 func isLikelyServerResponse(err error) bool {
@@ -71,6 +79,8 @@ func isLikelyServerResponse(err error) bool {
 // response header asserts that the standby replica is a primary at a higher
 // epoch than this server, this incterceptor coordinates with the Controller to
 // immediately transition to standby and to stop replicating to the standby.
+//
+// Requests to the failoverEndpoints are sent in every role.
 type clientinterceptor struct {
 	lgr        *logrus.Entry
 	role       Role
@@ -114,10 +124,11 @@ func (ci *clientinterceptor) Unary() grpc.UnaryClientInterceptor {
 	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
 		role, epoch := ci.getRole()
 		ci.lgr.Tracef("cluster: clientinterceptor: processing request to %s, role %s", method, string(role))
-		if role == RoleStandby {
+		isFailover := failoverEndpoints[method]
+		if role == RoleStandby && !isFailover {
 			return status.Error(codes.FailedPrecondition, "cluster: clientinterceptor: this server is a standby and is not currently replicating to its standby")
 		}
-		if role == RoleDetectedBrokenConfig {
+		if role == RoleDetectedBrokenConfig && !isFailover {
 			return status.Error(codes.FailedPrecondition, "cluster: clientinterceptor: this server is in detected_broken_config and is not currently replicating to its standby")
 		}
 		ctx = metadata.AppendToOutgoingContext(ctx, clusterRoleHeader, string(role), clusterRoleEpochHeader, strconv.Itoa(epoch))
@@ -183,6 +194,8 @@ func (ci *clientinterceptor) Options() []grpc.DialOption {
 // request asserts that the client is the current primary at an epoch higher
 // than our current epoch, this interceptor coordinates with the Controller to
 // immediately transition to standby and allow replication requests through.
+// * for incoming standby traffic to the failoverEndpoints, it calls the handler
+// in every role.
 // * for incoming requests which are not standby, it will currently fail the
 // requests with codes.Unauthenticated. Eventually, it will allow read-only
 // traffic through which is authenticated and authorized.
@@ -249,6 +262,9 @@ func (si *serverinterceptor) Unary() grpc.UnaryServerInterceptor {
 			if err := grpc.SetHeader(ctx, metadata.Pairs(clusterRoleHeader, string(role), clusterRoleEpochHeader, strconv.Itoa(epoch))); err != nil {
 				return nil, err
 			}
+			if failoverEndpoints[info.FullMethod] {
+				return handler(ctx, req)
+			}
 			if role == RolePrimary {
 				// As a primary, we do not accept replication requests.
 				return nil, status.Error(codes.FailedPrecondition, "this server is a primary and is not currently accepting replication")
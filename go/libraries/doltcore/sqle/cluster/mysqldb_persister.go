@@ -257,9 +257,11 @@ func (p *replicatingMySQLDbPersister) Persist(ctx *sql.Context, data []byte) err
 		var rsc doltdb.ReplicationStatusController
 		rsc.Wait = make([]func(context.Context) error, len(p.replicas))
 		rsc.NotifyWaitFailed = make([]func(), len(p.replicas))
+		rsc.Replicas = make([]string, len(p.replicas))
 		for i, r := range p.replicas {
 			rsc.Wait[i] = r.UpdateMySQLDb(ctx, p.current, p.version)
 			rsc.NotifyWaitFailed[i] = func() {}
+			rsc.Replicas[i] = r.client.remote
 		}
 		p.mu.Unlock()
 		dsess.WaitForReplicationController(ctx, rsc)
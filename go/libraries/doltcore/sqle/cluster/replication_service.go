@@ -44,6 +44,9 @@ type replicationServiceServer struct {
 	branchControlFilesys filesys.Filesys
 
 	dropDatabase func(*sql.Context, string) error
+
+	// nil unless automatic failover is configured.
+	failover *autoFailover
 }
 
 func (s *replicationServiceServer) UpdateUsersAndGrants(ctx context.Context, req *replicationapi.UpdateUsersAndGrantsRequest) (*replicationapi.UpdateUsersAndGrantsResponse, error) {
@@ -99,3 +102,17 @@ func (s *replicationServiceServer) DropDatabase(ctx context.Context, req *replic
 	}
 	return &replicationapi.DropDatabaseResponse{}, nil
 }
+
+func (s *replicationServiceServer) Heartbeat(ctx context.Context, req *replicationapi.HeartbeatRequest) (*replicationapi.HeartbeatResponse, error) {
+	if s.failover == nil {
+		return nil, status.Error(codes.Unimplemented, "unimplemented")
+	}
+	return s.failover.handleHeartbeat(req), nil
+}
+
+func (s *replicationServiceServer) RequestVote(ctx context.Context, req *replicationapi.RequestVoteRequest) (*replicationapi.RequestVoteResponse, error) {
+	if s.failover == nil {
+		return nil, status.Error(codes.Unimplemented, "unimplemented")
+	}
+	return s.failover.handleRequestVote(req), nil
+}
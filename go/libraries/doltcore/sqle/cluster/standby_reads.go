@@ -0,0 +1,184 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/clusterdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+)
+
+// A primary which is caught up heartbeats its standbys about once a second. A
+// standby we have not heard from in this long is not reported as healthy.
+const standbyHealthyContactTimeout = 5 * time.Second
+
+var ErrReplicationLagExceeded = dsess.ErrReplicationLagExceeded
+
+// CheckReplicationLag enforces @@dolt_max_replication_lag_ms for a session
+// reading |dbname| on a standby. When the last update applied from the
+// primary is older than the bound, it waits for up to
+// @@dolt_replication_lag_wait_timeout_ms for a newer one before returning
+// ErrReplicationLagExceeded.
+//
+// A caught up primary heartbeats its standbys about once a second, so bounds
+// much below that will see a standby as lagging even when it is caught up.
+func (c *Controller) CheckReplicationLag(ctx *sql.Context, dbname string) error {
+	if c == nil {
+		return nil
+	}
+	maxLag, err := sessionMillisVariable(ctx, dsess.DoltMaxReplicationLagMs)
+	if err != nil || maxLag == 0 {
+		return err
+	}
+	waitTimeout, err := sessionMillisVariable(ctx, dsess.DoltReplicationLagWaitTimeoutMs)
+	if err != nil {
+		return err
+	}
+	deadline := time.Now().Add(waitTimeout)
+	for {
+		c.mu.Lock()
+		role := c.role
+		applied := c.applied
+		commithooks := make([]*commithook, 0, len(c.commithooks))
+		for _, h := range c.commithooks {
+			if h.dbname == dbname {
+				commithooks = append(commithooks, h)
+			}
+		}
+		c.mu.Unlock()
+		if role == RolePrimary || len(commithooks) == 0 {
+			return nil
+		}
+
+		var lastApplied time.Time
+		for _, h := range commithooks {
+			if t := h.lastApplied(); t.After(lastApplied) {
+				lastApplied = t
+			}
+		}
+		if lastApplied != (time.Time{}) && time.Since(lastApplied) <= maxLag {
+			return nil
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			if lastApplied == (time.Time{}) {
+				return fmt.Errorf("%w: database %s has not received an update from the primary", ErrReplicationLagExceeded, dbname)
+			}
+			return fmt.Errorf("%w: database %s last received an update from the primary %dms ago", ErrReplicationLagExceeded, dbname, time.Since(lastApplied).Milliseconds())
+		}
+		timer := time.NewTimer(remaining)
+		select {
+		case <-applied:
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return context.Cause(ctx)
+		}
+		timer.Stop()
+	}
+}
+
+func sessionMillisVariable(ctx *sql.Context, name string) (time.Duration, error) {
+	val, err := ctx.GetSessionVariable(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+	millis, ok := val.(int64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected type for variable %s: %T", name, val)
+	}
+	return time.Duration(millis) * time.Millisecond, nil
+}
+
+// Called with |c.mu| held. Wakes any sessions waiting in
+// CheckReplicationLag for a newer update from the primary.
+func (c *Controller) notifyApplied() {
+	close(c.applied)
+	c.applied = make(chan struct{})
+}
+
+// GetStandbyStatus returns the status of each of our standbys, aggregated
+// across all of the replicated databases. It returns nothing unless we are the
+// primary.
+func (c *Controller) GetStandbyStatus() []clusterdb.StandbyStatus {
+	if c == nil {
+		return []clusterdb.StandbyStatus{}
+	}
+	c.mu.Lock()
+	role := c.role
+	commithooks := make([]*commithook, len(c.commithooks))
+	copy(commithooks, c.commithooks)
+	c.mu.Unlock()
+	if role != RolePrimary {
+		return []clusterdb.StandbyStatus{}
+	}
+
+	remotes := c.cfg.StandbyRemotes()
+	ret := make([]clusterdb.StandbyStatus, len(remotes))
+	for i, r := range remotes {
+		ret[i].Remote = r.Name()
+		ret[i].Host = standbyHost(r.RemoteURLTemplate())
+		ret[i].Healthy = true
+		var oldestContact time.Time
+		neverContacted := false
+		for _, h := range commithooks {
+			if h.remotename != r.Name() {
+				continue
+			}
+			ret[i].Databases += 1
+			lastContact, lag, currentErr := h.standbyHealth()
+			if lag > ret[i].ReplicationLag {
+				ret[i].ReplicationLag = lag
+			}
+			if currentErr != nil {
+				ret[i].Healthy = false
+				if ret[i].CurrentError == nil {
+					ret[i].CurrentError = currentErr
+				}
+			}
+			if lastContact == (time.Time{}) {
+				neverContacted = true
+			} else if oldestContact == (time.Time{}) || lastContact.Before(oldestContact) {
+				oldestContact = lastContact
+			}
+		}
+		if neverContacted || ret[i].Databases == 0 {
+			ret[i].Healthy = false
+		} else {
+			ret[i].LastContact = &oldestContact
+			if time.Since(oldestContact) > standbyHealthyContactTimeout {
+				ret[i].Healthy = false
+			}
+		}
+	}
+	return ret
+}
+
+func standbyHost(remoteUrlTemplate string) string {
+	urlStr := strings.Replace(remoteUrlTemplate, dsess.URLTemplateDatabasePlaceholder, "", -1)
+	u, err := url.Parse(urlStr)
+	if err != nil {
+		return ""
+	}
+	return u.Hostname()
+}
@@ -38,6 +38,9 @@ type ReplicaStatus struct {
 	// A string describing the last encountered error.  NULL when we are a
 	// standby. NULL when our last replication attempt succeeded.
 	CurrentError *string
+	// As a primary, whether the standby has not yet acknowledged our most
+	// recent write. NULL when we are a standby.
+	Lagging *bool
 }
 
 type ClusterStatusProvider interface {
@@ -93,7 +96,7 @@ func replicaStatusesToRows(rss []ReplicaStatus) []sql.Row {
 }
 
 func replicaStatusToRow(rs ReplicaStatus) sql.Row {
-	ret := make(sql.Row, 7)
+	ret := make(sql.Row, 8)
 	ret[0] = rs.Database
 	ret[1] = rs.Remote
 	ret[2] = rs.Role
@@ -107,6 +110,13 @@ func replicaStatusToRow(rs ReplicaStatus) sql.Row {
 	if rs.CurrentError != nil {
 		ret[6] = *rs.CurrentError
 	}
+	if rs.Lagging != nil {
+		if *rs.Lagging {
+			ret[7] = int8(1)
+		} else {
+			ret[7] = int8(0)
+		}
+	}
 	return ret
 }
 
@@ -119,5 +129,6 @@ func (t ClusterStatusTable) Schema() sql.Schema {
 		{Name: "replication_lag_millis", Type: types.Int64, Source: StatusTableName, PrimaryKey: false, Nullable: true},
 		{Name: "last_update", Type: types.Datetime, Source: StatusTableName, PrimaryKey: false, Nullable: true},
 		{Name: "current_error", Type: types.Text, Source: StatusTableName, PrimaryKey: false, Nullable: true},
+		{Name: "is_lagging", Type: types.Boolean, Source: StatusTableName, PrimaryKey: false, Nullable: true},
 	}
 }
@@ -32,13 +32,21 @@ import (
 const DoltClusterDbName = "dolt_cluster"
 
 type database struct {
-	statusProvider ClusterStatusProvider
+	statusProvider StatusProvider
+}
+
+// StatusProvider provides the contents of the tables in the dolt_cluster
+// database.
+type StatusProvider interface {
+	ClusterStatusProvider
+	StandbyStatusProvider
 }
 
 var _ sql.Database = database{}
 var _ dsess.SqlDatabase = database{}
 
 const StatusTableName = "dolt_cluster_status"
+const StandbysTableName = "dolt_cluster_standbys"
 
 func (database) Name() string {
 	return DoltClusterDbName
@@ -53,14 +61,17 @@ func (db database) GetTableInsensitive(ctx *sql.Context, tblName string) (sql.Ta
 	if tblName == StatusTableName {
 		return NewClusterStatusTable(db.statusProvider), true, nil
 	}
+	if tblName == StandbysTableName {
+		return NewStandbysTable(db.statusProvider), true, nil
+	}
 	return nil, false, nil
 }
 
 func (database) GetTableNames(ctx *sql.Context) ([]string, error) {
-	return []string{StatusTableName}, nil
+	return []string{StatusTableName, StandbysTableName}, nil
 }
 
-func NewClusterDatabase(p ClusterStatusProvider) sql.Database {
+func NewClusterDatabase(p StatusProvider) sql.Database {
 	return database{p}
 }
 
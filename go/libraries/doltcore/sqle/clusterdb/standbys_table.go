@@ -0,0 +1,123 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clusterdb
+
+import (
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+type StandbyStatus struct {
+	// The name of the standby remote.
+	Remote string
+	// The host of the standby, taken from its remote_url_template.
+	Host string
+	// The number of databases replicating to this standby.
+	Databases int
+	// The largest replication lag across all of the databases. Zero when
+	// the standby is caught up on every database.
+	ReplicationLag time.Duration
+	// The least recent time a push or heartbeat to this standby succeeded
+	// across all of the databases. NULL if some database has not yet
+	// replicated to the standby.
+	LastContact *time.Time
+	// Whether the standby is currently reachable, replicating without
+	// errors, and has recently been in contact with us.
+	Healthy bool
+	// A string describing one of the current replication errors, if any.
+	CurrentError *string
+}
+
+type StandbyStatusProvider interface {
+	GetStandbyStatus() []StandbyStatus
+}
+
+var _ sql.Table = StandbysTable{}
+
+func NewStandbysTable(provider StandbyStatusProvider) sql.Table {
+	return StandbysTable{provider}
+}
+
+// StandbysTable lists the standbys of this server, along with whether each
+// one is healthy. It is only populated on a primary; a standby does not
+// know the state of its peers.
+type StandbysTable struct {
+	provider StandbyStatusProvider
+}
+
+func (t StandbysTable) Name() string {
+	return StandbysTableName
+}
+
+func (t StandbysTable) String() string {
+	return StandbysTableName
+}
+
+func (t StandbysTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+func (t StandbysTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	return sql.PartitionsToPartitionIter((*partition)(nil)), nil
+}
+
+func (t StandbysTable) PartitionRows(*sql.Context, sql.Partition) (sql.RowIter, error) {
+	if t.provider == nil {
+		return sql.RowsToRowIter(), nil
+	}
+	return sql.RowsToRowIter(standbyStatusesToRows(t.provider.GetStandbyStatus())...), nil
+}
+
+func standbyStatusesToRows(sss []StandbyStatus) []sql.Row {
+	ret := make([]sql.Row, len(sss))
+	for i, ss := range sss {
+		ret[i] = standbyStatusToRow(ss)
+	}
+	return ret
+}
+
+func standbyStatusToRow(ss StandbyStatus) sql.Row {
+	ret := make(sql.Row, 7)
+	ret[0] = ss.Remote
+	ret[1] = ss.Host
+	ret[2] = int64(ss.Databases)
+	ret[3] = ss.ReplicationLag.Milliseconds()
+	if ss.LastContact != nil {
+		ret[4] = *ss.LastContact
+	}
+	if ss.Healthy {
+		ret[5] = int8(1)
+	} else {
+		ret[5] = int8(0)
+	}
+	if ss.CurrentError != nil {
+		ret[6] = *ss.CurrentError
+	}
+	return ret
+}
+
+func (t StandbysTable) Schema() sql.Schema {
+	return sql.Schema{
+		{Name: "standby_remote", Type: types.Text, Source: StandbysTableName, PrimaryKey: true, Nullable: false},
+		{Name: "host", Type: types.Text, Source: StandbysTableName, PrimaryKey: false, Nullable: false},
+		{Name: "databases", Type: types.Int64, Source: StandbysTableName, PrimaryKey: false, Nullable: false},
+		{Name: "replication_lag_millis", Type: types.Int64, Source: StandbysTableName, PrimaryKey: false, Nullable: false},
+		{Name: "last_contact", Type: types.Datetime, Source: StandbysTableName, PrimaryKey: false, Nullable: true},
+		{Name: "is_healthy", Type: types.Boolean, Source: StandbysTableName, PrimaryKey: false, Nullable: false},
+		{Name: "current_error", Type: types.Text, Source: StandbysTableName, PrimaryKey: false, Nullable: true},
+	}
+}
@@ -331,6 +331,9 @@ func (db Database) getTableInsensitive(ctx *sql.Context, head *doltdb.Commit, ds
 		}
 
 		tableName := tblName[len(doltdb.DoltDiffTablePrefix):]
+		if err := checkRowPoliciesUnrestricted(ctx, db, tableName, tblName); err != nil {
+			return nil, false, err
+		}
 		dt, err := dtables.NewDiffTable(ctx, db.Name(), tableName, db.ddb, root, head)
 		if err != nil {
 			return nil, false, err
@@ -339,6 +342,9 @@ func (db Database) getTableInsensitive(ctx *sql.Context, head *doltdb.Commit, ds
 
 	case strings.HasPrefix(lwrName, doltdb.DoltCommitDiffTablePrefix):
 		suffix := tblName[len(doltdb.DoltCommitDiffTablePrefix):]
+		if err := checkRowPoliciesUnrestricted(ctx, db, suffix, tblName); err != nil {
+			return nil, false, err
+		}
 		ws, err := ds.WorkingSet(ctx, db.RevisionQualifiedName())
 		if err != nil {
 			return nil, false, err
@@ -378,6 +384,9 @@ func (db Database) getTableInsensitive(ctx *sql.Context, head *doltdb.Commit, ds
 
 	case strings.HasPrefix(lwrName, doltdb.DoltConfTablePrefix):
 		suffix := tblName[len(doltdb.DoltConfTablePrefix):]
+		if err := checkRowPoliciesUnrestricted(ctx, db, suffix, tblName); err != nil {
+			return nil, false, err
+		}
 		srcTable, ok, err := db.getTableInsensitive(ctx, head, ds, root, suffix, asOf)
 		if err != nil {
 			return nil, false, err
@@ -392,6 +401,9 @@ func (db Database) getTableInsensitive(ctx *sql.Context, head *doltdb.Commit, ds
 
 	case strings.HasPrefix(lwrName, doltdb.DoltConstViolTablePrefix):
 		suffix := tblName[len(doltdb.DoltConstViolTablePrefix):]
+		if err := checkRowPoliciesUnrestricted(ctx, db, suffix, tblName); err != nil {
+			return nil, false, err
+		}
 		dt, err := dtables.NewConstraintViolationsTable(ctx, suffix, root, dtables.RootSetter(db))
 		if err != nil {
 			return nil, false, err
@@ -490,6 +502,17 @@ func (db Database) getTableInsensitive(ctx *sql.Context, head *doltdb.Commit, ds
 			versionableTable := backingTable.(dtables.VersionableTable)
 			dt, found = dtables.NewIgnoreTable(ctx, versionableTable), true
 		}
+	case doltdb.RowPoliciesTableName:
+		backingTable, _, err := db.getTable(ctx, root, doltdb.RowPoliciesTableName)
+		if err != nil {
+			return nil, false, err
+		}
+		if backingTable == nil {
+			dt, found = dtables.NewEmptyRowPoliciesTable(ctx), true
+		} else {
+			versionableTable := backingTable.(dtables.VersionableTable)
+			dt, found = dtables.NewRowPoliciesTable(ctx, versionableTable), true
+		}
 	case doltdb.DocTableName:
 		backingTable, _, err := db.getTable(ctx, root, doltdb.DocTableName)
 		if err != nil {
@@ -503,6 +526,10 @@ func (db Database) getTableInsensitive(ctx *sql.Context, head *doltdb.Commit, ds
 		}
 	case doltdb.StatisticsTableName:
 		dt, found = dtables.NewStatisticsTable(ctx, db.Name(), db.ddb, asOf), true
+	case doltdb.SlowQueriesTableName, doltdb.GCStatusTableName, doltdb.BackupStatusTableName, doltdb.ResourceLimitsTableName:
+		if pro, ok := dsess.DSessFromSess(ctx.Session).Provider().(*DoltDatabaseProvider); ok {
+			dt, found = pro.ServerTable(lwrName)
+		}
 	case doltdb.ProceduresTableName:
 		found = true
 		backingTable, _, err := db.getTable(ctx, root, doltdb.ProceduresTableName)
@@ -980,7 +1007,7 @@ func (db Database) GetHeadRoot(ctx *sql.Context) (doltdb.RootValue, error) {
 // DropTable drops the table with the name given.
 // The planner returns the correct case sensitive name in tableName
 func (db Database) DropTable(ctx *sql.Context, tableName string) error {
-	if err := dsess.CheckAccessForDb(ctx, db, branch_control.Permissions_Write); err != nil {
+	if err := dsess.CheckTableAccessForDb(ctx, db, tableName); err != nil {
 		return err
 	}
 	if doltdb.IsNonAlterableSystemTable(tableName) {
@@ -1096,7 +1123,7 @@ func (db Database) removeTableFromAutoIncrementTracker(
 
 // CreateTable creates a table with the name and schema given.
 func (db Database) CreateTable(ctx *sql.Context, tableName string, sch sql.PrimaryKeySchema, collation sql.CollationID, comment string) error {
-	if err := dsess.CheckAccessForDb(ctx, db, branch_control.Permissions_Write); err != nil {
+	if err := dsess.CheckTableAccessForDb(ctx, db, tableName); err != nil {
 		return err
 	}
 
@@ -1117,7 +1144,7 @@ func (db Database) CreateTable(ctx *sql.Context, tableName string, sch sql.Prima
 
 // CreateIndexedTable creates a table with the name and schema given.
 func (db Database) CreateIndexedTable(ctx *sql.Context, tableName string, sch sql.PrimaryKeySchema, idxDef sql.IndexDef, collation sql.CollationID) error {
-	if err := dsess.CheckAccessForDb(ctx, db, branch_control.Permissions_Write); err != nil {
+	if err := dsess.CheckTableAccessForDb(ctx, db, tableName); err != nil {
 		return err
 	}
 
@@ -1428,7 +1455,10 @@ func (db Database) AllSchemas(ctx *sql.Context) ([]sql.DatabaseSchema, error) {
 
 // RenameTable implements sql.TableRenamer
 func (db Database) RenameTable(ctx *sql.Context, oldName, newName string) error {
-	if err := dsess.CheckAccessForDb(ctx, db, branch_control.Permissions_Write); err != nil {
+	if err := dsess.CheckTableAccessForDb(ctx, db, oldName); err != nil {
+		return err
+	}
+	if err := dsess.CheckTableAccessForDb(ctx, db, newName); err != nil {
 		return err
 	}
 	root, err := db.GetRoot(ctx)
@@ -23,7 +23,9 @@ import (
 	"strings"
 	"sync"
 
+	gms "github.com/dolthub/go-mysql-server"
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
 	"github.com/dolthub/dolt/go/libraries/doltcore/dbfactory"
@@ -36,6 +38,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dfunctions"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dprocedures"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtables"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/resolve"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqlserver"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor"
@@ -65,8 +68,26 @@ type DoltDatabaseProvider struct {
 
 	dbFactoryUrl string
 	isStandby    *bool
+
+	// Called before a standby returns one of its dolt databases to a session.
+	standbyReadCheck StandbyReadCheck
+
+	// The system tables which show the state of the sql-server rather than of a database, such as dolt_slow_queries,
+	// keyed by their lower case names. They are the same in every database.
+	serverTables map[string]sql.Table
+
+	// The privilege database of the engine, which row policies read the roles of accounts from. nil if there isn't one.
+	mysqlDb *mysql_db.MySQLDb
+
+	// The engine which runs the queries of sessions on this provider, which stored procedures run statements with so
+	// that they are analyzed and have their privileges checked as any other. nil if there isn't one.
+	engine *gms.Engine
 }
 
+// StandbyReadCheck is called with the name of a database before the session of |ctx| reads it from a standby. An
+// error prevents the session from reading the database.
+type StandbyReadCheck func(ctx *sql.Context, dbName string) error
+
 var _ sql.DatabaseProvider = (*DoltDatabaseProvider)(nil)
 var _ sql.FunctionProvider = (*DoltDatabaseProvider)(nil)
 var _ sql.MutableDatabaseProvider = (*DoltDatabaseProvider)(nil)
@@ -150,6 +171,7 @@ func NewDoltDatabaseProviderWithDatabases(defaultBranch string, fs filesys.Files
 		InitDatabaseHooks:      []InitDatabaseHook{ConfigureReplicationDatabaseHook},
 		isStandby:              new(bool),
 		droppedDatabaseManager: newDroppedDatabaseManager(fs),
+		serverTables:           defaultServerTables(),
 	}, nil
 }
 
@@ -204,6 +226,72 @@ func (p *DoltDatabaseProvider) SetIsStandby(standby bool) {
 	*p.isStandby = standby
 }
 
+// SetStandbyReadCheck sets a check which is run before a session reads a dolt database from this provider while it is a
+// standby.
+func (p *DoltDatabaseProvider) SetStandbyReadCheck(check StandbyReadCheck) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.standbyReadCheck = check
+}
+
+// defaultServerTables returns the server tables of a provider before any are set, which show that the parts of the
+// sql-server they are for aren't enabled.
+func defaultServerTables() map[string]sql.Table {
+	tables := make(map[string]sql.Table)
+	for _, t := range []sql.Table{
+		dtables.NewSlowQueriesTable(nil),
+		dtables.NewGCStatusTable(nil),
+		dtables.NewBackupStatusTable(nil),
+	} {
+		tables[strings.ToLower(t.Name())] = t
+	}
+	return tables
+}
+
+// SetServerTable sets a system table which shows the state of the sql-server rather than of a database, and so is the
+// same in every database. It replaces any table with the same name.
+func (p *DoltDatabaseProvider) SetServerTable(table sql.Table) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.serverTables[strings.ToLower(table.Name())] = table
+}
+
+// ServerTable returns the server table named |name| set with SetServerTable, if there is one.
+func (p *DoltDatabaseProvider) ServerTable(name string) (sql.Table, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	t, ok := p.serverTables[strings.ToLower(name)]
+	return t, ok
+}
+
+// SetMySQLDb sets the privilege database which row policies read the roles of accounts from.
+func (p *DoltDatabaseProvider) SetMySQLDb(db *mysql_db.MySQLDb) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.mysqlDb = db
+}
+
+// MySQLDb returns the privilege database set with SetMySQLDb, or nil if there isn't one.
+func (p *DoltDatabaseProvider) MySQLDb() *mysql_db.MySQLDb {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.mysqlDb
+}
+
+// SetEngine sets the engine which runs the queries of sessions on this provider.
+func (p *DoltDatabaseProvider) SetEngine(engine *gms.Engine) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.engine = engine
+}
+
+// Engine returns the engine set with SetEngine, or nil if there isn't one.
+func (p *DoltDatabaseProvider) Engine() *gms.Engine {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.engine
+}
+
 // FileSystemForDatabase returns a filesystem, with the working directory set to the root directory
 // of the requested database. If the requested database isn't found, a database not found error
 // is returned.
@@ -232,6 +320,10 @@ func (p *DoltDatabaseProvider) Database(ctx *sql.Context, name string) (sql.Data
 		return nil, sql.ErrDatabaseNotFound.New(name)
 	}
 
+	if err = p.checkStandbyRead(ctx, database); err != nil {
+		return nil, err
+	}
+
 	overriddenSchemaValue, err := getOverriddenSchemaValue(ctx)
 	if err != nil {
 		return nil, err
@@ -313,7 +405,26 @@ func (p *DoltDatabaseProvider) HasDatabase(ctx *sql.Context, name string) bool {
 	if err != nil && !sql.ErrDatabaseNotFound.Is(err) {
 		ctx.GetLogger().Warnf("Error getting database %s: %s", name, err.Error())
 	}
-	return err == nil
+	// A standby which is too far behind its primary still has the database, it just refuses to read it
+	return err == nil || errors.Is(err, dsess.ErrReplicationLagExceeded)
+}
+
+// checkStandbyRead runs the standby read check for |database| while this provider is a standby. The check runs once
+// for each database a transaction reads, and every read of the database in the transaction gets the same result.
+func (p *DoltDatabaseProvider) checkStandbyRead(ctx *sql.Context, database dsess.SqlDatabase) error {
+	p.mu.RLock()
+	standby := *p.isStandby
+	check := p.standbyReadCheck
+	p.mu.RUnlock()
+	if !standby || check == nil || !database.Versioned() {
+		return nil
+	}
+
+	baseName, _ := dsess.SplitRevisionDbName(database.Name())
+	if sess, ok := ctx.Session.(*dsess.DoltSession); ok {
+		return sess.CheckStandbyRead(ctx, baseName, check)
+	}
+	return check(ctx, baseName)
 }
 
 func (p *DoltDatabaseProvider) AllDatabases(ctx *sql.Context) (all []sql.Database) {
@@ -1331,6 +1442,8 @@ func (p *DoltDatabaseProvider) TableFunction(_ *sql.Context, name string) (sql.T
 		return &ReflogTableFunction{}, nil
 	case "dolt_query_diff":
 		return &QueryDiffTableFunction{}, nil
+	case "dolt_json_diff":
+		return &JsonDiffTableFunction{}, nil
 	}
 
 	if fun, ok := p.tableFunctions[name]; ok {
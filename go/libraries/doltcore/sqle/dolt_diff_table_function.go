@@ -432,6 +432,10 @@ func (dtf *DiffTableFunction) generateSchema(ctx *sql.Context, fromCommitVal, to
 		return fmt.Errorf("unexpected database type: %T", dtf.database)
 	}
 
+	if err := checkRowPoliciesUnrestricted(ctx, sqledb, tableName, "DOLT_DIFF()"); err != nil {
+		return err
+	}
+
 	delta, err := dtf.cacheTableDelta(ctx, fromCommitVal, toCommitVal, dotCommitVal, tableName, sqledb)
 	if err != nil {
 		return err
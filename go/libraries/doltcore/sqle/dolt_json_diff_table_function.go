@@ -0,0 +1,554 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtables"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+)
+
+const jsonDiffDefaultRowCount = 100
+
+var _ sql.TableFunction = (*JsonDiffTableFunction)(nil)
+var _ sql.ExecSourceRel = (*JsonDiffTableFunction)(nil)
+
+// JsonDiffTableFunction implements the dolt_json_diff table function, which reports the changes made to a single
+// JSON column between two revisions as one row per changed JSON path, rather than as a wholesale replacement of
+// the document.
+type JsonDiffTableFunction struct {
+	ctx *sql.Context
+
+	// fromCommitExpr and toCommitExpr are set when the function is invoked with four arguments
+	// dolt_json_diff('from_commit', 'to_commit', 'table_name', 'column_name')
+	fromCommitExpr sql.Expression
+	toCommitExpr   sql.Expression
+
+	// dotCommitExpr is set when the function is invoked with three arguments
+	// dolt_json_diff('from_commit..to_commit', 'table_name', 'column_name')
+	dotCommitExpr sql.Expression
+
+	tableNameExpr  sql.Expression
+	columnNameExpr sql.Expression
+
+	database sql.Database
+}
+
+var jsonDiffTableSchema = sql.Schema{
+	&sql.Column{Name: "primary_key", Type: types.JSON, Nullable: false},   // 0
+	&sql.Column{Name: "path", Type: types.LongText, Nullable: false},      // 1
+	&sql.Column{Name: "diff_type", Type: types.LongText, Nullable: false}, // 2
+	&sql.Column{Name: "from_value", Type: types.JSON, Nullable: true},     // 3
+	&sql.Column{Name: "to_value", Type: types.JSON, Nullable: true},       // 4
+}
+
+// NewInstance creates a new instance of TableFunction interface
+func (jd *JsonDiffTableFunction) NewInstance(ctx *sql.Context, db sql.Database, expressions []sql.Expression) (sql.Node, error) {
+	newInstance := &JsonDiffTableFunction{
+		ctx:      ctx,
+		database: db,
+	}
+
+	node, err := newInstance.WithExpressions(expressions...)
+	if err != nil {
+		return nil, err
+	}
+
+	return node, nil
+}
+
+func (jd *JsonDiffTableFunction) DataLength(ctx *sql.Context) (uint64, error) {
+	numBytesPerRow := schema.SchemaAvgLength(jd.Schema())
+	numRows, _, err := jd.RowCount(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return numBytesPerRow * numRows, nil
+}
+
+func (jd *JsonDiffTableFunction) RowCount(_ *sql.Context) (uint64, bool, error) {
+	return jsonDiffDefaultRowCount, false, nil
+}
+
+// Database implements the sql.Databaser interface
+func (jd *JsonDiffTableFunction) Database() sql.Database {
+	return jd.database
+}
+
+// WithDatabase implements the sql.Databaser interface
+func (jd *JsonDiffTableFunction) WithDatabase(database sql.Database) (sql.Node, error) {
+	njd := *jd
+	njd.database = database
+	return &njd, nil
+}
+
+// Name implements the sql.TableFunction interface
+func (jd *JsonDiffTableFunction) Name() string {
+	return "dolt_json_diff"
+}
+
+func (jd *JsonDiffTableFunction) commitsResolved() bool {
+	if jd.dotCommitExpr != nil {
+		return jd.dotCommitExpr.Resolved()
+	}
+	return jd.fromCommitExpr.Resolved() && jd.toCommitExpr.Resolved()
+}
+
+// Resolved implements the sql.Resolvable interface
+func (jd *JsonDiffTableFunction) Resolved() bool {
+	return jd.commitsResolved() && jd.tableNameExpr.Resolved() && jd.columnNameExpr.Resolved()
+}
+
+func (jd *JsonDiffTableFunction) IsReadOnly() bool {
+	return true
+}
+
+// String implements the Stringer interface
+func (jd *JsonDiffTableFunction) String() string {
+	if jd.dotCommitExpr != nil {
+		return fmt.Sprintf("DOLT_JSON_DIFF(%s, %s, %s)", jd.dotCommitExpr.String(), jd.tableNameExpr.String(), jd.columnNameExpr.String())
+	}
+	return fmt.Sprintf("DOLT_JSON_DIFF(%s, %s, %s, %s)", jd.fromCommitExpr.String(), jd.toCommitExpr.String(), jd.tableNameExpr.String(), jd.columnNameExpr.String())
+}
+
+// Schema implements the sql.Node interface.
+func (jd *JsonDiffTableFunction) Schema() sql.Schema {
+	return jsonDiffTableSchema
+}
+
+// Children implements the sql.Node interface.
+func (jd *JsonDiffTableFunction) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (jd *JsonDiffTableFunction) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("unexpected children")
+	}
+	return jd, nil
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (jd *JsonDiffTableFunction) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	_, _, _, tableName, _, err := jd.evaluateArguments()
+	if err != nil {
+		return false
+	}
+
+	subject := sql.PrivilegeCheckSubject{Database: jd.database.Name(), Table: tableName}
+	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(subject, sql.PrivilegeType_Select))
+}
+
+// Expressions implements the sql.Expressioner interface.
+func (jd *JsonDiffTableFunction) Expressions() []sql.Expression {
+	exprs := []sql.Expression{}
+	if jd.dotCommitExpr != nil {
+		exprs = append(exprs, jd.dotCommitExpr)
+	} else {
+		exprs = append(exprs, jd.fromCommitExpr, jd.toCommitExpr)
+	}
+	return append(exprs, jd.tableNameExpr, jd.columnNameExpr)
+}
+
+// WithExpressions implements the sql.Expressioner interface.
+func (jd *JsonDiffTableFunction) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) < 3 || len(exprs) > 4 {
+		return nil, sql.ErrInvalidArgumentNumber.New(jd.Name(), "3 or 4", len(exprs))
+	}
+
+	for _, expr := range exprs {
+		if !expr.Resolved() {
+			return nil, ErrInvalidNonLiteralArgument.New(jd.Name(), expr.String())
+		}
+		// prepared statements resolve functions beforehand, so above check fails
+		if _, ok := expr.(sql.FunctionExpression); ok {
+			return nil, ErrInvalidNonLiteralArgument.New(jd.Name(), expr.String())
+		}
+	}
+
+	newJd := *jd
+	if len(exprs) == 3 {
+		if !strings.Contains(exprs[0].String(), "..") {
+			return nil, sql.ErrInvalidArgumentDetails.New(newJd.Name(), "There are only 3 arguments present, and the first does not contain '..'")
+		}
+		newJd.dotCommitExpr = exprs[0]
+		newJd.tableNameExpr = exprs[1]
+		newJd.columnNameExpr = exprs[2]
+	} else {
+		newJd.fromCommitExpr = exprs[0]
+		newJd.toCommitExpr = exprs[1]
+		newJd.tableNameExpr = exprs[2]
+		newJd.columnNameExpr = exprs[3]
+	}
+
+	for _, expr := range newJd.Expressions() {
+		if !types.IsText(expr.Type()) && !expression.IsBindVar(expr) {
+			return nil, sql.ErrInvalidArgumentDetails.New(newJd.Name(), expr.String())
+		}
+	}
+
+	return &newJd, nil
+}
+
+// RowIter implements the sql.Node interface
+func (jd *JsonDiffTableFunction) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	fromCommitVal, toCommitVal, dotCommitVal, tableName, columnName, err := jd.evaluateArguments()
+	if err != nil {
+		return nil, err
+	}
+
+	sqledb, ok := jd.database.(dsess.SqlDatabase)
+	if !ok {
+		return nil, fmt.Errorf("unexpected database type: %T", jd.database)
+	}
+	if err := checkRowPoliciesUnrestricted(ctx, sqledb, tableName, "DOLT_JSON_DIFF()"); err != nil {
+		return nil, err
+	}
+
+	fromRefDetails, toRefDetails, err := loadDetailsForRefs(ctx, fromCommitVal, toCommitVal, dotCommitVal, sqledb)
+	if err != nil {
+		return nil, err
+	}
+
+	fromTblExists, err := fromRefDetails.root.HasTable(ctx, doltdb.TableName{Name: tableName})
+	if err != nil {
+		return nil, err
+	}
+	toTblExists, err := toRefDetails.root.HasTable(ctx, doltdb.TableName{Name: tableName})
+	if err != nil {
+		return nil, err
+	}
+	if !fromTblExists && !toTblExists {
+		return nil, sql.ErrTableNotFound.New(tableName)
+	}
+
+	deltas, err := diff.GetTableDeltas(ctx, fromRefDetails.root, toRefDetails.root)
+	if err != nil {
+		return nil, err
+	}
+
+	delta := findMatchingDelta(deltas, tableName)
+	if delta.FromTable == nil && delta.ToTable == nil {
+		// the table exists but is unchanged between the two revisions
+		return &jsonDiffTableFunctionRowIter{}, nil
+	}
+
+	if err = validateJsonDiffColumn(delta, tableName, columnName); err != nil {
+		return nil, err
+	}
+
+	diffTableSch, j, err := dtables.GetDiffTableSchemaAndJoiner(delta.Format(), delta.FromSch, delta.ToSch)
+	if err != nil {
+		return nil, err
+	}
+	diffPkSch, err := sqlutil.FromDoltSchema("", "", diffTableSch)
+	if err != nil {
+		return nil, err
+	}
+
+	dp := dtables.NewDiffPartition(delta.ToTable, delta.FromTable, toRefDetails.hashStr, fromRefDetails.hashStr, toRefDetails.commitTime, fromRefDetails.commitTime, delta.ToSch, delta.FromSch)
+	ri := dtables.NewDiffPartitionRowIter(*dp, sqledb.DbData().Ddb, j)
+
+	return newJsonDiffTableFunctionRowIter(ri, diffPkSch.Schema, delta.FromSch, delta.ToSch, columnName)
+}
+
+// validateJsonDiffColumn returns an error if the table named cannot be diffed row by row, or if |columnName| is not a
+// JSON column on either side of |delta|.
+func validateJsonDiffColumn(delta diff.TableDelta, tableName, columnName string) error {
+	if !schema.ArePrimaryKeySetsDiffable(delta.Format(), delta.FromSch, delta.ToSch) {
+		return fmt.Errorf("primary key sets differ between revisions for table '%s', cannot compute JSON diff", tableName)
+	}
+	if (delta.FromSch != nil && schema.IsKeyless(delta.FromSch)) || (delta.ToSch != nil && schema.IsKeyless(delta.ToSch)) {
+		return fmt.Errorf("table '%s' has no primary key, cannot compute JSON diff", tableName)
+	}
+
+	found := false
+	for _, sch := range []schema.Schema{delta.FromSch, delta.ToSch} {
+		if sch == nil {
+			continue
+		}
+		col, ok := sch.GetAllCols().GetByNameCaseInsensitive(columnName)
+		if !ok {
+			continue
+		}
+		if _, ok := col.TypeInfo.ToSqlType().(types.JsonType); !ok {
+			return fmt.Errorf("column '%s' of table '%s' is not a JSON column", columnName, tableName)
+		}
+		found = true
+	}
+	if !found {
+		return sql.ErrColumnNotFound.New(columnName)
+	}
+	return nil
+}
+
+// evaluateArguments returns fromCommitVal, toCommitVal, dotCommitVal, tableName and columnName.
+// It evaluates the argument expressions to turn them into values this JsonDiffTableFunction
+// can use. Note that this method only evals the expressions, and doesn't validate the values.
+func (jd *JsonDiffTableFunction) evaluateArguments() (interface{}, interface{}, interface{}, string, string, error) {
+	tableNameVal, err := jd.tableNameExpr.Eval(jd.ctx, nil)
+	if err != nil {
+		return nil, nil, nil, "", "", err
+	}
+	tableName, ok := tableNameVal.(string)
+	if !ok {
+		return nil, nil, nil, "", "", ErrInvalidTableName.New(jd.tableNameExpr.String())
+	}
+
+	columnNameVal, err := jd.columnNameExpr.Eval(jd.ctx, nil)
+	if err != nil {
+		return nil, nil, nil, "", "", err
+	}
+	columnName, ok := columnNameVal.(string)
+	if !ok {
+		return nil, nil, nil, "", "", sql.ErrInvalidArgumentDetails.New(jd.Name(), jd.columnNameExpr.String())
+	}
+
+	if jd.dotCommitExpr != nil {
+		dotCommitVal, err := jd.dotCommitExpr.Eval(jd.ctx, nil)
+		if err != nil {
+			return nil, nil, nil, "", "", err
+		}
+
+		return nil, nil, dotCommitVal, tableName, columnName, nil
+	}
+
+	fromCommitVal, err := jd.fromCommitExpr.Eval(jd.ctx, nil)
+	if err != nil {
+		return nil, nil, nil, "", "", err
+	}
+
+	toCommitVal, err := jd.toCommitExpr.Eval(jd.ctx, nil)
+	if err != nil {
+		return nil, nil, nil, "", "", err
+	}
+
+	return fromCommitVal, toCommitVal, nil, tableName, columnName, nil
+}
+
+//------------------------------------
+// jsonDiffTableFunctionRowIter
+//------------------------------------
+
+var _ sql.RowIter = (*jsonDiffTableFunctionRowIter)(nil)
+
+// jsonDiffTableFunctionRowIter reads rows from a diff partition and expands each changed JSON document into the
+// individual path changes between its from and to values.
+type jsonDiffTableFunctionRowIter struct {
+	rowIter sql.RowIter
+
+	fromPkIdxs, toPkIdxs []int
+	fromColIdx, toColIdx int
+	diffTypeIdx          int
+
+	pending []sql.Row
+}
+
+func newJsonDiffTableFunctionRowIter(rowIter sql.RowIter, diffSch sql.Schema, fromSch, toSch schema.Schema, columnName string) (*jsonDiffTableFunctionRowIter, error) {
+	itr := &jsonDiffTableFunctionRowIter{
+		rowIter:     rowIter,
+		fromColIdx:  -1,
+		toColIdx:    -1,
+		diffTypeIdx: diffSch.IndexOfColName(diffTypeColumnName),
+	}
+
+	if fromSch != nil {
+		itr.fromPkIdxs = pkIndexesInDiffSchema(diffSch, fromSch, diff.FromColNamer)
+		if col, ok := fromSch.GetAllCols().GetByNameCaseInsensitive(columnName); ok {
+			itr.fromColIdx = diffSch.IndexOfColName(diff.FromColNamer(col.Name))
+		}
+	}
+	if toSch != nil {
+		itr.toPkIdxs = pkIndexesInDiffSchema(diffSch, toSch, diff.ToColNamer)
+		if col, ok := toSch.GetAllCols().GetByNameCaseInsensitive(columnName); ok {
+			itr.toColIdx = diffSch.IndexOfColName(diff.ToColNamer(col.Name))
+		}
+	}
+
+	if itr.diffTypeIdx < 0 {
+		return nil, fmt.Errorf("unable to find column '%s' in diff schema", diffTypeColumnName)
+	}
+
+	return itr, nil
+}
+
+func pkIndexesInDiffSchema(diffSch sql.Schema, sch schema.Schema, namer func(string) string) []int {
+	pkCols := sch.GetPKCols().GetColumns()
+	idxs := make([]int, len(pkCols))
+	for i, col := range pkCols {
+		idxs[i] = diffSch.IndexOfColName(namer(col.Name))
+	}
+	return idxs
+}
+
+func (itr *jsonDiffTableFunctionRowIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for len(itr.pending) == 0 {
+		if itr.rowIter == nil {
+			return nil, io.EOF
+		}
+
+		r, err := itr.rowIter.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		itr.pending, err = itr.pathDiffRows(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	row := itr.pending[0]
+	itr.pending = itr.pending[1:]
+	return row, nil
+}
+
+// pathDiffRows converts a single row of the table's diff into the JSON path changes for the column being diffed.
+func (itr *jsonDiffTableFunctionRowIter) pathDiffRows(ctx *sql.Context, r sql.Row) ([]sql.Row, error) {
+	pkIdxs := itr.toPkIdxs
+	if r[itr.diffTypeIdx] == diffTypeRemoved {
+		pkIdxs = itr.fromPkIdxs
+	}
+	pkVals := make([]interface{}, len(pkIdxs))
+	for i, idx := range pkIdxs {
+		pkVals[i] = r[idx]
+	}
+	pk, _, err := types.JSON.Convert(pkVals)
+	if err != nil {
+		return nil, err
+	}
+
+	var from, to interface{}
+	if itr.fromColIdx >= 0 {
+		from, err = jsonValueToInterface(r[itr.fromColIdx])
+		if err != nil {
+			return nil, err
+		}
+	}
+	if itr.toColIdx >= 0 {
+		to, err = jsonValueToInterface(r[itr.toColIdx])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	diffs, err := JsonPathDiffs(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]sql.Row, len(diffs))
+	for i, d := range diffs {
+		var fromVal, toVal interface{}
+		if d.From != nil {
+			fromVal = *d.From
+		}
+		if d.To != nil {
+			toVal = *d.To
+		}
+		rows[i] = sql.Row{pk, d.Key, jsonDiffTypeString(d.Type), fromVal, toVal}
+	}
+	return rows, nil
+}
+
+func (itr *jsonDiffTableFunctionRowIter) Close(ctx *sql.Context) error {
+	if itr.rowIter != nil {
+		return itr.rowIter.Close(ctx)
+	}
+	return nil
+}
+
+const (
+	diffTypeAdded    = "added"
+	diffTypeModified = "modified"
+	diffTypeRemoved  = "removed"
+)
+
+func jsonDiffTypeString(dt tree.DiffType) string {
+	switch dt {
+	case tree.AddedDiff:
+		return diffTypeAdded
+	case tree.RemovedDiff:
+		return diffTypeRemoved
+	default:
+		return diffTypeModified
+	}
+}
+
+// jsonValueToInterface unwraps a JSON column value read from a diff row into its go representation, so that it can
+// be compared with the JSON differ. NULL values are returned as nil.
+func jsonValueToInterface(v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	wrapper, ok := v.(sql.JSONWrapper)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type for JSON value: %T", v)
+	}
+	return wrapper.ToInterface()
+}
+
+// JsonPathDiffs returns the path-level differences between two JSON values. Objects are compared key by key,
+// recursively; any other pair of differing values, including arrays, is reported as a single modification at the
+// deepest path that contains it. A nil |from| or |to| is treated as an absent document, which is reported as an
+// addition or removal of the root path "$".
+func JsonPathDiffs(from, to interface{}) ([]tree.JsonDiff, error) {
+	if from == nil && to == nil {
+		return nil, nil
+	} else if from == nil {
+		return []tree.JsonDiff{{Key: "$", To: &types.JSONDocument{Val: to}, Type: tree.AddedDiff}}, nil
+	} else if to == nil {
+		return []tree.JsonDiff{{Key: "$", From: &types.JSONDocument{Val: from}, Type: tree.RemovedDiff}}, nil
+	}
+
+	fromObj, fromIsObj := from.(types.JsonObject)
+	toObj, toIsObj := to.(types.JsonObject)
+	if !fromIsObj || !toIsObj {
+		fromDoc, toDoc := types.JSONDocument{Val: from}, types.JSONDocument{Val: to}
+		cmp, err := fromDoc.Compare(toDoc)
+		if err != nil {
+			return nil, err
+		}
+		if cmp == 0 {
+			return nil, nil
+		}
+		return []tree.JsonDiff{{Key: "$", From: &fromDoc, To: &toDoc, Type: tree.ModifiedDiff}}, nil
+	}
+
+	var diffs []tree.JsonDiff
+	differ := tree.NewJsonDiffer("$", fromObj, toObj)
+	for {
+		d, err := differ.Next()
+		if err == io.EOF {
+			return diffs, nil
+		} else if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, d)
+	}
+}
@@ -0,0 +1,348 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/types"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+// logFilters restricts the commits listed by dolt_log() to the ones whose metadata, or whose changes to a table,
+// match all the filters that are set.
+type logFilters struct {
+	author *regexp.Regexp
+	grep   *regexp.Regexp
+	since  time.Time
+	until  time.Time
+	where  *logPickaxe
+}
+
+// matches returns whether |commit| matches all the filters.
+func (f *logFilters) matches(ctx *sql.Context, commit *doltdb.Commit) (bool, error) {
+	if f.author != nil || f.grep != nil || !f.since.IsZero() || !f.until.IsZero() {
+		meta, err := commit.GetCommitMeta(ctx)
+		if err != nil {
+			return false, err
+		}
+		if !f.matchesMeta(meta) {
+			return false, nil
+		}
+	}
+
+	// The pickaxe needs to diff the commit's rows, so it's only checked once everything else matched
+	if f.where != nil {
+		return f.where.touchedBy(ctx, commit)
+	}
+	return true, nil
+}
+
+func (f *logFilters) matchesMeta(meta *datas.CommitMeta) bool {
+	if f.author != nil && !f.author.MatchString(fmt.Sprintf("%s <%s>", meta.Name, meta.Email)) {
+		return false
+	}
+	if f.grep != nil && !f.grep.MatchString(meta.Description) {
+		return false
+	}
+	t := meta.Time()
+	if !f.since.IsZero() && t.Before(f.since) {
+		return false
+	}
+	if !f.until.IsZero() && t.After(f.until) {
+		return false
+	}
+	return true
+}
+
+// options returns the filters formatted as dolt_log() arguments.
+func (f *logFilters) options() []string {
+	var options []string
+	if f.author != nil {
+		options = append(options, fmt.Sprintf("--author %s", f.author))
+	}
+	if !f.since.IsZero() {
+		options = append(options, fmt.Sprintf("--since %s", f.since.Format(time.RFC3339)))
+	}
+	if !f.until.IsZero() {
+		options = append(options, fmt.Sprintf("--until %s", f.until.Format(time.RFC3339)))
+	}
+	if f.grep != nil {
+		options = append(options, fmt.Sprintf("--grep %s", f.grep))
+	}
+	if f.where != nil {
+		options = append(options, fmt.Sprintf("--where %s", f.where))
+	}
+	return options
+}
+
+// logPickaxe matches the commits that changed a row of a table in which a column has a given value, either before
+// or after the change. Commits are compared to their first parent.
+type logPickaxe struct {
+	table  string
+	column string
+	value  string
+}
+
+var logPickaxeExpr = regexp.MustCompile(`^\s*([^.=\s]+)\.([^=\s]+)\s*=\s*(.*?)\s*$`)
+
+// parseLogPickaxe parses a pickaxe of the form "table.column = value". The value may be quoted.
+func parseLogPickaxe(expr string) (*logPickaxe, error) {
+	m := logPickaxeExpr.FindStringSubmatch(expr)
+	if m == nil {
+		return nil, fmt.Errorf("invalid --where expression '%s', expected table.column = value", expr)
+	}
+	value := m[3]
+	if len(value) >= 2 && (value[0] == '\'' || value[0] == '"') && value[len(value)-1] == value[0] {
+		value = value[1 : len(value)-1]
+	}
+	return &logPickaxe{table: m[1], column: m[2], value: value}, nil
+}
+
+func (p *logPickaxe) String() string {
+	return fmt.Sprintf("%s.%s = '%s'", p.table, p.column, p.value)
+}
+
+// errPickaxeMatched stops a diff once a matching row has been found.
+var errPickaxeMatched = errors.New("pickaxe matched")
+
+// touchedBy returns whether |commit| added, removed or modified a row matching the pickaxe.
+func (p *logPickaxe) touchedBy(ctx *sql.Context, commit *doltdb.Commit) (bool, error) {
+	root, err := commit.GetRootValue(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !types.IsFormat_DOLT(root.VRW().Format()) {
+		return false, fmt.Errorf("--where is not supported for this storage format")
+	}
+
+	var parentRoot doltdb.RootValue
+	if commit.NumParents() > 0 {
+		optCmt, err := commit.GetParent(ctx, 0)
+		if err != nil {
+			return false, err
+		}
+		parent, ok := optCmt.ToCommit()
+		if !ok {
+			return false, doltdb.ErrGhostCommitEncountered
+		}
+		parentRoot, err = parent.GetRootValue(ctx)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	to, err := p.loadSide(ctx, root)
+	if err != nil {
+		return false, err
+	}
+	from, err := p.loadSide(ctx, parentRoot)
+	if err != nil {
+		return false, err
+	}
+
+	switch {
+	case !from.exists && !to.exists:
+		return false, nil
+	case !from.exists:
+		return to.anyRowMatches(ctx)
+	case !to.exists:
+		return from.anyRowMatches(ctx)
+	case from.rows.HashOf() == to.rows.HashOf() && from.pos == to.pos && from.inKey == to.inKey:
+		return false, nil
+	}
+
+	if from.isSinglePK() && to.isSinglePK() && from.kd.Equals(to.kd) {
+		return p.keyTouched(ctx, from, to)
+	}
+
+	err = prolly.DiffMaps(ctx, from.rows, to.rows, false, func(ctx context.Context, diff tree.Diff) error {
+		matched, err := from.rowMatches(ctx, val.Tuple(diff.Key), val.Tuple(diff.From))
+		if err != nil {
+			return err
+		}
+		if !matched {
+			matched, err = to.rowMatches(ctx, val.Tuple(diff.Key), val.Tuple(diff.To))
+			if err != nil {
+				return err
+			}
+		}
+		if matched {
+			return errPickaxeMatched
+		}
+		return nil
+	})
+	if errors.Is(err, errPickaxeMatched) {
+		return true, nil
+	} else if err != nil && err != io.EOF {
+		return false, err
+	}
+	return false, nil
+}
+
+// keyTouched returns whether the row whose primary key is the pickaxe's value differs between |from| and |to|.
+func (p *logPickaxe) keyTouched(ctx context.Context, from, to pickaxeSide) (bool, error) {
+	if !to.valueOk {
+		return false, nil
+	}
+	tb := val.NewTupleBuilder(to.kd)
+	if err := tree.PutField(ctx, to.rows.NodeStore(), tb, 0, to.value); err != nil {
+		return false, err
+	}
+	key := tb.Build(to.rows.Pool())
+
+	var fromValue, toValue val.Tuple
+	var fromOk, toOk bool
+	err := from.rows.Get(ctx, key, func(k, v val.Tuple) error {
+		fromOk, fromValue = k != nil, v
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	err = to.rows.Get(ctx, key, func(k, v val.Tuple) error {
+		toOk, toValue = k != nil, v
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if fromOk != toOk {
+		return true, nil
+	}
+	return fromOk && string(fromValue) != string(toValue), nil
+}
+
+// pickaxeSide is the pickaxe's table and column at one side of a commit's diff.
+type pickaxeSide struct {
+	exists bool
+	rows   prolly.Map
+	kd, vd val.TupleDesc
+
+	// colOk is false if the table doesn't have the pickaxe's column
+	colOk bool
+	inKey bool
+	pos   int
+	typ   sql.Type
+
+	// valueOk is false if the pickaxe's value can't be converted to the column's type, in which case no row matches
+	valueOk bool
+	value   interface{}
+}
+
+// loadSide loads the pickaxe's table and column from |root|, which may be nil.
+func (p *logPickaxe) loadSide(ctx *sql.Context, root doltdb.RootValue) (pickaxeSide, error) {
+	if root == nil {
+		return pickaxeSide{}, nil
+	}
+	tbl, _, ok, err := doltdb.GetTableInsensitive(ctx, root, doltdb.TableName{Name: p.table})
+	if err != nil || !ok {
+		return pickaxeSide{}, err
+	}
+
+	sch, err := tbl.GetSchema(ctx)
+	if err != nil {
+		return pickaxeSide{}, err
+	}
+	idx, err := tbl.GetRowData(ctx)
+	if err != nil {
+		return pickaxeSide{}, err
+	}
+	side := pickaxeSide{exists: true, rows: durable.ProllyMapFromIndex(idx)}
+	side.kd, side.vd = side.rows.Descriptors()
+
+	col, ok := sch.GetAllCols().GetByNameCaseInsensitive(p.column)
+	if !ok {
+		return side, nil
+	}
+	side.colOk = true
+	if schema.IsKeyless(sch) {
+		// the first field of a keyless row's value is its cardinality
+		side.pos = sch.GetNonPKCols().TagToIdx[col.Tag] + 1
+	} else if col.IsPartOfPK {
+		side.inKey = true
+		side.pos = sch.GetPKCols().TagToIdx[col.Tag]
+	} else {
+		side.pos = sch.GetNonPKCols().TagToIdx[col.Tag]
+	}
+
+	side.typ = col.TypeInfo.ToSqlType()
+	side.value, _, err = side.typ.Convert(p.value)
+	side.valueOk = err == nil && side.value != nil
+	return side, nil
+}
+
+// isSinglePK returns whether the pickaxe's column is the only primary key column of the table.
+func (s pickaxeSide) isSinglePK() bool {
+	return s.colOk && s.inKey && s.kd.Count() == 1
+}
+
+// rowMatches returns whether the row with |key| and |value| has the pickaxe's value. A nil |value| is a row that
+// doesn't exist on this side.
+func (s pickaxeSide) rowMatches(ctx context.Context, key, value val.Tuple) (bool, error) {
+	if !s.colOk || !s.valueOk || value == nil {
+		return false, nil
+	}
+	tup, desc := value, s.vd
+	if s.inKey {
+		tup, desc = key, s.kd
+	}
+	v, err := tree.GetField(ctx, desc, s.pos, tup, s.rows.NodeStore())
+	if err != nil || v == nil {
+		return false, err
+	}
+	cmp, err := s.typ.Compare(v, s.value)
+	if err != nil {
+		return false, err
+	}
+	return cmp == 0, nil
+}
+
+// anyRowMatches returns whether any row on this side has the pickaxe's value.
+func (s pickaxeSide) anyRowMatches(ctx context.Context) (bool, error) {
+	if !s.colOk || !s.valueOk {
+		return false, nil
+	}
+	iter, err := s.rows.IterAll(ctx)
+	if err != nil {
+		return false, err
+	}
+	for {
+		k, v, err := iter.Next(ctx)
+		if err == io.EOF {
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+		matched, err := s.rowMatches(ctx, k, v)
+		if err != nil || matched {
+			return matched, err
+		}
+	}
+}
@@ -16,18 +16,22 @@ package sqle
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/types"
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/libraries/doltcore/dconfig"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions/commitwalk"
 	"github.com/dolthub/dolt/go/libraries/doltcore/merge"
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
 	"github.com/dolthub/dolt/go/store/hash"
 )
 
@@ -47,6 +51,7 @@ type LogTableFunction struct {
 	minParents  int
 	showParents bool
 	decoration  string
+	filters     logFilters
 
 	database sql.Database
 }
@@ -148,6 +153,8 @@ func (ltf *LogTableFunction) getOptionsString() string {
 		options = append(options, "--tables", strings.Join(ltf.tableNames, ","))
 	}
 
+	options = append(options, ltf.filters.options()...)
+
 	return strings.Join(options, ", ")
 }
 
@@ -262,9 +269,53 @@ func (ltf *LogTableFunction) addOptions(expression []sql.Expression) error {
 	}
 	ltf.decoration = decorateOption
 
+	return ltf.addFilterOptions(apr)
+}
+
+// addFilterOptions parses the options that filter commits by their metadata or their changes.
+func (ltf *LogTableFunction) addFilterOptions(apr *argparser.ArgParseResults) error {
+	var err error
+	if author, ok := apr.GetValue(cli.AuthorParam); ok {
+		if ltf.filters.author, err = regexp.Compile(author); err != nil {
+			return ltf.invalidArgDetailsErr(fmt.Sprintf("invalid --%s pattern: %s", cli.AuthorParam, err.Error()))
+		}
+	}
+	if grep, ok := apr.GetValue(cli.GrepFlag); ok {
+		if ltf.filters.grep, err = regexp.Compile(grep); err != nil {
+			return ltf.invalidArgDetailsErr(fmt.Sprintf("invalid --%s pattern: %s", cli.GrepFlag, err.Error()))
+		}
+	}
+	if since, ok := apr.GetValue(cli.SinceFlag); ok {
+		if ltf.filters.since, err = dconfig.ParseDate(since); err != nil {
+			return ltf.invalidArgDetailsErr(fmt.Sprintf("invalid --%s date: %s", cli.SinceFlag, since))
+		}
+	}
+	if until, ok := apr.GetValue(cli.UntilFlag); ok {
+		if ltf.filters.until, err = parseUntilDate(until); err != nil {
+			return ltf.invalidArgDetailsErr(fmt.Sprintf("invalid --%s date: %s", cli.UntilFlag, until))
+		}
+	}
+	if where, ok := apr.GetValue(cli.WhereFlag); ok {
+		if ltf.filters.where, err = parseLogPickaxe(where); err != nil {
+			return ltf.invalidArgDetailsErr(err.Error())
+		}
+	}
 	return nil
 }
 
+// parseUntilDate parses the date of the --until option. A date without a time means the end of that day, so that the
+// commits made on that day are included.
+func parseUntilDate(until string) (time.Time, error) {
+	t, err := dconfig.ParseDate(until)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !strings.Contains(until, "T") {
+		t = t.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+	return t, nil
+}
+
 func (ltf *LogTableFunction) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
 	if len(exprs) != 0 {
 		return nil, sql.ErrInvalidChildrenNumber.New(0, len(exprs))
@@ -407,7 +458,10 @@ func (ltf *LogTableFunction) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter
 			return false, nil
 		}
 
-		return commit.NumParents() >= ltf.minParents, nil
+		if commit.NumParents() < ltf.minParents {
+			return false, nil
+		}
+		return ltf.filters.matches(ctx, commit)
 	}
 
 	cHashToRefs, err := getCommitHashToRefs(ctx, sqledb.DbData().Ddb, ltf.decoration)
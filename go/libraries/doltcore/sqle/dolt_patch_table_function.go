@@ -164,6 +164,19 @@ func (p *PatchTableFunction) PartitionRows(ctx *sql.Context, partition sql.Parti
 	includeSchemaDiff := bytes.Equal(partition.Key(), schemaAndDataChangePartitionKey) || bytes.Equal(partition.Key(), schemaChangePartitionKey)
 	includeDataDiff := bytes.Equal(partition.Key(), schemaAndDataChangePartitionKey) || bytes.Equal(partition.Key(), dataChangePartitionKey)
 
+	if includeDataDiff {
+		for _, td := range tableDeltas {
+			for _, name := range []string{td.FromName.Name, td.ToName.Name} {
+				if name == "" {
+					continue
+				}
+				if err := checkRowPoliciesUnrestricted(ctx, sqledb, name, "DOLT_PATCH()"); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
 	patches, err := getPatchNodes(ctx, sqledb.DbData(), tableDeltas, fromRefDetails, toRefDetails, includeSchemaDiff, includeDataDiff)
 	if err != nil {
 		return nil, err
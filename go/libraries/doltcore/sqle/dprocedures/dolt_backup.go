@@ -23,6 +23,7 @@ import (
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
 	"github.com/dolthub/dolt/go/cmd/dolt/doltversion"
 	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/backup"
 	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
 	"github.com/dolthub/dolt/go/libraries/doltcore/dbfactory"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
@@ -31,6 +32,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/utils/argparser"
 	"github.com/dolthub/dolt/go/libraries/utils/filesys"
 	"github.com/dolthub/dolt/go/store/datas/pull"
+	"github.com/dolthub/dolt/go/store/hash"
 	"github.com/dolthub/dolt/go/store/types"
 )
 
@@ -173,6 +175,18 @@ func restoreBackup(ctx *sql.Context, _ env.DbData, apr *argparser.ArgParseResult
 		return err
 	}
 
+	var restoreRoot hash.Hash
+	if at, ok := apr.GetValue(cli.AtFlag); ok {
+		t, err := backup.ParseTime(at)
+		if err != nil {
+			return err
+		}
+		restoreRoot, err = backup.RootAt(ctx, backupUrl, nil, t)
+		if err != nil {
+			return err
+		}
+	}
+
 	sess := dsess.DSessFromSess(ctx.Session)
 	existingDbData, restoringExistingDb := sess.GetDbData(ctx, dbName)
 	if restoringExistingDb {
@@ -181,7 +195,7 @@ func restoreBackup(ctx *sql.Context, _ env.DbData, apr *argparser.ArgParseResult
 				"A database with that name already exists. Did you mean to supply --force?", dbName)
 		}
 
-		return syncRootsFromBackup(ctx, existingDbData, sess, r)
+		return syncRootsFromBackup(ctx, existingDbData, sess, r, restoreRoot)
 	} else {
 		// Track whether the db directory existed before we tried to create it, so we can clean up on errors
 		userDirExisted, _ := sess.Provider().FileSystem().Exists(dbName)
@@ -199,7 +213,7 @@ func restoreBackup(ctx *sql.Context, _ env.DbData, apr *argparser.ArgParseResult
 			return err
 		}
 
-		if err = syncRootsFromBackup(ctx, clonedEnv.DbData(), sess, r); err != nil {
+		if err = syncRootsFromBackup(ctx, clonedEnv.DbData(), sess, r, restoreRoot); err != nil {
 			// If we're cloning into a directory that already exists do not erase it.
 			// Otherwise, make a best effort to delete any directory we created.
 			if userDirExisted {
@@ -317,8 +331,37 @@ func syncRootsToBackup(ctx *sql.Context, dbData env.DbData, sess *dsess.DoltSess
 	return nil
 }
 
-// syncRootsFromBackup syncs the roots from the backup specified by |backup| to |dbData|.
-func syncRootsFromBackup(ctx *sql.Context, dbData env.DbData, sess *dsess.DoltSession, backup env.Remote) error {
+// SyncBackup syncs the database |dbName| to the backup at |backupUrl|, creating the backup if it does not exist yet, and
+// returns the root of the backup afterward. Only the chunks which the backup does not have yet are copied to it.
+func SyncBackup(ctx *sql.Context, dbName, backupUrl string) (hash.Hash, error) {
+	sess := dsess.DSessFromSess(ctx.Session)
+	dbData, ok := sess.GetDbData(ctx, dbName)
+	if !ok {
+		return hash.Hash{}, sql.ErrDatabaseNotFound.New(dbName)
+	}
+
+	nbf := dbData.Ddb.ValueReadWriter().Format()
+	err := dbfactory.PrepareDB(ctx, nbf, backupUrl, nil)
+	if err != nil {
+		return hash.Hash{}, fmt.Errorf("error preparing backup destination: %w", err)
+	}
+
+	b := env.NewRemote("__scheduled__", backupUrl, nil)
+	err = syncRootsToBackup(ctx, dbData, sess, b)
+	if err != nil {
+		return hash.Hash{}, err
+	}
+
+	destDb, err := sess.Provider().GetRemoteDB(ctx, nbf, b, true)
+	if err != nil {
+		return hash.Hash{}, fmt.Errorf("error loading backup destination: %w", err)
+	}
+	return destDb.NomsRoot(ctx)
+}
+
+// syncRootsFromBackup syncs the roots from the backup specified by |backup| to |dbData|, as of the restore point whose
+// root is |root|, or as of the latest sync of the backup if |root| is empty.
+func syncRootsFromBackup(ctx *sql.Context, dbData env.DbData, sess *dsess.DoltSession, backup env.Remote, root hash.Hash) error {
 	destDb, err := sess.Provider().GetRemoteDB(ctx, dbData.Ddb.ValueReadWriter().Format(), backup, true)
 	if err != nil {
 		return fmt.Errorf("error loading backup destination: %w", err)
@@ -329,7 +372,11 @@ func syncRootsFromBackup(ctx *sql.Context, dbData env.DbData, sess *dsess.DoltSe
 		return err
 	}
 
-	err = actions.SyncRoots(ctx, destDb, dbData.Ddb, tmpDir, runProgFuncs, stopProgFuncs)
+	if root.IsEmpty() {
+		err = actions.SyncRoots(ctx, destDb, dbData.Ddb, tmpDir, runProgFuncs, stopProgFuncs)
+	} else {
+		err = actions.SyncRootsAt(ctx, destDb, dbData.Ddb, root, tmpDir, runProgFuncs, stopProgFuncs)
+	}
 	if err != nil && err != pull.ErrDBUpToDate {
 		return fmt.Errorf("error syncing backup: %w", err)
 	}
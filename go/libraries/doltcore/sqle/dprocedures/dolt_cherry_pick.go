@@ -24,6 +24,7 @@ import (
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
 	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
 	"github.com/dolthub/dolt/go/libraries/doltcore/cherry_pick"
+	"github.com/dolthub/dolt/go/libraries/doltcore/merge"
 )
 
 var ErrEmptyCherryPick = errors.New("cannot cherry-pick empty string")
@@ -83,19 +84,28 @@ func doDoltCherryPick(ctx *sql.Context, args []string) (string, int, int, int, e
 		return "", 0, 0, 0, cherry_pick.AbortCherryPick(ctx, dbName)
 	}
 
-	// we only support cherry-picking a single commit for now.
-	if apr.NArg() == 0 {
-		return "", 0, 0, 0, ErrEmptyCherryPick
-	} else if apr.NArg() > 1 {
-		return "", 0, 0, 0, fmt.Errorf("cherry-picking multiple commits is not supported yet")
+	if (apr.Contains(cli.ContinueFlag) || apr.Contains(cli.SkipFlag)) && apr.NArg() > 0 {
+		return "", 0, 0, 0, fmt.Errorf("error: --%s and --%s do not take commit arguments", cli.ContinueFlag, cli.SkipFlag)
 	}
 
-	cherryStr := apr.Arg(0)
-	if len(cherryStr) == 0 {
-		return "", 0, 0, 0, ErrEmptyCherryPick
+	var commit string
+	var mergeResult *merge.Result
+	switch {
+	case apr.Contains(cli.ContinueFlag):
+		commit, mergeResult, err = cherry_pick.ContinueCherryPick(ctx, dbName)
+	case apr.Contains(cli.SkipFlag):
+		commit, mergeResult, err = cherry_pick.SkipCherryPick(ctx, dbName)
+	default:
+		if apr.NArg() == 0 {
+			return "", 0, 0, 0, ErrEmptyCherryPick
+		}
+		for _, cherryStr := range apr.Args {
+			if len(cherryStr) == 0 {
+				return "", 0, 0, 0, ErrEmptyCherryPick
+			}
+		}
+		commit, mergeResult, err = cherry_pick.CherryPickCommits(ctx, apr.Args, cherry_pick.CherryPickOptions{})
 	}
-
-	commit, mergeResult, err := cherry_pick.CherryPick(ctx, cherryStr, cherry_pick.CherryPickOptions{})
 	if err != nil {
 		return "", 0, 0, 0, err
 	}
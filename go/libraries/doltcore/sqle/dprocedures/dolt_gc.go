@@ -56,6 +56,10 @@ func doltGC(ctx *sql.Context, args ...string) (sql.RowIter, error) {
 
 var ErrServerPerformedGC = errors.New("this connection was established when this server performed an online garbage collection. this connection can no longer be used. please reconnect.")
 
+// ErrGCConnectionsOpen is returned by CollectGarbage when it may not kill connections, and other connections to the
+// server were open once the GC reached its safepoint. The GC was abandoned, and no garbage was collected.
+var ErrGCConnectionsOpen = errors.New("other connections were open at the gc safepoint")
+
 func doDoltGC(ctx *sql.Context, args []string) (int, error) {
 	dbName := ctx.GetCurrentDatabase()
 
@@ -75,16 +79,29 @@ func doDoltGC(ctx *sql.Context, args []string) (int, error) {
 		return cmdFailure, InvalidArgErr
 	}
 
+	err = CollectGarbage(ctx, dbName, apr.Contains(cli.ShallowFlag), true)
+	if err != nil {
+		return cmdFailure, err
+	}
+	return cmdSuccess, nil
+}
+
+// CollectGarbage runs garbage collection on the database |dbName|. A shallow GC only prunes table files which are no
+// longer referenced. Once a full GC has copied everything which is reachable, no session may keep referencing chunks
+// which are about to be deleted. If |killConnections| is true, the GC kills every connection to the server other than
+// the one of |ctx|. Otherwise, it gives up with ErrGCConnectionsOpen if there are any. Either way, the session of
+// |ctx| can no longer be used after a full GC.
+func CollectGarbage(ctx *sql.Context, dbName string, shallow, killConnections bool) error {
 	dSess := dsess.DSessFromSess(ctx.Session)
 	ddb, ok := dSess.GetDoltDB(ctx, dbName)
 	if !ok {
-		return cmdFailure, fmt.Errorf("Could not load database %s", dbName)
+		return fmt.Errorf("Could not load database %s", dbName)
 	}
 
-	if apr.Contains(cli.ShallowFlag) {
-		err = ddb.ShallowGC(ctx)
+	if shallow {
+		err := ddb.ShallowGC(ctx)
 		if err != nil {
-			return cmdFailure, err
+			return err
 		}
 	} else {
 		// Currently, if this server is involved in cluster
@@ -97,11 +114,11 @@ func doDoltGC(ctx *sql.Context, args []string) (int, error) {
 		if _, role, ok := sql.SystemVariables.GetGlobal(dsess.DoltClusterRoleVariable); ok {
 			// TODO: magic constant...
 			if role.(string) != "primary" {
-				return cmdFailure, fmt.Errorf("cannot run a full dolt_gc() while cluster replication is enabled and role is %s; must be the primary", role.(string))
+				return fmt.Errorf("cannot run a full dolt_gc() while cluster replication is enabled and role is %s; must be the primary", role.(string))
 			}
 			_, epoch, ok := sql.SystemVariables.GetGlobal(dsess.DoltClusterRoleEpochVariable)
 			if !ok {
-				return cmdFailure, fmt.Errorf("internal error: cannot run a full dolt_gc(); cluster replication is enabled but could not read %s", dsess.DoltClusterRoleEpochVariable)
+				return fmt.Errorf("internal error: cannot run a full dolt_gc(); cluster replication is enabled but could not read %s", dsess.DoltClusterRoleEpochVariable)
 			}
 			origepoch = epoch.(int)
 		}
@@ -109,7 +126,7 @@ func doDoltGC(ctx *sql.Context, args []string) (int, error) {
 		// TODO: If we got a callback at the beginning and an
 		// (allowed-to-block) callback at the end, we could more
 		// gracefully tear things down.
-		err = ddb.GC(ctx, func() error {
+		err := ddb.GC(ctx, func() error {
 			if origepoch != -1 {
 				// Here we need to sanity check role and epoch.
 				if _, role, ok := sql.SystemVariables.GetGlobal(dsess.DoltClusterRoleVariable); ok {
@@ -130,6 +147,13 @@ func doDoltGC(ctx *sql.Context, args []string) (int, error) {
 
 			killed := make(map[uint32]struct{})
 			processes := ctx.ProcessList.Processes()
+			if !killConnections {
+				for _, p := range processes {
+					if p.Connection != ctx.Session.ID() {
+						return ErrGCConnectionsOpen
+					}
+				}
+			}
 			for _, p := range processes {
 				if p.Connection != ctx.Session.ID() {
 					// Kill any inflight query.
@@ -162,9 +186,9 @@ func doDoltGC(ctx *sql.Context, args []string) (int, error) {
 			return nil
 		})
 		if err != nil {
-			return cmdFailure, err
+			return err
 		}
 	}
 
-	return cmdSuccess, nil
+	return nil
 }
@@ -346,6 +346,14 @@ func executeFFMerge(ctx *sql.Context, dbName string, squash bool, ws *doltdb.Wor
 		}
 	}
 
+	// The fast-forward below isn't undone if setting the working set fails, so check this first
+	if err = dsess.CheckRowPoliciesUnchanged(ctx, dbName, ws.WorkingRoot(), workingRoot); err != nil {
+		return ws, err
+	}
+	if err = dsess.CheckTableAccessUnchanged(ctx, dbName, ws.WorkingRoot(), workingRoot); err != nil {
+		return ws, err
+	}
+
 	// TODO: This is all incredibly suspect, needs to be replaced with library code that is functional instead of
 	//  altering global state
 	if !squash {
@@ -357,6 +365,7 @@ func executeFFMerge(ctx *sql.Context, dbName string, squash bool, ws *doltdb.Wor
 		if err != nil {
 			return ws, err
 		}
+		dsess.DSessFromSess(ctx.Session).RecordHeadUpdate(cm2)
 	}
 
 	ws = ws.WithWorkingRoot(workingRoot).WithStagedRoot(stagedRoot)
@@ -529,6 +538,9 @@ func mergeRootToWorking(
 			return ws, err
 		}
 	}
+	if err = dsess.CheckTableAccessUnchanged(ctx, dbName, ws.WorkingRoot(), working); err != nil {
+		return nil, err
+	}
 
 	if !squash || merged.HasSchemaConflicts() {
 		ws = ws.StartMerge(cm2, cm2Spec)
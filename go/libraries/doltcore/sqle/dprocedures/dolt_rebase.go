@@ -17,9 +17,12 @@ package dprocedures
 import (
 	"errors"
 	"fmt"
+	"strings"
 
+	gms "github.com/dolthub/go-mysql-server"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/shopspring/decimal"
 	goerrors "gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
@@ -51,7 +54,9 @@ var RebaseActionEnumType = types.MustCreateEnumType([]string{
 	rebase.RebaseActionPick,
 	rebase.RebaseActionReword,
 	rebase.RebaseActionSquash,
-	rebase.RebaseActionFixup}, sql.Collation_Default)
+	rebase.RebaseActionFixup,
+	rebase.RebaseActionEdit,
+	rebase.RebaseActionExec}, sql.Collation_Default)
 
 var DoltRebaseSystemTableSchema = []*sql.Column{
 	{
@@ -98,6 +103,24 @@ var SuccessfulRebaseMessage = "Successfully rebased and updated refs/heads/"
 
 var RebaseAbortedMessage = "Interactive rebase aborted"
 
+// RebaseStoppedAtEditMessage begins the message returned when a rebase stops after applying the commit of an edit
+// action, so that it can be amended before the rebase is continued.
+var RebaseStoppedAtEditMessage = "Stopped at commit "
+
+// RebaseExecFailedMessage begins the message returned when a rebase stops because the SQL statement of an exec action
+// failed. The rebase can be continued once the problem is fixed.
+var RebaseExecFailedMessage = "exec failed: "
+
+// RebaseWorkingBranchPrefix is the prefix of the name of the temporary branch on which a rebase replays commits.
+var RebaseWorkingBranchPrefix = "dolt_rebase_"
+
+// rebaseResult is the status and message returned by dolt_rebase() once a rebase finishes, or stops before the end
+// of its plan.
+type rebaseResult struct {
+	status  int
+	message string
+}
+
 func doltRebase(ctx *sql.Context, args ...string) (sql.RowIter, error) {
 	res, message, err := doDoltRebase(ctx, args)
 	if err != nil {
@@ -126,12 +149,11 @@ func doDoltRebase(ctx *sql.Context, args []string) (int, string, error) {
 		}
 
 	case apr.Contains(cli.ContinueFlag):
-		rebaseBranch, err := continueRebase(ctx)
+		result, err := continueRebase(ctx)
 		if err != nil {
 			return 1, "", err
-		} else {
-			return 0, SuccessfulRebaseMessage + rebaseBranch, nil
 		}
+		return result.status, result.message, nil
 
 	default:
 		if apr.NArg() == 0 {
@@ -139,14 +161,21 @@ func doDoltRebase(ctx *sql.Context, args []string) (int, string, error) {
 		} else if apr.NArg() > 1 {
 			return 1, "", fmt.Errorf("too many args")
 		}
-		if !apr.Contains(cli.InteractiveFlag) {
-			return 1, "", fmt.Errorf("non-interactive rebases not currently supported")
-		}
-		err = startRebase(ctx, apr.Arg(0))
+		onto, _ := apr.GetValue(cli.OntoFlag)
+		err = startRebase(ctx, apr.Arg(0), onto)
 		if err != nil {
 			return 1, "", err
 		}
 
+		// A non-interactive rebase runs the default rebase plan right away
+		if !apr.Contains(cli.InteractiveFlag) {
+			result, err := continueRebase(ctx)
+			if err != nil {
+				return 1, "", err
+			}
+			return result.status, result.message, nil
+		}
+
 		currentBranch, err := currentBranch(ctx)
 		if err != nil {
 			return 1, "", err
@@ -158,10 +187,15 @@ func doDoltRebase(ctx *sql.Context, args []string) (int, string, error) {
 	}
 }
 
-func startRebase(ctx *sql.Context, upstreamPoint string) error {
+// startRebase starts a rebase of the commits reachable from the current branch, but not from |upstreamPoint|. The
+// commits are replayed onto |ontoPoint|, or onto |upstreamPoint| if |ontoPoint| is empty.
+func startRebase(ctx *sql.Context, upstreamPoint, ontoPoint string) error {
 	if upstreamPoint == "" {
 		return fmt.Errorf("no upstream branch specified")
 	}
+	if ontoPoint == "" {
+		ontoPoint = upstreamPoint
+	}
 
 	err := validateWorkingSetCanStartRebase(ctx)
 	if err != nil {
@@ -202,11 +236,27 @@ func startRebase(ctx *sql.Context, upstreamPoint string) error {
 		return doltdb.ErrGhostCommitEncountered
 	}
 
+	ontoCommit := upstreamCommit
+	if ontoPoint != upstreamPoint {
+		commitSpec, err = doltdb.NewCommitSpec(ontoPoint)
+		if err != nil {
+			return err
+		}
+		optCmt, err = dbData.Ddb.Resolve(ctx, commitSpec, headRef)
+		if err != nil {
+			return err
+		}
+		ontoCommit, ok = optCmt.ToCommit()
+		if !ok {
+			return doltdb.ErrGhostCommitEncountered
+		}
+	}
+
 	// rebaseWorkingBranch is the name of the temporary branch used when performing a rebase. In Git, a rebase
 	// happens with a detatched HEAD, but Dolt doesn't support that, we use a temporary branch.
-	rebaseWorkingBranch := "dolt_rebase_" + rebaseBranch
+	rebaseWorkingBranch := RebaseWorkingBranchPrefix + rebaseBranch
 	var rsc doltdb.ReplicationStatusController
-	err = actions.CreateBranchWithStartPt(ctx, dbData, rebaseWorkingBranch, upstreamPoint, false, &rsc)
+	err = actions.CreateBranchWithStartPt(ctx, dbData, rebaseWorkingBranch, ontoPoint, false, &rsc)
 	if err != nil {
 		return err
 	}
@@ -245,7 +295,7 @@ func startRebase(ctx *sql.Context, upstreamPoint string) error {
 		return err
 	}
 
-	newWorkingSet, err := workingSet.StartRebase(ctx, upstreamCommit, rebaseBranch, branchRoots.Working)
+	newWorkingSet, err := workingSet.StartRebase(ctx, ontoCommit, rebaseBranch, branchRoots.Working)
 	if err != nil {
 		return err
 	}
@@ -381,63 +431,83 @@ func abortRebase(ctx *sql.Context) error {
 	return doltSession.SwitchWorkingSet(ctx, ctx.GetCurrentDatabase(), wsRef)
 }
 
-func continueRebase(ctx *sql.Context) (string, error) {
-	// TODO: Eventually, when we allow interactive-rebases to be stopped and started (e.g. with the break action,
-	//       or for conflict resolution), we'll need to track what step we're at in the rebase plan.
-
+// continueRebase executes the rebase plan of the active rebase. If the rebase previously stopped at an edit or exec
+// step, it resumes after that step, first amending any changes made in the meantime into the last rebased commit.
+// The returned result describes whether the rebase finished, or stopped again before the end of its plan.
+func continueRebase(ctx *sql.Context) (rebaseResult, error) {
 	// Validate that we are in an interactive rebase
 	doltSession := dsess.DSessFromSess(ctx.Session)
 	workingSet, err := doltSession.WorkingSet(ctx, ctx.GetCurrentDatabase())
 	if err != nil {
-		return "", err
+		return rebaseResult{}, err
 	}
 	if !workingSet.RebaseActive() {
-		return "", fmt.Errorf("no rebase in progress")
+		return rebaseResult{}, fmt.Errorf("no rebase in progress")
 	}
 
 	db, err := doltSession.Provider().Database(ctx, ctx.GetCurrentDatabase())
 	if err != nil {
-		return "", err
+		return rebaseResult{}, err
 	}
 
 	rdb, ok := db.(rebase.RebasePlanDatabase)
 	if !ok {
-		return "", fmt.Errorf("expected a dsess.RebasePlanDatabase implementation, but received a %T", db)
+		return rebaseResult{}, fmt.Errorf("expected a dsess.RebasePlanDatabase implementation, but received a %T", db)
 	}
 	rebasePlan, err := rdb.LoadRebasePlan(ctx)
 	if err != nil {
-		return "", err
+		return rebaseResult{}, err
 	}
 
 	err = rebase.ValidateRebasePlan(ctx, rebasePlan)
 	if err != nil {
-		return "", err
+		return rebaseResult{}, err
+	}
+
+	rebaseState := workingSet.RebaseState()
+	if rebaseState.RebasingStarted() {
+		err = amendRebaseChanges(ctx, rebaseState)
+		if err != nil {
+			return rebaseResult{}, err
+		}
 	}
+	lastAttemptedStep := decimal.New(rebaseState.LastAttemptedStep(), -2)
 
 	for _, step := range rebasePlan.Steps {
-		err = processRebasePlanStep(ctx, &step)
+		if rebaseState.RebasingStarted() && step.RebaseOrder.LessThanOrEqual(lastAttemptedStep) {
+			continue
+		}
+
+		err = recordRebaseStep(ctx, step.RebaseOrder)
+		if err != nil {
+			return rebaseResult{}, err
+		}
+		stopped, err := processRebasePlanStep(ctx, &step)
 		if err != nil {
-			return "", err
+			return rebaseResult{}, err
+		}
+		if stopped != nil {
+			return *stopped, nil
 		}
 	}
 
 	// Update the branch being rebased to point to the same commit as our temporary working branch
 	rebaseBranchWorkingSet, err := doltSession.WorkingSet(ctx, ctx.GetCurrentDatabase())
 	if err != nil {
-		return "", err
+		return rebaseResult{}, err
 	}
 	dbData, ok := doltSession.GetDbData(ctx, ctx.GetCurrentDatabase())
 	if !ok {
-		return "", fmt.Errorf("unable to get db data for database %s", ctx.GetCurrentDatabase())
+		return rebaseResult{}, fmt.Errorf("unable to get db data for database %s", ctx.GetCurrentDatabase())
 	}
 
 	rebaseBranch := rebaseBranchWorkingSet.RebaseState().Branch()
-	rebaseWorkingBranch := "dolt_rebase_" + rebaseBranch
+	rebaseWorkingBranch := RebaseWorkingBranchPrefix + rebaseBranch
 
 	// Check that the branch being rebased hasn't been updated since the rebase started
 	err = validateRebaseBranchHasntChanged(ctx, rebaseBranch, rebaseBranchWorkingSet.RebaseState())
 	if err != nil {
-		return "", err
+		return rebaseResult{}, err
 	}
 
 	// TODO: copyABranch (and the underlying call to doltdb.NewBranchAtCommit) has a race condition
@@ -448,66 +518,214 @@ func continueRebase(ctx *sql.Context) (string, error) {
 	//       database.CommitWithWorkingSet, since it updates a branch head and working set atomically.
 	err = copyABranch(ctx, dbData, rebaseWorkingBranch, rebaseBranch, true, nil)
 	if err != nil {
-		return "", err
+		return rebaseResult{}, err
+	}
+	if rebasedHead, err := dbData.Ddb.ResolveCommitRef(ctx, ref.NewBranchRef(rebaseBranch)); err == nil {
+		doltSession.RecordHeadUpdate(rebasedHead)
 	}
 
 	// Checkout the branch being rebased
 	previousBranchWorkingSetRef, err := ref.WorkingSetRefForHead(ref.NewBranchRef(rebaseBranchWorkingSet.RebaseState().Branch()))
 	if err != nil {
-		return "", err
+		return rebaseResult{}, err
 	}
 	err = doltSession.SwitchWorkingSet(ctx, ctx.GetCurrentDatabase(), previousBranchWorkingSetRef)
 	if err != nil {
-		return "", err
+		return rebaseResult{}, err
 	}
 
 	// delete the temporary working branch
 	dbData, ok = doltSession.GetDbData(ctx, ctx.GetCurrentDatabase())
 	if !ok {
-		return "", fmt.Errorf("unable to lookup dbdata")
+		return rebaseResult{}, fmt.Errorf("unable to lookup dbdata")
 	}
-	return rebaseBranch, actions.DeleteBranch(ctx, dbData, rebaseWorkingBranch, actions.DeleteOptions{
+	err = actions.DeleteBranch(ctx, dbData, rebaseWorkingBranch, actions.DeleteOptions{
 		Force: true,
 	}, doltSession.Provider(), nil)
+	if err != nil {
+		return rebaseResult{}, err
+	}
+	return rebaseResult{status: 0, message: SuccessfulRebaseMessage + rebaseBranch}, nil
 }
 
-func processRebasePlanStep(ctx *sql.Context, planStep *rebase.RebasePlanStep) error {
-	// Make sure we have a transaction opened for the session
-	// NOTE: After our first call to cherry-pick, the tx is committed, so a new tx needs to be started
-	//       as we process additional rebase actions.
+// recordRebaseStep records |step| as the last attempted step of the active rebase, so that the rebase can resume
+// after it if it stops.
+func recordRebaseStep(ctx *sql.Context, step decimal.Decimal) error {
+	err := startRebaseTransaction(ctx)
+	if err != nil {
+		return err
+	}
+
 	doltSession := dsess.DSessFromSess(ctx.Session)
-	if doltSession.GetTransaction() == nil {
-		_, err := doltSession.StartTransaction(ctx, sql.ReadWrite)
-		if err != nil {
-			return err
-		}
+	workingSet, err := doltSession.WorkingSet(ctx, ctx.GetCurrentDatabase())
+	if err != nil {
+		return err
+	}
+	// Rebase orders have two decimal places, so they are recorded exactly in hundredths
+	rebaseState := workingSet.RebaseState().WithLastAttemptedStep(step.Shift(2).IntPart()).WithRebasingStarted(true)
+	return doltSession.SetWorkingSet(ctx, ctx.GetCurrentDatabase(), workingSet.WithRebaseState(rebaseState))
+}
+
+// amendRebaseChanges amends any changes made while the rebase was stopped into the last commit created by the rebase.
+func amendRebaseChanges(ctx *sql.Context, rebaseState *doltdb.RebaseState) error {
+	err := startRebaseTransaction(ctx)
+	if err != nil {
+		return err
+	}
+
+	doltSession := dsess.DSessFromSess(ctx.Session)
+	roots, ok := doltSession.GetRoots(ctx, ctx.GetCurrentDatabase())
+	if !ok {
+		return fmt.Errorf("unable to get roots for database %s", ctx.GetCurrentDatabase())
+	}
+	wsOnlyHasIgnoredTables, err := diff.WorkingSetContainsOnlyIgnoredTables(ctx, roots)
+	if err != nil || wsOnlyHasIgnoredTables {
+		return err
+	}
+
+	headHash, err := roots.Head.HashOf()
+	if err != nil {
+		return err
+	}
+	ontoRoot, err := rebaseState.OntoCommit().GetRootValue(ctx)
+	if err != nil {
+		return err
+	}
+	ontoHash, err := ontoRoot.HashOf()
+	if err != nil {
+		return err
+	}
+	if headHash == ontoHash {
+		return fmt.Errorf("cannot amend uncommitted changes into the commit being rebased onto; " +
+			"commit them with dolt_commit() before continuing the rebase")
+	}
+
+	_, _, err = doDoltCommit(ctx, []string{"-A", "--amend"})
+	return err
+}
+
+// startRebaseTransaction makes sure the session has a transaction open. After each commit created by the rebase, the
+// transaction is committed, so a new one needs to be started as additional rebase steps are processed.
+func startRebaseTransaction(ctx *sql.Context) error {
+	doltSession := dsess.DSessFromSess(ctx.Session)
+	if doltSession.GetTransaction() != nil {
+		return nil
+	}
+	tx, err := doltSession.StartTransaction(ctx, sql.ReadWrite)
+	if err != nil {
+		return err
+	}
+	ctx.SetTransaction(tx)
+	return nil
+}
+
+// processRebasePlanStep applies |planStep| of the rebase plan. If the rebase needs to stop after this step, the
+// returned result describes why; otherwise it is nil.
+func processRebasePlanStep(ctx *sql.Context, planStep *rebase.RebasePlanStep) (*rebaseResult, error) {
+	err := startRebaseTransaction(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	switch planStep.Action {
 	case rebase.RebaseActionDrop:
-		return nil
+		return nil, nil
 
 	case rebase.RebaseActionPick, rebase.RebaseActionReword:
 		options := cherry_pick.CherryPickOptions{}
 		if planStep.Action == rebase.RebaseActionReword {
 			options.CommitMessage = planStep.CommitMsg
 		}
-		return handleRebaseCherryPick(ctx, planStep.CommitHash, options)
+		return nil, handleRebaseCherryPick(ctx, planStep.CommitHash, options)
+
+	case rebase.RebaseActionEdit:
+		err = handleRebaseCherryPick(ctx, planStep.CommitHash, cherry_pick.CherryPickOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return &rebaseResult{status: 0, message: fmt.Sprintf("%s%s: %s\n\n"+
+			"Amend the commit as needed, then continue rebasing by calling dolt_rebase('--continue'). "+
+			"Uncommitted changes are amended into the commit when the rebase continues.",
+			RebaseStoppedAtEditMessage, planStep.CommitHash, planStep.CommitMsg)}, nil
+
+	case rebase.RebaseActionExec:
+		err = runRebaseExec(ctx, planStep.CommitMsg)
+		if err != nil {
+			return &rebaseResult{status: 1, message: fmt.Sprintf("%s%s: %s\n\n"+
+				"Fix the problem, then continue rebasing by calling dolt_rebase('--continue'), "+
+				"or abort it by calling dolt_rebase('--abort'). "+
+				"Uncommitted changes are amended into the last rebased commit when the rebase continues.",
+				RebaseExecFailedMessage, planStep.CommitMsg, err.Error())}, nil
+		}
+		return nil, nil
 
 	case rebase.RebaseActionSquash, rebase.RebaseActionFixup:
 		options := cherry_pick.CherryPickOptions{Amend: true}
 		if planStep.Action == rebase.RebaseActionSquash {
 			commitMessage, err := squashCommitMessage(ctx, planStep.CommitHash)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			options.CommitMessage = commitMessage
 		}
-		return handleRebaseCherryPick(ctx, planStep.CommitHash, options)
+		return nil, handleRebaseCherryPick(ctx, planStep.CommitHash, options)
 
 	default:
-		return fmt.Errorf("rebase action '%s' is not supported", planStep.Action)
+		return nil, fmt.Errorf("rebase action '%s' is not supported", planStep.Action)
+	}
+}
+
+// engineProvider is a database provider which knows the engine that runs the queries of its sessions.
+type engineProvider interface {
+	Engine() *gms.Engine
+}
+
+// runRebaseExec runs the SQL |statement| of an exec step of a rebase plan. SELECT statements are assertions: they fail
+// if the first column of their first row is false, zero or NULL. An error is returned if the statement fails, or if
+// it leaves uncommitted changes behind. The statement is run by the engine of the session, so that the privileges of
+// the user running the rebase are checked for it as for any other statement.
+func runRebaseExec(ctx *sql.Context, statement string) error {
+	doltSession := dsess.DSessFromSess(ctx.Session)
+	var engine *gms.Engine
+	if ep, ok := doltSession.Provider().(engineProvider); ok {
+		engine = ep.Engine()
+	}
+	if engine == nil {
+		return fmt.Errorf("exec steps can not be run without the engine of the session")
 	}
+	sch, iter, _, err := engine.Query(ctx, statement)
+	if err != nil {
+		return err
+	}
+	rows, err := sql.RowIterToRows(ctx, iter)
+	if err != nil {
+		return err
+	}
+
+	isSelect := strings.HasPrefix(strings.ToLower(strings.TrimSpace(statement)), "select")
+	if isSelect && !types.IsOkResultSchema(sch) && len(rows) > 0 && len(rows[0]) > 0 {
+		passed, err := sql.ConvertToBool(ctx, rows[0][0])
+		if err != nil || !passed {
+			return fmt.Errorf("assertion returned %v", rows[0][0])
+		}
+	}
+
+	err = startRebaseTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	roots, ok := doltSession.GetRoots(ctx, ctx.GetCurrentDatabase())
+	if !ok {
+		return fmt.Errorf("unable to get roots for database %s", ctx.GetCurrentDatabase())
+	}
+	wsOnlyHasIgnoredTables, err := diff.WorkingSetContainsOnlyIgnoredTables(ctx, roots)
+	if err != nil {
+		return err
+	}
+	if !wsOnlyHasIgnoredTables {
+		return fmt.Errorf("the statement left uncommitted changes")
+	}
+	return nil
 }
 
 // handleRebaseCherryPick runs a cherry-pick for the specified |commitHash|, using the specified
@@ -94,6 +94,18 @@ func doDoltReset(ctx *sql.Context, args []string) (int, error) {
 			return 1, err
 		}
 
+		ws, err := dSess.WorkingSet(ctx, dbName)
+		if err != nil {
+			return 1, err
+		}
+		// The head update below isn't undone if setting the working set fails, so check this first
+		if err = dsess.CheckRowPoliciesUnchanged(ctx, dbName, ws.WorkingRoot(), roots.Working); err != nil {
+			return 1, err
+		}
+		if err = dsess.CheckTableAccessUnchanged(ctx, dbName, ws.WorkingRoot(), roots.Working); err != nil {
+			return 1, err
+		}
+
 		// TODO: this overrides the transaction setting, needs to happen at commit, not here
 		if newHead != nil {
 			headRef, err := dbData.Rsr.CWBHeadRef()
@@ -103,14 +115,11 @@ func doDoltReset(ctx *sql.Context, args []string) (int, error) {
 			if err := dbData.Ddb.SetHeadToCommit(ctx, headRef, newHead); err != nil {
 				return 1, err
 			}
+			dSess.RecordHeadUpdate(newHead)
 		}
 
 		// TODO - refactor and make transactional with the head update above.
-		ws, err := dSess.WorkingSet(ctx, dbName)
-		if err != nil {
-			return 1, err
-		}
-		err = dSess.SetWorkingSet(ctx, dbName, ws.WithWorkingRoot(roots.Working).WithStagedRoot(roots.Staged).ClearMerge().ClearRebase())
+		err = dSess.SetWorkingSet(ctx, dbName, ws.WithWorkingRoot(roots.Working).WithStagedRoot(roots.Staged).ClearMerge().ClearRebase().ClearCherryPick())
 		if err != nil {
 			return 1, err
 		}
@@ -126,6 +126,9 @@ func doDoltRevert(ctx *sql.Context, args []string) (int, error) {
 		return 1, err
 	}
 	if !headHash.Equal(workingHash) {
+		if err = dsess.CheckTableAccessUnchanged(ctx, dbName, workingSet.WorkingRoot(), workingRoot); err != nil {
+			return 1, err
+		}
 		err = dSess.SetWorkingRoot(ctx, dbName, workingRoot)
 		if err != nil {
 			return 1, err
@@ -16,8 +16,13 @@ package dsess
 
 import (
 	"context"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 )
 
 // CheckAccessForDb checks whether the current user has the given permissions for the given database.
@@ -57,3 +62,225 @@ func CheckAccessForDb(ctx context.Context, db SqlDatabase, flags branch_control.
 	}
 	return branch_control.ErrIncorrectPermissions.New(user, host, branch)
 }
+
+// CheckTableAccessForDb checks whether the current user may write to every column of the given table of the given
+// database. Table entries of dolt_branch_control that match the table narrow the write permission of the branch down,
+// although admins of the branch may always write to its tables.
+func CheckTableAccessForDb(ctx context.Context, db SqlDatabase, table string) error {
+	columnAccess, err := ColumnAccessForDb(ctx, db, table)
+	if err != nil {
+		return err
+	}
+	if columnAccess != nil {
+		return columnAccess.err()
+	}
+	return nil
+}
+
+// ColumnAccess is the set of columns of a table that a user may write to, when the table entries of dolt_branch_control
+// restrict them from writing to the rest. A nil ColumnAccess allows writing to every column.
+type ColumnAccess struct {
+	user    string
+	host    string
+	branch  string
+	table   string
+	columns map[string]struct{}
+}
+
+// CheckTableAccessUnchanged returns an error if the working root |to| changes any table of the working root |from| that
+// the current user may not write to every column of, on the branch of the given database. Procedures which replace
+// the working root, such as dolt_merge, dolt_cherry_pick, dolt_revert and dolt_reset, write to tables without going
+// through the table writers that enforce the table entries of dolt_branch_control, so they call this before replacing
+// it. As only the table is compared, a user may not change a table with them when they may only write to some columns.
+func CheckTableAccessUnchanged(ctx *sql.Context, dbName string, from, to doltdb.RootValue) error {
+	if branch_control.GetBranchAwareSession(ctx) == nil {
+		return nil
+	}
+	ws, err := DSessFromSess(ctx.Session).WorkingSet(ctx, dbName)
+	if err != nil {
+		return err
+	}
+	branchRef, err := ws.Ref().ToHeadRef()
+	if err != nil {
+		return err
+	}
+	baseName, _ := SplitRevisionDbName(dbName)
+
+	tableNames, err := changedTableNames(ctx, from, to)
+	if err != nil {
+		return err
+	}
+	for _, tableName := range tableNames {
+		columnAccess, err := columnAccessForBranch(ctx, baseName, branchRef.GetPath(), tableName)
+		if err != nil {
+			return err
+		}
+		if columnAccess != nil {
+			return columnAccess.err()
+		}
+	}
+	return nil
+}
+
+// changedTableNames returns the names of the tables that were added, dropped or modified between |from| and |to|.
+func changedTableNames(ctx context.Context, from, to doltdb.RootValue) ([]string, error) {
+	fromNames, err := from.GetTableNames(ctx, doltdb.DefaultSchemaName)
+	if err != nil {
+		return nil, err
+	}
+	toNames, err := to.GetTableNames(ctx, doltdb.DefaultSchemaName)
+	if err != nil {
+		return nil, err
+	}
+
+	var changed []string
+	seen := make(map[string]struct{}, len(fromNames)+len(toNames))
+	for _, name := range append(fromNames, toNames...) {
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		tableName := doltdb.TableName{Name: name}
+		fromHash, fromOk, err := from.GetTableHash(ctx, tableName)
+		if err != nil {
+			return nil, err
+		}
+		toHash, toOk, err := to.GetTableHash(ctx, tableName)
+		if err != nil {
+			return nil, err
+		}
+		if fromOk != toOk || fromHash != toHash {
+			changed = append(changed, name)
+		}
+	}
+	return changed, nil
+}
+
+// ColumnAccessForDb checks whether the current user may write to the given table of the given database, and returns
+// the columns that they may write to. Returns a nil ColumnAccess when they may write to every column.
+func ColumnAccessForDb(ctx context.Context, db SqlDatabase, table string) (*ColumnAccess, error) {
+	if db.RevisionType() != RevisionTypeBranch {
+		// not a branch db, no check necessary
+		return nil, nil
+	}
+	dbName, branch := SplitRevisionDbName(db.RevisionQualifiedName())
+	return columnAccessForBranch(ctx, dbName, branch, table)
+}
+
+// columnAccessForBranch checks whether the current user may write to the given table on the given branch of the given
+// database, and returns the columns that they may write to. Returns a nil ColumnAccess when they may write to every
+// column.
+func columnAccessForBranch(ctx context.Context, dbName string, branch string, table string) (*ColumnAccess, error) {
+	branchAwareSession := branch_control.GetBranchAwareSession(ctx)
+	// A nil session means we're not in the SQL context, so we allow all operations
+	if branchAwareSession == nil {
+		return nil, nil
+	}
+
+	controller := branchAwareSession.GetController()
+	// Any context that has a non-nil session should always have a non-nil controller, so this is an error
+	if controller == nil {
+		return nil, branch_control.ErrMissingController.New()
+	}
+
+	controller.Access.RWMutex.RLock()
+	defer controller.Access.RWMutex.RUnlock()
+
+	user := branchAwareSession.GetUser()
+	host := branchAwareSession.GetHost()
+
+	_, perms := controller.Access.Match(dbName, branch, user, host)
+	if perms&branch_control.Permissions_Admin == branch_control.Permissions_Admin {
+		return nil, nil
+	}
+	// Table entries only narrow the branch's permissions down, so they never allow writes to a read-only branch
+	if perms&branch_control.Permissions_Write != branch_control.Permissions_Write {
+		return nil, branch_control.ErrIncorrectPermissions.New(user, host, branch)
+	}
+	ok, tablePerms, columns := controller.Access.MatchTable(dbName, branch, user, host, table)
+	if !ok {
+		return nil, nil
+	}
+	if tablePerms&(branch_control.Permissions_Write|branch_control.Permissions_Admin) == 0 {
+		return nil, branch_control.ErrIncorrectTablePerms.New(user, host, table, branch)
+	}
+	if columns == nil {
+		return nil, nil
+	}
+	columnAccess := &ColumnAccess{
+		user:    user,
+		host:    host,
+		branch:  branch,
+		table:   table,
+		columns: make(map[string]struct{}, len(columns)),
+	}
+	for _, column := range columns {
+		columnAccess.columns[column] = struct{}{}
+	}
+	return columnAccess, nil
+}
+
+// Allows returns whether the column with the given name may be written to.
+func (ca *ColumnAccess) Allows(column string) bool {
+	if ca == nil {
+		return true
+	}
+	_, ok := ca.columns[strings.ToLower(column)]
+	return ok
+}
+
+// err returns the error for writing to a column that may not be written to.
+func (ca *ColumnAccess) err() error {
+	columns := make([]string, 0, len(ca.columns))
+	for column := range ca.columns {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+	return branch_control.ErrRestrictedColumns.New(ca.user, ca.host, strings.Join(columns, ", "), ca.table, ca.branch)
+}
+
+// columnRestrictedTableWriter is a TableWriter which only allows updates that leave the columns outside of its
+// ColumnAccess unchanged.
+type columnRestrictedTableWriter struct {
+	TableWriter
+	sch          sql.Schema
+	columnAccess *ColumnAccess
+}
+
+var _ TableWriter = columnRestrictedTableWriter{}
+
+// NewColumnRestrictedTableWriter returns a TableWriter over |tw| which rejects any update that changes a column of
+// |sch| which |columnAccess| does not allow writing to. Returns |tw| if |columnAccess| is nil.
+func NewColumnRestrictedTableWriter(tw TableWriter, sch sql.Schema, columnAccess *ColumnAccess) TableWriter {
+	if columnAccess == nil {
+		return tw
+	}
+	return columnRestrictedTableWriter{
+		TableWriter:  tw,
+		sch:          sch,
+		columnAccess: columnAccess,
+	}
+}
+
+// Update implements the interface sql.RowUpdater.
+func (w columnRestrictedTableWriter) Update(ctx *sql.Context, oldRow sql.Row, newRow sql.Row) error {
+	for i, col := range w.sch {
+		if w.columnAccess.Allows(col.Name) {
+			continue
+		}
+		if cmp, err := col.Type.Compare(oldRow[i], newRow[i]); err != nil || cmp != 0 {
+			return w.columnAccess.err()
+		}
+	}
+	return w.TableWriter.Update(ctx, oldRow, newRow)
+}
+
+// Insert implements the interface sql.RowInserter.
+func (w columnRestrictedTableWriter) Insert(ctx *sql.Context, row sql.Row) error {
+	return w.columnAccess.err()
+}
+
+// Delete implements the interface sql.RowDeleter.
+func (w columnRestrictedTableWriter) Delete(ctx *sql.Context, row sql.Row) error {
+	return w.columnAccess.err()
+}
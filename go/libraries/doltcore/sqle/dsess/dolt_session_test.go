@@ -36,6 +36,35 @@ func TestDoltSessionInit(t *testing.T) {
 	assert.Equal(t, conf, dsess.globalsConf)
 }
 
+func TestCheckStandbyRead(t *testing.T) {
+	sess := DefaultSession(emptyDatabaseProvider(), nil)
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sess))
+	var checked []string
+	check := func(ctx *sql.Context, dbName string) error {
+		checked = append(checked, dbName)
+		if dbName == "lagging" {
+			return ErrReplicationLagExceeded
+		}
+		return nil
+	}
+
+	// Each database is checked once per transaction
+	assert.NoError(t, sess.CheckStandbyRead(ctx, "mydb", check))
+	assert.NoError(t, sess.CheckStandbyRead(ctx, "MyDb", check))
+	assert.ErrorIs(t, sess.CheckStandbyRead(ctx, "lagging", check), ErrReplicationLagExceeded)
+	assert.ErrorIs(t, sess.CheckStandbyRead(ctx, "lagging", check), ErrReplicationLagExceeded)
+	assert.Equal(t, []string{"mydb", "lagging"}, checked)
+
+	// A check interrupted by the end of its statement is run again
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	canceledCtx := sql.NewContext(canceled, sql.WithSession(sess))
+	assert.NoError(t, sess.CheckStandbyRead(canceledCtx, "other", check))
+	assert.NoError(t, sess.CheckStandbyRead(ctx, "other", check))
+	assert.NoError(t, sess.CheckStandbyRead(ctx, "other", check))
+	assert.Equal(t, []string{"mydb", "lagging", "other", "other"}, checked)
+}
+
 func TestNewPersistedSystemVariables(t *testing.T) {
 	dsess := DefaultSession(emptyDatabaseProvider(), nil)
 	conf := config.NewMapConfig(map[string]string{"max_connections": "1000"})
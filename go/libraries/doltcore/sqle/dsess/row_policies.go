@@ -0,0 +1,96 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dsess
+
+import (
+	"context"
+	"strings"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+var ErrRowPolicyViolation = errors.NewKind("the row policies of table `%s` do not allow this %s")
+var ErrRowPoliciesPermissions = errors.NewKind("`%s`@`%s` does not have the privileges to modify the row policies of database `%s`")
+var ErrRowPoliciesRestrictRead = errors.NewKind("cannot read the rows of table `%s` through %s, since the table has row policies")
+
+// BypassesRowPolicies returns whether the current user is exempt from the row policies of the given database. The
+// users that may modify the dolt_row_policies table are the super users of the database, as defined by
+// branch_control.HasDatabasePrivileges, and the policies never restrict them.
+func BypassesRowPolicies(ctx context.Context, dbName string) bool {
+	branchAwareSession := branch_control.GetBranchAwareSession(ctx)
+	// A nil session means we're not in the SQL context, so we allow all operations
+	if branchAwareSession == nil {
+		return true
+	}
+	baseName, _ := SplitRevisionDbName(dbName)
+	return branch_control.HasDatabasePrivileges(branchAwareSession, baseName)
+}
+
+// CheckRowPoliciesAccess returns an error if the current user may not modify the row policies of the given database.
+func CheckRowPoliciesAccess(ctx context.Context, dbName string) error {
+	if BypassesRowPolicies(ctx, dbName) {
+		return nil
+	}
+	branchAwareSession := branch_control.GetBranchAwareSession(ctx)
+	baseName, _ := SplitRevisionDbName(dbName)
+	return ErrRowPoliciesPermissions.New(branchAwareSession.GetUser(), branchAwareSession.GetHost(), baseName)
+}
+
+// CheckRowPoliciesUnrestricted returns an error if the current user is restricted by row policies of the table named
+// in |root|, the root that the row policies of the given database are read from. System tables and table functions
+// which read the rows of a table without going through the table itself can't enforce its row policies, so they call
+// this to refuse to read them instead. |source| names the system table or table function in the error.
+func CheckRowPoliciesUnrestricted(ctx context.Context, dbName string, root doltdb.RootValue, tableName, source string) error {
+	if BypassesRowPolicies(ctx, dbName) {
+		return nil
+	}
+	policies, err := doltdb.GetRowPolicies(ctx, root)
+	if err != nil {
+		return err
+	}
+	// Policies are deny by default, so any policy of the table restricts every user who doesn't bypass them
+	for _, policy := range policies {
+		if strings.EqualFold(policy.Table, tableName) {
+			return ErrRowPoliciesRestrictRead.New(tableName, source)
+		}
+	}
+	return nil
+}
+
+// CheckRowPoliciesUnchanged returns an error if the working root |to| has different row policies than the working
+// root |from| and the current user may not modify the row policies of the given database. This keeps such users from
+// replacing the policies with dolt_reset, dolt_merge, dolt_cherry_pick, dolt_revert and other procedures which
+// replace the working root.
+func CheckRowPoliciesUnchanged(ctx context.Context, dbName string, from, to doltdb.RootValue) error {
+	if BypassesRowPolicies(ctx, dbName) {
+		return nil
+	}
+	tableName := doltdb.TableName{Name: doltdb.RowPoliciesTableName}
+	fromHash, fromOk, err := from.GetTableHash(ctx, tableName)
+	if err != nil {
+		return err
+	}
+	toHash, toOk, err := to.GetTableHash(ctx, tableName)
+	if err != nil {
+		return err
+	}
+	if fromOk == toOk && fromHash == toHash {
+		return nil
+	}
+	return CheckRowPoliciesAccess(ctx, dbName)
+}
@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -34,6 +35,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
 	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/globalstate"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor"
 	"github.com/dolthub/dolt/go/libraries/utils/config"
 	"github.com/dolthub/dolt/go/libraries/utils/filesys"
@@ -67,12 +69,20 @@ type DoltSession struct {
 	// If non-nil, this will be returned from ValidateSession.
 	// Used by sqle/cluster to put a session into a terminal err state.
 	validateErr error
+
+	// The number of rows this session has read from table and index data. Used by the sql-server slow query log.
+	rowsExamined *atomic.Uint64
+	// The last commit this session created or moved the head of a branch to. Used by the sql-server audit log.
+	lastHeadUpdate *atomic.Pointer[HeadUpdate]
+	// The result of the standby read check of each database read in the current transaction.
+	standbyReadChecks map[string]error
 }
 
 var _ sql.Session = (*DoltSession)(nil)
 var _ sql.PersistableSession = (*DoltSession)(nil)
 var _ sql.TransactionSession = (*DoltSession)(nil)
 var _ branch_control.Context = (*DoltSession)(nil)
+var _ index.RowsExaminedRecorder = (*DoltSession)(nil)
 
 // DefaultSession creates a DoltSession with default values
 func DefaultSession(pro DoltDatabaseProvider, sessFunc WriteSessFunc) *DoltSession {
@@ -89,6 +99,8 @@ func DefaultSession(pro DoltDatabaseProvider, sessFunc WriteSessFunc) *DoltSessi
 		mu:               &sync.Mutex{},
 		fs:               pro.FileSystem(),
 		writeSessProv:    sessFunc,
+		rowsExamined:     &atomic.Uint64{},
+		lastHeadUpdate:   &atomic.Pointer[HeadUpdate]{},
 	}
 }
 
@@ -119,11 +131,45 @@ func NewDoltSession(
 		mu:               &sync.Mutex{},
 		fs:               pro.FileSystem(),
 		writeSessProv:    writeSessProv,
+		rowsExamined:     &atomic.Uint64{},
+		lastHeadUpdate:   &atomic.Pointer[HeadUpdate]{},
 	}
 
 	return sess, nil
 }
 
+// RowsExamined returns the number of rows this session has read from table and index data since it was created. Rows
+// are only counted once index.EnableRowsExamined has been called.
+func (d *DoltSession) RowsExamined() uint64 {
+	return d.rowsExamined.Load()
+}
+
+// RecordRowsExamined implements index.RowsExaminedRecorder.
+func (d *DoltSession) RecordRowsExamined(n uint64) {
+	d.rowsExamined.Add(n)
+}
+
+// HeadUpdate is a commit that a session created, or moved the head of a branch to.
+type HeadUpdate struct {
+	Commit hash.Hash
+}
+
+// LastHeadUpdate returns the last commit this session created or moved the head of a branch to, or nil if it hasn't.
+// Every update is stored as a new HeadUpdate, so whether a statement updated a head can be told by comparing the
+// pointers returned before and after it. Unlike the head of the branch, this isn't changed by other sessions.
+func (d *DoltSession) LastHeadUpdate() *HeadUpdate {
+	return d.lastHeadUpdate.Load()
+}
+
+// RecordHeadUpdate records that this session created |cm|, or moved the head of a branch to it.
+func (d *DoltSession) RecordHeadUpdate(cm *doltdb.Commit) {
+	h, err := cm.HashOf()
+	if err != nil {
+		return
+	}
+	d.lastHeadUpdate.Store(&HeadUpdate{Commit: h})
+}
+
 // Provider returns the RevisionDatabaseProvider for this session.
 func (d *DoltSession) Provider() DoltDatabaseProvider {
 	return d.provider
@@ -331,6 +377,9 @@ func (d *DoltSession) StartTransaction(ctx *sql.Context, tCharacteristic sql.Tra
 
 	// New transaction, clear all session state
 	d.clear()
+	d.mu.Lock()
+	d.standbyReadChecks = nil
+	d.mu.Unlock()
 
 	// Take a snapshot of the current noms root for every database under management
 	doltDatabases := d.provider.DoltDatabases()
@@ -393,6 +442,36 @@ func (d *DoltSession) StartTransaction(ctx *sql.Context, tCharacteristic sql.Tra
 }
 
 // clear clears all DB state for this session
+// ErrReplicationLagExceeded is returned when a session reads a database from a standby which is further behind its
+// primary than @@dolt_max_replication_lag_ms allows.
+var ErrReplicationLagExceeded = errors.New("standby replication lag exceeds @@" + DoltMaxReplicationLagMs)
+
+// CheckStandbyRead runs |check| for the database |dbName| the first time the current transaction reads it on a standby,
+// and returns the same result for every later read of the database until the transaction ends. The check can wait for
+// the standby to catch up, so it isn't run again for each table or statement of the transaction.
+func (d *DoltSession) CheckStandbyRead(ctx *sql.Context, dbName string, check func(ctx *sql.Context, dbName string) error) error {
+	key := strings.ToLower(dbName)
+	d.mu.Lock()
+	err, ok := d.standbyReadChecks[key]
+	d.mu.Unlock()
+	if ok {
+		return err
+	}
+
+	err = check(ctx, dbName)
+	if ctx.Err() != nil {
+		// A check interrupted by the end of the statement says nothing about later statements
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.standbyReadChecks == nil {
+		d.standbyReadChecks = make(map[string]error)
+	}
+	d.standbyReadChecks[key] = err
+	return err
+}
+
 func (d *DoltSession) clear() {
 	d.mu.Lock()
 	defer d.mu.Unlock()
@@ -630,6 +709,9 @@ func (d *DoltSession) commitBranchState(
 	if err != nil {
 		return nil, err
 	}
+	if newCommit != nil {
+		d.RecordHeadUpdate(newCommit)
+	}
 
 	// Anything that commits a transaction needs its current transaction state cleared so that the next statement starts
 	// a new transaction. This should in principle be done by the engine, but it currently only understands explicit
@@ -990,6 +1072,9 @@ func (d *DoltSession) SetWorkingSet(ctx *sql.Context, dbName string, ws *doltdb.
 	if ws.Ref() != branchState.WorkingSet().Ref() {
 		return fmt.Errorf("must switch working sets with SwitchWorkingSet")
 	}
+	if err = CheckRowPoliciesUnchanged(ctx, dbName, branchState.WorkingSet().WorkingRoot(), ws.WorkingRoot()); err != nil {
+		return err
+	}
 	branchState.workingSet = ws
 
 	err = d.setDbSessionVars(ctx, branchState, true)
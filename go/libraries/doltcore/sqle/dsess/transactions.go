@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -26,10 +27,14 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/vitess/go/mysql"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
 	"github.com/dolthub/dolt/go/libraries/doltcore/merge"
+	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor"
 	"github.com/dolthub/dolt/go/store/datas"
 	"github.com/dolthub/dolt/go/store/hash"
@@ -240,6 +245,7 @@ func doltCommit(ctx *sql.Context,
 			// is the value which we are trying to commit.
 			start := time.Now()
 
+			span, ctx := ctx.Span("dsess.mergeHead")
 			result, err := merge.MergeRoots(
 				ctx,
 				pending.Roots.Staged,
@@ -249,6 +255,7 @@ func doltCommit(ctx *sql.Context,
 				startState,
 				mergeOpts,
 				merge.MergeOpts{})
+			endSpan(span, err)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -295,6 +302,10 @@ func (tx *DoltTransaction) DoltCommit(
 	return tx.doCommit(ctx, workingSet, commit, doltCommit, dbName)
 }
 
+// WaitForReplicationController waits for the replication tracked by |rsc| to be acknowledged, for up to
+// @@dolt_cluster_ack_writes_timeout_secs. When @@dolt_cluster_ack_writes_min_acks is less than all of the replicas,
+// it returns as soon as that many replicas have acknowledged the write. Replicas which did not acknowledge the write
+// are reported as session warnings.
 func WaitForReplicationController(ctx *sql.Context, rsc doltdb.ReplicationStatusController) {
 	if len(rsc.Wait) == 0 {
 		return
@@ -307,10 +318,31 @@ func WaitForReplicationController(ctx *sql.Context, rsc doltdb.ReplicationStatus
 	if timeoutI == 0 {
 		return
 	}
+	minAcks := servercfg.ClusterMinAcksAll
+	if _, v, ok := sql.SystemVariables.GetGlobal(DoltClusterAckWritesMinAcks); ok {
+		minAcks = v.(string)
+	}
+
+	// Waits for the same replica are acknowledged together.
+	replicas := rsc.Replicas
+	pending := make(map[string]int)
+	for i := range rsc.Wait {
+		pending[replicas[i]] += 1
+	}
+	numReplicas := len(pending)
+	required, ok := replicationAcksRequired(minAcks, numReplicas)
+	if !ok {
+		ctx.Session.Warn(&sql.Warning{
+			Level:   "Warning",
+			Code:    mysql.ERWrongValueForVar,
+			Message: fmt.Sprintf("@@%s is '%s', which can't be met by the %d replicas of this write; waiting for %d of them.", DoltClusterAckWritesMinAcks, minAcks, numReplicas, required),
+		})
+	}
 
 	cCtx, cancel := context.WithCancelCause(ctx)
 	var wg sync.WaitGroup
 	wg.Add(len(rsc.Wait))
+	acked := make(chan int, len(rsc.Wait))
 	for i, f := range rsc.Wait {
 		f := f
 		i := i
@@ -318,7 +350,7 @@ func WaitForReplicationController(ctx *sql.Context, rsc doltdb.ReplicationStatus
 			defer wg.Done()
 			err := f(cCtx)
 			if err == nil {
-				rsc.Wait[i] = nil
+				acked <- i
 			}
 		}()
 	}
@@ -329,39 +361,100 @@ func WaitForReplicationController(ctx *sql.Context, rsc doltdb.ReplicationStatus
 		close(done)
 	}()
 
-	waitFailed := false
-	select {
-	case <-time.After(time.Duration(timeoutI) * time.Second):
-		// We timed out before all the waiters were done.
-		// First we make certain to finalize everything.
+	numAcked := 0
+	ack := func(i int) {
+		rsc.Wait[i] = nil
+		pending[replicas[i]] -= 1
+		if pending[replicas[i]] == 0 {
+			numAcked += 1
+		}
+	}
+
+	timedOut := false
+	timer := time.NewTimer(time.Duration(timeoutI) * time.Second)
+	defer timer.Stop()
+wait:
+	for numAcked < required {
+		select {
+		case i := <-acked:
+			ack(i)
+		case <-done:
+			break wait
+		case <-timer.C:
+			// We timed out before enough of the waiters were done.
+			timedOut = true
+			break wait
+		}
+	}
+	// We make certain to finalize everything, including the waits for
+	// replicas we no longer need to hear from.
+	if timedOut {
 		cancel(doltdb.ErrReplicationWaitFailed)
-		<-done
-		waitFailed = true
-	case <-done:
+	} else {
 		cancel(context.Canceled)
 	}
+	<-done
+	close(acked)
+	for i := range acked {
+		ack(i)
+	}
+	waitFailed := timedOut && numAcked < required
 
-	// Just because our waiters all completed does not mean they all
-	// returned nil errors. Any non-nil entries in rsc.Wait returned an
-	// error. We turn those into warnings here.
+	// Any non-nil entries in rsc.Wait did not complete successfully. We
+	// turn those into warnings here.
 	numFailed := 0
+	var lagging []string
 	for i, f := range rsc.Wait {
 		if f != nil {
 			numFailed += 1
 			if waitFailed {
 				rsc.NotifyWaitFailed[i]()
 			}
+			if pending[replicas[i]] > 0 {
+				lagging = append(lagging, replicas[i])
+				pending[replicas[i]] = 0
+			}
 		}
 	}
-	if numFailed > 0 {
+	if numFailed == 0 {
+		return
+	}
+	if numAcked < required {
 		ctx.Session.Warn(&sql.Warning{
 			Level:   "Warning",
 			Code:    mysql.ERQueryTimeout,
-			Message: fmt.Sprintf("Timed out replication of commit to %d out of %d replicas.", numFailed, len(rsc.Wait)),
+			Message: fmt.Sprintf("Timed out replication of commit to %d out of %d replicas: %s.", len(lagging), numReplicas, strings.Join(lagging, ", ")),
+		})
+	} else {
+		ctx.Session.Warn(&sql.Warning{
+			Level:   "Note",
+			Code:    mysql.ERQueryTimeout,
+			Message: fmt.Sprintf("Commit was acknowledged by %d out of %d replicas; still replicating to: %s.", numAcked, numAcked+len(lagging), strings.Join(lagging, ", ")),
 		})
 	}
 }
 
+// replicationAcksRequired returns how many of |numReplicas| replicas must acknowledge a write for |minAcks|, which is
+// servercfg.ClusterMinAcksAll, servercfg.ClusterMinAcksMajority or a number of replicas. A number which is not
+// between 1 and |numReplicas| is clamped to that range, and an invalid value waits for all of the replicas; in both
+// cases it returns false.
+func replicationAcksRequired(minAcks string, numReplicas int) (int, bool) {
+	switch minAcks {
+	case servercfg.ClusterMinAcksAll:
+		return numReplicas, true
+	case servercfg.ClusterMinAcksMajority:
+		return numReplicas/2 + 1, true
+	}
+	n, err := strconv.Atoi(minAcks)
+	if err != nil || n > numReplicas {
+		return numReplicas, false
+	}
+	if n < 1 {
+		return 1, false
+	}
+	return n, true
+}
+
 // doCommit commits this transaction with the write function provided. It takes the same params as DoltCommit
 func (tx *DoltTransaction) doCommit(
 	ctx *sql.Context,
@@ -369,7 +462,14 @@ func (tx *DoltTransaction) doCommit(
 	commit *doltdb.PendingCommit,
 	writeFn transactionWrite,
 	dbName string,
-) (*doltdb.WorkingSet, *doltdb.Commit, error) {
+) (_ *doltdb.WorkingSet, _ *doltdb.Commit, err error) {
+	span, ctx := ctx.Span("dsess.DoltTransaction.Commit", trace.WithAttributes(
+		attribute.String("database", dbName),
+		attribute.Bool("dolt_commit", commit != nil)))
+	defer func() {
+		endSpan(span, err)
+	}()
+
 	sess := DSessFromSess(ctx.Session)
 	branchState, ok, err := sess.lookupDbState(ctx, dbName)
 	if err != nil {
@@ -396,6 +496,7 @@ func (tx *DoltTransaction) doCommit(
 	mergeOpts := branchState.EditOpts()
 
 	for i := 0; i < maxTxCommitRetries; i++ {
+		span.SetAttributes(attribute.Int("attempts", i+1))
 		updatedWs, newCommit, err := func() (*doltdb.WorkingSet, *doltdb.Commit, error) {
 			// Serialize commits, since only one can possibly succeed at a time anyway
 			txLock.Lock()
@@ -482,7 +583,11 @@ func (tx *DoltTransaction) mergeRoots(
 	existingWorkingSet *doltdb.WorkingSet,
 	workingSet *doltdb.WorkingSet,
 	mergeOpts editor.Options,
-) (*doltdb.WorkingSet, error) {
+) (_ *doltdb.WorkingSet, err error) {
+	span, ctx := ctx.Span("dsess.DoltTransaction.mergeRoots")
+	defer func() {
+		endSpan(span, err)
+	}()
 
 	if !rootsEqual(existingWorkingSet.WorkingRoot(), workingSet.WorkingRoot()) {
 		result, err := merge.MergeRoots(
@@ -519,6 +624,15 @@ func (tx *DoltTransaction) mergeRoots(
 	return workingSet, nil
 }
 
+// endSpan records |err|, if any, on |span| and ends it.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
 // rollback attempts a transaction rollback
 func (tx *DoltTransaction) rollback(ctx *sql.Context) error {
 	sess := DSessFromSess(ctx.Session)
@@ -0,0 +1,180 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dsess
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	gmstypes "github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+)
+
+func TestReplicationAcksRequired(t *testing.T) {
+	tests := []struct {
+		minAcks     string
+		numReplicas int
+		expected    int
+		valid       bool
+	}{
+		{servercfg.ClusterMinAcksAll, 3, 3, true},
+		{servercfg.ClusterMinAcksMajority, 1, 1, true},
+		{servercfg.ClusterMinAcksMajority, 2, 2, true},
+		{servercfg.ClusterMinAcksMajority, 3, 2, true},
+		{servercfg.ClusterMinAcksMajority, 4, 3, true},
+		{"1", 3, 1, true},
+		{"3", 3, 3, true},
+		// A count above the number of replicas waits for all of them.
+		{"4", 2, 2, false},
+		{"0", 2, 1, false},
+		{"invalid", 2, 2, false},
+	}
+	for _, test := range tests {
+		required, valid := replicationAcksRequired(test.minAcks, test.numReplicas)
+		assert.Equal(t, test.expected, required, "min_acks %s with %d replicas", test.minAcks, test.numReplicas)
+		assert.Equal(t, test.valid, valid, "min_acks %s with %d replicas", test.minAcks, test.numReplicas)
+	}
+}
+
+func setReplicationWaitVariables(t *testing.T, timeoutSecs int64, minAcks string) {
+	sql.SystemVariables.AddSystemVariables([]sql.SystemVariable{
+		&sql.MysqlSystemVariable{
+			Name:    DoltClusterAckWritesTimeoutSecs,
+			Dynamic: true,
+			Scope:   sql.GetMysqlScope(sql.SystemVariableScope_Global),
+			Type:    gmstypes.NewSystemIntType(DoltClusterAckWritesTimeoutSecs, 0, 60, false),
+			Default: timeoutSecs,
+		},
+		&sql.MysqlSystemVariable{
+			Name:    DoltClusterAckWritesMinAcks,
+			Dynamic: false,
+			Scope:   sql.GetMysqlScope(sql.SystemVariableScope_Global),
+			Type:    gmstypes.NewSystemStringType(DoltClusterAckWritesMinAcks),
+			Default: minAcks,
+		},
+	})
+	t.Cleanup(func() {
+		sql.SystemVariables.AddSystemVariables([]sql.SystemVariable{
+			&sql.MysqlSystemVariable{
+				Name:    DoltClusterAckWritesTimeoutSecs,
+				Dynamic: true,
+				Scope:   sql.GetMysqlScope(sql.SystemVariableScope_Global),
+				Type:    gmstypes.NewSystemIntType(DoltClusterAckWritesTimeoutSecs, 0, 60, false),
+				Default: int64(0),
+			},
+		})
+	})
+}
+
+// replicationWaits returns a ReplicationStatusController with a wait for each of |replicas|. The waits for the
+// replicas in |slow| do not complete until they are canceled. |failed| records the replicas that were notified that
+// their wait failed.
+func replicationWaits(replicas []string, slow map[string]bool, failed map[string]bool) doltdb.ReplicationStatusController {
+	var rsc doltdb.ReplicationStatusController
+	for _, r := range replicas {
+		r := r
+		if slow[r] {
+			rsc.Wait = append(rsc.Wait, func(ctx context.Context) error {
+				<-ctx.Done()
+				return context.Cause(ctx)
+			})
+		} else {
+			rsc.Wait = append(rsc.Wait, func(ctx context.Context) error {
+				return nil
+			})
+		}
+		rsc.NotifyWaitFailed = append(rsc.NotifyWaitFailed, func() {
+			failed[r] = true
+		})
+		rsc.Replicas = append(rsc.Replicas, r)
+	}
+	return rsc
+}
+
+func TestWaitForReplicationController(t *testing.T) {
+	replicas := []string{"standby1", "standby2", "standby3"}
+
+	t.Run("MajorityAcknowledged", func(t *testing.T) {
+		setReplicationWaitVariables(t, 10, servercfg.ClusterMinAcksMajority)
+		ctx := sql.NewEmptyContext()
+		failed := make(map[string]bool)
+		start := time.Now()
+		WaitForReplicationController(ctx, replicationWaits(replicas, map[string]bool{"standby3": true}, failed))
+		assert.Less(t, time.Since(start), 5*time.Second)
+		assert.Empty(t, failed)
+		warnings := ctx.Warnings()
+		require.Len(t, warnings, 1)
+		assert.Equal(t, "Note", warnings[0].Level)
+		assert.Contains(t, warnings[0].Message, "standby3")
+	})
+
+	t.Run("AllTimesOut", func(t *testing.T) {
+		setReplicationWaitVariables(t, 1, servercfg.ClusterMinAcksAll)
+		ctx := sql.NewEmptyContext()
+		failed := make(map[string]bool)
+		WaitForReplicationController(ctx, replicationWaits(replicas, map[string]bool{"standby3": true}, failed))
+		assert.Equal(t, map[string]bool{"standby3": true}, failed)
+		warnings := ctx.Warnings()
+		require.Len(t, warnings, 1)
+		assert.Equal(t, "Warning", warnings[0].Level)
+		assert.Equal(t, "Timed out replication of commit to 1 out of 3 replicas: standby3.", warnings[0].Message)
+	})
+
+	t.Run("CountNotReached", func(t *testing.T) {
+		setReplicationWaitVariables(t, 1, "2")
+		ctx := sql.NewEmptyContext()
+		failed := make(map[string]bool)
+		WaitForReplicationController(ctx, replicationWaits(replicas, map[string]bool{"standby2": true, "standby3": true}, failed))
+		assert.Equal(t, map[string]bool{"standby2": true, "standby3": true}, failed)
+		warnings := ctx.Warnings()
+		require.Len(t, warnings, 1)
+		assert.Equal(t, "Timed out replication of commit to 2 out of 3 replicas: standby2, standby3.", warnings[0].Message)
+	})
+
+	t.Run("WaitsForTheSameReplicaAreAcknowledgedTogether", func(t *testing.T) {
+		setReplicationWaitVariables(t, 1, "1")
+		ctx := sql.NewEmptyContext()
+		failed := make(map[string]bool)
+		rsc := replicationWaits([]string{"standby1", "standby2"}, map[string]bool{"standby2": true}, failed)
+		// standby1 also has a wait which fails, so it does not acknowledge the write.
+		rsc.Wait = append(rsc.Wait, func(ctx context.Context) error {
+			return errors.New("replication failed")
+		})
+		rsc.NotifyWaitFailed = append(rsc.NotifyWaitFailed, func() {})
+		rsc.Replicas = append(rsc.Replicas, "standby1")
+		WaitForReplicationController(ctx, rsc)
+		warnings := ctx.Warnings()
+		require.Len(t, warnings, 1)
+		assert.Equal(t, "Timed out replication of commit to 2 out of 2 replicas: standby2, standby1.", warnings[0].Message)
+	})
+
+	t.Run("ClampedCountIsReported", func(t *testing.T) {
+		setReplicationWaitVariables(t, 10, "5")
+		ctx := sql.NewEmptyContext()
+		failed := make(map[string]bool)
+		WaitForReplicationController(ctx, replicationWaits(replicas, nil, failed))
+		assert.Empty(t, failed)
+		warnings := ctx.Warnings()
+		require.Len(t, warnings, 1)
+		assert.Equal(t, "@@dolt_cluster_ack_writes_min_acks is '5', which can't be met by the 3 replicas of this write; waiting for 3 of them.", warnings[0].Message)
+	})
+}
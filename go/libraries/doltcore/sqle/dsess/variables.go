@@ -54,10 +54,17 @@ const (
 	ShowBranchDatabases                  = "dolt_show_branch_databases"
 	DoltLogLevel                         = "dolt_log_level"
 	ShowSystemTables                     = "dolt_show_system_tables"
+	BinlogReplicaPersistFilters          = "dolt_binlog_replica_persist_filters"
+	BinlogReplicaChannel                 = "dolt_binlog_replica_channel"
+	BinlogReplicaCommitBatchSize         = "dolt_binlog_replica_commit_batch_size"
+	BinlogReplicaCommitIntervalSecs      = "dolt_binlog_replica_commit_interval_secs"
 
 	DoltClusterRoleVariable         = "dolt_cluster_role"
 	DoltClusterRoleEpochVariable    = "dolt_cluster_role_epoch"
 	DoltClusterAckWritesTimeoutSecs = "dolt_cluster_ack_writes_timeout_secs"
+	DoltClusterAckWritesMinAcks     = "dolt_cluster_ack_writes_min_acks"
+	DoltMaxReplicationLagMs         = "dolt_max_replication_lag_ms"
+	DoltReplicationLagWaitTimeoutMs = "dolt_replication_lag_wait_timeout_ms"
 
 	DoltStatsAutoRefreshEnabled   = "dolt_stats_auto_refresh_enabled"
 	DoltStatsBootstrapEnabled     = "dolt_stats_bootstrap_enabled"
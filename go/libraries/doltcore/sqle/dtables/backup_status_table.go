@@ -0,0 +1,115 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/backup"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+)
+
+// BackupStatusTable is a sql.Table implementation that implements a system table which shows the state of every
+// scheduled backup of the sql-server.
+type BackupStatusTable struct {
+	scheduler *backup.Scheduler
+}
+
+var _ sql.Table = BackupStatusTable{}
+
+// NewBackupStatusTable creates a BackupStatusTable. |scheduler| is nil when no backups are scheduled, in which case the
+// table is empty.
+func NewBackupStatusTable(scheduler *backup.Scheduler) sql.Table {
+	return BackupStatusTable{scheduler: scheduler}
+}
+
+// Name implements the interface sql.Table.
+func (t BackupStatusTable) Name() string {
+	return doltdb.BackupStatusTableName
+}
+
+// String implements the interface sql.Table.
+func (t BackupStatusTable) String() string {
+	return doltdb.BackupStatusTableName
+}
+
+// Schema implements the interface sql.Table.
+func (t BackupStatusTable) Schema() sql.Schema {
+	return []*sql.Column{
+		{Name: "database", Type: types.Text, Source: doltdb.BackupStatusTableName, PrimaryKey: true, Nullable: false},
+		{Name: "url", Type: types.Text, Source: doltdb.BackupStatusTableName, PrimaryKey: true, Nullable: false},
+		{Name: "schedule", Type: types.Text, Source: doltdb.BackupStatusTableName, PrimaryKey: false, Nullable: false},
+		{Name: "retention", Type: types.Uint64, Source: doltdb.BackupStatusTableName, PrimaryKey: false, Nullable: false},
+		{Name: "next_sync", Type: types.DatetimeMaxPrecision, Source: doltdb.BackupStatusTableName, PrimaryKey: false, Nullable: true},
+		{Name: "last_sync_start", Type: types.DatetimeMaxPrecision, Source: doltdb.BackupStatusTableName, PrimaryKey: false, Nullable: true},
+		{Name: "last_sync_duration_millis", Type: types.Uint64, Source: doltdb.BackupStatusTableName, PrimaryKey: false, Nullable: true},
+		{Name: "last_sync_result", Type: types.Text, Source: doltdb.BackupStatusTableName, PrimaryKey: false, Nullable: true},
+		{Name: "restore_points", Type: types.Uint64, Source: doltdb.BackupStatusTableName, PrimaryKey: false, Nullable: false},
+		{Name: "oldest_restore_point", Type: types.DatetimeMaxPrecision, Source: doltdb.BackupStatusTableName, PrimaryKey: false, Nullable: true},
+		{Name: "latest_restore_point", Type: types.DatetimeMaxPrecision, Source: doltdb.BackupStatusTableName, PrimaryKey: false, Nullable: true},
+		{Name: "latest_root", Type: types.Text, Source: doltdb.BackupStatusTableName, PrimaryKey: false, Nullable: true},
+	}
+}
+
+// Collation implements the interface sql.Table.
+func (t BackupStatusTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Partitions implements the interface sql.Table.
+func (t BackupStatusTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	return index.SinglePartitionIterFromNomsMap(nil), nil
+}
+
+// PartitionRows implements the interface sql.Table.
+func (t BackupStatusTable) PartitionRows(*sql.Context, sql.Partition) (sql.RowIter, error) {
+	status := t.scheduler.Status()
+	rows := make([]sql.Row, len(status))
+	for i, st := range status {
+		row := sql.Row{
+			st.Database,
+			st.URL,
+			st.Schedule,
+			uint64(st.Retention),
+			nil,
+			nil,
+			nil,
+			nil,
+			uint64(len(st.RestorePoints)),
+			nil,
+			nil,
+			nil,
+		}
+		if !st.NextSync.IsZero() {
+			row[4] = st.NextSync.UTC()
+		}
+		if st.LastResult != "" {
+			row[5] = st.LastSyncStart.UTC()
+			row[6] = uint64(st.LastDuration / time.Millisecond)
+			row[7] = st.LastResult
+		}
+		if n := len(st.RestorePoints); n > 0 {
+			row[9] = st.RestorePoints[0].Time.UTC()
+			row[10] = st.RestorePoints[n-1].Time.UTC()
+			row[11] = st.RestorePoints[n-1].Root.String()
+		}
+		rows[i] = row
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
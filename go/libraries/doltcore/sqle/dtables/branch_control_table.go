@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/types"
 	"github.com/dolthub/vitess/go/sqltypes"
 
@@ -61,12 +62,39 @@ var accessSchema = sql.Schema{
 		Source:     AccessTableName,
 		PrimaryKey: true,
 	},
+	&sql.Column{
+		Name:       "table",
+		Type:       accessTableType,
+		Source:     AccessTableName,
+		PrimaryKey: true,
+		Default:    mustAccessTableDefault(),
+	},
 	&sql.Column{
 		Name:       "permissions",
 		Type:       types.MustCreateSetType(PermissionsStrings, sql.Collation_utf8mb4_0900_ai_ci),
 		Source:     AccessTableName,
 		PrimaryKey: false,
 	},
+	&sql.Column{
+		Name:       "columns",
+		Type:       types.MustCreateString(sqltypes.VarChar, 16383, sql.Collation_utf8mb4_0900_ai_ci),
+		Source:     AccessTableName,
+		PrimaryKey: false,
+		Nullable:   true,
+	},
+}
+
+// accessTableType is the type of the "table" column of the "dolt_branch_control" table.
+var accessTableType = types.MustCreateString(sqltypes.VarChar, 16383, sql.Collation_utf8mb4_0900_ai_ci)
+
+// mustAccessTableDefault returns the default value of the "table" column, which makes an entry apply to the branch as a
+// whole when it is omitted.
+func mustAccessTableDefault() *sql.ColumnDefaultValue {
+	def, err := sql.NewColumnDefaultValue(expression.NewLiteral(branch_control.AllTables, accessTableType), accessTableType, true, false, false)
+	if err != nil {
+		panic(err)
+	}
+	return def
 }
 
 // BranchControlTable provides a layer over the branch_control.Access structure, exposing it as a system table.
@@ -127,7 +155,9 @@ func (tbl BranchControlTable) PartitionRows(context *sql.Context, partition sql.
 			value.Branch,
 			value.User,
 			value.Host,
+			value.Table,
 			uint64(value.Permissions),
+			accessColumnsValue(value.Columns),
 		})
 	}
 	return sql.RowsToRowIter(rows...), nil
@@ -175,15 +205,12 @@ func (tbl BranchControlTable) Insert(ctx *sql.Context, row sql.Row) error {
 	tbl.RWMutex.Lock()
 	defer tbl.RWMutex.Unlock()
 
-	// Database, Branch, and Host are case-insensitive, while user is case-sensitive
-	database := strings.ToLower(branch_control.FoldExpression(row[0].(string)))
-	branch := strings.ToLower(branch_control.FoldExpression(row[1].(string)))
-	user := branch_control.FoldExpression(row[2].(string))
-	host := strings.ToLower(branch_control.FoldExpression(row[3].(string)))
-	perms := branch_control.Permissions(row[4].(uint64))
+	database, branch, user, host, table := foldAccessRow(row)
+	perms := branch_control.Permissions(row[5].(uint64))
+	columns := accessColumns(row)
 
 	// Verify that the lengths of each expression fit within an uint16
-	if len(database) > math.MaxUint16 || len(branch) > math.MaxUint16 || len(user) > math.MaxUint16 || len(host) > math.MaxUint16 {
+	if len(database) > math.MaxUint16 || len(branch) > math.MaxUint16 || len(user) > math.MaxUint16 || len(host) > math.MaxUint16 || len(table) > math.MaxUint16 {
 		return branch_control.ErrExpressionsTooLong.New(database, branch, user, host)
 	}
 
@@ -197,23 +224,16 @@ func (tbl BranchControlTable) Insert(ctx *sql.Context, row sql.Row) error {
 		// determine if the user attempting the insertion has permission to perform the insertion.
 		_, modPerms := tbl.Match(database, branch, insertUser, insertHost)
 		if modPerms&branch_control.Permissions_Admin != branch_control.Permissions_Admin {
-			permStr, _ := accessSchema[4].Type.(sql.SetType).BitsToString(uint64(perms))
+			permStr, _ := accessSchema[5].Type.(sql.SetType).BitsToString(uint64(perms))
 			return branch_control.ErrInsertingAccessRow.New(insertUser, insertHost, database, branch, user, host, permStr)
 		}
 	}
 
-	// We check if we're inserting a subset of an already-existing row. If we are, we deny the insertion as the existing
-	// row will already match against ALL possible values for this row.
-	if ok, modPerms := tbl.Match(database, branch, user, host); ok {
-		permBits := uint64(modPerms)
-		permStr, _ := accessSchema[4].Type.(sql.SetType).BitsToString(permBits)
-		return sql.NewUniqueKeyErr(
-			fmt.Sprintf(`[%q, %q, %q, %q, %q]`, database, branch, user, host, permStr),
-			true,
-			sql.Row{database, branch, user, host, permBits})
+	if err := tbl.checkUniqueness(database, branch, user, host, table); err != nil {
+		return err
 	}
 
-	tbl.Access.Insert(database, branch, user, host, perms)
+	tbl.Access.Insert(database, branch, user, host, table, columns, perms)
 	return nil
 }
 
@@ -222,31 +242,20 @@ func (tbl BranchControlTable) Update(ctx *sql.Context, old sql.Row, new sql.Row)
 	tbl.RWMutex.Lock()
 	defer tbl.RWMutex.Unlock()
 
-	// Database, Branch, and Host are case-insensitive, while User is case-sensitive
-	oldDatabase := strings.ToLower(branch_control.FoldExpression(old[0].(string)))
-	oldBranch := strings.ToLower(branch_control.FoldExpression(old[1].(string)))
-	oldUser := branch_control.FoldExpression(old[2].(string))
-	oldHost := strings.ToLower(branch_control.FoldExpression(old[3].(string)))
-	newDatabase := strings.ToLower(branch_control.FoldExpression(new[0].(string)))
-	newBranch := strings.ToLower(branch_control.FoldExpression(new[1].(string)))
-	newUser := branch_control.FoldExpression(new[2].(string))
-	newHost := strings.ToLower(branch_control.FoldExpression(new[3].(string)))
-	newPerms := branch_control.Permissions(new[4].(uint64))
+	oldDatabase, oldBranch, oldUser, oldHost, oldTable := foldAccessRow(old)
+	newDatabase, newBranch, newUser, newHost, newTable := foldAccessRow(new)
+	newPerms := branch_control.Permissions(new[5].(uint64))
+	newColumns := accessColumns(new)
 
 	// Verify that the lengths of each expression fit within an uint16
-	if len(newDatabase) > math.MaxUint16 || len(newBranch) > math.MaxUint16 || len(newUser) > math.MaxUint16 || len(newHost) > math.MaxUint16 {
+	if len(newDatabase) > math.MaxUint16 || len(newBranch) > math.MaxUint16 || len(newUser) > math.MaxUint16 || len(newHost) > math.MaxUint16 || len(newTable) > math.MaxUint16 {
 		return branch_control.ErrExpressionsTooLong.New(newDatabase, newBranch, newUser, newHost)
 	}
 
 	// If we're not updating the same row, then we check for a row violation
-	if oldDatabase != newDatabase || oldBranch != newBranch || oldUser != newUser || oldHost != newHost {
-		if ok, modPerms := tbl.Match(newDatabase, newBranch, newUser, newHost); ok {
-			permBits := uint64(modPerms)
-			permStr, _ := accessSchema[4].Type.(sql.SetType).BitsToString(permBits)
-			return sql.NewUniqueKeyErr(
-				fmt.Sprintf(`[%q, %q, %q, %q, %q]`, newDatabase, newBranch, newUser, newHost, permStr),
-				true,
-				sql.Row{newDatabase, newBranch, newUser, newHost, permBits})
+	if oldDatabase != newDatabase || oldBranch != newBranch || oldUser != newUser || oldHost != newHost || oldTable != newTable {
+		if err := tbl.checkUniqueness(newDatabase, newBranch, newUser, newHost, newTable); err != nil {
+			return err
 		}
 	}
 
@@ -272,8 +281,8 @@ func (tbl BranchControlTable) Update(ctx *sql.Context, old sql.Row, new sql.Row)
 		}
 	}
 
-	tbl.Access.Delete(oldDatabase, oldBranch, oldUser, oldHost)
-	tbl.Access.Insert(newDatabase, newBranch, newUser, newHost, newPerms)
+	tbl.Access.Delete(oldDatabase, oldBranch, oldUser, oldHost, oldTable)
+	tbl.Access.Insert(newDatabase, newBranch, newUser, newHost, newTable, newColumns, newPerms)
 	return nil
 }
 
@@ -282,11 +291,7 @@ func (tbl BranchControlTable) Delete(ctx *sql.Context, row sql.Row) error {
 	tbl.RWMutex.Lock()
 	defer tbl.RWMutex.Unlock()
 
-	// Database, Branch, and Host are case-insensitive, while User is case-sensitive
-	database := strings.ToLower(branch_control.FoldExpression(row[0].(string)))
-	branch := strings.ToLower(branch_control.FoldExpression(row[1].(string)))
-	user := branch_control.FoldExpression(row[2].(string))
-	host := strings.ToLower(branch_control.FoldExpression(row[3].(string)))
+	database, branch, user, host, table := foldAccessRow(row)
 
 	// A nil session means we're not in the SQL context, so we allow the deletion in such a case
 	if branchAwareSession := branch_control.GetBranchAwareSession(ctx); branchAwareSession != nil &&
@@ -302,7 +307,7 @@ func (tbl BranchControlTable) Delete(ctx *sql.Context, row sql.Row) error {
 		}
 	}
 
-	tbl.Access.Delete(database, branch, user, host)
+	tbl.Access.Delete(database, branch, user, host, table)
 	return nil
 }
 
@@ -310,3 +315,58 @@ func (tbl BranchControlTable) Delete(ctx *sql.Context, row sql.Row) error {
 func (tbl BranchControlTable) Close(context *sql.Context) error {
 	return branch_control.SaveData(context)
 }
+
+// checkUniqueness returns an error if the given expressions would violate the uniqueness of the table. We deny the
+// insertion of a branch entry that is a subset of an already-existing branch entry, as the existing entry will already
+// match against ALL possible values for it. Table entries for a subset of another entry's tables are allowed, as the
+// longest match takes precedence, so only an exact duplicate is a violation for them.
+func (tbl BranchControlTable) checkUniqueness(database, branch, user, host, table string) error {
+	if table == branch_control.AllTables {
+		if ok, modPerms := tbl.Match(database, branch, user, host); ok {
+			permBits := uint64(modPerms)
+			permStr, _ := accessSchema[5].Type.(sql.SetType).BitsToString(permBits)
+			return sql.NewUniqueKeyErr(
+				fmt.Sprintf(`[%q, %q, %q, %q, %q, %q]`, database, branch, user, host, table, permStr),
+				true,
+				sql.Row{database, branch, user, host, table, permBits, nil})
+		}
+		return nil
+	}
+	if existing, ok := tbl.Get(database, branch, user, host, table); ok {
+		permBits := uint64(existing.Permissions)
+		permStr, _ := accessSchema[5].Type.(sql.SetType).BitsToString(permBits)
+		return sql.NewUniqueKeyErr(
+			fmt.Sprintf(`[%q, %q, %q, %q, %q, %q]`, existing.Database, existing.Branch, existing.User, existing.Host, existing.Table, permStr),
+			true,
+			sql.Row{existing.Database, existing.Branch, existing.User, existing.Host, existing.Table, permBits, accessColumnsValue(existing.Columns)})
+	}
+	return nil
+}
+
+// foldAccessRow returns the folded expressions of the given row. Database, Branch, Host, and Table are
+// case-insensitive, while User is case-sensitive.
+func foldAccessRow(row sql.Row) (database, branch, user, host, table string) {
+	database = strings.ToLower(branch_control.FoldExpression(row[0].(string)))
+	branch = strings.ToLower(branch_control.FoldExpression(row[1].(string)))
+	user = branch_control.FoldExpression(row[2].(string))
+	host = strings.ToLower(branch_control.FoldExpression(row[3].(string)))
+	table = strings.ToLower(branch_control.FoldExpression(row[4].(string)))
+	return database, branch, user, host, table
+}
+
+// accessColumns returns the normalized column list of the given row. A NULL column list allows every column.
+func accessColumns(row sql.Row) string {
+	if row[6] == nil {
+		return ""
+	}
+	return branch_control.NormalizeColumns(row[6].(string))
+}
+
+// accessColumnsValue returns the value of the "columns" column for the given column list, which is NULL when every
+// column is allowed.
+func accessColumnsValue(columns string) interface{} {
+	if len(columns) == 0 {
+		return nil
+	}
+	return columns
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/autogc"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+)
+
+// GCStatusTable is a sql.Table implementation that implements a system table which shows the state of automatic
+// garbage collection for every database on the sql-server.
+type GCStatusTable struct {
+	scheduler *autogc.Scheduler
+}
+
+var _ sql.Table = GCStatusTable{}
+
+// NewGCStatusTable creates a GCStatusTable. |scheduler| is nil when automatic garbage collection is not enabled, in
+// which case the table is empty.
+func NewGCStatusTable(scheduler *autogc.Scheduler) sql.Table {
+	return GCStatusTable{scheduler: scheduler}
+}
+
+// Name implements the interface sql.Table.
+func (t GCStatusTable) Name() string {
+	return doltdb.GCStatusTableName
+}
+
+// String implements the interface sql.Table.
+func (t GCStatusTable) String() string {
+	return doltdb.GCStatusTableName
+}
+
+// Schema implements the interface sql.Table.
+func (t GCStatusTable) Schema() sql.Schema {
+	return []*sql.Column{
+		{Name: "database", Type: types.Text, Source: doltdb.GCStatusTableName, PrimaryKey: true, Nullable: false},
+		{Name: "size", Type: types.Uint64, Source: doltdb.GCStatusTableName, PrimaryKey: false, Nullable: false},
+		{Name: "journal_size", Type: types.Uint64, Source: doltdb.GCStatusTableName, PrimaryKey: false, Nullable: false},
+		{Name: "new_table_files", Type: types.Uint64, Source: doltdb.GCStatusTableName, PrimaryKey: false, Nullable: false},
+		{Name: "size_after_last_gc", Type: types.Uint64, Source: doltdb.GCStatusTableName, PrimaryKey: false, Nullable: false},
+		{Name: "last_check", Type: types.DatetimeMaxPrecision, Source: doltdb.GCStatusTableName, PrimaryKey: false, Nullable: false},
+		{Name: "gc_count", Type: types.Uint64, Source: doltdb.GCStatusTableName, PrimaryKey: false, Nullable: false},
+		{Name: "last_gc_trigger", Type: types.Text, Source: doltdb.GCStatusTableName, PrimaryKey: false, Nullable: true},
+		{Name: "last_gc_start", Type: types.DatetimeMaxPrecision, Source: doltdb.GCStatusTableName, PrimaryKey: false, Nullable: true},
+		{Name: "last_gc_duration_millis", Type: types.Uint64, Source: doltdb.GCStatusTableName, PrimaryKey: false, Nullable: true},
+		{Name: "last_gc_result", Type: types.Text, Source: doltdb.GCStatusTableName, PrimaryKey: false, Nullable: true},
+	}
+}
+
+// Collation implements the interface sql.Table.
+func (t GCStatusTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Partitions implements the interface sql.Table.
+func (t GCStatusTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	return index.SinglePartitionIterFromNomsMap(nil), nil
+}
+
+// PartitionRows implements the interface sql.Table.
+func (t GCStatusTable) PartitionRows(*sql.Context, sql.Partition) (sql.RowIter, error) {
+	status := t.scheduler.Status()
+	rows := make([]sql.Row, len(status))
+	for i, st := range status {
+		row := sql.Row{
+			st.Database,
+			st.Stats.Size,
+			st.Stats.JournalSize,
+			uint64(st.Stats.NewGenTableFiles),
+			st.SizeAfterLastGC,
+			st.LastCheck.UTC(),
+			st.GCCount,
+			nil,
+			nil,
+			nil,
+			nil,
+		}
+		if st.LastResult != "" {
+			row[7] = st.LastTrigger
+			row[8] = st.LastGCStart.UTC()
+			row[9] = uint64(st.LastDuration / time.Millisecond)
+			row[10] = st.LastResult
+		}
+		rows[i] = row
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
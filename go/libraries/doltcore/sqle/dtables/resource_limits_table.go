@@ -0,0 +1,266 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/resourcelimits"
+)
+
+// resourceLimitsSchema is the schema for the "dolt_resource_limits" table.
+var resourceLimitsSchema = sql.Schema{
+	&sql.Column{
+		Name:       "user",
+		Type:       types.MustCreateString(sqltypes.VarChar, 32, sql.Collation_utf8mb4_0900_bin),
+		Source:     doltdb.ResourceLimitsTableName,
+		PrimaryKey: true,
+	},
+	&sql.Column{
+		Name:       "host",
+		Type:       types.MustCreateString(sqltypes.VarChar, 255, sql.Collation_utf8mb4_0900_ai_ci),
+		Source:     doltdb.ResourceLimitsTableName,
+		PrimaryKey: true,
+	},
+	&sql.Column{
+		Name:     "max_connections",
+		Type:     types.Uint64,
+		Source:   doltdb.ResourceLimitsTableName,
+		Nullable: true,
+	},
+	&sql.Column{
+		Name:     "max_execution_time_millis",
+		Type:     types.Uint64,
+		Source:   doltdb.ResourceLimitsTableName,
+		Nullable: true,
+	},
+	&sql.Column{
+		Name:     "max_rows_returned",
+		Type:     types.Uint64,
+		Source:   doltdb.ResourceLimitsTableName,
+		Nullable: true,
+	},
+}
+
+// ResourceLimitsTable exposes the resource limits of the accounts and roles in the "mysql" database as a system
+// table. Each row holds the limits of one account or role, and a NULL limit means the resource is not limited.
+// Writing to the table requires the CREATE USER privilege, and without it only the limits of the current account
+// are shown.
+type ResourceLimitsTable struct {
+	db *mysql_db.MySQLDb
+}
+
+var _ sql.Table = ResourceLimitsTable{}
+var _ sql.InsertableTable = ResourceLimitsTable{}
+var _ sql.ReplaceableTable = ResourceLimitsTable{}
+var _ sql.UpdatableTable = ResourceLimitsTable{}
+var _ sql.DeletableTable = ResourceLimitsTable{}
+var _ sql.RowInserter = ResourceLimitsTable{}
+var _ sql.RowReplacer = ResourceLimitsTable{}
+var _ sql.RowUpdater = ResourceLimitsTable{}
+var _ sql.RowDeleter = ResourceLimitsTable{}
+
+// NewResourceLimitsTable returns a new ResourceLimitsTable over the accounts in |db|.
+func NewResourceLimitsTable(db *mysql_db.MySQLDb) ResourceLimitsTable {
+	return ResourceLimitsTable{db: db}
+}
+
+// Name implements the interface sql.Table.
+func (tbl ResourceLimitsTable) Name() string {
+	return doltdb.ResourceLimitsTableName
+}
+
+// String implements the interface sql.Table.
+func (tbl ResourceLimitsTable) String() string {
+	return doltdb.ResourceLimitsTableName
+}
+
+// Schema implements the interface sql.Table.
+func (tbl ResourceLimitsTable) Schema() sql.Schema {
+	return resourceLimitsSchema
+}
+
+// Collation implements the interface sql.Table.
+func (tbl ResourceLimitsTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Partitions implements the interface sql.Table.
+func (tbl ResourceLimitsTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	return index.SinglePartitionIterFromNomsMap(nil), nil
+}
+
+// PartitionRows implements the interface sql.Table.
+func (tbl ResourceLimitsTable) PartitionRows(ctx *sql.Context, _ sql.Partition) (sql.RowIter, error) {
+	accounts, err := resourcelimits.List(tbl.db)
+	if err != nil {
+		return nil, err
+	}
+	canManage := tbl.canManageLimits(ctx)
+	client := ctx.Session.Client()
+
+	var rows []sql.Row
+	for _, account := range accounts {
+		if !canManage && (account.User != client.User || account.Host != client.Address) {
+			continue
+		}
+		rows = append(rows, sql.Row{
+			account.User,
+			account.Host,
+			nullIfZero(account.Limits.MaxConnections),
+			nullIfZero(account.Limits.MaxExecutionTimeMillis),
+			nullIfZero(account.Limits.MaxRowsReturned),
+		})
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+func nullIfZero(limit uint64) interface{} {
+	if limit == 0 {
+		return nil
+	}
+	return limit
+}
+
+// Inserter implements the interface sql.InsertableTable.
+func (tbl ResourceLimitsTable) Inserter(*sql.Context) sql.RowInserter {
+	return tbl
+}
+
+// Replacer implements the interface sql.ReplaceableTable.
+func (tbl ResourceLimitsTable) Replacer(*sql.Context) sql.RowReplacer {
+	return tbl
+}
+
+// Updater implements the interface sql.UpdatableTable.
+func (tbl ResourceLimitsTable) Updater(*sql.Context) sql.RowUpdater {
+	return tbl
+}
+
+// Deleter implements the interface sql.DeletableTable.
+func (tbl ResourceLimitsTable) Deleter(*sql.Context) sql.RowDeleter {
+	return tbl
+}
+
+// StatementBegin implements the interface sql.TableEditor.
+func (tbl ResourceLimitsTable) StatementBegin(*sql.Context) {}
+
+// DiscardChanges implements the interface sql.TableEditor.
+func (tbl ResourceLimitsTable) DiscardChanges(*sql.Context, error) error {
+	return nil
+}
+
+// StatementComplete implements the interface sql.TableEditor.
+func (tbl ResourceLimitsTable) StatementComplete(*sql.Context) error {
+	return nil
+}
+
+// Insert implements the interface sql.RowInserter.
+func (tbl ResourceLimitsTable) Insert(ctx *sql.Context, row sql.Row) error {
+	if err := tbl.checkCanManageLimits(ctx); err != nil {
+		return err
+	}
+	user, host, limits := resourceLimitsFromRow(row)
+	if existing, err := tbl.limitsOf(user, host); err != nil {
+		return err
+	} else if !existing.IsZero() {
+		return sql.NewUniqueKeyErr(fmt.Sprintf("[%q, %q]", user, host), true, row)
+	}
+	return resourcelimits.Set(ctx, tbl.db, user, host, limits)
+}
+
+// Update implements the interface sql.RowUpdater.
+func (tbl ResourceLimitsTable) Update(ctx *sql.Context, old sql.Row, new sql.Row) error {
+	if err := tbl.checkCanManageLimits(ctx); err != nil {
+		return err
+	}
+	oldUser, oldHost, _ := resourceLimitsFromRow(old)
+	newUser, newHost, newLimits := resourceLimitsFromRow(new)
+	if oldUser != newUser || oldHost != newHost {
+		if existing, err := tbl.limitsOf(newUser, newHost); err != nil {
+			return err
+		} else if !existing.IsZero() {
+			return sql.NewUniqueKeyErr(fmt.Sprintf("[%q, %q]", newUser, newHost), true, new)
+		}
+		if err := resourcelimits.Set(ctx, tbl.db, oldUser, oldHost, resourcelimits.Limits{}); err != nil {
+			return err
+		}
+	}
+	return resourcelimits.Set(ctx, tbl.db, newUser, newHost, newLimits)
+}
+
+// Delete implements the interface sql.RowDeleter.
+func (tbl ResourceLimitsTable) Delete(ctx *sql.Context, row sql.Row) error {
+	if err := tbl.checkCanManageLimits(ctx); err != nil {
+		return err
+	}
+	user, host, _ := resourceLimitsFromRow(row)
+	return resourcelimits.Set(ctx, tbl.db, user, host, resourcelimits.Limits{})
+}
+
+// Close implements the interface sql.Closer.
+func (tbl ResourceLimitsTable) Close(*sql.Context) error {
+	return nil
+}
+
+// limitsOf returns the limits currently stored for |user|@|host|, which are zero if the account does not exist.
+func (tbl ResourceLimitsTable) limitsOf(user, host string) (resourcelimits.Limits, error) {
+	rd := tbl.db.Reader()
+	defer rd.Close()
+	if u, ok := rd.GetUser(mysql_db.UserPrimaryKey{User: user, Host: host}); ok {
+		return resourcelimits.FromUser(u)
+	}
+	return resourcelimits.Limits{}, nil
+}
+
+// canManageLimits returns whether the current user may see and change the limits of every account, which requires
+// the same privilege as creating accounts.
+func (tbl ResourceLimitsTable) canManageLimits(ctx *sql.Context) bool {
+	if !tbl.db.Enabled() {
+		return true
+	}
+	privs := tbl.db.UserActivePrivilegeSet(ctx)
+	return privs.Has(sql.PrivilegeType_Super) || privs.Has(sql.PrivilegeType_CreateUser)
+}
+
+func (tbl ResourceLimitsTable) checkCanManageLimits(ctx *sql.Context) error {
+	if !tbl.canManageLimits(ctx) {
+		client := ctx.Session.Client()
+		return sql.ErrPrivilegeCheckFailed.New(fmt.Sprintf("'%s'@'%s'", client.User, client.Address))
+	}
+	return nil
+}
+
+func resourceLimitsFromRow(row sql.Row) (string, string, resourcelimits.Limits) {
+	return row[0].(string), row[1].(string), resourcelimits.Limits{
+		MaxConnections:         limitFromValue(row[2]),
+		MaxExecutionTimeMillis: limitFromValue(row[3]),
+		MaxRowsReturned:        limitFromValue(row[4]),
+	}
+}
+
+func limitFromValue(v interface{}) uint64 {
+	if v == nil {
+		return 0
+	}
+	return v.(uint64)
+}
@@ -0,0 +1,278 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
+)
+
+var RowPoliciesSqlSchema sql.PrimaryKeySchema
+
+func init() {
+	RowPoliciesSqlSchema, _ = sqlutil.FromDoltSchema("", doltdb.RowPoliciesTableName, doltdb.RowPoliciesSchema)
+}
+
+var _ sql.Table = (*RowPoliciesTable)(nil)
+var _ sql.UpdatableTable = (*RowPoliciesTable)(nil)
+var _ sql.DeletableTable = (*RowPoliciesTable)(nil)
+var _ sql.InsertableTable = (*RowPoliciesTable)(nil)
+var _ sql.ReplaceableTable = (*RowPoliciesTable)(nil)
+var _ sql.IndexAddressableTable = (*RowPoliciesTable)(nil)
+
+// RowPoliciesTable is the system table that stores the row policies of the tables of a database. Like dolt_ignore and
+// dolt_docs, its rows are versioned along with the rest of the database, but only the policies in the working set of
+// the default branch are enforced, on every branch and revision of the database.
+type RowPoliciesTable struct {
+	backingTable VersionableTable
+}
+
+func (rt *RowPoliciesTable) Name() string {
+	return doltdb.RowPoliciesTableName
+}
+
+func (rt *RowPoliciesTable) String() string {
+	return doltdb.RowPoliciesTableName
+}
+
+// Schema is a sql.Table interface function that gets the sql.Schema of the dolt_row_policies system table.
+func (rt *RowPoliciesTable) Schema() sql.Schema {
+	return RowPoliciesSqlSchema.Schema
+}
+
+func (rt *RowPoliciesTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Partitions is a sql.Table interface function that returns a partition of the data.
+func (rt *RowPoliciesTable) Partitions(context *sql.Context) (sql.PartitionIter, error) {
+	if rt.backingTable == nil {
+		// no backing table; return an empty iter.
+		return index.SinglePartitionIterFromNomsMap(nil), nil
+	}
+	return rt.backingTable.Partitions(context)
+}
+
+func (rt *RowPoliciesTable) PartitionRows(context *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	if rt.backingTable == nil {
+		// no backing table; return an empty iter.
+		return sql.RowsToRowIter(), nil
+	}
+
+	return rt.backingTable.PartitionRows(context, partition)
+}
+
+// NewRowPoliciesTable creates a RowPoliciesTable
+func NewRowPoliciesTable(_ *sql.Context, backingTable VersionableTable) sql.Table {
+	return &RowPoliciesTable{backingTable: backingTable}
+}
+
+// NewEmptyRowPoliciesTable creates a RowPoliciesTable
+func NewEmptyRowPoliciesTable(_ *sql.Context) sql.Table {
+	return &RowPoliciesTable{}
+}
+
+// Replacer returns a RowReplacer for this table. The RowReplacer will have Insert and optionally Delete called once
+// for each row, followed by a call to Close() when all rows have been processed.
+func (rt *RowPoliciesTable) Replacer(ctx *sql.Context) sql.RowReplacer {
+	return newRowPoliciesWriter(rt)
+}
+
+// Updater returns a RowUpdater for this table. The RowUpdater will have Update called once for each row to be
+// updated, followed by a call to Close() when all rows have been processed.
+func (rt *RowPoliciesTable) Updater(ctx *sql.Context) sql.RowUpdater {
+	return newRowPoliciesWriter(rt)
+}
+
+// Inserter returns an Inserter for this table. The Inserter will get one call to Insert() for each row to be
+// inserted, and will end with a call to Close() to finalize the insert operation.
+func (rt *RowPoliciesTable) Inserter(*sql.Context) sql.RowInserter {
+	return newRowPoliciesWriter(rt)
+}
+
+// Deleter returns a RowDeleter for this table. The RowDeleter will get one call to Delete for each row to be deleted,
+// and will end with a call to Close() to finalize the delete operation.
+func (rt *RowPoliciesTable) Deleter(*sql.Context) sql.RowDeleter {
+	return newRowPoliciesWriter(rt)
+}
+
+func (rt *RowPoliciesTable) LockedToRoot(ctx *sql.Context, root doltdb.RootValue) (sql.IndexAddressableTable, error) {
+	if rt.backingTable == nil {
+		return rt, nil
+	}
+	return rt.backingTable.LockedToRoot(ctx, root)
+}
+
+// IndexedAccess implements IndexAddressableTable, but RowPoliciesTables has no indexes.
+// Thus, this should never be called.
+func (rt *RowPoliciesTable) IndexedAccess(lookup sql.IndexLookup) sql.IndexedTable {
+	panic("Unreachable")
+}
+
+// GetIndexes implements IndexAddressableTable, but RowPoliciesTables has no indexes.
+func (rt *RowPoliciesTable) GetIndexes(ctx *sql.Context) ([]sql.Index, error) {
+	return nil, nil
+}
+
+func (rt *RowPoliciesTable) PreciseMatch() bool {
+	return true
+}
+
+var _ sql.RowReplacer = (*rowPoliciesWriter)(nil)
+var _ sql.RowUpdater = (*rowPoliciesWriter)(nil)
+var _ sql.RowInserter = (*rowPoliciesWriter)(nil)
+var _ sql.RowDeleter = (*rowPoliciesWriter)(nil)
+
+type rowPoliciesWriter struct {
+	rt                      *RowPoliciesTable
+	errDuringStatementBegin error
+	tableWriter             dsess.TableWriter
+}
+
+func newRowPoliciesWriter(rt *RowPoliciesTable) *rowPoliciesWriter {
+	return &rowPoliciesWriter{rt, nil, nil}
+}
+
+// Insert inserts the row given, returning an error if it cannot. Insert will be called once for each row to process
+// for the insert operation, which may involve many rows. After all rows in an operation have been processed, Close
+// is called.
+func (rw *rowPoliciesWriter) Insert(ctx *sql.Context, r sql.Row) error {
+	if err := rw.errDuringStatementBegin; err != nil {
+		return err
+	}
+	return rw.tableWriter.Insert(ctx, r)
+}
+
+// Update the given row. Provides both the old and new rows.
+func (rw *rowPoliciesWriter) Update(ctx *sql.Context, old sql.Row, new sql.Row) error {
+	if err := rw.errDuringStatementBegin; err != nil {
+		return err
+	}
+	return rw.tableWriter.Update(ctx, old, new)
+}
+
+// Delete deletes the given row. Returns ErrDeleteRowNotFound if the row was not found. Delete will be called once for
+// each row to process for the delete operation, which may involve many rows. After all rows have been processed,
+// Close is called.
+func (rw *rowPoliciesWriter) Delete(ctx *sql.Context, r sql.Row) error {
+	if err := rw.errDuringStatementBegin; err != nil {
+		return err
+	}
+	return rw.tableWriter.Delete(ctx, r)
+}
+
+// StatementBegin is called before the first operation of a statement. Integrators should mark the state of the data
+// in some way that it may be returned to in the case of an error.
+func (rw *rowPoliciesWriter) StatementBegin(ctx *sql.Context) {
+	dbName := ctx.GetCurrentDatabase()
+	dSess := dsess.DSessFromSess(ctx.Session)
+
+	// Users that are restricted by row policies could otherwise grant themselves access to every row
+	if err := dsess.CheckRowPoliciesAccess(ctx, dbName); err != nil {
+		rw.errDuringStatementBegin = err
+		return
+	}
+
+	// TODO: this needs to use a revision qualified name
+	roots, _ := dSess.GetRoots(ctx, dbName)
+	dbState, ok, err := dSess.LookupDbState(ctx, dbName)
+	if err != nil {
+		rw.errDuringStatementBegin = err
+		return
+	}
+	if !ok {
+		rw.errDuringStatementBegin = fmt.Errorf("no root value found in session")
+		return
+	}
+
+	found, err := roots.Working.HasTable(ctx, doltdb.TableName{Name: doltdb.RowPoliciesTableName})
+	if err != nil {
+		rw.errDuringStatementBegin = err
+		return
+	}
+
+	if !found {
+		// underlying table doesn't exist. Record this, then create the table.
+		newRootValue, err := doltdb.CreateEmptyTable(ctx, roots.Working, doltdb.TableName{Name: doltdb.RowPoliciesTableName}, doltdb.RowPoliciesSchema)
+		if err != nil {
+			rw.errDuringStatementBegin = err
+			return
+		}
+
+		if dbState.WorkingSet() == nil {
+			rw.errDuringStatementBegin = doltdb.ErrOperationNotSupportedInDetachedHead
+			return
+		}
+
+		// We use WriteSession.SetWorkingSet instead of DoltSession.SetWorkingRoot because we want to avoid modifying the root
+		// until the end of the transaction, but we still want the WriteSession to be able to find the newly
+		// created table.
+		if ws := dbState.WriteSession(); ws != nil {
+			err = ws.SetWorkingSet(ctx, dbState.WorkingSet().WithWorkingRoot(newRootValue))
+			if err != nil {
+				rw.errDuringStatementBegin = err
+				return
+			}
+		}
+
+		err = dSess.SetWorkingRoot(ctx, dbName, newRootValue)
+		if err != nil {
+			rw.errDuringStatementBegin = err
+			return
+		}
+	}
+
+	if ws := dbState.WriteSession(); ws != nil {
+		tableWriter, err := ws.GetTableWriter(ctx, doltdb.TableName{Name: doltdb.RowPoliciesTableName}, dbName, dSess.SetWorkingRoot)
+		if err != nil {
+			rw.errDuringStatementBegin = err
+			return
+		}
+		rw.tableWriter = tableWriter
+		tableWriter.StatementBegin(ctx)
+	}
+}
+
+// DiscardChanges is called if a statement encounters an error, and all current changes since the statement beginning
+// should be discarded.
+func (rw *rowPoliciesWriter) DiscardChanges(ctx *sql.Context, errorEncountered error) error {
+	if rw.tableWriter != nil {
+		return rw.tableWriter.DiscardChanges(ctx, errorEncountered)
+	}
+	return nil
+}
+
+// StatementComplete is called after the last operation of the statement, indicating that it has successfully completed.
+// The mark set in StatementBegin may be removed, and a new one should be created on the next StatementBegin.
+func (rw *rowPoliciesWriter) StatementComplete(ctx *sql.Context) error {
+	if rw.tableWriter != nil {
+		return rw.tableWriter.StatementComplete(ctx)
+	}
+	return nil
+}
+
+// Close finalizes the write operation, persisting the result.
+func (rw rowPoliciesWriter) Close(ctx *sql.Context) error {
+	if rw.tableWriter != nil {
+		return rw.tableWriter.Close(ctx)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/slowlog"
+)
+
+// SlowQueriesTable is a sql.Table implementation that implements a system table which shows the queries held in
+// memory by the sql-server slow query log. The log is shared by every database on the server.
+type SlowQueriesTable struct {
+	log *slowlog.Log
+}
+
+var _ sql.Table = SlowQueriesTable{}
+
+// NewSlowQueriesTable creates a SlowQueriesTable. |log| is nil when the slow query log is not enabled, in which case
+// the table is empty.
+func NewSlowQueriesTable(log *slowlog.Log) sql.Table {
+	return SlowQueriesTable{log: log}
+}
+
+// Name implements the interface sql.Table.
+func (t SlowQueriesTable) Name() string {
+	return doltdb.SlowQueriesTableName
+}
+
+// String implements the interface sql.Table.
+func (t SlowQueriesTable) String() string {
+	return doltdb.SlowQueriesTableName
+}
+
+// Schema implements the interface sql.Table.
+func (t SlowQueriesTable) Schema() sql.Schema {
+	return []*sql.Column{
+		{Name: "start_time", Type: types.DatetimeMaxPrecision, Source: doltdb.SlowQueriesTableName, PrimaryKey: false, Nullable: false},
+		{Name: "connection_id", Type: types.Uint32, Source: doltdb.SlowQueriesTableName, PrimaryKey: false, Nullable: false},
+		{Name: "user", Type: types.Text, Source: doltdb.SlowQueriesTableName, PrimaryKey: false, Nullable: false},
+		{Name: "host", Type: types.Text, Source: doltdb.SlowQueriesTableName, PrimaryKey: false, Nullable: false},
+		{Name: "database", Type: types.Text, Source: doltdb.SlowQueriesTableName, PrimaryKey: false, Nullable: true},
+		{Name: "branch", Type: types.Text, Source: doltdb.SlowQueriesTableName, PrimaryKey: false, Nullable: true},
+		{Name: "duration_millis", Type: types.Uint64, Source: doltdb.SlowQueriesTableName, PrimaryKey: false, Nullable: false},
+		{Name: "rows_examined", Type: types.Uint64, Source: doltdb.SlowQueriesTableName, PrimaryKey: false, Nullable: false},
+		{Name: "rows_returned", Type: types.Uint64, Source: doltdb.SlowQueriesTableName, PrimaryKey: false, Nullable: false},
+		{Name: "chunks_read", Type: types.Uint64, Source: doltdb.SlowQueriesTableName, PrimaryKey: false, Nullable: false},
+		{Name: "fingerprint", Type: types.LongText, Source: doltdb.SlowQueriesTableName, PrimaryKey: false, Nullable: false},
+		{Name: "query", Type: types.LongText, Source: doltdb.SlowQueriesTableName, PrimaryKey: false, Nullable: false},
+	}
+}
+
+// Collation implements the interface sql.Table.
+func (t SlowQueriesTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Partitions implements the interface sql.Table.
+func (t SlowQueriesTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	return index.SinglePartitionIterFromNomsMap(nil), nil
+}
+
+// PartitionRows implements the interface sql.Table.
+func (t SlowQueriesTable) PartitionRows(*sql.Context, sql.Partition) (sql.RowIter, error) {
+	entries := t.log.Entries()
+	rows := make([]sql.Row, len(entries))
+	for i, e := range entries {
+		rows[i] = sql.Row{
+			e.Start.UTC(),
+			e.ConnectionID,
+			e.User,
+			e.Host,
+			nullIfEmpty(e.Database),
+			nullIfEmpty(e.Branch),
+			uint64(e.Duration.Milliseconds()),
+			e.RowsExamined,
+			e.RowsReturned,
+			e.ChunksRead,
+			e.Fingerprint,
+			e.Query,
+		}
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+func nullIfEmpty(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
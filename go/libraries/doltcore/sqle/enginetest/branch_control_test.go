@@ -64,7 +64,7 @@ type BranchControlBlockTest struct {
 // "other".
 var TestUserSetUpScripts = []string{
 	"DELETE FROM dolt_branch_control WHERE user = '%';",
-	"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', 'admin');",
+	"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', '%', 'admin', NULL);",
 	"CREATE USER testuser@localhost;",
 	"GRANT ALL ON *.* TO testuser@localhost;",
 	"REVOKE SUPER ON *.* FROM testuser@localhost;",
@@ -326,7 +326,7 @@ var BranchControlBlockTests = []BranchControlBlockTest{
 	{
 		Name: "DOLT_BRANCH Force Move",
 		SetUpScript: []string{
-			"INSERT INTO dolt_branch_control VALUES ('%', 'newother', 'testuser', 'localhost', 'write');",
+			"INSERT INTO dolt_branch_control VALUES ('%', 'newother', 'testuser', 'localhost', '%', 'write', NULL);",
 		},
 		Query:       "CALL DOLT_BRANCH('-f', '-m', 'other', 'newother');",
 		ExpectedErr: branch_control.ErrCannotDeleteBranch,
@@ -603,7 +603,7 @@ var BranchControlTests = []BranchControlTest{
 		Name: "Namespace entries block",
 		SetUpScript: []string{
 			"DELETE FROM dolt_branch_control WHERE user = '%';",
-			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', 'admin');",
+			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', '%', 'admin', NULL);",
 			"CREATE USER testuser@localhost;",
 			"GRANT ALL ON *.* TO testuser@localhost;",
 		},
@@ -682,14 +682,14 @@ var BranchControlTests = []BranchControlTest{
 		Name: "Require admin to modify tables",
 		SetUpScript: []string{
 			"DELETE FROM dolt_branch_control WHERE user = '%';",
-			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', 'admin');",
+			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', '%', 'admin', NULL);",
 			"CREATE USER a@localhost;",
 			"CREATE USER b@localhost;",
 			"GRANT ALL ON *.* TO a@localhost;",
 			"REVOKE SUPER ON *.* FROM a@localhost;",
 			"GRANT ALL ON *.* TO b@localhost;",
 			"REVOKE SUPER ON *.* FROM b@localhost;",
-			"INSERT INTO dolt_branch_control VALUES ('%', 'other', 'a', 'localhost', 'write'), ('%', 'prefix%', 'a', 'localhost', 'admin')",
+			"INSERT INTO dolt_branch_control VALUES ('%', 'other', 'a', 'localhost', '%', 'write', NULL), ('%', 'prefix%', 'a', 'localhost', '%', 'admin', NULL)",
 		},
 		Assertions: []BranchControlTestAssertion{
 			{
@@ -713,7 +713,7 @@ var BranchControlTests = []BranchControlTest{
 			{
 				User:  "a",
 				Host:  "localhost",
-				Query: "INSERT INTO dolt_branch_control VALUES ('%', 'prefix1%', 'b', 'localhost', 'write');",
+				Query: "INSERT INTO dolt_branch_control VALUES ('%', 'prefix1%', 'b', 'localhost', '%', 'write', NULL);",
 				Expected: []sql.Row{
 					{types.NewOkResult(1)},
 				},
@@ -755,7 +755,7 @@ var BranchControlTests = []BranchControlTest{
 			{
 				User:        "b",
 				Host:        "localhost",
-				Query:       "INSERT INTO dolt_branch_control VALUES ('%', 'prefix1%', 'b', 'localhost', 'admin');",
+				Query:       "INSERT INTO dolt_branch_control VALUES ('%', 'prefix1%', 'b', 'localhost', '%', 'admin', NULL);",
 				ExpectedErr: branch_control.ErrInsertingAccessRow,
 			},
 			{ // Since "a" has admin on "prefix%", they can also insert into the namespace table
@@ -804,16 +804,16 @@ var BranchControlTests = []BranchControlTest{
 		Name: "Deleting entries works",
 		SetUpScript: []string{
 			"DELETE FROM dolt_branch_control WHERE user = '%';",
-			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', 'admin');",
+			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', '%', 'admin', NULL);",
 			"CREATE TABLE test (pk BIGINT PRIMARY KEY);",
 			"CREATE USER testuser@localhost;",
 			"GRANT ALL ON *.* TO testuser@localhost;",
-			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'testuser', 'localhost_1', 'write');",
-			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'testuser', 'localhost_2', 'write');",
-			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'testuser', 'localhost', 'write');",
-			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'testuser', 'localhost_3', 'write');",
-			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'testuser', 'localhost_4', 'write');",
-			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'testuser', 'localhost_5', 'write');",
+			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'testuser', 'localhost_1', '%', 'write', NULL);",
+			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'testuser', 'localhost_2', '%', 'write', NULL);",
+			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'testuser', 'localhost', '%', 'write', NULL);",
+			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'testuser', 'localhost_3', '%', 'write', NULL);",
+			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'testuser', 'localhost_4', '%', 'write', NULL);",
+			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'testuser', 'localhost_5', '%', 'write', NULL);",
 			"DELETE FROM dolt_branch_control WHERE host IN ('localhost_2', 'localhost_3');",
 		},
 		Assertions: []BranchControlTestAssertion{
@@ -822,10 +822,10 @@ var BranchControlTests = []BranchControlTest{
 				Host:  "localhost",
 				Query: "SELECT * FROM dolt_branch_control WHERE user = 'testuser';",
 				Expected: []sql.Row{
-					{"%", "%", "testuser", "localhost_1", "write"},
-					{"%", "%", "testuser", "localhost", "write"},
-					{"%", "%", "testuser", "localhost_4", "write"},
-					{"%", "%", "testuser", "localhost_5", "write"},
+					{"%", "%", "testuser", "localhost_1", "%", "write", nil},
+					{"%", "%", "testuser", "localhost", "%", "write", nil},
+					{"%", "%", "testuser", "localhost_4", "%", "write", nil},
+					{"%", "%", "testuser", "localhost_5", "%", "write", nil},
 				},
 			},
 			{
@@ -849,9 +849,9 @@ var BranchControlTests = []BranchControlTest{
 				Host:  "localhost",
 				Query: "SELECT * FROM dolt_branch_control WHERE user = 'testuser';",
 				Expected: []sql.Row{
-					{"%", "%", "testuser", "localhost_1", "write"},
-					{"%", "%", "testuser", "localhost", "write"},
-					{"%", "%", "testuser", "localhost_4", "write"},
+					{"%", "%", "testuser", "localhost_1", "%", "write", nil},
+					{"%", "%", "testuser", "localhost", "%", "write", nil},
+					{"%", "%", "testuser", "localhost_4", "%", "write", nil},
 				},
 			},
 			{
@@ -875,8 +875,8 @@ var BranchControlTests = []BranchControlTest{
 				Host:  "localhost",
 				Query: "SELECT * FROM dolt_branch_control WHERE user = 'testuser';",
 				Expected: []sql.Row{
-					{"%", "%", "testuser", "localhost", "write"},
-					{"%", "%", "testuser", "localhost_4", "write"},
+					{"%", "%", "testuser", "localhost", "%", "write", nil},
+					{"%", "%", "testuser", "localhost_4", "%", "write", nil},
 				},
 			},
 			{
@@ -900,7 +900,7 @@ var BranchControlTests = []BranchControlTest{
 				Host:  "localhost",
 				Query: "SELECT * FROM dolt_branch_control WHERE user = 'testuser';",
 				Expected: []sql.Row{
-					{"%", "%", "testuser", "localhost", "write"},
+					{"%", "%", "testuser", "localhost", "%", "write", nil},
 				},
 			},
 			{
@@ -948,7 +948,7 @@ var BranchControlTests = []BranchControlTest{
 			{
 				User:  "root",
 				Host:  "localhost",
-				Query: "INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', '%', 'admin');",
+				Query: "INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', '%', '%', 'admin', NULL);",
 				Expected: []sql.Row{
 					{types.NewOkResult(1)},
 				},
@@ -958,7 +958,7 @@ var BranchControlTests = []BranchControlTest{
 				Host:  "localhost",
 				Query: "SELECT * FROM dolt_branch_control;",
 				Expected: []sql.Row{
-					{"%", "%", "root", "%", "admin"},
+					{"%", "%", "root", "%", "%", "admin", nil},
 				},
 			},
 		},
@@ -967,31 +967,31 @@ var BranchControlTests = []BranchControlTest{
 		Name: "Subset entries count as duplicates",
 		SetUpScript: []string{
 			"DELETE FROM dolt_branch_control WHERE user = '%';",
-			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', 'admin');",
+			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', '%', 'admin', NULL);",
 			"CREATE USER testuser@localhost;",
 			"GRANT ALL ON *.* TO testuser@localhost;",
-			"INSERT INTO dolt_branch_control VALUES ('%', 'prefix', 'testuser', 'localhost', 'admin');",
-			"INSERT INTO dolt_branch_control VALUES ('%', 'prefix1%', 'testuser', 'localhost', 'admin');",
-			"INSERT INTO dolt_branch_control VALUES ('%', 'prefix2_', 'testuser', 'localhost', 'admin');",
-			"INSERT INTO dolt_branch_control VALUES ('%', 'prefix3_', 'testuser', 'localhost', 'admin');",
+			"INSERT INTO dolt_branch_control VALUES ('%', 'prefix', 'testuser', 'localhost', '%', 'admin', NULL);",
+			"INSERT INTO dolt_branch_control VALUES ('%', 'prefix1%', 'testuser', 'localhost', '%', 'admin', NULL);",
+			"INSERT INTO dolt_branch_control VALUES ('%', 'prefix2_', 'testuser', 'localhost', '%', 'admin', NULL);",
+			"INSERT INTO dolt_branch_control VALUES ('%', 'prefix3_', 'testuser', 'localhost', '%', 'admin', NULL);",
 		},
 		Assertions: []BranchControlTestAssertion{
 			{ // The pre-existing "prefix1%" entry will cover ALL possible matches of "prefix1sub%", so we treat it as a duplicate
 				User:        "testuser",
 				Host:        "localhost",
-				Query:       "INSERT INTO dolt_branch_control VALUES ('%', 'prefix1sub%', 'testuser', 'localhost', 'admin');",
+				Query:       "INSERT INTO dolt_branch_control VALUES ('%', 'prefix1sub%', 'testuser', 'localhost', '%', 'admin', NULL);",
 				ExpectedErr: sql.ErrPrimaryKeyViolation,
 			},
 			{ // The ending "%" fully covers "_", so we also treat it as a duplicate
 				User:        "testuser",
 				Host:        "localhost",
-				Query:       "INSERT INTO dolt_branch_control VALUES ('%', 'prefix1_', 'testuser', 'localhost', 'admin');",
+				Query:       "INSERT INTO dolt_branch_control VALUES ('%', 'prefix1_', 'testuser', 'localhost', '%', 'admin', NULL);",
 				ExpectedErr: sql.ErrPrimaryKeyViolation,
 			},
 			{ // This is the reverse of the above case, so this is NOT a duplicate (although the original is now a subset)
 				User:  "root",
 				Host:  "localhost",
-				Query: "INSERT INTO dolt_branch_control VALUES ('%', 'prefix2%', 'testuser', 'localhost', 'admin');",
+				Query: "INSERT INTO dolt_branch_control VALUES ('%', 'prefix2%', 'testuser', 'localhost', '%', 'admin', NULL);",
 				Expected: []sql.Row{
 					{types.NewOkResult(1)},
 				},
@@ -1001,29 +1001,29 @@ var BranchControlTests = []BranchControlTest{
 				Host:  "localhost",
 				Query: "SELECT * FROM dolt_branch_control WHERE user = 'testuser';",
 				Expected: []sql.Row{
-					{"%", "prefix", "testuser", "localhost", "admin"},
-					{"%", "prefix1%", "testuser", "localhost", "admin"},
-					{"%", "prefix2_", "testuser", "localhost", "admin"},
-					{"%", "prefix2%", "testuser", "localhost", "admin"},
-					{"%", "prefix3_", "testuser", "localhost", "admin"},
+					{"%", "prefix", "testuser", "localhost", "%", "admin", nil},
+					{"%", "prefix1%", "testuser", "localhost", "%", "admin", nil},
+					{"%", "prefix2_", "testuser", "localhost", "%", "admin", nil},
+					{"%", "prefix2%", "testuser", "localhost", "%", "admin", nil},
+					{"%", "prefix3_", "testuser", "localhost", "%", "admin", nil},
 				},
 			},
 			{ // Sanity checks to ensure that straight-up duplicates are also caught
 				User:        "testuser",
 				Host:        "localhost",
-				Query:       "INSERT INTO dolt_branch_control VALUES ('%', 'prefix', 'testuser', 'localhost', 'admin');",
+				Query:       "INSERT INTO dolt_branch_control VALUES ('%', 'prefix', 'testuser', 'localhost', '%', 'admin', NULL);",
 				ExpectedErr: sql.ErrPrimaryKeyViolation,
 			},
 			{
 				User:        "testuser",
 				Host:        "localhost",
-				Query:       "INSERT INTO dolt_branch_control VALUES ('%', 'prefix1%', 'testuser', 'localhost', 'admin');",
+				Query:       "INSERT INTO dolt_branch_control VALUES ('%', 'prefix1%', 'testuser', 'localhost', '%', 'admin', NULL);",
 				ExpectedErr: sql.ErrPrimaryKeyViolation,
 			},
 			{
 				User:        "testuser",
 				Host:        "localhost",
-				Query:       "INSERT INTO dolt_branch_control VALUES ('%', 'prefix3_', 'testuser', 'localhost', 'admin');",
+				Query:       "INSERT INTO dolt_branch_control VALUES ('%', 'prefix3_', 'testuser', 'localhost', '%', 'admin', NULL);",
 				ExpectedErr: sql.ErrPrimaryKeyViolation,
 			},
 			{ // Verify that creating branches also skips adding an entry if it would be a subset
@@ -1031,7 +1031,7 @@ var BranchControlTests = []BranchControlTest{
 				Host:  "localhost",
 				Query: "SELECT * FROM dolt_branch_control WHERE user = 'root';",
 				Expected: []sql.Row{
-					{"%", "%", "root", "localhost", "admin"},
+					{"%", "%", "root", "localhost", "%", "admin", nil},
 				},
 			},
 			{
@@ -1045,7 +1045,7 @@ var BranchControlTests = []BranchControlTest{
 				Host:  "localhost",
 				Query: "SELECT * FROM dolt_branch_control WHERE user = 'root';",
 				Expected: []sql.Row{
-					{"%", "%", "root", "localhost", "admin"},
+					{"%", "%", "root", "localhost", "%", "admin", nil},
 				},
 			},
 		},
@@ -1054,7 +1054,7 @@ var BranchControlTests = []BranchControlTest{
 		Name: "Creating branch creates new entry",
 		SetUpScript: []string{
 			"DELETE FROM dolt_branch_control WHERE user = '%';",
-			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', 'admin');",
+			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', '%', 'admin', NULL);",
 			"CREATE USER testuser@localhost;",
 			"GRANT ALL ON *.* TO testuser@localhost;",
 		},
@@ -1076,7 +1076,7 @@ var BranchControlTests = []BranchControlTest{
 				Host:  "localhost",
 				Query: "SELECT * FROM dolt_branch_control WHERE user = 'testuser';",
 				Expected: []sql.Row{
-					{"mydb", "otherbranch", "testuser", "localhost", "admin"},
+					{"mydb", "otherbranch", "testuser", "localhost", "%", "admin", nil},
 				},
 			},
 		},
@@ -1085,11 +1085,11 @@ var BranchControlTests = []BranchControlTest{
 		Name: "Renaming branch creates new entry",
 		SetUpScript: []string{
 			"DELETE FROM dolt_branch_control WHERE user = '%';",
-			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', 'admin');",
+			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', '%', 'admin', NULL);",
 			"CREATE USER testuser@localhost;",
 			"GRANT ALL ON *.* TO testuser@localhost;",
 			"CALL DOLT_BRANCH('otherbranch');",
-			"INSERT INTO dolt_branch_control VALUES ('%', 'otherbranch', 'testuser', 'localhost', 'write');",
+			"INSERT INTO dolt_branch_control VALUES ('%', 'otherbranch', 'testuser', 'localhost', '%', 'write', NULL);",
 		},
 		Assertions: []BranchControlTestAssertion{
 			{
@@ -1097,7 +1097,7 @@ var BranchControlTests = []BranchControlTest{
 				Host:  "localhost",
 				Query: "SELECT * FROM dolt_branch_control WHERE user = 'testuser';",
 				Expected: []sql.Row{
-					{"%", "otherbranch", "testuser", "localhost", "write"},
+					{"%", "otherbranch", "testuser", "localhost", "%", "write", nil},
 				},
 			},
 			{
@@ -1117,8 +1117,8 @@ var BranchControlTests = []BranchControlTest{
 				Host:  "localhost",
 				Query: "SELECT * FROM dolt_branch_control WHERE user = 'testuser';",
 				Expected: []sql.Row{
-					{"%", "otherbranch", "testuser", "localhost", "write"},  // Original entry remains
-					{"mydb", "newbranch", "testuser", "localhost", "admin"}, // New entry is scoped specifically to db
+					{"%", "otherbranch", "testuser", "localhost", "%", "write", nil},  // Original entry remains
+					{"mydb", "newbranch", "testuser", "localhost", "%", "admin", nil}, // New entry is scoped specifically to db
 				},
 			},
 		},
@@ -1127,7 +1127,7 @@ var BranchControlTests = []BranchControlTest{
 		Name: "Copying branch creates new entry",
 		SetUpScript: []string{
 			"DELETE FROM dolt_branch_control WHERE user = '%';",
-			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', 'admin');",
+			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', '%', 'admin', NULL);",
 			"CREATE USER testuser@localhost;",
 			"GRANT ALL ON *.* TO testuser@localhost;",
 			"CALL DOLT_BRANCH('otherbranch');",
@@ -1156,7 +1156,7 @@ var BranchControlTests = []BranchControlTest{
 				Host:  "localhost",
 				Query: "SELECT * FROM dolt_branch_control WHERE user = 'testuser';",
 				Expected: []sql.Row{
-					{"mydb", "newbranch", "testuser", "localhost", "admin"},
+					{"mydb", "newbranch", "testuser", "localhost", "%", "admin", nil},
 				},
 			},
 		},
@@ -1165,8 +1165,8 @@ var BranchControlTests = []BranchControlTest{
 		Name: "Proper database scoping",
 		SetUpScript: []string{
 			"DELETE FROM dolt_branch_control WHERE user = '%';",
-			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', 'admin')," +
-				"('dba', 'main', 'testuser', 'localhost', 'write'), ('dbb', 'other', 'testuser', 'localhost', 'write');",
+			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', '%', 'admin', NULL)," +
+				"('dba', 'main', 'testuser', 'localhost', '%', 'write', NULL), ('dbb', 'other', 'testuser', 'localhost', '%', 'write', NULL);",
 			"CREATE DATABASE dba;", // Implicitly creates "main" branch
 			"CREATE DATABASE dbb;", // Implicitly creates "main" branch
 			"CREATE USER testuser@localhost;",
@@ -1271,7 +1271,7 @@ var BranchControlTests = []BranchControlTest{
 				Host:  "localhost",
 				Query: "SELECT * FROM dolt_branch_control WHERE user = 'testuser';",
 				Expected: []sql.Row{
-					{"mydb", "newbranch", "testuser", "localhost", "admin"},
+					{"mydb", "newbranch", "testuser", "localhost", "%", "admin", nil},
 				},
 			},
 		},
@@ -1280,7 +1280,7 @@ var BranchControlTests = []BranchControlTest{
 		Name: "Database-level admin privileges allow scoped table modifications",
 		SetUpScript: []string{
 			"DELETE FROM dolt_branch_control WHERE user = '%';",
-			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', 'admin');",
+			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', '%', 'admin', NULL);",
 			"CREATE DATABASE dba;",
 			"CREATE DATABASE dbb;",
 			"CREATE USER a@localhost;",
@@ -1295,7 +1295,7 @@ var BranchControlTests = []BranchControlTest{
 			{
 				User:  "a",
 				Host:  "localhost",
-				Query: "INSERT INTO dolt_branch_control VALUES ('dba', 'dummy1', '%', '%', 'write');",
+				Query: "INSERT INTO dolt_branch_control VALUES ('dba', 'dummy1', '%', '%', '%', 'write', NULL);",
 				Expected: []sql.Row{
 					{types.NewOkResult(1)},
 				},
@@ -1303,31 +1303,31 @@ var BranchControlTests = []BranchControlTest{
 			{
 				User:        "a",
 				Host:        "localhost",
-				Query:       "INSERT INTO dolt_branch_control VALUES ('db_', 'dummy2', '%', '%', 'write');",
+				Query:       "INSERT INTO dolt_branch_control VALUES ('db_', 'dummy2', '%', '%', '%', 'write', NULL);",
 				ExpectedErr: branch_control.ErrInsertingAccessRow,
 			},
 			{
 				User:        "a",
 				Host:        "localhost",
-				Query:       "INSERT INTO dolt_branch_control VALUES ('dbb', 'dummy3', '%', '%', 'write');",
+				Query:       "INSERT INTO dolt_branch_control VALUES ('dbb', 'dummy3', '%', '%', '%', 'write', NULL);",
 				ExpectedErr: branch_control.ErrInsertingAccessRow,
 			},
 			{
 				User:        "b",
 				Host:        "localhost",
-				Query:       "INSERT INTO dolt_branch_control VALUES ('dba', 'dummy4', '%', '%', 'write');",
+				Query:       "INSERT INTO dolt_branch_control VALUES ('dba', 'dummy4', '%', '%', '%', 'write', NULL);",
 				ExpectedErr: branch_control.ErrInsertingAccessRow,
 			},
 			{
 				User:        "b",
 				Host:        "localhost",
-				Query:       "INSERT INTO dolt_branch_control VALUES ('db_', 'dummy5', '%', '%', 'write');",
+				Query:       "INSERT INTO dolt_branch_control VALUES ('db_', 'dummy5', '%', '%', '%', 'write', NULL);",
 				ExpectedErr: branch_control.ErrInsertingAccessRow,
 			},
 			{
 				User:  "b",
 				Host:  "localhost",
-				Query: "INSERT INTO dolt_branch_control VALUES ('dbb', 'dummy6', '%', '%', 'write');",
+				Query: "INSERT INTO dolt_branch_control VALUES ('dbb', 'dummy6', '%', '%', '%', 'write', NULL);",
 				Expected: []sql.Row{
 					{types.NewOkResult(1)},
 				},
@@ -1343,7 +1343,7 @@ var BranchControlTests = []BranchControlTest{
 			{
 				User:  "a",
 				Host:  "localhost",
-				Query: "INSERT INTO dolt_branch_control VALUES ('db_', 'dummy7', '%', '%', 'write');",
+				Query: "INSERT INTO dolt_branch_control VALUES ('db_', 'dummy7', '%', '%', '%', 'write', NULL);",
 				Expected: []sql.Row{
 					{types.NewOkResult(1)},
 				},
@@ -1353,10 +1353,230 @@ var BranchControlTests = []BranchControlTest{
 				Host:  "localhost",
 				Query: "SELECT * FROM dolt_branch_control;",
 				Expected: []sql.Row{
-					{"%", "%", "root", "localhost", "admin"},
-					{"dba", "dummy1", "%", "%", "write"},
-					{"dbb", "dummy6", "%", "%", "write"},
-					{"db_", "dummy7", "%", "%", "write"},
+					{"%", "%", "root", "localhost", "%", "admin", nil},
+					{"dba", "dummy1", "%", "%", "%", "write", nil},
+					{"dbb", "dummy6", "%", "%", "%", "write", nil},
+					{"db_", "dummy7", "%", "%", "%", "write", nil},
+				},
+			},
+		},
+	},
+	{
+		Name: "Table entries restrict writes to tables and columns",
+		SetUpScript: []string{
+			"DELETE FROM dolt_branch_control WHERE user = '%';",
+			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', '%', 'admin', NULL);",
+			"INSERT INTO dolt_branch_control (`database`, branch, user, host, permissions) VALUES ('%', 'main', 'analyst', 'localhost', 'write');",
+			"CREATE USER analyst@localhost;",
+			"GRANT ALL ON *.* TO analyst@localhost;",
+			"CREATE TABLE facts (pk BIGINT PRIMARY KEY, v1 BIGINT);",
+			"CREATE TABLE annotations (pk BIGINT PRIMARY KEY, note VARCHAR(100), reviewer VARCHAR(100));",
+			"INSERT INTO facts VALUES (1, 1);",
+			"INSERT INTO annotations VALUES (1, 'first', 'root');",
+			"INSERT INTO dolt_branch_control VALUES ('%', 'main', 'analyst', 'localhost', 'fact%', 'read', NULL), ('%', 'main', 'analyst', 'localhost', 'annotations', 'write', NULL);",
+		},
+		Assertions: []BranchControlTestAssertion{
+			{
+				User:        "analyst",
+				Host:        "localhost",
+				Query:       "INSERT INTO facts VALUES (2, 2);",
+				ExpectedErr: branch_control.ErrIncorrectTablePerms,
+			},
+			{
+				User:        "analyst",
+				Host:        "localhost",
+				Query:       "UPDATE facts SET v1 = 2;",
+				ExpectedErr: branch_control.ErrIncorrectTablePerms,
+			},
+			{
+				User:        "analyst",
+				Host:        "localhost",
+				Query:       "DROP TABLE facts;",
+				ExpectedErr: branch_control.ErrIncorrectTablePerms,
+			},
+			{
+				User:        "analyst",
+				Host:        "localhost",
+				Query:       "CREATE TABLE facts_archive (pk BIGINT PRIMARY KEY);",
+				ExpectedErr: branch_control.ErrIncorrectTablePerms,
+			},
+			{
+				User:  "analyst",
+				Host:  "localhost",
+				Query: "INSERT INTO annotations VALUES (2, 'second', 'analyst');",
+				Expected: []sql.Row{
+					{types.NewOkResult(1)},
+				},
+			},
+			{
+				User:        "analyst",
+				Host:        "localhost",
+				Query:       "INSERT INTO dolt_branch_control VALUES ('%', 'main', 'analyst', 'localhost', 'facts', 'write', NULL);",
+				ExpectedErr: branch_control.ErrInsertingAccessRow,
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "UPDATE dolt_branch_control SET columns = ' Note ' WHERE `table` = 'annotations';",
+				Expected: []sql.Row{
+					{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}},
+				},
+			},
+			{
+				User:  "analyst",
+				Host:  "localhost",
+				Query: "UPDATE annotations SET note = 'edited' WHERE pk = 1;",
+				Expected: []sql.Row{
+					{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}},
+				},
+			},
+			{
+				User:        "analyst",
+				Host:        "localhost",
+				Query:       "UPDATE annotations SET reviewer = 'analyst' WHERE pk = 1;",
+				ExpectedErr: branch_control.ErrRestrictedColumns,
+			},
+			{
+				User:        "analyst",
+				Host:        "localhost",
+				Query:       "INSERT INTO annotations VALUES (3, 'third', 'analyst');",
+				ExpectedErr: branch_control.ErrRestrictedColumns,
+			},
+			{
+				User:        "analyst",
+				Host:        "localhost",
+				Query:       "DELETE FROM annotations WHERE pk = 2;",
+				ExpectedErr: branch_control.ErrRestrictedColumns,
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SELECT * FROM annotations ORDER BY pk;",
+				Expected: []sql.Row{
+					{1, "edited", "root"},
+					{2, "second", "analyst"},
+				},
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SELECT * FROM dolt_branch_control WHERE user = 'analyst';",
+				Expected: []sql.Row{
+					{"%", "main", "analyst", "localhost", "%", "write", nil},
+					{"%", "main", "analyst", "localhost", "fact%", "read", nil},
+					{"%", "main", "analyst", "localhost", "annotations", "write", "note"},
+				},
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "INSERT INTO facts VALUES (2, 2);",
+				Expected: []sql.Row{
+					{types.NewOkResult(1)},
+				},
+			},
+		},
+	},
+	{
+		Name: "Table entries don't grant writes to read-only branches",
+		SetUpScript: []string{
+			"DELETE FROM dolt_branch_control WHERE user = '%';",
+			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', '%', 'admin', NULL), ('%', 'main', 'analyst', 'localhost', '%', 'read', NULL);",
+			"CREATE USER analyst@localhost;",
+			"GRANT ALL ON *.* TO analyst@localhost;",
+			"CREATE TABLE facts (pk BIGINT PRIMARY KEY, v1 BIGINT);",
+			"INSERT INTO dolt_branch_control VALUES ('%', 'main', 'analyst', 'localhost', 'facts', 'write', NULL);",
+		},
+		Assertions: []BranchControlTestAssertion{
+			{
+				User:        "analyst",
+				Host:        "localhost",
+				Query:       "INSERT INTO facts VALUES (1, 1);",
+				ExpectedErr: branch_control.ErrIncorrectPermissions,
+			},
+			{
+				User:        "analyst",
+				Host:        "localhost",
+				Query:       "ALTER TABLE facts ADD COLUMN v2 BIGINT;",
+				ExpectedErr: branch_control.ErrIncorrectPermissions,
+			},
+		},
+	},
+	{
+		Name: "Table entries restrict procedures that replace the working root",
+		SetUpScript: []string{
+			"DELETE FROM dolt_branch_control WHERE user = '%';",
+			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', '%', 'admin', NULL), ('%', 'main', 'analyst', 'localhost', '%', 'write', NULL);",
+			"CREATE USER analyst@localhost;",
+			"GRANT ALL ON *.* TO analyst@localhost;",
+			"CREATE TABLE facts (pk BIGINT PRIMARY KEY, v1 BIGINT);",
+			"CREATE TABLE notes (pk BIGINT PRIMARY KEY, note VARCHAR(100));",
+			"CALL DOLT_COMMIT('-Am', 'create tables');",
+			"CALL DOLT_BRANCH('feature');",
+			"INSERT INTO facts VALUES (1, 1);",
+			"CALL DOLT_COMMIT('-Am', 'add a fact');",
+			"CALL DOLT_CHECKOUT('feature');",
+			"INSERT INTO facts VALUES (2, 2);",
+			"CALL DOLT_COMMIT('-Am', 'add a fact on feature');",
+			"SET @feature_head = hashof('HEAD');",
+			"CALL DOLT_CHECKOUT('main');",
+			"INSERT INTO dolt_branch_control VALUES ('%', 'main', 'analyst', 'localhost', 'facts', 'write', 'v1'), ('%', 'main', 'analyst', 'localhost', 'notes', 'write', NULL);",
+		},
+		Assertions: []BranchControlTestAssertion{
+			{
+				User:        "analyst",
+				Host:        "localhost",
+				Query:       "CALL DOLT_MERGE('feature');",
+				ExpectedErr: branch_control.ErrRestrictedColumns,
+			},
+			{
+				User:        "analyst",
+				Host:        "localhost",
+				Query:       "CALL DOLT_CHERRY_PICK(@feature_head);",
+				ExpectedErr: branch_control.ErrRestrictedColumns,
+			},
+			{
+				User:        "analyst",
+				Host:        "localhost",
+				Query:       "CALL DOLT_REVERT('HEAD');",
+				ExpectedErr: branch_control.ErrRestrictedColumns,
+			},
+			{
+				User:        "analyst",
+				Host:        "localhost",
+				Query:       "CALL DOLT_RESET('--hard', 'HEAD~1');",
+				ExpectedErr: branch_control.ErrRestrictedColumns,
+			},
+			{
+				User:  "analyst",
+				Host:  "localhost",
+				Query: "SELECT * FROM facts ORDER BY pk;",
+				Expected: []sql.Row{
+					{1, 1},
+				},
+			},
+			{
+				User:  "analyst",
+				Host:  "localhost",
+				Query: "INSERT INTO notes VALUES (1, 'scratch');",
+				Expected: []sql.Row{
+					{types.NewOkResult(1)},
+				},
+			},
+			{
+				User:  "analyst",
+				Host:  "localhost",
+				Query: "CALL DOLT_RESET('--hard');",
+				Expected: []sql.Row{
+					{0},
+				},
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "CALL DOLT_MERGE('feature', '--no-commit');",
+				Expected: []sql.Row{
+					{"", 0, 0, "merge successful"},
 				},
 			},
 		},
@@ -1448,7 +1668,7 @@ func TestBranchControlBlocks(t *testing.T) {
 			})
 			enginetest.AssertErrWithCtx(t, engine, harness, userCtx, test.Query, nil, test.ExpectedErr)
 
-			addUserQuery := "INSERT INTO dolt_branch_control VALUES ('%', 'main', 'testuser', 'localhost', 'write'), ('%', 'other', 'testuser', 'localhost', 'write');"
+			addUserQuery := "INSERT INTO dolt_branch_control VALUES ('%', 'main', 'testuser', 'localhost', '%', 'write', NULL), ('%', 'other', 'testuser', 'localhost', '%', 'write', NULL);"
 			addUserQueryResults := []sql.Row{{types.NewOkResult(2)}}
 			enginetest.TestQueryWithContext(t, rootCtx, engine, harness, addUserQuery, addUserQueryResults, nil, nil, nil)
 
@@ -1486,7 +1706,7 @@ func TestBranchControlBlocks(t *testing.T) {
 				enginetest.RunQueryWithContext(t, engine, harness, rootCtx, statement)
 			}
 
-			addUserQuery := "INSERT INTO dolt_branch_control VALUES ('%', 'main', 'testuser', 'localhost', 'write');"
+			addUserQuery := "INSERT INTO dolt_branch_control VALUES ('%', 'main', 'testuser', 'localhost', '%', 'write', NULL);"
 			addUserQueryResults := []sql.Row{{types.NewOkResult(1)}}
 			enginetest.TestQueryWithContext(t, rootCtx, engine, harness, addUserQuery, addUserQueryResults, nil, nil, nil)
 
@@ -1496,7 +1716,7 @@ func TestBranchControlBlocks(t *testing.T) {
 			})
 			enginetest.AssertErrWithCtx(t, engine, harness, userCtx, test.Query, nil, test.ExpectedErr)
 
-			addUserQuery = "INSERT INTO dolt_branch_control VALUES ('%', 'other', 'testuser', 'localhost', 'write');"
+			addUserQuery = "INSERT INTO dolt_branch_control VALUES ('%', 'other', 'testuser', 'localhost', '%', 'write', NULL);"
 			addUserQueryResults = []sql.Row{{types.NewOkResult(1)}}
 			enginetest.TestQueryWithContext(t, rootCtx, engine, harness, addUserQuery, addUserQueryResults, nil, nil, nil)
 
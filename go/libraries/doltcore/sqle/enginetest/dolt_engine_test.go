@@ -35,6 +35,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/dtestutils"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dprocedures"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/statspro"
 	"github.com/dolthub/dolt/go/libraries/utils/config"
@@ -690,6 +691,90 @@ func TestDoltUserPrivileges(t *testing.T) {
 	}
 }
 
+// TestRowPoliciesOfCommitDatabases tests that the row policies of a database restrict reads of the revision databases
+// of its commits, which can't be named in a DoltUserPrivTests script as their hashes aren't known ahead of time.
+func TestRowPoliciesOfCommitDatabases(t *testing.T) {
+	harness := newDoltHarness(t)
+	defer harness.Close()
+	harness.Setup(setup.MydbData)
+	engine, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer engine.Close()
+
+	engine.EngineAnalyzer().Catalog.MySQLDb.AddRootAccount()
+	engine.EngineAnalyzer().Catalog.MySQLDb.SetPersister(&mysql_db.NoopPersister{})
+
+	ctx := enginetest.NewContextWithClient(harness, sql.Client{User: "root", Address: "localhost"})
+	for _, statement := range []string{
+		"CREATE TABLE orders (id BIGINT PRIMARY KEY, tenant VARCHAR(20));",
+		"INSERT INTO orders VALUES (1, 'acme'), (2, 'globex');",
+		"CALL DOLT_COMMIT('-Am', 'add orders');",
+		"INSERT INTO dolt_row_policies VALUES ('orders', 'acme_rows', 'acme', 'select', 'tenant = \"acme\"');",
+		"CALL DOLT_COMMIT('-Am', 'add row policies');",
+		"CREATE USER acme@localhost;",
+		"GRANT SELECT ON mydb.* TO acme@localhost;",
+	} {
+		enginetest.RunQueryWithContext(t, engine, harness, ctx, statement)
+	}
+	_, iter, _, err := engine.Query(ctx, "SELECT commit_hash FROM dolt_log WHERE message = 'add orders';")
+	require.NoError(t, err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	commitDb := fmt.Sprintf("`mydb/%s`", rows[0][0])
+
+	// The commit was made before the policies were added, but they still restrict it
+	ctx = enginetest.NewContextWithClient(harness, sql.Client{User: "acme", Address: "localhost"})
+	enginetest.TestQueryWithContext(t, ctx, engine, harness, "SELECT * FROM "+commitDb+".orders;", []sql.Row{{1, "acme"}}, nil, nil, nil)
+	ctx = enginetest.NewContextWithClient(harness, sql.Client{User: "root", Address: "localhost"})
+	enginetest.TestQueryWithContext(t, ctx, engine, harness, "SELECT * FROM "+commitDb+".orders;", []sql.Row{{1, "acme"}, {2, "globex"}}, nil, nil, nil)
+}
+
+// TestDoltRebaseExecPrivileges tests that the statements of exec steps of a rebase plan are checked against the
+// privileges of the user running the rebase.
+func TestDoltRebaseExecPrivileges(t *testing.T) {
+	harness := newDoltHarness(t)
+	defer harness.Close()
+	harness.Setup(setup.MydbData)
+	engine, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer engine.Close()
+
+	engine.EngineAnalyzer().Catalog.MySQLDb.AddRootAccount()
+	engine.EngineAnalyzer().Catalog.MySQLDb.SetPersister(&mysql_db.NoopPersister{})
+
+	ctx := enginetest.NewContextWithClient(harness, sql.Client{User: "root", Address: "localhost"})
+	for _, statement := range []string{
+		"create table t (pk int primary key);",
+		"call dolt_commit('-Am', 'creating table t');",
+		"call dolt_branch('branch1');",
+		"call dolt_checkout('branch1');",
+		"insert into t values (1);",
+		"call dolt_commit('-am', 'inserting row 1');",
+		"create database other;",
+		"create table other.secret (pk int primary key);",
+		"create user tester@localhost;",
+		"grant all on mydb.* to tester@localhost;",
+	} {
+		enginetest.RunQueryWithContext(t, engine, harness, ctx, statement)
+	}
+
+	// tester can rebase branch1, but can't read other.secret in an exec step
+	ctx = enginetest.NewContextWithClient(harness, sql.Client{User: "tester", Address: "localhost"})
+	for _, statement := range []string{
+		"call dolt_checkout('branch1');",
+		"call dolt_rebase('-i', 'main');",
+		"insert into dolt_rebase values (1.5, 'exec', '', 'select count(*) = 0 from other.secret');",
+	} {
+		enginetest.RunQueryWithContext(t, engine, harness, ctx, statement)
+	}
+	enginetest.TestQueryWithContext(t, ctx, engine, harness, "call dolt_rebase('--continue');", []sql.Row{{1,
+		dprocedures.RebaseExecFailedMessage + "select count(*) = 0 from other.secret: Access denied for user 'tester'@'localhost' to database 'other'\n\n" +
+			"Fix the problem, then continue rebasing by calling dolt_rebase('--continue'), " +
+			"or abort it by calling dolt_rebase('--abort'). " +
+			"Uncommitted changes are amended into the last rebased commit when the rebase continues."}}, nil, nil, nil)
+}
+
 func TestJoinOps(t *testing.T) {
 	if types.IsFormat_LD(types.Format_Default) {
 		t.Skip("DOLT_LD keyless indexes are not sorted")
@@ -1560,6 +1645,16 @@ func TestSchemaDiffTableFunctionPrepared(t *testing.T) {
 	RunSchemaDiffTableFunctionTestsPrepared(t, harness)
 }
 
+func TestJsonDiffTableFunction(t *testing.T) {
+	harness := newDoltEnginetestHarness(t)
+	RunJsonDiffTableFunctionTests(t, harness)
+}
+
+func TestJsonDiffTableFunctionPrepared(t *testing.T) {
+	harness := newDoltEnginetestHarness(t)
+	RunJsonDiffTableFunctionTestsPrepared(t, harness)
+}
+
 func TestDoltDatabaseCollationDiffs(t *testing.T) {
 	harness := newDoltEnginetestHarness(t)
 	RunDoltDatabaseCollationDiffsTests(t, harness)
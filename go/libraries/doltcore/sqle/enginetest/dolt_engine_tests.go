@@ -1370,6 +1370,28 @@ func RunSchemaDiffTableFunctionTestsPrepared(t *testing.T, harness DoltEnginetes
 	}
 }
 
+func RunJsonDiffTableFunctionTests(t *testing.T, harness DoltEnginetestHarness) {
+	for _, test := range JsonDiffTableFunctionScriptTests {
+		t.Run(test.Name, func(t *testing.T) {
+			harness = harness.NewHarness(t)
+			defer harness.Close()
+			harness.Setup(setup.MydbData)
+			enginetest.TestScript(t, harness, test)
+		})
+	}
+}
+
+func RunJsonDiffTableFunctionTestsPrepared(t *testing.T, harness DoltEnginetestHarness) {
+	for _, test := range JsonDiffTableFunctionScriptTests {
+		t.Run(test.Name, func(t *testing.T) {
+			harness = harness.NewHarness(t)
+			defer harness.Close()
+			harness.Setup(setup.MydbData)
+			enginetest.TestScriptPrepared(t, harness, test)
+		})
+	}
+}
+
 func RunDoltDatabaseCollationDiffsTests(t *testing.T, harness DoltEnginetestHarness) {
 	for _, test := range DoltDatabaseCollationScriptTests {
 		t.Run(test.Name, func(t *testing.T) {
@@ -254,6 +254,7 @@ func (d *DoltHarness) NewEngine(t *testing.T) (enginetest.QueryEngine, error) {
 		}
 		e.Analyzer.ExecBuilder = rowexec.NewOverrideBuilder(kvexec.Builder{})
 		d.engine = e
+		doltProvider.SetEngine(e)
 
 		ctx := enginetest.NewContext(d)
 		databases := pro.AllDatabases(ctx)
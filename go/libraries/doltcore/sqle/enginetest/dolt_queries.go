@@ -26,6 +26,7 @@ import (
 	"github.com/dolthub/vitess/go/vt/proto/query"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
 )
 
 var ViewsWithAsOfScriptTest = queries.ScriptTest{
@@ -1875,6 +1876,287 @@ var DoltUserPrivTests = []queries.UserPrivilegeTest{
 			},
 		},
 	},
+	{
+		Name: "dolt_row_policies restricts the rows that users read and write",
+		SetUpScript: []string{
+			"CREATE TABLE mydb.orders (id BIGINT PRIMARY KEY, tenant VARCHAR(20), amount BIGINT, INDEX (tenant));",
+			"INSERT INTO mydb.orders VALUES (1, 'acme', 10), (2, 'acme', 20), (3, 'globex', 30), (4, 'globex', 40);",
+			"INSERT INTO mydb.dolt_row_policies VALUES ('orders', 'acme_rows', 'acme', 'select,insert,update,delete', 'tenant = \"acme\"'), ('Orders', 'globex_rows', 'globex', 'select', 'tenant = \"globex\"');",
+			"CALL DOLT_ADD('.');",
+			"CALL DOLT_COMMIT('-m', 'add orders and their row policies');",
+			"CREATE USER acme@localhost;",
+			"CREATE USER globex@localhost;",
+			"GRANT SELECT, INSERT, UPDATE, DELETE, DROP ON mydb.* TO acme@localhost;",
+			"GRANT SELECT, INSERT, UPDATE, DELETE ON mydb.* TO globex@localhost;",
+		},
+		Assertions: []queries.UserPrivilegeTestAssertion{
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SELECT * FROM mydb.dolt_row_policies ORDER BY policy_name;",
+				Expected: []sql.Row{{"orders", "acme_rows", "acme", "select,insert,update,delete", "tenant = \"acme\""}, {"Orders", "globex_rows", "globex", "select", "tenant = \"globex\""}},
+			},
+			{
+				// The policies were committed along with the table
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SELECT COUNT(*) FROM mydb.dolt_status;",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				// Users that may modify the row policies are not restricted by them
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SELECT COUNT(*) FROM mydb.orders;",
+				Expected: []sql.Row{{4}},
+			},
+			{
+				User:     "acme",
+				Host:     "localhost",
+				Query:    "SELECT * FROM mydb.orders ORDER BY id;",
+				Expected: []sql.Row{{1, "acme", 10}, {2, "acme", 20}},
+			},
+			{
+				User:     "acme",
+				Host:     "localhost",
+				Query:    "SELECT COUNT(*) FROM mydb.orders;",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				User:     "acme",
+				Host:     "localhost",
+				Query:    "SELECT amount FROM mydb.orders WHERE tenant = 'globex' OR id = 3;",
+				Expected: []sql.Row{},
+			},
+			{
+				User:     "acme",
+				Host:     "localhost",
+				Query:    "SELECT a.id, b.id FROM mydb.orders a JOIN mydb.orders b ON a.id = b.id ORDER BY a.id;",
+				Expected: []sql.Row{{1, 1}, {2, 2}},
+			},
+			{
+				User:     "acme",
+				Host:     "localhost",
+				Query:    "INSERT INTO mydb.orders VALUES (5, 'acme', 50);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				User:        "acme",
+				Host:        "localhost",
+				Query:       "INSERT INTO mydb.orders VALUES (6, 'globex', 60);",
+				ExpectedErr: dsess.ErrRowPolicyViolation,
+			},
+			{
+				User:     "acme",
+				Host:     "localhost",
+				Query:    "UPDATE mydb.orders SET amount = amount + 1;",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 3, Info: plan.UpdateInfo{Matched: 3, Updated: 3}}}},
+			},
+			{
+				User:        "acme",
+				Host:        "localhost",
+				Query:       "UPDATE mydb.orders SET tenant = 'globex' WHERE id = 1;",
+				ExpectedErr: dsess.ErrRowPolicyViolation,
+			},
+			{
+				User:     "globex",
+				Host:     "localhost",
+				Query:    "SELECT id, amount FROM mydb.orders ORDER BY id;",
+				Expected: []sql.Row{{3, 30}, {4, 40}},
+			},
+			{
+				// globex only has a SELECT policy
+				User:        "globex",
+				Host:        "localhost",
+				Query:       "UPDATE mydb.orders SET amount = 0;",
+				ExpectedErr: dsess.ErrRowPolicyViolation,
+			},
+			{
+				User:        "globex",
+				Host:        "localhost",
+				Query:       "INSERT INTO mydb.dolt_row_policies VALUES ('orders', 'all_rows', 'globex', 'select', 'true');",
+				ExpectedErr: dsess.ErrRowPoliciesPermissions,
+			},
+			{
+				// This is run as a truncation, which must still only delete the rows covered by the policies
+				User:     "acme",
+				Host:     "localhost",
+				Query:    "DELETE FROM mydb.orders;",
+				Expected: []sql.Row{{types.NewOkResult(3)}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SELECT * FROM mydb.orders ORDER BY id;",
+				Expected: []sql.Row{{3, "globex", 30}, {4, "globex", 40}},
+			},
+		},
+	},
+	{
+		Name: "dolt_row_policies can't be bypassed through system tables, table functions or procedures",
+		SetUpScript: []string{
+			"CREATE TABLE mydb.orders (id BIGINT PRIMARY KEY, tenant VARCHAR(20), amount BIGINT);",
+			"INSERT INTO mydb.orders VALUES (1, 'acme', 10), (2, 'globex', 20);",
+			"CALL DOLT_ADD('.');",
+			"CALL DOLT_COMMIT('-m', 'add orders');",
+			"INSERT INTO mydb.dolt_row_policies VALUES ('orders', 'acme_rows', 'acme', 'select,insert,update,delete', 'tenant = \"acme\"');",
+			"CALL DOLT_COMMIT('-Am', 'add row policies');",
+			"UPDATE mydb.orders SET amount = amount + 1;",
+			"CALL DOLT_COMMIT('-am', 'update amounts');",
+			"CALL DOLT_CHECKOUT('-b', 'drop_policies');",
+			"DELETE FROM mydb.dolt_row_policies;",
+			"CALL DOLT_COMMIT('-Am', 'drop row policies');",
+			"CALL DOLT_CHECKOUT('main');",
+			"CREATE USER acme@localhost;",
+			"GRANT SELECT, INSERT, UPDATE, DELETE, EXECUTE ON mydb.* TO acme@localhost;",
+		},
+		Assertions: []queries.UserPrivilegeTestAssertion{
+			{
+				User:        "acme",
+				Host:        "localhost",
+				Query:       "SELECT * FROM mydb.dolt_diff_orders;",
+				ExpectedErr: dsess.ErrRowPoliciesRestrictRead,
+			},
+			{
+				User:        "acme",
+				Host:        "localhost",
+				Query:       "SELECT * FROM mydb.dolt_commit_diff_orders WHERE from_commit = 'HEAD~' AND to_commit = 'HEAD';",
+				ExpectedErr: dsess.ErrRowPoliciesRestrictRead,
+			},
+			{
+				User:        "acme",
+				Host:        "localhost",
+				Query:       "SELECT * FROM dolt_diff('HEAD~', 'HEAD', 'orders');",
+				ExpectedErr: dsess.ErrRowPoliciesRestrictRead,
+			},
+			{
+				User:        "acme",
+				Host:        "localhost",
+				Query:       "SELECT * FROM dolt_patch('HEAD~', 'HEAD');",
+				ExpectedErr: dsess.ErrRowPoliciesRestrictRead,
+			},
+			{
+				User:        "acme",
+				Host:        "localhost",
+				Query:       "SELECT * FROM dolt_json_diff('HEAD~', 'HEAD', 'orders', 'amount');",
+				ExpectedErr: dsess.ErrRowPoliciesRestrictRead,
+			},
+			{
+				User:        "acme",
+				Host:        "localhost",
+				Query:       "SELECT * FROM mydb.dolt_blame_orders;",
+				ExpectedErr: dsess.ErrRowPoliciesRestrictRead,
+			},
+			{
+				User:        "acme",
+				Host:        "localhost",
+				Query:       "SELECT * FROM mydb.dolt_conflicts_orders;",
+				ExpectedErr: dsess.ErrRowPoliciesRestrictRead,
+			},
+			{
+				User:        "acme",
+				Host:        "localhost",
+				Query:       "SELECT * FROM mydb.dolt_constraint_violations_orders;",
+				ExpectedErr: dsess.ErrRowPoliciesRestrictRead,
+			},
+			{
+				// History tables read through the table, which enforces the policies
+				User:     "acme",
+				Host:     "localhost",
+				Query:    "SELECT id, amount FROM mydb.dolt_history_orders ORDER BY amount;",
+				Expected: []sql.Row{{1, 10}, {1, 10}, {1, 11}},
+			},
+			{
+				// The policies of the default branch apply to every branch and revision of the database, even those
+				// without them
+				User:     "acme",
+				Host:     "localhost",
+				Query:    "SELECT * FROM `mydb/drop_policies`.orders;",
+				Expected: []sql.Row{{1, "acme", 11}},
+			},
+			{
+				User:     "acme",
+				Host:     "localhost",
+				Query:    "SELECT * FROM mydb.orders AS OF 'drop_policies';",
+				Expected: []sql.Row{{1, "acme", 11}},
+			},
+			{
+				User:        "acme",
+				Host:        "localhost",
+				Query:       "SELECT * FROM dolt_diff('main', 'drop_policies', 'orders');",
+				ExpectedErr: dsess.ErrRowPoliciesRestrictRead,
+			},
+			{
+				// Users who bypass the policies may still use all of them
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SELECT COUNT(*) FROM dolt_diff('HEAD~', 'HEAD', 'orders');",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				User:        "acme",
+				Host:        "localhost",
+				Query:       "CALL DOLT_RESET('--hard', 'HEAD~2');",
+				ExpectedErr: dsess.ErrRowPoliciesPermissions,
+			},
+			{
+				User:        "acme",
+				Host:        "localhost",
+				Query:       "CALL DOLT_MERGE('drop_policies');",
+				ExpectedErr: dsess.ErrRowPoliciesPermissions,
+			},
+			{
+				User:        "acme",
+				Host:        "localhost",
+				Query:       "CALL DOLT_CHERRY_PICK('drop_policies');",
+				ExpectedErr: dsess.ErrRowPoliciesPermissions,
+			},
+			{
+				User:        "acme",
+				Host:        "localhost",
+				Query:       "CALL DOLT_REVERT('HEAD~1');",
+				ExpectedErr: dsess.ErrRowPoliciesPermissions,
+			},
+			{
+				// None of them moved the branch head or changed the working set before failing
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SELECT message FROM mydb.dolt_log LIMIT 1;",
+				Expected: []sql.Row{{"update amounts"}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SELECT * FROM mydb.dolt_status;",
+				Expected: []sql.Row{},
+			},
+			{
+				// Procedures which leave the policies alone still work
+				User:     "acme",
+				Host:     "localhost",
+				Query:    "INSERT INTO mydb.orders VALUES (3, 'acme', 30);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				User:     "acme",
+				Host:     "localhost",
+				Query:    "CALL DOLT_RESET('--hard');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SELECT policy_name FROM mydb.dolt_row_policies;",
+				Expected: []sql.Row{{"acme_rows"}},
+			},
+			{
+				User:     "acme",
+				Host:     "localhost",
+				Query:    "SELECT * FROM mydb.orders;",
+				Expected: []sql.Row{{1, "acme", 11}},
+			},
+		},
+	},
 }
 
 // HistorySystemTableScriptTests contains working tests for both prepared and non-prepared
@@ -4516,6 +4798,71 @@ var LogTableFunctionScriptTests = []queries.ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "filtering by author, date, message and row changes",
+		SetUpScript: []string{
+			"create table t (pk int primary key, c1 varchar(20));",
+			"create table k (a int, b int);",
+			"call dolt_add('.');",
+			"call dolt_commit('-m', 'creating tables', '--date', '2022-08-01T12:00:00');",
+			"insert into t values (1, 'one'), (2, 'two');",
+			"insert into k values (5, 6);",
+			"call dolt_commit('-am', 'fix: inserting rows', '--author', 'Bob <bob@example.com>', '--date', '2022-08-02T12:00:00');",
+			"update t set c1 = 'uno' where pk = 1;",
+			"call dolt_commit('-am', 'renaming one', '--date', '2022-08-03T12:00:00');",
+			"update t set c1 = 'dos' where pk = 2;",
+			"update k set b = 7;",
+			"call dolt_commit('-am', 'fix: renaming two', '--date', '2022-08-04T12:00:00');",
+			"delete from t where pk = 1;",
+			"call dolt_commit('-am', 'deleting one', '--date', '2022-08-05T12:00:00');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SELECT message from dolt_log('--author', '^Bob <bob@example.com>$');",
+				Expected: []sql.Row{{"fix: inserting rows"}},
+			},
+			{
+				Query:    "SELECT message from dolt_log('--author', '^root <root@localhost>$', '--grep', '^fix');",
+				Expected: []sql.Row{{"fix: renaming two"}},
+			},
+			{
+				Query:    "SELECT message from dolt_log('--since', '2022-08-02', '--until', '2022-08-03');",
+				Expected: []sql.Row{{"renaming one"}, {"fix: inserting rows"}},
+			},
+			{
+				Query:    "SELECT message from dolt_log('--where', 't.pk = 1');",
+				Expected: []sql.Row{{"deleting one"}, {"renaming one"}, {"fix: inserting rows"}},
+			},
+			{
+				Query:    "SELECT message from dolt_log('--where', 't.c1 = ''two''');",
+				Expected: []sql.Row{{"fix: renaming two"}, {"fix: inserting rows"}},
+			},
+			{
+				Query:    "SELECT message from dolt_log('--where', 'k.b = 7');",
+				Expected: []sql.Row{{"fix: renaming two"}},
+			},
+			{
+				Query:    "SELECT message from dolt_log('--where', 't.c1 = uno', '--until', '2022-08-04');",
+				Expected: []sql.Row{{"renaming one"}},
+			},
+			{
+				Query:    "SELECT count(*) from dolt_log('--where', 'missing.pk = 1');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:       "SELECT * from dolt_log('--where', 'pk = 1');",
+				ExpectedErr: sql.ErrInvalidArgumentDetails,
+			},
+			{
+				Query:       "SELECT * from dolt_log('--since', 'yesterday');",
+				ExpectedErr: sql.ErrInvalidArgumentDetails,
+			},
+			{
+				Query:       "SELECT * from dolt_log('--grep', '(');",
+				ExpectedErr: sql.ErrInvalidArgumentDetails,
+			},
+		},
+	},
 }
 
 var LargeJsonObjectScriptTests = []queries.ScriptTest{
@@ -6221,6 +6568,258 @@ var DoltCherryPickTests = []queries.ScriptTest{
 			},*/
 		},
 	},
+	{
+		Name: "cherry-pick lists and ranges of commits",
+		SetUpScript: []string{
+			"create table t (pk int primary key, v varchar(100));",
+			"call dolt_commit('-Am', 'create table t');",
+			"call dolt_checkout('-b', 'branch1');",
+			"insert into t values (1, 'one');",
+			"call dolt_commit('-am', 'adding row 1');",
+			"set @commit1 = hashof('HEAD');",
+			"insert into t values (2, 'two');",
+			"call dolt_commit('-am', 'adding row 2');",
+			"insert into t values (3, 'three');",
+			"call dolt_commit('-am', 'adding row 3');",
+			"set @commit3 = hashof('HEAD');",
+			"call dolt_checkout('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_cherry_pick(@commit3, @commit1);",
+				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+			},
+			{
+				Query:    "select * from t;",
+				Expected: []sql.Row{{1, "one"}, {3, "three"}},
+			},
+			{
+				Query:    "select message from dolt_log limit 3;",
+				Expected: []sql.Row{{"adding row 1"}, {"adding row 3"}, {"create table t"}},
+			},
+			{
+				// commits that were already applied make no changes, and are skipped
+				Query:    "call dolt_cherry_pick('HEAD~2..branch1');",
+				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+			},
+			{
+				Query:    "select * from t;",
+				Expected: []sql.Row{{1, "one"}, {2, "two"}, {3, "three"}},
+			},
+			{
+				Query:    "select message from dolt_log limit 4;",
+				Expected: []sql.Row{{"adding row 2"}, {"adding row 1"}, {"adding row 3"}, {"create table t"}},
+			},
+			{
+				Query:          "call dolt_cherry_pick(@commit1, @commit3);",
+				ExpectedErrStr: "no changes were made, nothing to commit",
+			},
+			{
+				Query:          "call dolt_cherry_pick('..branch1');",
+				ExpectedErrStr: "invalid commit range: ..branch1",
+			},
+		},
+	},
+	{
+		Name: "cherry-pick a range with a commit that becomes empty",
+		SetUpScript: []string{
+			"create table t (pk int primary key, v varchar(100));",
+			"insert into dolt_ignore values ('ignored_%', true);",
+			"call dolt_commit('-Am', 'create table t');",
+			"call dolt_checkout('-b', 'branch1');",
+			"insert into t values (1, 'one');",
+			"call dolt_commit('-am', 'adding row 1');",
+			"insert into t values (2, 'two');",
+			"call dolt_commit('-am', 'adding row 2');",
+			"call dolt_checkout('main');",
+			"insert into t values (1, 'one');",
+			"call dolt_commit('-am', 'adding row 1 on main');",
+			"create table ignored_t (pk int primary key);",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				// the changes of the first commit are already on main, and the working set only differs from HEAD in
+				// an ignored table, so the first commit is skipped
+				Query:    "call dolt_cherry_pick('main..branch1');",
+				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+			},
+			{
+				Query:    "select * from t;",
+				Expected: []sql.Row{{1, "one"}, {2, "two"}},
+			},
+			{
+				Query:    "select message from dolt_log limit 3;",
+				Expected: []sql.Row{{"adding row 2"}, {"adding row 1 on main"}, {"create table t"}},
+			},
+		},
+	},
+	{
+		Name: "cherry-pick a range with conflicts, then continue",
+		SetUpScript: []string{
+			"set @@autocommit=1;",
+			"SET @@dolt_allow_commit_conflicts=1;",
+			"create table t (pk int primary key, v varchar(100));",
+			"insert into t values (1, 'one');",
+			"call dolt_commit('-Am', 'create table t');",
+			"call dolt_branch('branch1');",
+			"update t set v = 'ein' where pk = 1;",
+			"call dolt_commit('-am', 'updating row 1 -> ein');",
+			"call dolt_checkout('branch1');",
+			"update t set v = 'uno' where pk = 1;",
+			"call dolt_commit('-am', 'updating row 1 -> uno');",
+			"insert into t values (2, 'two');",
+			"call dolt_commit('-am', 'adding row 2');",
+			"call dolt_checkout('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "call dolt_cherry_pick('--continue');",
+				ExpectedErrStr: "error: There is no cherry-pick in progress",
+			},
+			{
+				Query:    "call dolt_cherry_pick('main..branch1');",
+				Expected: []sql.Row{{"", 1, 0, 0}},
+			},
+			{
+				Query:    "select * from dolt_conflicts;",
+				Expected: []sql.Row{{"t", uint64(1)}},
+			},
+			{
+				Query:          "call dolt_cherry_pick('branch1');",
+				ExpectedErrStr: "error: a cherry-pick is already in progress, use --continue, --skip or --abort",
+			},
+			{
+				Query:          "call dolt_cherry_pick('--continue');",
+				ExpectedErrStr: "error: no changes are staged, stage the resolved tables with dolt_add() or use --skip to skip this commit",
+			},
+			{
+				Query:    "call dolt_conflicts_resolve('--theirs', 't');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "call dolt_add('t');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "call dolt_cherry_pick('--continue');",
+				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+			},
+			{
+				Query:    "select * from t;",
+				Expected: []sql.Row{{1, "uno"}, {2, "two"}},
+			},
+			{
+				Query:    "select message from dolt_log limit 3;",
+				Expected: []sql.Row{{"adding row 2"}, {"updating row 1 -> uno"}, {"updating row 1 -> ein"}},
+			},
+			{
+				// Assert that the resolved commit only has one parent (i.e. not a merge commit)
+				Query:    "select count(*) from dolt_commit_ancestors where commit_hash = hashof('HEAD~1');",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select * from dolt_status;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:          "call dolt_cherry_pick('--continue');",
+				ExpectedErrStr: "error: There is no cherry-pick in progress",
+			},
+		},
+	},
+	{
+		Name: "cherry-pick a range with conflicts, then skip",
+		SetUpScript: []string{
+			"set @@autocommit=1;",
+			"SET @@dolt_allow_commit_conflicts=1;",
+			"create table t (pk int primary key, v varchar(100));",
+			"insert into t values (1, 'one');",
+			"call dolt_commit('-Am', 'create table t');",
+			"call dolt_branch('branch1');",
+			"update t set v = 'ein' where pk = 1;",
+			"call dolt_commit('-am', 'updating row 1 -> ein');",
+			"call dolt_checkout('branch1');",
+			"update t set v = 'uno' where pk = 1;",
+			"call dolt_commit('-am', 'updating row 1 -> uno');",
+			"insert into t values (2, 'two');",
+			"call dolt_commit('-am', 'adding row 2');",
+			"call dolt_checkout('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "call dolt_cherry_pick('--skip');",
+				ExpectedErrStr: "error: There is no cherry-pick in progress",
+			},
+			{
+				Query:    "call dolt_cherry_pick('main..branch1');",
+				Expected: []sql.Row{{"", 1, 0, 0}},
+			},
+			{
+				Query:    "call dolt_cherry_pick('--skip');",
+				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+			},
+			{
+				Query:    "select * from dolt_conflicts;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select * from t;",
+				Expected: []sql.Row{{1, "ein"}, {2, "two"}},
+			},
+			{
+				Query:    "select message from dolt_log limit 2;",
+				Expected: []sql.Row{{"adding row 2"}, {"updating row 1 -> ein"}},
+			},
+		},
+	},
+	{
+		Name: "cherry-pick a range with conflicts, then abort",
+		SetUpScript: []string{
+			"set @@autocommit=1;",
+			"SET @@dolt_allow_commit_conflicts=1;",
+			"create table t (pk int primary key, v varchar(100));",
+			"insert into t values (1, 'one');",
+			"call dolt_commit('-Am', 'create table t');",
+			"call dolt_branch('branch1');",
+			"update t set v = 'ein' where pk = 1;",
+			"call dolt_commit('-am', 'updating row 1 -> ein');",
+			"call dolt_checkout('branch1');",
+			"insert into t values (2, 'two');",
+			"call dolt_commit('-am', 'adding row 2');",
+			"update t set v = 'uno' where pk = 1;",
+			"call dolt_commit('-am', 'updating row 1 -> uno');",
+			"insert into t values (3, 'three');",
+			"call dolt_commit('-am', 'adding row 3');",
+			"call dolt_checkout('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_cherry_pick('main..branch1');",
+				Expected: []sql.Row{{"", 1, 0, 0}},
+			},
+			{
+				Query:    "call dolt_cherry_pick('--abort');",
+				Expected: []sql.Row{{"", 0, 0, 0}},
+			},
+			{
+				// commits that were picked before the conflict are kept
+				Query:    "select * from t;",
+				Expected: []sql.Row{{1, "ein"}, {2, "two"}},
+			},
+			{
+				Query:    "select message from dolt_log limit 2;",
+				Expected: []sql.Row{{"adding row 2"}, {"updating row 1 -> ein"}},
+			},
+			{
+				Query:          "call dolt_cherry_pick('--continue');",
+				ExpectedErrStr: "error: There is no cherry-pick in progress",
+			},
+			{
+				Query:          "call dolt_cherry_pick('--abort');",
+				ExpectedErrStr: "error: There is no cherry-pick merge to abort",
+			},
+		},
+	},
 }
 
 var DoltCommitTests = []queries.ScriptTest{
@@ -6199,3 +6199,104 @@ var QueryDiffTableScriptTests = []queries.ScriptTest{
 		},
 	},
 }
+
+var JsonDiffTableFunctionScriptTests = []queries.ScriptTest{
+	{
+		Name: "basic json path diffs",
+		SetUpScript: []string{
+			"create table t (pk int primary key, doc json, c1 int);",
+			`insert into t values (1, '{"a": 1, "b": {"c": 2, "d": [1, 2]}}', 1), (2, '{"x": "y"}', 2), (3, '[1, 2, 3]', 3), (4, null, 4);`,
+			"call dolt_commit('-Am', 'commit 1');",
+			`update t set doc = '{"a": 1, "b": {"c": 3, "d": [1, 2, 3], "e": true}}' where pk = 1;`,
+			"update t set c1 = 20 where pk = 2;",
+			"update t set doc = '[1, 2]' where pk = 3;",
+			`update t set doc = '{"new": 1}' where pk = 4;`,
+			`insert into t values (5, '{"z": null}', 5);`,
+			"call dolt_commit('-am', 'commit 2');",
+			"delete from t where pk = 2;",
+			"call dolt_commit('-am', 'commit 3');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "select * from dolt_json_diff('HEAD~2', 'HEAD~1', 't');",
+				ExpectedErrStr: "Invalid argument to dolt_json_diff: There are only 3 arguments present, and the first does not contain '..'",
+			},
+			{
+				Query:          "select * from dolt_json_diff('HEAD~2', 'HEAD~1');",
+				ExpectedErrStr: "function 'dolt_json_diff' expected 3 or 4 arguments, 2 received",
+			},
+			{
+				Query:       "select * from dolt_json_diff('HEAD~2', 'HEAD~1', 'doesnotexist', 'doc');",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+			{
+				Query:       "select * from dolt_json_diff('HEAD~2', 'HEAD~1', 't', 'doesnotexist');",
+				ExpectedErr: sql.ErrColumnNotFound,
+			},
+			{
+				Query:          "select * from dolt_json_diff('HEAD~2', 'HEAD~1', 't', 'c1');",
+				ExpectedErrStr: "column 'c1' of table 't' is not a JSON column",
+			},
+			{
+				Query: "select * from dolt_json_diff('HEAD~2', 'HEAD~1', 't', 'doc');",
+				Expected: []sql.Row{
+					{gmstypes.MustJSON(`[1]`), `$."b"."c"`, "modified", gmstypes.MustJSON(`2`), gmstypes.MustJSON(`3`)},
+					{gmstypes.MustJSON(`[1]`), `$."b"."d"`, "modified", gmstypes.MustJSON(`[1, 2]`), gmstypes.MustJSON(`[1, 2, 3]`)},
+					{gmstypes.MustJSON(`[1]`), `$."b"."e"`, "added", nil, gmstypes.MustJSON(`true`)},
+					{gmstypes.MustJSON(`[3]`), `$`, "modified", gmstypes.MustJSON(`[1, 2, 3]`), gmstypes.MustJSON(`[1, 2]`)},
+					{gmstypes.MustJSON(`[4]`), `$`, "added", nil, gmstypes.MustJSON(`{"new": 1}`)},
+					{gmstypes.MustJSON(`[5]`), `$`, "added", nil, gmstypes.MustJSON(`{"z": null}`)},
+				},
+			},
+			{
+				Query: "select primary_key, path, diff_type from dolt_json_diff('HEAD~1..HEAD', 't', 'doc');",
+				Expected: []sql.Row{
+					{gmstypes.MustJSON(`[2]`), `$`, "removed"},
+				},
+			},
+			{
+				Query:    "select * from dolt_json_diff('HEAD', 'HEAD', 't', 'doc');",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "json path diffs with composite keys and schema changes",
+		SetUpScript: []string{
+			"create table t (a int, b varchar(10), primary key (a, b));",
+			"insert into t values (1, 'one');",
+			"call dolt_commit('-Am', 'commit 1');",
+			"alter table t add column doc json;",
+			`update t set doc = '{"k": "v"}';`,
+			`insert into t values (2, 'two', '{"k": "w"}');`,
+			"call dolt_commit('-am', 'commit 2');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "select * from dolt_json_diff('HEAD~1', 'HEAD', 't', 'doc');",
+				Expected: []sql.Row{
+					{gmstypes.MustJSON(`[1, "one"]`), `$`, "added", nil, gmstypes.MustJSON(`{"k": "v"}`)},
+					{gmstypes.MustJSON(`[2, "two"]`), `$`, "added", nil, gmstypes.MustJSON(`{"k": "w"}`)},
+				},
+			},
+			{
+				Query:    "select * from dolt_json_diff('HEAD', 'WORKING', 't', 'doc');",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "json path diffs on keyless tables",
+		SetUpScript: []string{
+			"create table t (doc json);",
+			`insert into t values ('{"a": 1}');`,
+			"call dolt_commit('-Am', 'commit 1');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "select * from dolt_json_diff('HEAD~1', 'HEAD', 't', 'doc');",
+				ExpectedErrStr: "table 't' has no primary key, cannot compute JSON diff",
+			},
+		},
+	},
+}
@@ -37,7 +37,7 @@ var DoltRebaseScriptTests = []queries.ScriptTest{
 				ExpectedErrStr: "no rebase in progress",
 			}, {
 				Query:          "call dolt_rebase('main');",
-				ExpectedErrStr: "non-interactive rebases not currently supported",
+				ExpectedErrStr: "didn't identify any commits!",
 			}, {
 				Query:          "call dolt_rebase('-i');",
 				ExpectedErrStr: "not enough args",
@@ -709,6 +709,210 @@ var DoltRebaseScriptTests = []queries.ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "dolt_rebase: non-interactive rebase and --onto",
+		SetUpScript: []string{
+			"create table t (pk int primary key);",
+			"call dolt_commit('-Am', 'creating table t');",
+			"call dolt_branch('branch1');",
+
+			"insert into t values (0);",
+			"call dolt_commit('-am', 'inserting row 0');",
+
+			"call dolt_checkout('branch1');",
+			"insert into t values (1);",
+			"call dolt_commit('-am', 'inserting row 1');",
+			"insert into t values (10);",
+			"call dolt_commit('-am', 'inserting row 10');",
+			"call dolt_tag('oldbase');",
+
+			"call dolt_checkout('-b', 'branch2');",
+			"insert into t values (100);",
+			"call dolt_commit('-am', 'inserting row 100');",
+			"call dolt_checkout('branch1');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_rebase('main');",
+				Expected: []sql.Row{{0, "Successfully rebased and updated refs/heads/branch1"}},
+			},
+			{
+				Query:    "select active_branch();",
+				Expected: []sql.Row{{"branch1"}},
+			},
+			{
+				Query:    "select message from dolt_log limit 3;",
+				Expected: []sql.Row{{"inserting row 10"}, {"inserting row 1"}, {"inserting row 0"}},
+			},
+			{
+				Query:    "select * from t;",
+				Expected: []sql.Row{{0}, {1}, {10}},
+			},
+			{
+				Query:    "call dolt_checkout('branch2');",
+				Expected: []sql.Row{{0, "Switched to branch 'branch2'"}},
+			},
+			{
+				Query:    "call dolt_rebase('--onto', 'main', 'oldbase');",
+				Expected: []sql.Row{{0, "Successfully rebased and updated refs/heads/branch2"}},
+			},
+			{
+				Query:    "select message from dolt_log limit 2;",
+				Expected: []sql.Row{{"inserting row 100"}, {"inserting row 0"}},
+			},
+			{
+				Query:    "select * from t;",
+				Expected: []sql.Row{{0}, {100}},
+			},
+			{
+				Query:    "select * from dolt_branches where name like 'dolt_rebase_%';",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "dolt_rebase: edit and exec actions",
+		SetUpScript: []string{
+			"create table t (pk int primary key);",
+			"call dolt_commit('-Am', 'creating table t');",
+			"call dolt_branch('branch1');",
+
+			"insert into t values (0);",
+			"call dolt_commit('-am', 'inserting row 0');",
+
+			"call dolt_checkout('branch1');",
+			"insert into t values (1);",
+			"call dolt_commit('-am', 'inserting row 1');",
+			"insert into t values (10);",
+			"call dolt_commit('-am', 'inserting row 10');",
+			"insert into t values (100);",
+			"call dolt_commit('-am', 'inserting row 100');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "call dolt_rebase('-i', 'main');",
+				Expected: []sql.Row{{0, "interactive rebase started on branch dolt_rebase_branch1; " +
+					"adjust the rebase plan in the dolt_rebase table, then " +
+					"continue rebasing by calling dolt_rebase('--continue')"}},
+			},
+			{
+				Query:    "update dolt_rebase set action='edit' where rebase_order=1;",
+				Expected: []sql.Row{{gmstypes.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
+			},
+			{
+				Query:    "insert into dolt_rebase values (1.5, 'exec', '', ''), (2.5, 'exec', '', 'select count(*) = 3 from t');",
+				Expected: []sql.Row{{gmstypes.OkResult{RowsAffected: 2}}},
+			},
+			{
+				Query:          "call dolt_rebase('--continue');",
+				ExpectedErrStr: rebase.ErrInvalidRebasePlanExecWithoutStatement.Error(),
+			},
+			{
+				Query:    "update dolt_rebase set commit_message='select count(*) = 3 from t' where rebase_order=1.5;",
+				Expected: []sql.Row{{gmstypes.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
+			},
+			{
+				// the edit action stops the rebase after applying the first commit
+				Query:            "call dolt_rebase('--continue');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "select active_branch();",
+				Expected: []sql.Row{{"dolt_rebase_branch1"}},
+			},
+			{
+				Query:    "select message from dolt_log limit 2;",
+				Expected: []sql.Row{{"inserting row 1"}, {"inserting row 0"}},
+			},
+			{
+				// changes made while stopped are amended into the edited commit
+				Query:    "insert into t values (2);",
+				Expected: []sql.Row{{gmstypes.OkResult{RowsAffected: 1}}},
+			},
+			{
+				// the exec action at 1.5 passes, but the one at 2.5 sees the extra row and fails and stops the rebase again
+				Query: "call dolt_rebase('--continue');",
+				Expected: []sql.Row{{1, dprocedures.RebaseExecFailedMessage + "select count(*) = 3 from t: assertion returned false\n\n" +
+					"Fix the problem, then continue rebasing by calling dolt_rebase('--continue'), " +
+					"or abort it by calling dolt_rebase('--abort'). " +
+					"Uncommitted changes are amended into the last rebased commit when the rebase continues."}},
+			},
+			{
+				Query:    "select * from t as of 'HEAD~1';",
+				Expected: []sql.Row{{0}, {1}, {2}},
+			},
+			{
+				Query:    "select message from dolt_log limit 3;",
+				Expected: []sql.Row{{"inserting row 10"}, {"inserting row 1"}, {"inserting row 0"}},
+			},
+			{
+				Query:    "delete from t where pk = 10;",
+				Expected: []sql.Row{{gmstypes.OkResult{RowsAffected: 1}}},
+			},
+			{
+				Query:    "call dolt_rebase('--continue');",
+				Expected: []sql.Row{{0, "Successfully rebased and updated refs/heads/branch1"}},
+			},
+			{
+				Query:    "select active_branch();",
+				Expected: []sql.Row{{"branch1"}},
+			},
+			{
+				Query:    "select message from dolt_log limit 4;",
+				Expected: []sql.Row{{"inserting row 100"}, {"inserting row 10"}, {"inserting row 1"}, {"inserting row 0"}},
+			},
+			{
+				Query:    "select * from t;",
+				Expected: []sql.Row{{0}, {1}, {2}, {100}},
+			},
+			{
+				Query:    "select * from dolt_branches where name='dolt_rebase_branch1';",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "dolt_rebase: exec statements that leave uncommitted changes stop the rebase",
+		SetUpScript: []string{
+			"create table t (pk int primary key);",
+			"call dolt_commit('-Am', 'creating table t');",
+			"call dolt_branch('branch1');",
+
+			"call dolt_checkout('branch1');",
+			"insert into t values (1);",
+			"call dolt_commit('-am', 'inserting row 1');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:            "call dolt_rebase('-i', 'main');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "insert into dolt_rebase values (0.5, 'exec', '', 'insert into t values (5)');",
+				Expected: []sql.Row{{gmstypes.OkResult{RowsAffected: 1}}},
+			},
+			{
+				Query: "call dolt_rebase('--continue');",
+				Expected: []sql.Row{{1, dprocedures.RebaseExecFailedMessage + "insert into t values (5): the statement left uncommitted changes\n\n" +
+					"Fix the problem, then continue rebasing by calling dolt_rebase('--continue'), " +
+					"or abort it by calling dolt_rebase('--abort'). " +
+					"Uncommitted changes are amended into the last rebased commit when the rebase continues."}},
+			},
+			{
+				// no commit has been rebased yet, so there is nothing to amend the changes into
+				Query:          "call dolt_rebase('--continue');",
+				ExpectedErrStr: "cannot amend uncommitted changes into the commit being rebased onto; commit them with dolt_commit() before continuing the rebase",
+			},
+			{
+				Query:    "call dolt_rebase('--abort');",
+				Expected: []sql.Row{{0, "Interactive rebase aborted"}},
+			},
+			{
+				Query:    "select * from t;",
+				Expected: []sql.Row{{1}},
+			},
+		},
+	},
 }
 
 var DoltRebaseMultiSessionScriptTests = []queries.ScriptTest{
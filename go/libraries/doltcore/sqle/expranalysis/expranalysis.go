@@ -27,6 +27,7 @@ import (
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlfmt"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
 )
 
 // ResolveDefaultExpression returns a sql.Expression for the column default or generated expression for the
@@ -71,6 +72,60 @@ func ResolveCheckExpression(ctx *sql.Context, tableName string, sch schema.Schem
 	return nil, fmt.Errorf("unable to find check expression")
 }
 
+// ResolveFilterExpression returns a sql.Expression for the filter provided, which may refer to the columns of the
+// table with the given name and schema. Fields of the expression are indexed by their position in the full schema.
+func ResolveFilterExpression(ctx *sql.Context, tableName string, sch schema.Schema, filterExpr string) (sql.Expression, error) {
+	pkSch, err := sqlutil.FromDoltSchema("mydb", tableName, sch)
+	if err != nil {
+		return nil, err
+	}
+
+	mockDatabase := memory.NewDatabase("mydb")
+	mockDatabase.AddTable(tableName, memory.NewTable(mockDatabase, tableName, pkSch, nil))
+	mockProvider := memory.NewDBProvider(mockDatabase)
+	catalog := analyzer.NewCatalog(mockProvider)
+	parseCtx := sql.NewContext(ctx, sql.WithSession(memory.NewSession(sql.NewBaseSession(), mockProvider)))
+	parseCtx.SetCurrentDatabase("mydb")
+
+	query := fmt.Sprintf("SELECT * FROM `%s` WHERE %s", tableName, filterExpr)
+	b := planbuilder.New(parseCtx, catalog, sql.NewMysqlParser())
+	pseudoAnalyzedQuery, _, _, _, err := b.Parse(query, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var filter *plan.Filter
+	transform.Inspect(pseudoAnalyzedQuery, func(n sql.Node) bool {
+		if f, ok := n.(*plan.Filter); ok {
+			filter = f
+			return false
+		}
+		return true
+	})
+	if filter == nil {
+		return nil, fmt.Errorf("unable to find filter expression")
+	}
+	// Subqueries would read from the mock database rather than the real one
+	if transform.InspectExpr(filter.Expression, func(e sql.Expression) bool {
+		_, ok := e.(*plan.Subquery)
+		return ok
+	}) {
+		return nil, fmt.Errorf("filter expressions may not contain subqueries")
+	}
+
+	expr, _, err := transform.Expr(filter.Expression, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		if col, ok := e.(*expression.GetField); ok {
+			idx := pkSch.Schema.IndexOfColName(col.Name())
+			if idx < 0 {
+				return nil, transform.SameTree, fmt.Errorf("unable to find column %s in table %s", col.Name(), tableName)
+			}
+			return col.WithIndex(idx), transform.NewTree, nil
+		}
+		return e, transform.SameTree, nil
+	})
+	return expr, err
+}
+
 func stripTableNamesFromExpression(expr sql.Expression) sql.Expression {
 	e, _, _ := transform.Expr(expr, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
 		if col, ok := e.(*expression.GetField); ok {
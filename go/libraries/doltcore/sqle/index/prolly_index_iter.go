@@ -93,6 +93,7 @@ func (p prollyIndexIter) Next(ctx *sql.Context) (sql.Row, error) {
 	if err != nil {
 		return nil, err
 	}
+	RecordRowsExamined(ctx, 1)
 	for to := range p.pkMap {
 		from := p.pkMap.MapOrdinal(to)
 		p.pkBld.PutRaw(to, idxKey.GetField(from))
@@ -217,6 +218,7 @@ func (p prollyCoveringIndexIter) Next(ctx *sql.Context) (sql.Row, error) {
 	if err != nil {
 		return nil, err
 	}
+	RecordRowsExamined(ctx, 1)
 
 	r := make(sql.Row, len(p.projections))
 	if err := p.writeRowFromTuples(ctx, k, v, r); err != nil {
@@ -340,6 +342,7 @@ func newProllyKeylessIndexIter(
 func (p prollyKeylessIndexIter) Next(ctx *sql.Context) (sql.Row, error) {
 	r, ok := <-p.rowChan
 	if ok {
+		RecordRowsExamined(ctx, 1)
 		return r, nil
 	}
 
@@ -15,6 +15,8 @@
 package index
 
 import (
+	"sync/atomic"
+
 	"github.com/dolthub/go-mysql-server/sql"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
@@ -23,6 +25,33 @@ import (
 	"github.com/dolthub/dolt/go/store/val"
 )
 
+// RowsExaminedRecorder is implemented by sessions which keep a count of the
+// rows they read from table and index data, as reported by the slow query log.
+type RowsExaminedRecorder interface {
+	RecordRowsExamined(n uint64)
+}
+
+// rowsExaminedEnabled is whether RecordRowsExamined counts rows at all. Row iterators call it for every row, so it's
+// only enabled when something reads the counts, which keeps queries from paying for them otherwise.
+var rowsExaminedEnabled atomic.Bool
+
+// EnableRowsExamined makes RecordRowsExamined count the rows examined by each session from now on. The slow query log
+// calls this when it is configured.
+func EnableRowsExamined() {
+	rowsExaminedEnabled.Store(true)
+}
+
+// RecordRowsExamined adds |n| to the rows examined by the session of |ctx|, if it keeps a count of them and counting
+// was enabled with EnableRowsExamined.
+func RecordRowsExamined(ctx *sql.Context, n uint64) {
+	if !rowsExaminedEnabled.Load() {
+		return
+	}
+	if r, ok := ctx.Session.(RowsExaminedRecorder); ok {
+		r.RecordRowsExamined(n)
+	}
+}
+
 type prollyRowIter struct {
 	iter prolly.MapIter
 	ns   tree.NodeStore
@@ -172,6 +201,7 @@ func (it prollyRowIter) Next(ctx *sql.Context) (sql.Row, error) {
 	if err != nil {
 		return nil, err
 	}
+	RecordRowsExamined(ctx, 1)
 
 	row := make(sql.Row, it.rowLen)
 	for i, idx := range it.keyProj {
@@ -229,6 +259,7 @@ func (it *prollyKeylessIter) nextTuple(ctx *sql.Context) error {
 	if err != nil {
 		return err
 	}
+	RecordRowsExamined(ctx, 1)
 
 	it.card = val.ReadKeylessCardinality(value)
 	it.curr = make(sql.Row, it.rowLen)
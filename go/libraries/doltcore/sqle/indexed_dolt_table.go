@@ -19,6 +19,7 @@ import (
 
 	"github.com/dolthub/go-mysql-server/sql"
 
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
 	"github.com/dolthub/dolt/go/store/types"
 )
@@ -78,6 +79,9 @@ func (idt *IndexedDoltTable) PartitionRows(ctx *sql.Context, part sql.Partition)
 	if err != nil {
 		return nil, err
 	}
+	if iter, err := rowPolicyPartitionRows(ctx, idt.DoltTable, idt.idx, key, idt.isDoltFormat, part); err != nil || iter != nil {
+		return iter, err
+	}
 
 	if idt.lb == nil || !canCache || idt.lb.Key() != key {
 		idt.lb, err = index.NewIndexReaderBuilder(ctx, idt.DoltTable, idt.idx, key, idt.DoltTable.projectedCols, idt.DoltTable.sqlSch, idt.isDoltFormat)
@@ -96,6 +100,9 @@ func (idt *IndexedDoltTable) PartitionRows2(ctx *sql.Context, part sql.Partition
 	if err != nil {
 		return nil, err
 	}
+	if iter, err := rowPolicyPartitionRows(ctx, idt.DoltTable, idt.idx, key, idt.isDoltFormat, part); err != nil || iter != nil {
+		return iter, err
+	}
 	if idt.lb == nil || !canCache || idt.lb.Key() != key {
 		idt.lb, err = index.NewIndexReaderBuilder(ctx, idt.DoltTable, idt.idx, key, idt.DoltTable.projectedCols, idt.DoltTable.sqlSch, idt.isDoltFormat)
 		if err != nil {
@@ -162,6 +169,9 @@ func (t *WritableIndexedDoltTable) PartitionRows(ctx *sql.Context, part sql.Part
 	if err != nil {
 		return nil, err
 	}
+	if iter, err := rowPolicyPartitionRows(ctx, t.DoltTable, t.idx, key, t.isDoltFormat, part); err != nil || iter != nil {
+		return iter, err
+	}
 	if t.lb == nil || !canCache || t.lb.Key() != key {
 		t.lb, err = index.NewIndexReaderBuilder(ctx, t.DoltTable, t.idx, key, t.projectedCols, t.sqlSch, t.isDoltFormat)
 		if err != nil {
@@ -172,6 +182,25 @@ func (t *WritableIndexedDoltTable) PartitionRows(ctx *sql.Context, part sql.Part
 	return t.lb.NewPartitionRowIter(ctx, part)
 }
 
+// rowPolicyPartitionRows returns the rows of the partition that are covered by the row policies of |t|, projected
+// down to the projected columns of |t|. Returns a nil iterator if no row policies apply to the current user.
+func rowPolicyPartitionRows(ctx *sql.Context, t *DoltTable, idx index.DoltIndex, key doltdb.DataCacheKey, isDoltFormat bool, part sql.Partition) (sql.RowIter, error) {
+	policies, err := t.rowPolicies(ctx)
+	if err != nil || policies == nil {
+		return nil, err
+	}
+	// The row policies are evaluated against every column, so this can't use the projected lookup builder
+	lb, err := index.NewIndexReaderBuilder(ctx, t, idx, key, t.sch.GetAllCols().Tags, t.sqlSch, isDoltFormat)
+	if err != nil {
+		return nil, err
+	}
+	iter, err := lb.NewPartitionRowIter(ctx, part)
+	if err != nil {
+		return nil, err
+	}
+	return newRowPolicyIter(iter, policies, t.rowPolicyProjection()), nil
+}
+
 // WithProjections implements sql.ProjectedTable
 func (t *WritableIndexedDoltTable) WithProjections(colNames []string) sql.Table {
 	return &WritableIndexedDoltTable{
@@ -77,6 +77,17 @@ func (b Builder) Build(ctx *sql.Context, n sql.Node, r sql.Row) (sql.RowIter, er
 	return nil, nil
 }
 
+// hasRowPolicies returns whether any row policies apply to the current user for the table. Row policies filter the
+// rows of a table as they're read, so the key-value tuples of such tables can't be read directly.
+func hasRowPolicies(ctx *sql.Context, table sql.Table) (bool, error) {
+	if rp, ok := table.(interface {
+		HasRowPolicies(ctx *sql.Context) (bool, error)
+	}); ok {
+		return rp.HasRowPolicies(ctx)
+	}
+	return false, nil
+}
+
 func getIta(n sql.Node) (*plan.IndexedTableAccess, bool) {
 	switch n := n.(type) {
 	case *plan.TableAlias:
@@ -281,6 +292,9 @@ func getSourceKv(ctx *sql.Context, n sql.Node, isSrc bool) (prolly.Map, prolly.M
 		}
 		return m, mIter, destIter, s, t, n.Expression, nil
 	case *plan.IndexedTableAccess:
+		if ok, err := hasRowPolicies(ctx, n.UnderlyingTable()); err != nil || ok {
+			return prolly.Map{}, nil, nil, nil, nil, nil, err
+		}
 		var lb index.IndexScanBuilder
 		switch dt := n.UnderlyingTable().(type) {
 		case *sqle.WritableIndexedDoltTable:
@@ -335,6 +349,9 @@ func getSourceKv(ctx *sql.Context, n sql.Node, isSrc bool) (prolly.Map, prolly.M
 		}
 
 	case *plan.ResolvedTable:
+		if ok, err := hasRowPolicies(ctx, n.UnderlyingTable()); err != nil || ok {
+			return prolly.Map{}, nil, nil, nil, nil, nil, err
+		}
 		switch dt := n.UnderlyingTable().(type) {
 		case *sqle.WritableDoltTable:
 			tags = dt.ProjectedTags()
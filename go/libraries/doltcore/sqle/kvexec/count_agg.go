@@ -22,6 +22,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/expression"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
 	"github.com/dolthub/dolt/go/store/prolly"
 )
 
@@ -77,6 +78,7 @@ func (l *countAggKvIter) Next(ctx *sql.Context) (sql.Row, error) {
 		return nil, io.EOF
 	}
 	var cnt int64
+	var examined uint64
 	for {
 		k, v, err := l.srcIter.Next(ctx)
 		if err == io.EOF {
@@ -84,6 +86,7 @@ func (l *countAggKvIter) Next(ctx *sql.Context) (sql.Row, error) {
 		} else if err != nil {
 			return nil, err
 		}
+		examined++
 		if l.nullable {
 			if l.isKeyRef && k.FieldIsNull(l.idx) ||
 				v.FieldIsNull(l.idx) {
@@ -93,5 +96,6 @@ func (l *countAggKvIter) Next(ctx *sql.Context) (sql.Row, error) {
 		cnt++
 	}
 	l.done = true
+	index.RecordRowsExamined(ctx, examined)
 	return sql.Row{cnt}, nil
 }
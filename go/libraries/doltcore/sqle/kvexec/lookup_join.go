@@ -123,6 +123,7 @@ func (l *lookupJoinKvIter) Next(ctx *sql.Context) (sql.Row, error) {
 			if l.srcKey == nil {
 				return nil, io.EOF
 			}
+			index.RecordRowsExamined(ctx, 1)
 
 			l.dstKey, err = l.keyTupleMapper.dstKeyTuple(ctx, l.srcKey, l.srcVal)
 			if err != nil {
@@ -139,6 +140,9 @@ func (l *lookupJoinKvIter) Next(ctx *sql.Context) (sql.Row, error) {
 		if err != nil && err != io.EOF {
 			return nil, err
 		}
+		if dstKey != nil {
+			index.RecordRowsExamined(ctx, 1)
+		}
 
 		if dstKey == nil {
 			l.dstIter = nil
@@ -0,0 +1,218 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package resourcelimits implements per-account resource limits for
+// sql-server. Limits are stored in the user attributes of accounts and roles
+// in the "mysql" database, so they are persisted and replicated along with the
+// rest of the privilege data. The limits which apply to a connection are the
+// most restrictive of those of the account it authenticated as and those of
+// every role granted to that account.
+//
+// The limits are on concurrent connections, statement execution time and rows
+// returned per statement. The memory used by statements is not limited per
+// account, since the engine only accounts for the memory of the whole process.
+package resourcelimits
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+// attributeKey is the key of the user attributes object under which an account's limits are stored.
+const attributeKey = "dolt_resource_limits"
+
+var ErrNoSuchAccount = errors.NewKind("there is no account or role '%s'@'%s'")
+
+// Limits are the resource limits of an account or role. A zero value for a limit means the resource is not limited.
+type Limits struct {
+	// MaxConnections is the number of connections the account may have open at once.
+	MaxConnections uint64 `json:"max_connections,omitempty"`
+	// MaxExecutionTimeMillis is how long a single statement may run for.
+	MaxExecutionTimeMillis uint64 `json:"max_execution_time_millis,omitempty"`
+	// MaxRowsReturned is the number of rows a single statement may return to the client.
+	MaxRowsReturned uint64 `json:"max_rows_returned,omitempty"`
+}
+
+// IsZero returns whether |l| does not limit any resource.
+func (l Limits) IsZero() bool {
+	return l == Limits{}
+}
+
+// MaxExecutionTime returns MaxExecutionTimeMillis as a time.Duration.
+func (l Limits) MaxExecutionTime() time.Duration {
+	return time.Duration(l.MaxExecutionTimeMillis) * time.Millisecond
+}
+
+// Intersect returns the most restrictive of |l| and |other| for each resource.
+func (l Limits) Intersect(other Limits) Limits {
+	return Limits{
+		MaxConnections:         minLimit(l.MaxConnections, other.MaxConnections),
+		MaxExecutionTimeMillis: minLimit(l.MaxExecutionTimeMillis, other.MaxExecutionTimeMillis),
+		MaxRowsReturned:        minLimit(l.MaxRowsReturned, other.MaxRowsReturned),
+	}
+}
+
+func minLimit(a, b uint64) uint64 {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
+
+// Account is an account or role and the limits stored for it.
+type Account struct {
+	User   string
+	Host   string
+	Limits Limits
+}
+
+// FromUser returns the limits stored for the account or role |u|.
+func FromUser(u *mysql_db.User) (Limits, error) {
+	attrs, err := parseAttributes(u)
+	if err != nil {
+		return Limits{}, err
+	}
+	var l Limits
+	if raw, ok := attrs[attributeKey]; ok {
+		if err = json.Unmarshal(raw, &l); err != nil {
+			return Limits{}, err
+		}
+	}
+	return l, nil
+}
+
+// WithLimits returns a copy of |u| which stores |l|, keeping any other user attributes of |u|. Storing zero Limits
+// removes them from |u|.
+func WithLimits(u *mysql_db.User, l Limits) (*mysql_db.User, error) {
+	attrs, err := parseAttributes(u)
+	if err != nil {
+		return nil, err
+	}
+	if attrs == nil {
+		attrs = make(map[string]json.RawMessage)
+	}
+	if l.IsZero() {
+		delete(attrs, attributeKey)
+	} else {
+		raw, err := json.Marshal(l)
+		if err != nil {
+			return nil, err
+		}
+		attrs[attributeKey] = raw
+	}
+
+	updated := *u
+	updated.Attributes = nil
+	if len(attrs) > 0 {
+		buf, err := json.Marshal(attrs)
+		if err != nil {
+			return nil, err
+		}
+		str := string(buf)
+		updated.Attributes = &str
+	}
+	return &updated, nil
+}
+
+func parseAttributes(u *mysql_db.User) (map[string]json.RawMessage, error) {
+	if u.Attributes == nil || *u.Attributes == "" {
+		return nil, nil
+	}
+	var attrs map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(*u.Attributes), &attrs); err != nil {
+		return nil, err
+	}
+	return attrs, nil
+}
+
+// List returns every account and role in |db| which has limits, sorted by user and host.
+func List(db *mysql_db.MySQLDb) ([]Account, error) {
+	rd := db.Reader()
+	defer rd.Close()
+
+	var accounts []Account
+	var err error
+	rd.VisitUsers(func(u *mysql_db.User) {
+		if err != nil {
+			return
+		}
+		var l Limits
+		if l, err = FromUser(u); err == nil && !l.IsZero() {
+			accounts = append(accounts, Account{User: u.User, Host: u.Host, Limits: l})
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(accounts, func(i, j int) bool {
+		if accounts[i].User == accounts[j].User {
+			return accounts[i].Host < accounts[j].Host
+		}
+		return accounts[i].User < accounts[j].User
+	})
+	return accounts, nil
+}
+
+// Set stores |l| for the account or role |user|@|host| in |db| and persists |db|. Setting zero Limits removes any
+// limits the account has.
+func Set(ctx *sql.Context, db *mysql_db.MySQLDb, user, host string, l Limits) error {
+	ed := db.Editor()
+	defer ed.Close()
+	u, ok := ed.GetUser(mysql_db.UserPrimaryKey{User: user, Host: host})
+	if !ok {
+		return ErrNoSuchAccount.New(user, host)
+	}
+	updated, err := WithLimits(u, l)
+	if err != nil {
+		return err
+	}
+	// The user set indexes users by more than their primary key, so the old user must be removed before the updated
+	// one is added.
+	ed.RemoveUser(mysql_db.UserPrimaryKey{User: user, Host: host})
+	ed.PutUser(updated)
+	return db.Persist(ctx, ed)
+}
+
+// ForAccount returns the limits which apply to connections authenticated as the account |user|@|host|, which are
+// the most restrictive of the account's own limits and those of every role granted to it.
+func ForAccount(db *mysql_db.MySQLDb, user, host string) (Limits, error) {
+	rd := db.Reader()
+	defer rd.Close()
+
+	u := db.GetUser(rd, user, host, false)
+	if u == nil {
+		return Limits{}, nil
+	}
+	limits, err := FromUser(u)
+	if err != nil {
+		return Limits{}, err
+	}
+	for _, edge := range rd.GetToUserRoleEdges(mysql_db.RoleEdgesToKey{ToHost: u.Host, ToUser: u.User}) {
+		role := db.GetUser(rd, edge.FromUser, edge.FromHost, true)
+		if role == nil {
+			continue
+		}
+		roleLimits, err := FromUser(role)
+		if err != nil {
+			return Limits{}, err
+		}
+		limits = limits.Intersect(roleLimits)
+	}
+	return limits, nil
+}
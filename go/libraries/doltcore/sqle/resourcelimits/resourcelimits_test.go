@@ -0,0 +1,115 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcelimits
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memPersister struct {
+	data []byte
+}
+
+func (p *memPersister) Persist(_ *sql.Context, data []byte) error {
+	p.data = data
+	return nil
+}
+
+func (p *memPersister) LoadData(context.Context) ([]byte, error) {
+	return p.data, nil
+}
+
+func newTestDb(t *testing.T) (*mysql_db.MySQLDb, *memPersister) {
+	db := mysql_db.CreateEmptyMySQLDb()
+	p := &memPersister{}
+	db.SetPersister(p)
+	ed := db.Editor()
+	defer ed.Close()
+	attrs := `{"team": "analytics"}`
+	ed.PutUser(&mysql_db.User{User: "analyst", Host: "%", PrivilegeSet: mysql_db.NewPrivilegeSet(), Attributes: &attrs})
+	ed.PutUser(&mysql_db.User{User: "app", Host: "localhost", PrivilegeSet: mysql_db.NewPrivilegeSet()})
+	ed.PutUser(&mysql_db.User{User: "reporting", Host: "%", PrivilegeSet: mysql_db.NewPrivilegeSet(), IsRole: true})
+	ed.PutRoleEdge(&mysql_db.RoleEdge{FromUser: "reporting", FromHost: "%", ToUser: "analyst", ToHost: "%"})
+	return db, p
+}
+
+func TestIntersect(t *testing.T) {
+	a := Limits{MaxConnections: 5, MaxExecutionTimeMillis: 1000}
+	b := Limits{MaxConnections: 10, MaxRowsReturned: 100}
+	assert.Equal(t, Limits{MaxConnections: 5, MaxExecutionTimeMillis: 1000, MaxRowsReturned: 100}, a.Intersect(b))
+	assert.Equal(t, a, a.Intersect(Limits{}))
+}
+
+func TestSetAndList(t *testing.T) {
+	db, p := newTestDb(t)
+	ctx := sql.NewEmptyContext()
+
+	require.NoError(t, Set(ctx, db, "analyst", "%", Limits{MaxConnections: 2, MaxRowsReturned: 1000}))
+	require.NoError(t, Set(ctx, db, "reporting", "%", Limits{MaxConnections: 5, MaxExecutionTimeMillis: 30000}))
+	assert.NotEmpty(t, p.data)
+	assert.True(t, ErrNoSuchAccount.Is(Set(ctx, db, "nobody", "%", Limits{MaxConnections: 1})))
+
+	accounts, err := List(db)
+	require.NoError(t, err)
+	assert.Equal(t, []Account{
+		{User: "analyst", Host: "%", Limits: Limits{MaxConnections: 2, MaxRowsReturned: 1000}},
+		{User: "reporting", Host: "%", Limits: Limits{MaxConnections: 5, MaxExecutionTimeMillis: 30000}},
+	}, accounts)
+
+	// Other user attributes are kept
+	rd := db.Reader()
+	u, ok := rd.GetUser(mysql_db.UserPrimaryKey{User: "analyst", Host: "%"})
+	rd.Close()
+	require.True(t, ok)
+	assert.Contains(t, *u.Attributes, `"team":"analytics"`)
+
+	// Limits survive reloading the persisted privileges
+	reloaded := mysql_db.CreateEmptyMySQLDb()
+	require.NoError(t, reloaded.LoadData(ctx, p.data))
+	reloadedAccounts, err := List(reloaded)
+	require.NoError(t, err)
+	assert.Equal(t, accounts, reloadedAccounts)
+
+	require.NoError(t, Set(ctx, db, "analyst", "%", Limits{}))
+	accounts, err = List(db)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	assert.Equal(t, "reporting", accounts[0].User)
+}
+
+func TestForAccount(t *testing.T) {
+	db, _ := newTestDb(t)
+	ctx := sql.NewEmptyContext()
+	require.NoError(t, Set(ctx, db, "analyst", "%", Limits{MaxConnections: 10, MaxRowsReturned: 1000}))
+	require.NoError(t, Set(ctx, db, "reporting", "%", Limits{MaxConnections: 5, MaxExecutionTimeMillis: 30000}))
+
+	limits, err := ForAccount(db, "analyst", "%")
+	require.NoError(t, err)
+	assert.Equal(t, Limits{MaxConnections: 5, MaxExecutionTimeMillis: 30000, MaxRowsReturned: 1000}, limits)
+
+	limits, err = ForAccount(db, "app", "localhost")
+	require.NoError(t, err)
+	assert.True(t, limits.IsZero())
+
+	limits, err = ForAccount(db, "nobody", "localhost")
+	require.NoError(t, err)
+	assert.True(t, limits.IsZero())
+}
@@ -0,0 +1,268 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/expranalysis"
+)
+
+// rowPolicies are the predicates of the row policies which apply to the current user for a table, by the statement
+// type that they apply to. A row is only covered by the policies of a statement type if it satisfies any of their
+// predicates, so a statement type without any predicates covers no rows at all.
+type rowPolicies struct {
+	table      string
+	predicates map[doltdb.RowPolicyCommand][]sql.Expression
+}
+
+// loadRowPolicies returns the policies of the dolt_row_policies table of |root| which apply to the current user for
+// the table named |tableName| with the schema |sch|. Returns nil if the table has no row policies, or if the current
+// user bypasses the row policies of |db|.
+func loadRowPolicies(ctx *sql.Context, db dsess.SqlDatabase, root doltdb.RootValue, tableName string, sch schema.Schema) (*rowPolicies, error) {
+	if dsess.BypassesRowPolicies(ctx, db.Name()) {
+		return nil, nil
+	}
+	policies, err := doltdb.GetRowPolicies(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+
+	var rp *rowPolicies
+	var grantees map[string]struct{}
+	for _, policy := range policies {
+		if !strings.EqualFold(policy.Table, tableName) {
+			continue
+		}
+		if rp == nil {
+			rp = &rowPolicies{
+				table:      tableName,
+				predicates: make(map[doltdb.RowPolicyCommand][]sql.Expression),
+			}
+			grantees = rowPolicyGrantees(ctx)
+		}
+		if _, ok := grantees[policy.Grantee]; !ok {
+			continue
+		}
+		predicate, err := expranalysis.ResolveFilterExpression(ctx, tableName, sch, policy.Predicate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid predicate for row policy `%s` of table `%s`: %w", policy.Name, tableName, err)
+		}
+		for _, command := range []doltdb.RowPolicyCommand{doltdb.RowPolicySelect, doltdb.RowPolicyInsert, doltdb.RowPolicyUpdate, doltdb.RowPolicyDelete} {
+			if policy.Commands&command != 0 {
+				rp.predicates[command] = append(rp.predicates[command], predicate)
+			}
+		}
+	}
+	return rp, nil
+}
+
+// checkRowPoliciesUnrestricted returns an error if the current user is restricted by the row policies of the table
+// named, which |source| would otherwise expose the rows of without enforcing them. Like DoltTable, it reads the
+// policies from rowPoliciesRoot.
+func checkRowPoliciesUnrestricted(ctx *sql.Context, db dsess.SqlDatabase, tableName, source string) error {
+	if dsess.BypassesRowPolicies(ctx, db.Name()) {
+		return nil
+	}
+	root, err := rowPoliciesRoot(ctx, db)
+	if err != nil {
+		return err
+	}
+	return dsess.CheckRowPoliciesUnrestricted(ctx, db.Name(), root, tableName, source)
+}
+
+// rowPoliciesRoot returns the root that the row policies of |db| are read from, which is the working root of the
+// default branch of the database. The policies of every branch and revision of a database are the same, so that
+// they can't be escaped by reading an older commit, another branch, or a branch without them.
+func rowPoliciesRoot(ctx *sql.Context, db dsess.SqlDatabase) (doltdb.RootValue, error) {
+	baseName, _ := dsess.SplitRevisionDbName(db.Name())
+	baseDb, ok := dsess.DSessFromSess(ctx.Session).Provider().BaseDatabase(ctx, baseName)
+	if !ok {
+		return nil, sql.ErrDatabaseNotFound.New(baseName)
+	}
+	head, err := dsess.DefaultHead(baseName, baseDb)
+	if err != nil {
+		return nil, err
+	}
+	wsRef, err := ref.WorkingSetRefForHead(ref.NewBranchRef(head))
+	if err != nil {
+		return nil, err
+	}
+	ws, err := baseDb.DbData().Ddb.ResolveWorkingSet(ctx, wsRef)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the row policies of database %s from branch %s: %w", baseName, head, err)
+	}
+	return ws.WorkingRoot(), nil
+}
+
+// rowPolicyGrantees returns the grantees of the row policies which apply to the current user, which are the name of
+// the user, the names of the roles granted to them, and '%' for every user.
+func rowPolicyGrantees(ctx *sql.Context) map[string]struct{} {
+	client := ctx.Session.Client()
+	grantees := map[string]struct{}{
+		"%":         {},
+		client.User: {},
+	}
+	pro, ok := dsess.DSessFromSess(ctx.Session).Provider().(*DoltDatabaseProvider)
+	if !ok {
+		return grantees
+	}
+	mysqlDb := pro.MySQLDb()
+	if mysqlDb == nil {
+		return grantees
+	}
+	rd := mysqlDb.Reader()
+	defer rd.Close()
+	user := mysqlDb.GetUser(rd, client.User, client.Address, false)
+	if user == nil {
+		return grantees
+	}
+	for _, roleEdge := range rd.GetToUserRoleEdges(mysql_db.RoleEdgesToKey{ToHost: user.Host, ToUser: user.User}) {
+		grantees[roleEdge.FromUser] = struct{}{}
+	}
+	return grantees
+}
+
+// allows returns whether the row, which has every column of the table's schema, is covered by the policies of the
+// statement type given.
+func (rp *rowPolicies) allows(ctx *sql.Context, command doltdb.RowPolicyCommand, row sql.Row) (bool, error) {
+	for _, predicate := range rp.predicates[command] {
+		res, err := sql.EvaluateCondition(ctx, predicate, row)
+		if err != nil {
+			return false, err
+		}
+		if sql.IsTrue(res) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// check returns an error if the row is not covered by the policies of the statement type given.
+func (rp *rowPolicies) check(ctx *sql.Context, command doltdb.RowPolicyCommand, row sql.Row) error {
+	ok, err := rp.allows(ctx, command, row)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return dsess.ErrRowPolicyViolation.New(rp.table, strings.ToUpper(command.String()))
+	}
+	return nil
+}
+
+// rowPolicyIter is a sql.RowIter which only returns the rows of its child that are covered by the SELECT policies,
+// projected down to the given columns.
+type rowPolicyIter struct {
+	child      sql.RowIter
+	policies   *rowPolicies
+	projection []int
+}
+
+var _ sql.RowIter = (*rowPolicyIter)(nil)
+
+// newRowPolicyIter returns a rowPolicyIter over |child|, which must return every column of the table. The rows that
+// are returned only have the columns at the indexes in |projection|, or every column if |projection| is nil.
+func newRowPolicyIter(child sql.RowIter, policies *rowPolicies, projection []int) sql.RowIter {
+	return &rowPolicyIter{
+		child:      child,
+		policies:   policies,
+		projection: projection,
+	}
+}
+
+// Next implements the interface sql.RowIter.
+func (itr *rowPolicyIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for {
+		row, err := itr.child.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := itr.policies.allows(ctx, doltdb.RowPolicySelect, row)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		if itr.projection == nil {
+			return row, nil
+		}
+		projected := make(sql.Row, len(itr.projection))
+		for i, idx := range itr.projection {
+			projected[i] = row[idx]
+		}
+		return projected, nil
+	}
+}
+
+// Close implements the interface sql.RowIter.
+func (itr *rowPolicyIter) Close(ctx *sql.Context) error {
+	return itr.child.Close(ctx)
+}
+
+// rowPolicyTableWriter is a TableWriter which only writes rows that are covered by the policies of the statement type
+// of each write. An update must be covered by the UPDATE policies both before and after it is applied.
+type rowPolicyTableWriter struct {
+	dsess.TableWriter
+	policies *rowPolicies
+}
+
+var _ dsess.TableWriter = rowPolicyTableWriter{}
+
+// newRowPolicyTableWriter returns a TableWriter over |tw| which enforces |policies|. Returns |tw| if |policies| is nil.
+func newRowPolicyTableWriter(tw dsess.TableWriter, policies *rowPolicies) dsess.TableWriter {
+	if policies == nil {
+		return tw
+	}
+	return rowPolicyTableWriter{
+		TableWriter: tw,
+		policies:    policies,
+	}
+}
+
+// Insert implements the interface sql.RowInserter.
+func (w rowPolicyTableWriter) Insert(ctx *sql.Context, row sql.Row) error {
+	if err := w.policies.check(ctx, doltdb.RowPolicyInsert, row); err != nil {
+		return err
+	}
+	return w.TableWriter.Insert(ctx, row)
+}
+
+// Update implements the interface sql.RowUpdater.
+func (w rowPolicyTableWriter) Update(ctx *sql.Context, oldRow sql.Row, newRow sql.Row) error {
+	if err := w.policies.check(ctx, doltdb.RowPolicyUpdate, oldRow); err != nil {
+		return err
+	}
+	if err := w.policies.check(ctx, doltdb.RowPolicyUpdate, newRow); err != nil {
+		return err
+	}
+	return w.TableWriter.Update(ctx, oldRow, newRow)
+}
+
+// Delete implements the interface sql.RowDeleter.
+func (w rowPolicyTableWriter) Delete(ctx *sql.Context, row sql.Row) error {
+	if err := w.policies.check(ctx, doltdb.RowPolicyDelete, row); err != nil {
+		return err
+	}
+	return w.TableWriter.Delete(ctx, row)
+}
@@ -0,0 +1,206 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slowlog
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Fingerprint returns a normalized form of |query| which is the same for
+// queries that differ only in their literal values, comments, whitespace and
+// keyword case. Literals are replaced with ?, and lists of literals following
+// IN or VALUES, including all of the rows of a multi-row INSERT, are collapsed
+// into a single (?+).
+//
+// Fingerprint does not parse |query|, so it gives a useful result for
+// statements which fail to parse as well.
+func Fingerprint(query string) string {
+	s := scanner{query: query}
+	s.scan()
+	fp := s.out.String()
+	fp = inListRegex.ReplaceAllString(fp, "in (?+)")
+	fp = valuesListRegex.ReplaceAllString(fp, "$1 (?+)")
+	return fp
+}
+
+var inListRegex = regexp.MustCompile(`\bin \(\?(, \?)*\)`)
+var valuesListRegex = regexp.MustCompile(`\b(values?) \(\?(, \?)*\)(, \(\?(, \?)*\))*`)
+
+type tokenKind int
+
+const (
+	tokenNone tokenKind = iota
+	tokenWord
+	tokenLiteral
+	tokenOperator
+	tokenPunct
+)
+
+type scanner struct {
+	query string
+	pos   int
+	out   strings.Builder
+	// the kind and text of the last token written to |out|
+	last     tokenKind
+	lastText string
+}
+
+func (s *scanner) scan() {
+	for s.pos < len(s.query) {
+		c := s.query[s.pos]
+		switch {
+		case isSpace(c):
+			s.pos++
+		case c == '#' || (c == '-' && s.peek(1) == '-' && (s.pos+2 == len(s.query) || isSpace(s.query[s.pos+2]))):
+			s.skipToEndOfLine()
+		case c == '/' && s.peek(1) == '*':
+			s.skipBlockComment()
+		case c == '\'' || c == '"':
+			s.skipQuoted(c)
+			s.emit(tokenLiteral, "?")
+		case c == '`':
+			start := s.pos
+			s.skipQuoted(c)
+			s.emit(tokenWord, strings.ToLower(s.query[start:s.pos]))
+		case isDigit(c) || (c == '.' && isDigit(s.peek(1))):
+			s.skipNumber()
+			s.emit(tokenLiteral, "?")
+		case (c == '-' || c == '+') && isDigit(s.peek(1)) && s.last != tokenWord && s.last != tokenLiteral && s.lastText != ")":
+			s.pos++
+			s.skipNumber()
+			s.emit(tokenLiteral, "?")
+		case isWordChar(c):
+			start := s.pos
+			for s.pos < len(s.query) && isWordChar(s.query[s.pos]) {
+				s.pos++
+			}
+			word := strings.ToLower(s.query[start:s.pos])
+			if (word == "x" || word == "b" || word == "n" || word == "_binary" || word == "_utf8mb4") && s.peek(0) == '\'' {
+				s.skipQuoted('\'')
+				s.emit(tokenLiteral, "?")
+			} else {
+				s.emit(tokenWord, word)
+			}
+		case c == '?':
+			s.pos++
+			s.emit(tokenLiteral, "?")
+		case isOperatorChar(c):
+			start := s.pos
+			s.pos++
+			for s.pos < len(s.query) && isOperatorChar(s.query[s.pos]) && s.query[s.pos] != '-' && s.query[s.pos] != '+' {
+				s.pos++
+			}
+			s.emit(tokenOperator, s.query[start:s.pos])
+		default:
+			s.pos++
+			s.emit(tokenPunct, string(c))
+		}
+	}
+}
+
+// emit writes a token to the output, separated from the previous one by a
+// single space unless it is punctuation which reads better without one.
+func (s *scanner) emit(kind tokenKind, text string) {
+	if kind == tokenPunct && text == ";" {
+		// Trailing and separating semicolons are not part of the fingerprint.
+		return
+	}
+	if s.last != tokenNone {
+		noSpace := s.lastText == "(" || s.lastText == "." || s.lastText == "@" ||
+			text == ")" || text == "," || text == "."
+		if !noSpace {
+			s.out.WriteByte(' ')
+		}
+	}
+	s.out.WriteString(text)
+	s.last = kind
+	s.lastText = text
+}
+
+func (s *scanner) peek(n int) byte {
+	if s.pos+n < len(s.query) {
+		return s.query[s.pos+n]
+	}
+	return 0
+}
+
+func (s *scanner) skipToEndOfLine() {
+	for s.pos < len(s.query) && s.query[s.pos] != '\n' {
+		s.pos++
+	}
+}
+
+func (s *scanner) skipBlockComment() {
+	end := strings.Index(s.query[s.pos+2:], "*/")
+	if end == -1 {
+		s.pos = len(s.query)
+	} else {
+		s.pos += end + 4
+	}
+}
+
+// skipQuoted advances past a string or identifier quoted with |quote|,
+// honoring backslash escapes and doubled quotes.
+func (s *scanner) skipQuoted(quote byte) {
+	s.pos++
+	for s.pos < len(s.query) {
+		c := s.query[s.pos]
+		s.pos++
+		if c == '\\' && quote != '`' {
+			s.pos++
+		} else if c == quote {
+			if s.pos < len(s.query) && s.query[s.pos] == quote {
+				s.pos++
+			} else {
+				return
+			}
+		}
+	}
+	if s.pos > len(s.query) {
+		s.pos = len(s.query)
+	}
+}
+
+// skipNumber advances past a decimal, hexadecimal or binary number, including
+// any fraction and exponent.
+func (s *scanner) skipNumber() {
+	for s.pos < len(s.query) {
+		c := s.query[s.pos]
+		if isWordChar(c) || c == '.' {
+			s.pos++
+		} else if (c == '-' || c == '+') && (s.query[s.pos-1] == 'e' || s.query[s.pos-1] == 'E') {
+			s.pos++
+		} else {
+			return
+		}
+	}
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f' || c == '\v'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isWordChar(c byte) bool {
+	return c == '_' || c == '$' || isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
+
+func isOperatorChar(c byte) bool {
+	return strings.IndexByte("<>=!:|&~^+-*/%", c) != -1
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package slowlog implements the sql-server slow query log. Queries which run
+// for longer than a configured threshold are kept in a fixed size in-memory
+// buffer, which backs the dolt_slow_queries system table, and are optionally
+// appended to a file in the same format as the MySQL slow query log.
+package slowlog
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Entry is a single query recorded in the slow query log.
+type Entry struct {
+	// Start is when the query started running.
+	Start        time.Time
+	ConnectionID uint32
+	User         string
+	Host         string
+	Database     string
+	Branch       string
+	Duration     time.Duration
+	// RowsExamined is the number of rows the query read from table and index data.
+	RowsExamined uint64
+	// RowsReturned is the number of rows the query sent to the client.
+	RowsReturned uint64
+	// ChunksRead is the number of chunks requested from the storage of the
+	// query's database while it ran. It is a database-wide count rather than a
+	// per-session one, so chunks requested by other queries and background work
+	// against the same database at the same time are included.
+	ChunksRead  uint64
+	Fingerprint string
+	Query       string
+}
+
+// Log is a slow query log. It is safe for concurrent use.
+type Log struct {
+	threshold time.Duration
+
+	mu sync.Mutex
+	// |entries| is a ring buffer of the most recent entries. Once it is
+	// full, |next| is the index of the oldest entry.
+	entries []Entry
+	next    int
+	full    bool
+	w       io.WriteCloser
+}
+
+// New returns a Log which records queries running for at least |threshold|,
+// keeps the most recent |maxEntries| of them in memory and writes all of them
+// to |w|, if it is non-nil.
+func New(threshold time.Duration, maxEntries int, w io.WriteCloser) *Log {
+	return &Log{
+		threshold: threshold,
+		entries:   make([]Entry, maxEntries),
+		w:         w,
+	}
+}
+
+// Threshold returns how long a query must run before it is recorded.
+func (l *Log) Threshold() time.Duration {
+	return l.threshold
+}
+
+// Record adds |e| to the log if it ran for at least the log's threshold. An
+// error is returned if the entry could not be written to the log file, in
+// which case it is still kept in memory.
+func (l *Log) Record(e Entry) error {
+	if e.Duration < l.threshold {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.entries) > 0 {
+		l.entries[l.next] = e
+		l.next = (l.next + 1) % len(l.entries)
+		if l.next == 0 {
+			l.full = true
+		}
+	}
+	if l.w != nil {
+		_, err := io.WriteString(l.w, formatEntry(e))
+		return err
+	}
+	return nil
+}
+
+// Entries returns the entries which are held in memory, oldest first.
+func (l *Log) Entries() []Entry {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.full {
+		ret := make([]Entry, l.next)
+		copy(ret, l.entries[:l.next])
+		return ret
+	}
+	ret := make([]Entry, 0, len(l.entries))
+	ret = append(ret, l.entries[l.next:]...)
+	ret = append(ret, l.entries[:l.next]...)
+	return ret
+}
+
+// Close closes the log file, if there is one.
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.w == nil {
+		return nil
+	}
+	err := l.w.Close()
+	l.w = nil
+	return err
+}
+
+// formatEntry returns |e| in the format of the MySQL slow query log, with an
+// additional comment line carrying the Dolt specific fields.
+func formatEntry(e Entry) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# Time: %s\n", e.Start.UTC().Format("2006-01-02T15:04:05.000000Z"))
+	fmt.Fprintf(&sb, "# User@Host: %s[%s] @ %s []  Id: %d\n", e.User, e.User, e.Host, e.ConnectionID)
+	fmt.Fprintf(&sb, "# Query_time: %.6f  Lock_time: 0.000000 Rows_sent: %d  Rows_examined: %d\n", e.Duration.Seconds(), e.RowsReturned, e.RowsExamined)
+	fmt.Fprintf(&sb, "# Branch: %s  Chunks_read: %d  Fingerprint: %s\n", e.Branch, e.ChunksRead, e.Fingerprint)
+	if e.Database != "" {
+		fmt.Fprintf(&sb, "use %s;\n", e.Database)
+	}
+	fmt.Fprintf(&sb, "SET timestamp=%d;\n", e.Start.Unix())
+	sb.WriteString(strings.TrimRight(strings.TrimSpace(e.Query), ";"))
+	sb.WriteString(";\n")
+	return sb.String()
+}
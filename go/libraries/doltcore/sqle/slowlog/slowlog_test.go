@@ -0,0 +1,112 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slowlog
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFingerprint(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{"SELECT * FROM t WHERE id = 1", "select * from t where id = ?"},
+		{"select *\n  from t\twhere id=42;", "select * from t where id = ?"},
+		{"SELECT name FROM t WHERE name = 'it''s' AND x = \"a\\\"b\"", "select name from t where name = ? and x = ?"},
+		{"select * from t where id in (1, 2, 3)", "select * from t where id in (?+)"},
+		{"select * from t where id in ( 7 )", "select * from t where id in (?+)"},
+		{"insert into t values (1, 'a'), (2, 'b'), (3, 'c')", "insert into t values (?+)"},
+		{"insert into t (a, b) values (1, 'a')", "insert into t (a, b) values (?+)"},
+		{"select count(*) from `My Table` where x > -1.5e-3 and y = 0x1f and z = x'ff'", "select count (*) from `my table` where x > ? and y = ? and z = ?"},
+		{"select a-1, b + 2 from t", "select a - ?, b + ? from t"},
+		{"/* comment */ select @@autocommit -- trailing\n", "select @@autocommit"},
+		{"# comment\nselect 1", "select ?"},
+		{"select * from db.t where a <= ? and b <> ?", "select * from db.t where a <= ? and b <> ?"},
+		{"call dolt_checkout('-b', 'feature')", "call dolt_checkout (?, ?)"},
+		{"select * from t where a in (select b from u where c in (1,2))", "select * from t where a in (select b from u where c in (?+))"},
+		{"select 'unterminated", "select ?"},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			assert.Equal(t, test.expected, Fingerprint(test.query))
+		})
+	}
+}
+
+type nopCloser struct {
+	bytes.Buffer
+}
+
+func (*nopCloser) Close() error {
+	return nil
+}
+
+func TestLog(t *testing.T) {
+	var out nopCloser
+	l := New(100*time.Millisecond, 2, &out)
+	start := time.Date(2024, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	require.NoError(t, l.Record(Entry{Start: start, Duration: 50 * time.Millisecond, Query: "select 1"}))
+	assert.Empty(t, l.Entries())
+	assert.Empty(t, out.String())
+
+	for i, q := range []string{"select 2", "select 3", "select 4"} {
+		require.NoError(t, l.Record(Entry{
+			Start:        start.Add(time.Duration(i) * time.Second),
+			ConnectionID: 7,
+			User:         "root",
+			Host:         "localhost",
+			Database:     "db",
+			Branch:       "main",
+			Duration:     1500 * time.Millisecond,
+			RowsExamined: 10,
+			RowsReturned: 1,
+			ChunksRead:   3,
+			Fingerprint:  Fingerprint(q),
+			Query:        q,
+		}))
+	}
+
+	entries := l.Entries()
+	require.Len(t, entries, 2)
+	assert.Equal(t, "select 3", entries[0].Query)
+	assert.Equal(t, "select 4", entries[1].Query)
+
+	expected := "# Time: 2024-08-01T12:00:00.000000Z\n" +
+		"# User@Host: root[root] @ localhost []  Id: 7\n" +
+		"# Query_time: 1.500000  Lock_time: 0.000000 Rows_sent: 1  Rows_examined: 10\n" +
+		"# Branch: main  Chunks_read: 3  Fingerprint: select ?\n" +
+		"use db;\n" +
+		"SET timestamp=1722513600;\n" +
+		"select 2;\n"
+	assert.Equal(t, expected, out.String()[:len(expected)])
+
+	require.NoError(t, l.Close())
+}
+
+func TestLogWithoutEntries(t *testing.T) {
+	l := New(0, 0, nil)
+	require.NoError(t, l.Record(Entry{Query: "select 1"}))
+	assert.Empty(t, l.Entries())
+
+	var nilLog *Log
+	assert.Empty(t, nilLog.Entries())
+}
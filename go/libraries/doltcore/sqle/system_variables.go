@@ -198,6 +198,22 @@ func AddDoltSystemVariables() {
 			Type:    types.NewSystemIntType(dsess.DoltClusterAckWritesTimeoutSecs, 0, 60, false),
 			Default: int64(0),
 		},
+		&sql.MysqlSystemVariable{
+			// Session only, so that the sessions a standby uses to apply
+			// writes from its primary are never bounded by it.
+			Name:    dsess.DoltMaxReplicationLagMs,
+			Dynamic: true,
+			Scope:   sql.GetMysqlScope(sql.SystemVariableScope_Session),
+			Type:    types.NewSystemIntType(dsess.DoltMaxReplicationLagMs, 0, math.MaxInt64, false),
+			Default: int64(0),
+		},
+		&sql.MysqlSystemVariable{
+			Name:    dsess.DoltReplicationLagWaitTimeoutMs,
+			Dynamic: true,
+			Scope:   sql.GetMysqlScope(sql.SystemVariableScope_Session),
+			Type:    types.NewSystemIntType(dsess.DoltReplicationLagWaitTimeoutMs, 0, math.MaxInt64, false),
+			Default: int64(0),
+		},
 		&sql.MysqlSystemVariable{
 			Name:    dsess.ShowSystemTables,
 			Dynamic: true,
@@ -205,6 +221,34 @@ func AddDoltSystemVariables() {
 			Type:    types.NewSystemBoolType(dsess.ShowSystemTables),
 			Default: int8(0),
 		},
+		&sql.MysqlSystemVariable{
+			Name:    dsess.BinlogReplicaPersistFilters,
+			Dynamic: true,
+			Scope:   sql.GetMysqlScope(sql.SystemVariableScope_Global),
+			Type:    types.NewSystemBoolType(dsess.BinlogReplicaPersistFilters),
+			Default: int8(0),
+		},
+		&sql.MysqlSystemVariable{
+			Name:    dsess.BinlogReplicaChannel,
+			Dynamic: true,
+			Scope:   sql.GetMysqlScope(sql.SystemVariableScope_Session),
+			Type:    types.NewSystemStringType(dsess.BinlogReplicaChannel),
+			Default: "",
+		},
+		&sql.MysqlSystemVariable{
+			Name:    dsess.BinlogReplicaCommitBatchSize,
+			Dynamic: true,
+			Scope:   sql.GetMysqlScope(sql.SystemVariableScope_Global),
+			Type:    types.NewSystemIntType(dsess.BinlogReplicaCommitBatchSize, 0, math.MaxInt, false),
+			Default: int64(1),
+		},
+		&sql.MysqlSystemVariable{
+			Name:    dsess.BinlogReplicaCommitIntervalSecs,
+			Dynamic: true,
+			Scope:   sql.GetMysqlScope(sql.SystemVariableScope_Global),
+			Type:    types.NewSystemIntType(dsess.BinlogReplicaCommitIntervalSecs, 0, math.MaxInt, false),
+			Default: int64(0),
+		},
 		&sql.MysqlSystemVariable{
 			Name:    "dolt_dont_merge_json",
 			Dynamic: true,
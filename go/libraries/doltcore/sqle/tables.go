@@ -33,7 +33,6 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/fulltext"
 	sqltypes "github.com/dolthub/go-mysql-server/sql/types"
 
-	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
 	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
@@ -369,6 +368,41 @@ func (t *DoltTable) getRoot(ctx *sql.Context) (doltdb.RootValue, error) {
 	return t.db.GetRoot(ctx)
 }
 
+// rowPolicies returns the row policies which apply to the current user for this table, or nil if there are none. The
+// policies are always read from rowPoliciesRoot, so that reads of other branches and of history are restricted by
+// them as well.
+func (t *DoltTable) rowPolicies(ctx *sql.Context) (*rowPolicies, error) {
+	if dsess.BypassesRowPolicies(ctx, t.db.Name()) {
+		return nil, nil
+	}
+	root, err := rowPoliciesRoot(ctx, t.db)
+	if err != nil {
+		return nil, err
+	}
+	return loadRowPolicies(ctx, t.db, root, t.tableName, t.sch)
+}
+
+// HasRowPolicies returns whether any row policies apply to the current user for this table. Rows of such tables must
+// only be read through PartitionRows, which filters out the rows that the policies don't cover.
+func (t *DoltTable) HasRowPolicies(ctx *sql.Context) (bool, error) {
+	policies, err := t.rowPolicies(ctx)
+	return policies != nil, err
+}
+
+// rowPolicyProjection returns the indexes of the projected columns within every column of the table, or nil if there
+// isn't a projection.
+func (t *DoltTable) rowPolicyProjection() []int {
+	if t.projectedCols == nil {
+		return nil
+	}
+	allCols := t.sch.GetAllCols()
+	projection := make([]int, len(t.projectedCols))
+	for i, tag := range t.projectedCols {
+		projection[i] = allCols.TagToIdx[tag]
+	}
+	return projection
+}
+
 // GetIndexes implements sql.IndexedTable
 func (t *DoltTable) GetIndexes(ctx *sql.Context) ([]sql.Index, error) {
 	// If a schema override is in place, we can't trust that the indexes stored with the data
@@ -578,7 +612,12 @@ func (t *DoltTable) DataLength(ctx *sql.Context) (uint64, error) {
 // RowCount implements the sql.StatisticsTable interface.
 func (t *DoltTable) RowCount(ctx *sql.Context) (uint64, bool, error) {
 	rows, err := t.numRows(ctx)
-	return rows, true, err
+	if err != nil {
+		return 0, false, err
+	}
+	// The count is only an estimate when row policies hide some of the rows
+	hasPolicies, err := t.HasRowPolicies(ctx)
+	return rows, !hasPolicies, err
 }
 
 func (t *DoltTable) PrimaryKeySchema() sql.PrimaryKeySchema {
@@ -609,8 +648,15 @@ func (t *DoltTable) PartitionRows(ctx *sql.Context, partition sql.Partition) (sq
 	// to pass in the full column projection for the original/data schema so that we get all columns back. Then,
 	// the mappingRowIterator that we apply on top of the original row iterator will take care of mapping the
 	// original row and shrinking it down to the projected columns.
+	//
+	// Row policies are evaluated against every column of the original schema as well, so the projection is applied by
+	// the row policy iterator instead.
+	policies, err := t.rowPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
 	projCols := t.projectedCols
-	if t.overriddenSchema != nil {
+	if t.overriddenSchema != nil || policies != nil {
 		originalSchemaCols := t.sch.GetAllCols().GetColumns()
 		projCols = make([]uint64, len(originalSchemaCols))
 		for i, col := range originalSchemaCols {
@@ -622,6 +668,13 @@ func (t *DoltTable) PartitionRows(ctx *sql.Context, partition sql.Partition) (sq
 	if err != nil {
 		return originalRowIter, err
 	}
+	if policies != nil {
+		var projection []int
+		if t.overriddenSchema == nil {
+			projection = t.rowPolicyProjection()
+		}
+		originalRowIter = newRowPolicyIter(originalRowIter, policies, projection)
+	}
 
 	if t.overriddenSchema != nil {
 		return newMappingRowIter(ctx, t, originalRowIter)
@@ -692,14 +745,31 @@ func (t *WritableDoltTable) WithProjections(colNames []string) sql.Table {
 
 // Inserter implements sql.InsertableTable
 func (t *WritableDoltTable) Inserter(ctx *sql.Context) sql.RowInserter {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	te, err := t.getCheckedTableEditor(ctx)
+	if err != nil {
 		return sqlutil.NewStaticErrorEditor(err)
 	}
+	return te
+}
+
+// getCheckedTableEditor returns the table editor after checking that the current user may write to the table. The
+// editor rejects writes to any columns that the user may not write to, and writes of any rows that the row policies
+// of the table don't cover.
+func (t *WritableDoltTable) getCheckedTableEditor(ctx *sql.Context) (dsess.TableWriter, error) {
+	columnAccess, err := dsess.ColumnAccessForDb(ctx, t.db, t.Name())
+	if err != nil {
+		return nil, err
+	}
+	policies, err := t.rowPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
 	te, err := t.getTableEditor(ctx)
 	if err != nil {
-		return sqlutil.NewStaticErrorEditor(err)
+		return nil, err
 	}
-	return te
+	te = dsess.NewColumnRestrictedTableWriter(te, t.sqlSchema().Schema, columnAccess)
+	return newRowPolicyTableWriter(te, policies), nil
 }
 
 func (t *WritableDoltTable) getTableEditor(ctx *sql.Context) (ed dsess.TableWriter, err error) {
@@ -952,10 +1022,7 @@ func emptyFulltextTable(
 
 // Deleter implements sql.DeletableTable
 func (t *WritableDoltTable) Deleter(ctx *sql.Context) sql.RowDeleter {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
-		return sqlutil.NewStaticErrorEditor(err)
-	}
-	te, err := t.getTableEditor(ctx)
+	te, err := t.getCheckedTableEditor(ctx)
 	if err != nil {
 		return sqlutil.NewStaticErrorEditor(err)
 	}
@@ -964,10 +1031,7 @@ func (t *WritableDoltTable) Deleter(ctx *sql.Context) sql.RowDeleter {
 
 // Replacer implements sql.ReplaceableTable
 func (t *WritableDoltTable) Replacer(ctx *sql.Context) sql.RowReplacer {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
-		return sqlutil.NewStaticErrorEditor(err)
-	}
-	te, err := t.getTableEditor(ctx)
+	te, err := t.getCheckedTableEditor(ctx)
 	if err != nil {
 		return sqlutil.NewStaticErrorEditor(err)
 	}
@@ -976,8 +1040,14 @@ func (t *WritableDoltTable) Replacer(ctx *sql.Context) sql.RowReplacer {
 
 // Truncate implements sql.TruncateableTable
 func (t *WritableDoltTable) Truncate(ctx *sql.Context) (int, error) {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := dsess.CheckTableAccessForDb(ctx, t.db, t.Name()); err != nil {
+		return 0, err
+	}
+	if hasPolicies, err := t.HasRowPolicies(ctx); err != nil {
 		return 0, err
+	} else if hasPolicies {
+		// Only the rows that the row policies cover may be deleted
+		return t.deleteRows(ctx)
 	}
 	table, err := t.DoltTable.DoltTable(ctx)
 	if err != nil {
@@ -1022,6 +1092,39 @@ func (t *WritableDoltTable) Truncate(ctx *sql.Context) (int, error) {
 	return numOfRows, nil
 }
 
+// deleteRows deletes every row of the table that the current user can see one at a time, rather than truncating the
+// table, and returns the number of rows deleted.
+func (t *WritableDoltTable) deleteRows(ctx *sql.Context) (int, error) {
+	te, err := t.getCheckedTableEditor(ctx)
+	if err != nil {
+		return 0, err
+	}
+	table := t.DoltTable.WithProjections(nil)
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return 0, err
+	}
+	rows, err := sql.RowIterToRows(ctx, sql.NewTableRowIter(ctx, table, partitions))
+	if err != nil {
+		return 0, err
+	}
+	te.StatementBegin(ctx)
+	for _, row := range rows {
+		if err = te.Delete(ctx, row); err != nil {
+			_ = te.DiscardChanges(ctx, err)
+			_ = te.Close(ctx)
+			return 0, err
+		}
+	}
+	if err = te.StatementComplete(ctx); err != nil {
+		return 0, err
+	}
+	if err = te.Close(ctx); err != nil {
+		return 0, err
+	}
+	return len(rows), nil
+}
+
 // truncate returns an empty copy of the table given by setting the rows and indexes to empty. The schema can be
 // updated at the same time.
 func (t *WritableDoltTable) truncate(
@@ -1118,10 +1221,7 @@ func copyConstraintViolationsAndConflicts(ctx context.Context, from, to *doltdb.
 
 // Updater implements sql.UpdatableTable
 func (t *WritableDoltTable) Updater(ctx *sql.Context) sql.RowUpdater {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
-		return sqlutil.NewStaticErrorEditor(err)
-	}
-	te, err := t.getTableEditor(ctx)
+	te, err := t.getCheckedTableEditor(ctx)
 	if err != nil {
 		return sqlutil.NewStaticErrorEditor(err)
 	}
@@ -1130,7 +1230,7 @@ func (t *WritableDoltTable) Updater(ctx *sql.Context) sql.RowUpdater {
 
 // AutoIncrementSetter implements sql.AutoIncrementTable
 func (t *WritableDoltTable) AutoIncrementSetter(ctx *sql.Context) sql.AutoIncrementSetter {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := dsess.CheckTableAccessForDb(ctx, t.db, t.Name()); err != nil {
 		return sqlutil.NewStaticErrorEditor(err)
 	}
 	te, err := t.getTableEditor(ctx)
@@ -1577,7 +1677,7 @@ func (t *AlterableDoltTable) WithProjections(colNames []string) sql.Table {
 
 // AddColumn implements sql.AlterableTable
 func (t *AlterableDoltTable) AddColumn(ctx *sql.Context, column *sql.Column, order *sql.ColumnOrder) error {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := dsess.CheckTableAccessForDb(ctx, t.db, t.Name()); err != nil {
 		return err
 	}
 	root, err := t.getRoot(ctx)
@@ -1714,7 +1814,7 @@ func (t *AlterableDoltTable) RewriteInserter(
 	newColumn *sql.Column,
 	idxCols []sql.IndexColumn,
 ) (sql.RowInserter, error) {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := dsess.CheckTableAccessForDb(ctx, t.db, t.Name()); err != nil {
 		return nil, err
 	}
 	err := validateSchemaChange(t.Name(), oldSchema, newSchema, oldColumn, newColumn, idxCols)
@@ -2276,7 +2376,7 @@ func (t *AlterableDoltTable) dropColumnData(ctx *sql.Context, updatedTable *dolt
 // ModifyColumn implements sql.AlterableTable. ModifyColumn operations are only used for operations that change only
 // the schema of a table, not the data. For those operations, |RewriteInserter| is used.
 func (t *AlterableDoltTable) ModifyColumn(ctx *sql.Context, columnName string, column *sql.Column, order *sql.ColumnOrder) error {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := dsess.CheckTableAccessForDb(ctx, t.db, t.Name()); err != nil {
 		return err
 	}
 	ws, err := t.db.GetWorkingSet(ctx)
@@ -2449,7 +2549,7 @@ func allocatePrefixLengths(idxCols []sql.IndexColumn) []uint16 {
 
 // CreateIndex implements sql.IndexAlterableTable
 func (t *AlterableDoltTable) CreateIndex(ctx *sql.Context, idx sql.IndexDef) error {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := dsess.CheckTableAccessForDb(ctx, t.db, t.Name()); err != nil {
 		return err
 	}
 	if idx.Constraint != sql.IndexConstraint_None && idx.Constraint != sql.IndexConstraint_Unique && idx.Constraint != sql.IndexConstraint_Spatial {
@@ -2461,7 +2561,7 @@ func (t *AlterableDoltTable) CreateIndex(ctx *sql.Context, idx sql.IndexDef) err
 
 // DropIndex implements sql.IndexAlterableTable
 func (t *AlterableDoltTable) DropIndex(ctx *sql.Context, indexName string) error {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := dsess.CheckTableAccessForDb(ctx, t.db, t.Name()); err != nil {
 		return err
 	}
 	// We disallow removing internal dolt_ tables from SQL directly
@@ -2489,7 +2589,7 @@ func (t *AlterableDoltTable) DropIndex(ctx *sql.Context, indexName string) error
 
 // RenameIndex implements sql.IndexAlterableTable
 func (t *AlterableDoltTable) RenameIndex(ctx *sql.Context, fromIndexName string, toIndexName string) error {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := dsess.CheckTableAccessForDb(ctx, t.db, t.Name()); err != nil {
 		return err
 	}
 	// RenameIndex will error if there is a name collision or an index does not exist
@@ -2533,7 +2633,7 @@ func (t *AlterableDoltTable) CreateFulltextIndex(ctx *sql.Context, idx sql.Index
 	if !types.IsFormat_DOLT(t.Format()) {
 		return fmt.Errorf("FULLTEXT is not supported on storage format %s. Run `dolt migrate` to upgrade to the latest storage format.", t.Format().VersionString())
 	}
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := dsess.CheckTableAccessForDb(ctx, t.db, t.Name()); err != nil {
 		return err
 	}
 	if !idx.IsFullText() {
@@ -2724,7 +2824,7 @@ func (t *WritableDoltTable) createForeignKey(
 
 // AddForeignKey implements sql.ForeignKeyTable
 func (t *AlterableDoltTable) AddForeignKey(ctx *sql.Context, sqlFk sql.ForeignKeyConstraint) error {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := dsess.CheckTableAccessForDb(ctx, t.db, t.Name()); err != nil {
 		return err
 	}
 	// empty string foreign key names are replaced with a generated name elsewhere
@@ -2780,7 +2880,7 @@ func (t *AlterableDoltTable) AddForeignKey(ctx *sql.Context, sqlFk sql.ForeignKe
 
 // DropForeignKey implements sql.ForeignKeyTable
 func (t *AlterableDoltTable) DropForeignKey(ctx *sql.Context, fkName string) error {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := dsess.CheckTableAccessForDb(ctx, t.db, t.Name()); err != nil {
 		return err
 	}
 	root, err := t.getRoot(ctx)
@@ -2814,7 +2914,7 @@ func (t *AlterableDoltTable) DropForeignKey(ctx *sql.Context, fkName string) err
 // an update statement (including a no-op write statement) has the side-effect of causing a schema change.
 // TODO: get rid of explicit IsResolved tracking
 func (t *WritableDoltTable) UpdateForeignKey(ctx *sql.Context, fkName string, sqlFk sql.ForeignKeyConstraint) error {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := dsess.CheckTableAccessForDb(ctx, t.db, t.Name()); err != nil {
 		return err
 	}
 	root, err := t.getRoot(ctx)
@@ -3089,7 +3189,7 @@ func (t *WritableDoltTable) updateFromRoot(ctx *sql.Context, root doltdb.RootVal
 }
 
 func (t *AlterableDoltTable) CreateCheck(ctx *sql.Context, check *sql.CheckDefinition) error {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := dsess.CheckTableAccessForDb(ctx, t.db, t.Name()); err != nil {
 		return err
 	}
 	root, err := t.getRoot(ctx)
@@ -3145,7 +3245,7 @@ func (t *AlterableDoltTable) CreateCheck(ctx *sql.Context, check *sql.CheckDefin
 }
 
 func (t *AlterableDoltTable) DropCheck(ctx *sql.Context, chName string) error {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := dsess.CheckTableAccessForDb(ctx, t.db, t.Name()); err != nil {
 		return err
 	}
 	root, err := t.getRoot(ctx)
@@ -3196,7 +3296,7 @@ func (t *AlterableDoltTable) ModifyStoredCollation(ctx *sql.Context, collation s
 }
 
 func (t *AlterableDoltTable) ModifyDefaultCollation(ctx *sql.Context, collation sql.CollationID) error {
-	if err := dsess.CheckAccessForDb(ctx, t.db, branch_control.Permissions_Write); err != nil {
+	if err := dsess.CheckTableAccessForDb(ctx, t.db, t.Name()); err != nil {
 		return err
 	}
 	root, err := t.getRoot(ctx)
@@ -0,0 +1,283 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fixedwidth
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/row"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/untyped/csv"
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+	"github.com/dolthub/dolt/go/libraries/utils/iohelp"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+// ReadBufSize is the size of the buffer used when reading the file.
+var ReadBufSize = 256 * 1024
+
+// FixedWidthReader implements TableReader.  It reads text files laid out according to a Spec and returns rows. Each
+// field is trimmed and converted to the type of its column, and lines that cannot be converted are returned as bad
+// rows.
+type FixedWidthReader struct {
+	closer   io.Closer
+	bRd      *bufio.Reader
+	spec     *Spec
+	sch      schema.Schema
+	sqlTypes []sql.Type
+	numLine  int
+	isDone   bool
+
+	// csvRd splits the records of delimited files, after the lines to skip were read from bRd
+	csvRd *csv.CSVReader
+	// src is what bRd reads from. It keeps the read errors that csvRd reports as bad rows.
+	src *errorReader
+}
+
+// errorReader is an io.Reader which keeps the first error other than io.EOF returned by the reader it wraps.
+type errorReader struct {
+	r   io.Reader
+	err error
+}
+
+func (er *errorReader) Read(p []byte) (int, error) {
+	n, err := er.r.Read(p)
+	if err != nil && err != io.EOF && er.err == nil {
+		er.err = err
+	}
+	return n, err
+}
+
+var _ table.SqlTableReader = (*FixedWidthReader)(nil)
+
+// OpenFixedWidthReader opens a reader at a given path within a given filesys.
+func OpenFixedWidthReader(path string, fs filesys.ReadableFS, spec *Spec) (*FixedWidthReader, error) {
+	r, err := fs.OpenForRead(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFixedWidthReader(r, spec)
+}
+
+// NewFixedWidthReader creates a FixedWidthReader from a given ReadCloser.
+func NewFixedWidthReader(r io.ReadCloser, spec *Spec) (*FixedWidthReader, error) {
+	sch, err := spec.Schema()
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+
+	sqlTypes := make([]sql.Type, len(spec.Columns))
+	for i, col := range sch.GetAllCols().GetColumns() {
+		sqlTypes[i] = col.TypeInfo.ToSqlType()
+	}
+
+	src := &errorReader{r: r}
+	fwr := &FixedWidthReader{
+		closer:   r,
+		bRd:      bufio.NewReaderSize(src, ReadBufSize),
+		spec:     spec,
+		sch:      sch,
+		sqlTypes: sqlTypes,
+		src:      src,
+	}
+
+	if spec.Delimiter != "" {
+		for fwr.numLine < spec.SkipLines && !fwr.isDone {
+			_, fwr.isDone, err = iohelp.ReadLine(fwr.bRd)
+			if err != nil {
+				r.Close()
+				return nil, err
+			}
+			fwr.numLine++
+		}
+
+		names := make([]string, len(spec.Columns))
+		for i, col := range spec.Columns {
+			names[i] = col.Name
+		}
+		info := csv.NewCSVInfo().SetDelim(spec.Delimiter).SetHasHeaderLine(false).SetColumns(names)
+		fwr.csvRd, err = csv.NewCSVReader(types.Format_Default, io.NopCloser(fwr.bRd), info)
+		if err != nil {
+			r.Close()
+			return nil, err
+		}
+	}
+
+	return fwr, nil
+}
+
+func (fwr *FixedWidthReader) ReadRow(ctx context.Context) (row.Row, error) {
+	panic("deprecated")
+}
+
+// ReadSqlRow reads the next line of the file. If the line is bad the returned error will be non nil, and calling
+// table.IsBadRow(err) will return true. The row returned along with a bad row error holds the trimmed text of each
+// field.
+func (fwr *FixedWidthReader) ReadSqlRow(ctx context.Context) (sql.Row, error) {
+	var fields []string
+	if fwr.csvRd != nil {
+		var err error
+		fields, err = fwr.nextRecord(ctx)
+		if err != nil {
+			return stringsToRow(fields), err
+		}
+	} else {
+		line, err := fwr.nextLine()
+		if err != nil {
+			return nil, err
+		}
+		fields = fwr.split(line)
+	}
+
+	if len(fields) != len(fwr.spec.Columns) {
+		return stringsToRow(fields), table.NewBadRow(nil,
+			fmt.Sprintf("line %d: expected %d fields, but saw %d", fwr.numLine, len(fwr.spec.Columns), len(fields)),
+		)
+	}
+
+	raw := make([]string, len(fields))
+	for i, col := range fwr.spec.Columns {
+		raw[i] = col.trim(fields[i])
+	}
+
+	r := make(sql.Row, len(raw))
+	for i, field := range raw {
+		if field == "" {
+			continue
+		}
+
+		val, inRange, err := fwr.sqlTypes[i].Convert(field)
+		if err == nil && inRange == sql.OutOfRange {
+			err = errors.New("value out of range")
+		}
+		if err != nil {
+			return stringsToRow(raw), table.NewBadRow(nil,
+				fmt.Sprintf("line %d: column '%s' cannot convert '%s' to %s: %s", fwr.numLine, fwr.spec.Columns[i].Name, field, fwr.sqlTypes[i].String(), err.Error()),
+			)
+		}
+		r[i] = val
+	}
+
+	return r, nil
+}
+
+// nextLine returns the next line holding a record, skipping the header lines and blank lines.
+func (fwr *FixedWidthReader) nextLine() (string, error) {
+	for {
+		if fwr.isDone {
+			return "", io.EOF
+		}
+
+		line, done, err := iohelp.ReadLine(fwr.bRd)
+		if err != nil {
+			return "", err
+		}
+		fwr.isDone = done
+		if done && line == "" {
+			return "", io.EOF
+		}
+
+		fwr.numLine++
+		if fwr.numLine <= fwr.spec.SkipLines || strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		return line, nil
+	}
+}
+
+// nextRecord returns the untrimmed fields of the next record of a delimited file. Empty fields, quoted or not, are
+// returned as "". An error reading the file is returned as is, rather than as the end of the file or a bad row.
+func (fwr *FixedWidthReader) nextRecord(ctx context.Context) ([]string, error) {
+	r, err := fwr.csvRd.ReadSqlRow(ctx)
+	if fwr.src.err != nil {
+		return nil, fwr.src.err
+	}
+	if err == io.EOF {
+		return nil, err
+	}
+	fwr.numLine = fwr.spec.SkipLines + fwr.csvRd.LineNumber()
+
+	fields := make([]string, len(r))
+	for i, v := range r {
+		if v != nil {
+			fields[i] = v.(string)
+		}
+	}
+
+	// A record with the wrong number of fields is reported by the caller
+	if err != nil && len(fields) == len(fwr.spec.Columns) {
+		return fields, table.NewBadRow(nil, fmt.Sprintf("line %d: %s", fwr.numLine, err.Error()))
+	}
+	return fields, nil
+}
+
+// split returns the untrimmed fields of the fixed-width |line|. Fields that lie past the end of the line are empty.
+func (fwr *FixedWidthReader) split(line string) []string {
+	runes := []rune(line)
+	fields := make([]string, len(fwr.spec.Columns))
+	for i, col := range fwr.spec.Columns {
+		start := col.Start - 1
+		if start >= len(runes) {
+			continue
+		}
+		end := start + col.Length
+		if end > len(runes) {
+			end = len(runes)
+		}
+		fields[i] = string(runes[start:end])
+	}
+	return fields
+}
+
+func stringsToRow(strs []string) sql.Row {
+	r := make(sql.Row, len(strs))
+	for i, s := range strs {
+		r[i] = s
+	}
+	return r
+}
+
+// LineNumber returns the line of the file that the last row was read from.
+func (fwr *FixedWidthReader) LineNumber() int {
+	return fwr.numLine
+}
+
+// GetSchema gets the schema of the rows that this reader will return
+func (fwr *FixedWidthReader) GetSchema() schema.Schema {
+	return fwr.sch
+}
+
+// Close should release resources being held
+func (fwr *FixedWidthReader) Close(ctx context.Context) error {
+	if fwr.closer != nil {
+		err := fwr.closer.Close()
+		fwr.closer = nil
+
+		return err
+	} else {
+		return errors.New("Already closed.")
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fixedwidth
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/table"
+)
+
+const fixedSpec = `
+skip_lines: 1
+columns:
+  - name: id
+    start: 1
+    length: 6
+    type: int
+    trim_chars: "0 "
+  - name: name
+    start: 7
+    length: 10
+    trim: right
+  - name: amount
+    start: 17
+    length: 8
+    type: decimal(8,2)
+  - name: joined
+    start: 25
+    length: 10
+    type: date
+`
+
+func readAll(t *testing.T, specStr, data string) ([]sql.Row, []error) {
+	spec, err := ParseSpec([]byte(specStr))
+	require.NoError(t, err)
+
+	rd, err := NewFixedWidthReader(io.NopCloser(strings.NewReader(data)), spec)
+	require.NoError(t, err)
+	defer rd.Close(context.Background())
+
+	var rows []sql.Row
+	var errs []error
+	for {
+		r, err := rd.ReadSqlRow(context.Background())
+		if err == io.EOF {
+			return rows, errs
+		}
+		rows = append(rows, r)
+		errs = append(errs, err)
+	}
+}
+
+func TestFixedWidthReader(t *testing.T) {
+	data := "ID    NAME      AMOUNT  JOINED\n" +
+		"000001Alice        12.502024-01-15\n" +
+		"000002Bob           3.00\r\n" +
+		"\n" +
+		"000003Carol     notanum 2024-02-01\n" +
+		"   004Dé        100.00  2024-03-09\n" +
+		"000000Zero        0.00\n" +
+		"      Nobody      0.00\n" +
+		"000010Ten\n" +
+		"000100Hundred"
+
+	rows, errs := readAll(t, fixedSpec, data)
+	require.Len(t, rows, 8)
+
+	require.NoError(t, errs[0])
+	assert.Equal(t, sql.Row{int32(1), "Alice", decimal.RequireFromString("12.50"), time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)}, rows[0])
+
+	// fields past the end of a short line are NULL
+	require.NoError(t, errs[1])
+	assert.Equal(t, sql.Row{int32(2), "Bob", decimal.RequireFromString("3.00"), nil}, rows[1])
+
+	// bad lines are reported with their line number, and the blank line is skipped
+	require.Error(t, errs[2])
+	assert.True(t, table.IsBadRow(errs[2]))
+	assert.Contains(t, errs[2].Error(), "line 5: column 'amount' cannot convert 'notanum'")
+	assert.Equal(t, sql.Row{"3", "Carol", "notanum", "2024-02-01"}, rows[2])
+
+	// positions count characters rather than bytes
+	require.NoError(t, errs[3])
+	assert.Equal(t, int32(4), rows[3][0])
+	assert.Equal(t, "Dé", rows[3][1])
+
+	// trimming zero padding leaves a zero value, but a field of spaces is still NULL
+	require.NoError(t, errs[4])
+	assert.Equal(t, int32(0), rows[4][0])
+	require.NoError(t, errs[5])
+	assert.Nil(t, rows[5][0])
+
+	// zeros are only trimmed from the left
+	require.NoError(t, errs[6])
+	assert.Equal(t, int32(10), rows[6][0])
+	require.NoError(t, errs[7])
+	assert.Equal(t, int32(100), rows[7][0])
+}
+
+func TestTrimZeros(t *testing.T) {
+	chars := "0 "
+	tests := []struct {
+		trim     TrimMode
+		field    string
+		expected string
+	}{
+		{TrimBoth, " 01200 ", "1200"},
+		{TrimLeft, "01200 ", "1200 "},
+		{TrimRight, " 01200 ", " 01200"},
+		{TrimBoth, " 000 ", "0"},
+		{TrimLeft, "000", "0"},
+		{TrimRight, "000", "000"},
+		{TrimBoth, "   ", ""},
+	}
+	for _, test := range tests {
+		col := ColumnSpec{Trim: test.trim, TrimChars: &chars}
+		assert.Equal(t, test.expected, col.trim(test.field), "trim %s of '%s'", test.trim, test.field)
+	}
+}
+
+// failingReader returns |data|, then fails with |err|.
+type failingReader struct {
+	data io.Reader
+	err  error
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	n, err := r.data.Read(p)
+	if err == io.EOF {
+		return n, r.err
+	}
+	return n, err
+}
+
+func TestDelimitedReadError(t *testing.T) {
+	spec, err := ParseSpec([]byte("delimiter: \"|\"\ncolumns:\n  - name: a\n  - name: b\n"))
+	require.NoError(t, err)
+
+	readErr := errors.New("connection reset")
+	rd, err := NewFixedWidthReader(io.NopCloser(&failingReader{data: strings.NewReader("a|\"b"), err: readErr}), spec)
+	require.NoError(t, err)
+	defer rd.Close(context.Background())
+
+	_, err = rd.ReadSqlRow(context.Background())
+	assert.ErrorIs(t, err, readErr)
+	assert.False(t, table.IsBadRow(err))
+}
+
+func TestDelimitedReader(t *testing.T) {
+	spec := `
+delimiter: "~"
+skip_lines: 1
+columns:
+  - name: id
+    type: bigint
+  - name: name
+    trim: none
+`
+	data := "id~name\n" +
+		"1~ padded \n" +
+		"2~\" padded \"\n" +
+		"3~\"a~\"\"b\"\"\nc\"\n" +
+		"4\n" +
+		"5~~\n"
+	rows, errs := readAll(t, spec, data)
+	require.Len(t, rows, 5)
+
+	// as in csv files, whitespace before an unquoted field is skipped
+	require.NoError(t, errs[0])
+	assert.Equal(t, sql.Row{int64(1), "padded "}, rows[0])
+	require.NoError(t, errs[1])
+	assert.Equal(t, sql.Row{int64(2), " padded "}, rows[1])
+
+	// quoted fields can hold delimiters, quotes and line breaks
+	require.NoError(t, errs[2])
+	assert.Equal(t, sql.Row{int64(3), "a~\"b\"\nc"}, rows[2])
+
+	// line numbers count the lines of multi-line records
+	assert.True(t, table.IsBadRow(errs[3]))
+	assert.Contains(t, errs[3].Error(), "line 6: expected 2 fields, but saw 1")
+
+	assert.True(t, table.IsBadRow(errs[4]))
+	assert.Contains(t, errs[4].Error(), "line 7: expected 2 fields, but saw 3")
+}
+
+func TestSpecSchema(t *testing.T) {
+	spec, err := ParseSpec([]byte(fixedSpec))
+	require.NoError(t, err)
+	sch, err := spec.Schema()
+	require.NoError(t, err)
+
+	var types []string
+	for _, col := range sch.GetAllCols().GetColumns() {
+		types = append(types, col.Name+" "+col.TypeInfo.ToSqlType().String())
+	}
+	assert.Equal(t, []string{"id int", "name varchar(10)", "amount decimal(8,2)", "joined date"}, types)
+}
+
+func TestInvalidSpecs(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+		err  string
+	}{
+		{"no columns", "skip_lines: 1", "no columns defined"},
+		{"missing start", "columns:\n  - name: a\n    length: 2", "column 'a' must have a start of at least 1"},
+		{"missing length", "columns:\n  - name: a\n    start: 1", "column 'a' must have a length of at least 1"},
+		{"duplicate name", "delimiter: ','\ncolumns:\n  - name: a\n  - name: A", "duplicate column name 'A'"},
+		{"quote delimiter", "delimiter: '\"'\ncolumns:\n  - name: a", "delimiter cannot contain a quote"},
+		{"bad trim", "delimiter: ','\ncolumns:\n  - name: a\n    trim: middle", "invalid trim 'middle'"},
+		{"bad type", "delimiter: ','\ncolumns:\n  - name: a\n    type: notatype", "column 'a'"},
+		{"unknown field", "columns:\n  - name: a\n    start: 1\n    length: 1\n    width: 2", "field width not found"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseSpec([]byte(test.spec))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
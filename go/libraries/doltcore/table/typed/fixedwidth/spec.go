@@ -0,0 +1,222 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fixedwidth
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/planbuilder"
+	gmstypes "github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"gopkg.in/yaml.v2"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema/typeinfo"
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+)
+
+// TrimMode determines which side of a field has padding removed before it is converted.
+type TrimMode string
+
+const (
+	TrimBoth  TrimMode = "both"
+	TrimLeft  TrimMode = "left"
+	TrimRight TrimMode = "right"
+	TrimNone  TrimMode = "none"
+)
+
+const defaultTrimChars = " "
+
+// Spec describes the layout of a fixed-width or delimited text file. It is usually loaded from a YAML file such as:
+//
+//	skip_lines: 1
+//	columns:
+//	  - name: id
+//	    start: 1
+//	    length: 6
+//	    type: int
+//	    trim_chars: "0 "
+//	  - name: name
+//	    start: 7
+//	    length: 20
+//	    trim: right
+//
+// When Delimiter is set, fields are split on the delimiter and matched to columns in order, and the start and length of
+// each column are ignored. Delimited lines are parsed the same way as csv files are: a field may be enclosed in double
+// quotes to hold the delimiter, line breaks or leading whitespace, with "" standing for a quote inside it, and
+// whitespace before an unquoted field is skipped.
+type Spec struct {
+	// Delimiter separates the fields of a line. If empty, fields are located by their start and length.
+	Delimiter string `yaml:"delimiter,omitempty"`
+	// SkipLines is the number of lines, such as headers, to skip at the start of the file.
+	SkipLines int `yaml:"skip_lines,omitempty"`
+	// Columns are the fields of each line, in order.
+	Columns []ColumnSpec `yaml:"columns"`
+}
+
+// ColumnSpec describes a single field of a line.
+type ColumnSpec struct {
+	// Name is the name of the column the field is imported into.
+	Name string `yaml:"name"`
+	// Start is the 1-based position of the first character of the field.
+	Start int `yaml:"start,omitempty"`
+	// Length is the number of characters in the field.
+	Length int `yaml:"length,omitempty"`
+	// Type is the SQL type of the column, such as "int" or "decimal(10,2)". Fixed-width fields default to a varchar of
+	// their length, and delimited fields default to a varchar.
+	Type string `yaml:"type,omitempty"`
+	// Trim is which side of the field has padding removed, one of "both", "left", "right" or "none". Defaults to
+	// "both".
+	Trim TrimMode `yaml:"trim,omitempty"`
+	// TrimChars is the set of padding characters removed by Trim. Defaults to a space. A "0" is only removed from the
+	// left of a field, as zeros on the right are part of its value, and a field of only zeros and other padding is
+	// trimmed to a single "0" rather than to nothing, which would be imported as NULL.
+	TrimChars *string `yaml:"trim_chars,omitempty"`
+}
+
+// LoadSpec reads and validates the YAML spec at |path|.
+func LoadSpec(fs filesys.ReadableFS, path string) (*Spec, error) {
+	data, err := fs.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read spec file %s: %w", path, err)
+	}
+
+	return ParseSpec(data)
+}
+
+// ParseSpec parses and validates a YAML spec.
+func ParseSpec(data []byte) (*Spec, error) {
+	var spec Spec
+	if err := yaml.UnmarshalStrict(data, &spec); err != nil {
+		return nil, fmt.Errorf("invalid spec: %w", err)
+	}
+
+	if err := spec.validate(); err != nil {
+		return nil, fmt.Errorf("invalid spec: %w", err)
+	}
+
+	return &spec, nil
+}
+
+func (s *Spec) validate() error {
+	if len(s.Columns) == 0 {
+		return errors.New("no columns defined")
+	}
+	if s.SkipLines < 0 {
+		return errors.New("skip_lines cannot be negative")
+	}
+	if strings.ContainsAny(s.Delimiter, "\r\n") {
+		return errors.New("delimiter cannot contain a line break")
+	}
+	if strings.Contains(s.Delimiter, `"`) {
+		return errors.New("delimiter cannot contain a quote")
+	}
+
+	names := make(map[string]struct{}, len(s.Columns))
+	for i, col := range s.Columns {
+		if col.Name == "" {
+			return fmt.Errorf("column %d has no name", i+1)
+		}
+		lwr := strings.ToLower(col.Name)
+		if _, ok := names[lwr]; ok {
+			return fmt.Errorf("duplicate column name '%s'", col.Name)
+		}
+		names[lwr] = struct{}{}
+
+		if s.Delimiter == "" {
+			if col.Start < 1 {
+				return fmt.Errorf("column '%s' must have a start of at least 1", col.Name)
+			}
+			if col.Length < 1 {
+				return fmt.Errorf("column '%s' must have a length of at least 1", col.Name)
+			}
+		}
+
+		switch col.Trim {
+		case "", TrimBoth, TrimLeft, TrimRight, TrimNone:
+		default:
+			return fmt.Errorf("column '%s' has invalid trim '%s', expected one of both, left, right or none", col.Name, col.Trim)
+		}
+
+		if _, err := col.sqlType(s.Delimiter != ""); err != nil {
+			return fmt.Errorf("column '%s': %w", col.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (c ColumnSpec) sqlType(delimited bool) (sql.Type, error) {
+	if c.Type != "" {
+		return planbuilder.ParseColumnTypeString(c.Type)
+	}
+	if delimited {
+		return typeinfo.StringDefaultType.ToSqlType(), nil
+	}
+	return gmstypes.CreateString(sqltypes.VarChar, int64(c.Length), sql.Collation_Default)
+}
+
+// trim removes the padding from |field| according to the column's trim rules.
+func (c ColumnSpec) trim(field string) string {
+	chars := defaultTrimChars
+	if c.TrimChars != nil {
+		chars = *c.TrimChars
+	}
+
+	// Zeros are only padding on the left, so that "000100" is imported as 100 rather than 1
+	rightChars := strings.ReplaceAll(chars, "0", "")
+
+	var trimmed string
+	switch c.Trim {
+	case TrimNone:
+		return field
+	case TrimLeft:
+		trimmed = strings.TrimLeft(field, chars)
+	case TrimRight:
+		trimmed = strings.TrimRight(field, rightChars)
+	default:
+		trimmed = strings.TrimLeft(strings.TrimRight(field, rightChars), chars)
+	}
+
+	if trimmed == "" && rightChars != chars && strings.Contains(field, "0") {
+		// A field of only zero padding is a zero value rather than NULL
+		return "0"
+	}
+	return trimmed
+}
+
+// Schema returns a keyless schema with a column for each field of the spec.
+func (s *Spec) Schema() (schema.Schema, error) {
+	cols := make([]schema.Column, len(s.Columns))
+	for i, colSpec := range s.Columns {
+		sqlType, err := colSpec.sqlType(s.Delimiter != "")
+		if err != nil {
+			return nil, err
+		}
+		ti, err := typeinfo.FromSqlType(sqlType)
+		if err != nil {
+			return nil, err
+		}
+		cols[i], err = schema.NewColumnWithTypeInfo(colSpec.Name, uint64(i), ti, false, "", false, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return schema.SchemaFromCols(schema.NewColCollection(cols...))
+}
@@ -16,8 +16,10 @@ package parquet
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"reflect"
 	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -59,7 +61,9 @@ func OpenParquetReader(vrw types.ValueReadWriter, path string, sch schema.Schema
 }
 
 // NewParquetReader creates a ParquetReader from a given fileReader.
-// The ParquetFileInfo should describe the parquet file being read.
+// The ParquetFileInfo should describe the parquet file being read. Dots in column names separate the fields of nested
+// structs. Columns that are, or are nested within, lists, maps or structs rather than primitive values are read as
+// JSON documents.
 func NewParquetReader(vrw types.ValueReadWriter, fr source.ParquetFile, sche schema.Schema) (*ParquetReader, error) {
 	pr, err := reader.NewParquetColumnReader(fr, 4)
 	if err != nil {
@@ -68,19 +72,41 @@ func NewParquetReader(vrw types.ValueReadWriter, fr source.ParquetFile, sche sch
 
 	columns := sche.GetAllCols().GetColumns()
 	num := pr.GetNumRows()
+	fs := newFileSchema(pr.SchemaHandler)
+	root := pr.SchemaHandler.GetRootExName()
 
 	// TODO : need to solve for getting single row data in readRow (storing all columns data in memory right now)
 	data := make(map[string][]interface{})
 	var colName []string
+	var nestedCols []schema.Column
 	for _, col := range columns {
-		colData, _, _, cErr := pr.ReadColumnByPath(common.ReformPathStr(fmt.Sprintf("parquet_go_root.%s", col.Name)), num)
+		path, ok := fs.lookup(col.Name)
+		if ok && !fs.isFlat(path) {
+			nestedCols = append(nestedCols, col)
+			colName = append(colName, col.Name)
+			continue
+		}
+
+		colData, _, _, cErr := pr.ReadColumnByPath(common.ReformPathStr(fmt.Sprintf("%s.%s", root, col.Name)), num)
 		if cErr != nil {
 			return nil, fmt.Errorf("cannot read column: %s", cErr.Error())
 		}
+		if ok {
+			se := fs.element(path[len(path)-1])
+			for i := range colData {
+				colData[i] = convertPrimitive(se, colData[i])
+			}
+		}
 		data[col.Name] = colData
 		colName = append(colName, col.Name)
 	}
 
+	if len(nestedCols) > 0 {
+		if err = readNestedColumns(fr, fs, nestedCols, num, data); err != nil {
+			return nil, err
+		}
+	}
+
 	return &ParquetReader{
 		fileReader:     fr,
 		pReader:        pr,
@@ -93,6 +119,42 @@ func NewParquetReader(vrw types.ValueReadWriter, fr source.ParquetFile, sche sch
 	}, nil
 }
 
+// readNestedColumns reads whole rows from |fr| and stores the values of |cols| in |data| as JSON documents.
+func readNestedColumns(fr source.ParquetFile, fs *fileSchema, cols []schema.Column, num int64, data map[string][]interface{}) error {
+	rr, err := reader.NewParquetReader(fr, nil, 4)
+	if err != nil {
+		return err
+	}
+	defer rr.ReadStop()
+
+	rows, err := rr.ReadByNumber(int(num))
+	if err != nil {
+		return fmt.Errorf("cannot read nested columns: %s", err.Error())
+	}
+
+	for _, col := range cols {
+		path, _ := fs.lookup(col.Name)
+		colData := make([]interface{}, len(rows))
+		for i, r := range rows {
+			val, err := fs.nestedColumnValue(reflect.ValueOf(r), path)
+			if err != nil {
+				return fmt.Errorf("cannot read column %s: %s", col.Name, err.Error())
+			}
+			if val == nil {
+				continue
+			}
+			doc, err := json.Marshal(val)
+			if err != nil {
+				return fmt.Errorf("cannot read column %s: %s", col.Name, err.Error())
+			}
+			colData[i] = string(doc)
+		}
+		data[col.Name] = colData
+	}
+
+	return nil
+}
+
 func (pr *ParquetReader) ReadRow(ctx context.Context) (row.Row, error) {
 	panic("deprecated")
 }
@@ -107,11 +169,20 @@ func (pr *ParquetReader) ReadSqlRow(ctx context.Context) (sql.Row, error) {
 	allCols.Iter(func(tag uint64, col schema.Column) (stop bool, err error) {
 		val := pr.fileData[col.Name][pr.rowReadCounter]
 		if val != nil {
+			// values without a logical type are assumed to be written by dolt, which stores datetimes as
+			// microseconds and times as nanoseconds
 			switch col.TypeInfo.GetTypeIdentifier() {
 			case typeinfo.DatetimeTypeIdentifier:
-				val = time.UnixMicro(val.(int64))
+				if micros, ok := val.(int64); ok {
+					val = time.UnixMicro(micros)
+				}
 			case typeinfo.TimeTypeIdentifier:
-				val = gmstypes.Timespan(time.Duration(val.(int64)).Microseconds())
+				switch v := val.(type) {
+				case int64:
+					val = gmstypes.Timespan(time.Duration(v).Microseconds())
+				case time.Duration:
+					val = gmstypes.Timespan(v.Microseconds())
+				}
 			}
 		}
 
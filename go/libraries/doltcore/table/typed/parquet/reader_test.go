@@ -0,0 +1,189 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/writer"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema/typeinfo"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+type Address struct {
+	City string `parquet:"name=city, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Zip  *int32 `parquet:"name=zip, type=INT32, repetitiontype=OPTIONAL"`
+}
+
+type Event struct {
+	Id      int64            `parquet:"name=id, type=INT64"`
+	Amount  int64            `parquet:"name=amount, type=INT64, logicaltype=DECIMAL, logicaltype.precision=10, logicaltype.scale=2"`
+	Ts      int64            `parquet:"name=ts, type=INT64, logicaltype=TIMESTAMP, logicaltype.unit=MILLIS, logicaltype.isadjustedtoutc=true"`
+	Day     int32            `parquet:"name=day, type=INT32, convertedtype=DATE"`
+	Uid     string           `parquet:"name=uid, type=FIXED_LEN_BYTE_ARRAY, length=16, logicaltype=UUID"`
+	Small   int32            `parquet:"name=small, type=INT32, convertedtype=INT_16"`
+	Address *Address         `parquet:"name=address, repetitiontype=OPTIONAL"`
+	Tags    []string         `parquet:"name=tags, type=LIST, valuetype=BYTE_ARRAY, valueconvertedtype=UTF8"`
+	Scores  map[string]int32 `parquet:"name=scores, type=MAP, keytype=BYTE_ARRAY, keyconvertedtype=UTF8, valuetype=INT32"`
+}
+
+var eventUUID = uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+func writeEvents(t *testing.T) string {
+	file := path.Join(t.TempDir(), "events.parquet")
+	fw, err := local.NewLocalFileWriter(file)
+	require.NoError(t, err)
+
+	pw, err := writer.NewParquetWriter(fw, new(Event), 1)
+	require.NoError(t, err)
+
+	zip := int32(94105)
+	events := []Event{
+		{
+			Id:      1,
+			Amount:  12345,
+			Ts:      time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC).UnixMilli(),
+			Day:     19783,
+			Uid:     string(eventUUID[:]),
+			Small:   7,
+			Address: &Address{City: "San Francisco", Zip: &zip},
+			Tags:    []string{"a", "b"},
+			Scores:  map[string]int32{"math": 90},
+		},
+		{
+			Id:  2,
+			Uid: string(eventUUID[:]),
+		},
+	}
+	for _, e := range events {
+		require.NoError(t, pw.Write(e))
+	}
+	require.NoError(t, pw.WriteStop())
+	require.NoError(t, fw.Close())
+
+	return file
+}
+
+func colTypes(sch schema.Schema) map[string]string {
+	typeNames := make(map[string]string)
+	for _, col := range sch.GetAllCols().GetColumns() {
+		typeNames[col.Name] = col.TypeInfo.ToSqlType().String()
+	}
+	return typeNames
+}
+
+func TestInferSchema(t *testing.T) {
+	file := writeEvents(t)
+
+	sch, err := InferSchemaFromFile(file, false)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"id":      "bigint",
+		"amount":  "decimal(10,2)",
+		"ts":      "datetime(6)",
+		"day":     "date",
+		"uid":     "varchar(36)",
+		"small":   "smallint",
+		"address": "json",
+		"tags":    "json",
+		"scores":  "json",
+	}, colTypes(sch))
+
+	id, _ := sch.GetAllCols().GetByName("id")
+	assert.False(t, id.IsNullable())
+	address, _ := sch.GetAllCols().GetByName("address")
+	assert.True(t, address.IsNullable())
+
+	sch, err = InferSchemaFromFile(file, true)
+	require.NoError(t, err)
+	typeNames := colTypes(sch)
+	assert.Equal(t, typeinfo.StringDefaultType.ToSqlType().String(), typeNames["address.city"])
+	assert.Equal(t, "int", typeNames["address.zip"])
+	assert.Equal(t, "json", typeNames["tags"])
+	assert.NotContains(t, typeNames, "address")
+
+	// fields of an optional struct are nullable, even if they are required within the struct
+	city, _ := sch.GetAllCols().GetByName("address.city")
+	assert.True(t, city.IsNullable())
+}
+
+func TestReadNestedAndLogicalTypes(t *testing.T) {
+	ctx := context.Background()
+	file := writeEvents(t)
+
+	for _, flatten := range []bool{false, true} {
+		sch, err := InferSchemaFromFile(file, flatten)
+		require.NoError(t, err)
+
+		rd, err := OpenParquetReader(types.NewMemoryValueStore(), file, sch)
+		require.NoError(t, err)
+
+		values := func(r []interface{}) map[string]interface{} {
+			m := make(map[string]interface{})
+			for i, col := range sch.GetAllCols().GetColumns() {
+				m[col.Name] = r[i]
+			}
+			return m
+		}
+
+		r, err := rd.ReadSqlRow(ctx)
+		require.NoError(t, err)
+		first := values(r)
+		assert.Equal(t, "123.45", first["amount"])
+		assert.Equal(t, time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC), first["ts"].(time.Time).UTC())
+		assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), first["day"])
+		assert.Equal(t, eventUUID.String(), first["uid"])
+		assertJSONEqual(t, `["a","b"]`, first["tags"])
+		assertJSONEqual(t, `{"math":90}`, first["scores"])
+		if flatten {
+			assert.Equal(t, "San Francisco", first["address.city"])
+			assert.Equal(t, int32(94105), first["address.zip"])
+		} else {
+			assertJSONEqual(t, `{"city":"San Francisco","zip":94105}`, first["address"])
+		}
+
+		r, err = rd.ReadSqlRow(ctx)
+		require.NoError(t, err)
+		second := values(r)
+		if flatten {
+			assert.Nil(t, second["address.city"])
+		} else {
+			assert.Nil(t, second["address"])
+		}
+		assertJSONEqual(t, `[]`, second["tags"])
+
+		_, err = rd.ReadSqlRow(ctx)
+		assert.Equal(t, io.EOF, err)
+		require.NoError(t, rd.Close(ctx))
+	}
+}
+
+func assertJSONEqual(t *testing.T, expected string, actual interface{}) {
+	str, ok := actual.(string)
+	require.True(t, ok, "expected a JSON string, got %v", actual)
+	assert.True(t, json.Valid([]byte(str)))
+	assert.JSONEq(t, expected, str)
+}
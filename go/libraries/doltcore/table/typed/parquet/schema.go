@@ -0,0 +1,361 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	gmstypes "github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+	pschema "github.com/xitongsys/parquet-go/schema"
+	"github.com/xitongsys/parquet-go/source"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema/typeinfo"
+)
+
+// uuidStringLength is the length of a UUID formatted as a string, which is how UUID columns are imported
+const uuidStringLength = 36
+
+// fileSchema is the schema of a parquet file arranged as a tree, so that columns can be found by their dotted path
+// and nested values can be walked along with the schema elements that describe them.
+type fileSchema struct {
+	sh       *pschema.SchemaHandler
+	children [][]int32
+}
+
+func newFileSchema(sh *pschema.SchemaHandler) *fileSchema {
+	children := make([][]int32, len(sh.SchemaElements))
+
+	// schema elements are stored depth first, each group followed by its children
+	var pos int32 = 1
+	var walk func(idx int32)
+	walk = func(idx int32) {
+		for i := int32(0); i < sh.SchemaElements[idx].GetNumChildren(); i++ {
+			child := pos
+			pos++
+			children[idx] = append(children[idx], child)
+			walk(child)
+		}
+	}
+	if len(sh.SchemaElements) > 0 {
+		walk(0)
+	}
+
+	return &fileSchema{sh: sh, children: children}
+}
+
+func (fs *fileSchema) element(idx int32) *parquet.SchemaElement {
+	return fs.sh.SchemaElements[idx]
+}
+
+func (fs *fileSchema) name(idx int32) string {
+	return fs.sh.Infos[idx].ExName
+}
+
+func (fs *fileSchema) isLeaf(idx int32) bool {
+	return len(fs.children[idx]) == 0
+}
+
+// lookup returns the indexes of the schema elements along the path to the column named |name|, not including the
+// root. Dots in |name| separate the fields of nested structs.
+func (fs *fileSchema) lookup(name string) ([]int32, bool) {
+	var path []int32
+	idx := int32(0)
+	for _, field := range strings.Split(name, ".") {
+		found := false
+		for _, child := range fs.children[idx] {
+			if fs.name(child) == field {
+				idx, found = child, true
+				break
+			}
+		}
+		if !found {
+			return nil, false
+		}
+		path = append(path, idx)
+	}
+	return path, len(path) > 0
+}
+
+// isFlat returns whether the column at |path| holds a single primitive value per row, meaning it can be read with a
+// column reader rather than being reassembled into a JSON document.
+func (fs *fileSchema) isFlat(path []int32) bool {
+	for _, idx := range path {
+		if isRepeated(fs.element(idx)) {
+			return false
+		}
+	}
+	return fs.isLeaf(path[len(path)-1])
+}
+
+func isRepeated(se *parquet.SchemaElement) bool {
+	return se.RepetitionType != nil && *se.RepetitionType == parquet.FieldRepetitionType_REPEATED
+}
+
+func isRequired(se *parquet.SchemaElement) bool {
+	return se.RepetitionType == nil || *se.RepetitionType == parquet.FieldRepetitionType_REQUIRED
+}
+
+func hasConvertedType(se *parquet.SchemaElement, ct parquet.ConvertedType) bool {
+	return se.ConvertedType != nil && *se.ConvertedType == ct
+}
+
+// isListOrMap returns whether the group |se| is annotated as a LIST or MAP, as opposed to a struct.
+func isListOrMap(se *parquet.SchemaElement) bool {
+	lt := se.LogicalType
+	if lt != nil && (lt.IsSetLIST() || lt.IsSetMAP()) {
+		return true
+	}
+	return hasConvertedType(se, parquet.ConvertedType_LIST) ||
+		hasConvertedType(se, parquet.ConvertedType_MAP) ||
+		hasConvertedType(se, parquet.ConvertedType_MAP_KEY_VALUE)
+}
+
+// InferSchemaFromFile returns a schema for the parquet file at |path|. See InferSchema.
+func InferSchemaFromFile(path string, flattenStructs bool) (schema.Schema, error) {
+	fr, err := local.NewLocalFileReader(path)
+	if err != nil {
+		return nil, err
+	}
+	defer fr.Close()
+
+	return InferSchema(fr, flattenStructs)
+}
+
+// InferSchema returns a keyless schema with a column for each top level field of the parquet file given. Column
+// types are derived from the logical and converted types of each field, falling back to their physical types. Struct,
+// list and map fields are imported as JSON columns. If |flattenStructs| is true, the fields of structs are instead
+// imported as separate columns, named by joining the names of the struct and its field with a dot. Lists and maps are
+// never flattened.
+func InferSchema(fr source.ParquetFile, flattenStructs bool) (schema.Schema, error) {
+	pr, err := reader.NewParquetColumnReader(fr, 1)
+	if err != nil {
+		return nil, err
+	}
+	defer pr.ReadStop()
+
+	fs := newFileSchema(pr.SchemaHandler)
+
+	var cols []schema.Column
+	var addCols func(idx int32, prefix string, nullable bool) error
+	addCols = func(idx int32, prefix string, nullable bool) error {
+		for _, child := range fs.children[idx] {
+			se := fs.element(child)
+			name := prefix + fs.name(child)
+			childNullable := nullable || !isRequired(se)
+
+			if flattenStructs && !fs.isLeaf(child) && !isRepeated(se) && !isListOrMap(se) {
+				if err := addCols(child, name+".", childNullable); err != nil {
+					return err
+				}
+				continue
+			}
+
+			var ti typeinfo.TypeInfo
+			if fs.isLeaf(child) && !isRepeated(se) {
+				ti, err = typeInfoForElement(se)
+				if err != nil {
+					return fmt.Errorf("cannot infer the type of column %s: %w", name, err)
+				}
+			} else {
+				ti = typeinfo.JSONType
+			}
+
+			var constraints []schema.ColConstraint
+			if !childNullable {
+				constraints = append(constraints, schema.NotNullConstraint{})
+			}
+
+			col, err := schema.NewColumnWithTypeInfo(name, uint64(len(cols)), ti, false, "", false, "", constraints...)
+			if err != nil {
+				return err
+			}
+			cols = append(cols, col)
+		}
+		return nil
+	}
+
+	if len(fs.children) > 0 {
+		if err = addCols(0, "", false); err != nil {
+			return nil, err
+		}
+	}
+
+	return schema.SchemaFromCols(schema.NewColCollection(cols...))
+}
+
+// typeInfoForElement returns the type used to import the primitive schema element |se|.
+func typeInfoForElement(se *parquet.SchemaElement) (typeinfo.TypeInfo, error) {
+	if precision, scale, ok := decimalParams(se); ok {
+		decType, err := gmstypes.CreateDecimalType(uint8(precision), uint8(scale))
+		if err != nil {
+			return nil, err
+		}
+		return typeinfo.FromSqlType(decType)
+	}
+
+	lt := se.LogicalType
+	switch se.GetType() {
+	case parquet.Type_BOOLEAN:
+		return typeinfo.BoolType, nil
+
+	case parquet.Type_INT32, parquet.Type_INT64:
+		switch {
+		case (lt != nil && lt.IsSetDATE()) || hasConvertedType(se, parquet.ConvertedType_DATE):
+			return typeinfo.DateType, nil
+		case timestampUnit(se) != "":
+			return typeinfo.DatetimeType, nil
+		case timeUnit(se) != "":
+			return typeinfo.TimeType, nil
+		}
+		return integerTypeInfo(se), nil
+
+	case parquet.Type_INT96:
+		return typeinfo.DatetimeType, nil
+
+	case parquet.Type_FLOAT:
+		return typeinfo.Float32Type, nil
+
+	case parquet.Type_DOUBLE:
+		return typeinfo.Float64Type, nil
+
+	case parquet.Type_BYTE_ARRAY, parquet.Type_FIXED_LEN_BYTE_ARRAY:
+		switch {
+		case lt != nil && lt.IsSetUUID():
+			return typeinfo.FromSqlType(gmstypes.MustCreateStringWithDefaults(sqltypes.VarChar, uuidStringLength))
+		case (lt != nil && lt.IsSetJSON()) || hasConvertedType(se, parquet.ConvertedType_JSON):
+			return typeinfo.JSONType, nil
+		case (lt != nil && (lt.IsSetSTRING() || lt.IsSetENUM())) ||
+			hasConvertedType(se, parquet.ConvertedType_UTF8) || hasConvertedType(se, parquet.ConvertedType_ENUM):
+			return typeinfo.StringDefaultType, nil
+		case se.GetType() == parquet.Type_FIXED_LEN_BYTE_ARRAY:
+			return typeinfo.VarbinaryDefaultType, nil
+		}
+		return typeinfo.FromSqlType(gmstypes.LongBlob)
+	}
+
+	return nil, fmt.Errorf("unsupported parquet type %s", se.GetType().String())
+}
+
+// integerTypeInfo returns the integer type for the INT32 or INT64 element |se|, honoring any bit width and sign
+// given by its logical or converted type.
+func integerTypeInfo(se *parquet.SchemaElement) typeinfo.TypeInfo {
+	bitWidth, signed := 32, true
+	if se.GetType() == parquet.Type_INT64 {
+		bitWidth = 64
+	}
+
+	if lt := se.LogicalType; lt != nil && lt.IsSetINTEGER() {
+		bitWidth, signed = int(lt.INTEGER.BitWidth), lt.INTEGER.IsSigned
+	} else if se.ConvertedType != nil {
+		switch *se.ConvertedType {
+		case parquet.ConvertedType_INT_8:
+			bitWidth = 8
+		case parquet.ConvertedType_INT_16:
+			bitWidth = 16
+		case parquet.ConvertedType_UINT_8:
+			bitWidth, signed = 8, false
+		case parquet.ConvertedType_UINT_16:
+			bitWidth, signed = 16, false
+		case parquet.ConvertedType_UINT_32:
+			bitWidth, signed = 32, false
+		case parquet.ConvertedType_UINT_64:
+			bitWidth, signed = 64, false
+		}
+	}
+
+	var sqlType sql.Type
+	switch {
+	case bitWidth <= 8 && signed:
+		sqlType = gmstypes.Int8
+	case bitWidth <= 8:
+		sqlType = gmstypes.Uint8
+	case bitWidth <= 16 && signed:
+		sqlType = gmstypes.Int16
+	case bitWidth <= 16:
+		sqlType = gmstypes.Uint16
+	case bitWidth <= 32 && signed:
+		sqlType = gmstypes.Int32
+	case bitWidth <= 32:
+		sqlType = gmstypes.Uint32
+	case signed:
+		sqlType = gmstypes.Int64
+	default:
+		sqlType = gmstypes.Uint64
+	}
+
+	ti, _ := typeinfo.FromSqlType(sqlType)
+	return ti
+}
+
+// decimalParams returns the precision and scale of |se| if it is a decimal.
+func decimalParams(se *parquet.SchemaElement) (precision, scale int, ok bool) {
+	if lt := se.LogicalType; lt != nil && lt.IsSetDECIMAL() {
+		return int(lt.DECIMAL.Precision), int(lt.DECIMAL.Scale), true
+	}
+	if hasConvertedType(se, parquet.ConvertedType_DECIMAL) {
+		return int(se.GetPrecision()), int(se.GetScale()), true
+	}
+	return 0, 0, false
+}
+
+// timestampUnit returns the unit of |se| if it is a timestamp, one of "MILLIS", "MICROS" or "NANOS", or an empty
+// string otherwise.
+func timestampUnit(se *parquet.SchemaElement) string {
+	if lt := se.LogicalType; lt != nil && lt.IsSetTIMESTAMP() {
+		return timeUnitString(lt.TIMESTAMP.Unit)
+	}
+	switch {
+	case hasConvertedType(se, parquet.ConvertedType_TIMESTAMP_MILLIS):
+		return "MILLIS"
+	case hasConvertedType(se, parquet.ConvertedType_TIMESTAMP_MICROS):
+		return "MICROS"
+	}
+	return ""
+}
+
+// timeUnit returns the unit of |se| if it is a time of day, one of "MILLIS", "MICROS" or "NANOS", or an empty string
+// otherwise.
+func timeUnit(se *parquet.SchemaElement) string {
+	if lt := se.LogicalType; lt != nil && lt.IsSetTIME() {
+		return timeUnitString(lt.TIME.Unit)
+	}
+	switch {
+	case hasConvertedType(se, parquet.ConvertedType_TIME_MILLIS):
+		return "MILLIS"
+	case hasConvertedType(se, parquet.ConvertedType_TIME_MICROS):
+		return "MICROS"
+	}
+	return ""
+}
+
+func timeUnitString(unit *parquet.TimeUnit) string {
+	switch {
+	case unit == nil:
+		return "MICROS"
+	case unit.IsSetMILLIS():
+		return "MILLIS"
+	case unit.IsSetNANOS():
+		return "NANOS"
+	default:
+		return "MICROS"
+	}
+}
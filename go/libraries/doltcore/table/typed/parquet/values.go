@@ -0,0 +1,228 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	gmstypes "github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/google/uuid"
+	"github.com/xitongsys/parquet-go/parquet"
+	ptypes "github.com/xitongsys/parquet-go/types"
+)
+
+const jsonDatetimeFormat = "2006-01-02 15:04:05.999999"
+
+// convertPrimitive converts |val|, read from the primitive schema element |se|, according to the logical or
+// converted type of |se|. Timestamps, dates and INT96 values become time.Time, times of day become time.Duration,
+// decimals become strings and UUIDs become their string form. Other values are returned as is.
+func convertPrimitive(se *parquet.SchemaElement, val interface{}) interface{} {
+	if val == nil {
+		return nil
+	}
+
+	precision, scale, isDecimal := decimalParams(se)
+	lt := se.LogicalType
+
+	switch v := val.(type) {
+	case int32:
+		switch {
+		case isDecimal:
+			return ptypes.DECIMAL_INT_ToString(int64(v), precision, scale)
+		case (lt != nil && lt.IsSetDATE()) || hasConvertedType(se, parquet.ConvertedType_DATE):
+			return time.Unix(int64(v)*int64(24*time.Hour/time.Second), 0).UTC()
+		case timeUnit(se) == "MILLIS":
+			return time.Duration(v) * time.Millisecond
+		}
+
+	case int64:
+		if isDecimal {
+			return ptypes.DECIMAL_INT_ToString(v, precision, scale)
+		}
+		switch timestampUnit(se) {
+		case "MILLIS":
+			return time.UnixMilli(v)
+		case "MICROS":
+			return time.UnixMicro(v)
+		case "NANOS":
+			return time.Unix(0, v)
+		}
+		switch timeUnit(se) {
+		case "MICROS":
+			return time.Duration(v) * time.Microsecond
+		case "NANOS":
+			return time.Duration(v)
+		}
+
+	case string:
+		switch {
+		case se.GetType() == parquet.Type_INT96:
+			return ptypes.INT96ToTime(v)
+		case isDecimal:
+			return ptypes.DECIMAL_BYTE_ARRAY_ToString([]byte(v), precision, scale)
+		case lt != nil && lt.IsSetUUID():
+			if id, err := uuid.FromBytes([]byte(v)); err == nil {
+				return id.String()
+			}
+		}
+	}
+
+	return val
+}
+
+// nestedColumnValue returns the value of the column at |path| within |row|, a row read by a parquet-go reader without
+// a target struct, as a value that can be marshalled to JSON.
+func (fs *fileSchema) nestedColumnValue(row reflect.Value, path []int32) (interface{}, error) {
+	v := row
+	for i, idx := range path {
+		v = indirect(v)
+		if !v.IsValid() {
+			return nil, nil
+		}
+		if v.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("%s is nested within a repeated field", fs.name(path[len(path)-1]))
+		}
+		v = v.FieldByName(fs.sh.Infos[idx].InName)
+		if i == len(path)-1 {
+			return fs.nestedValue(idx, v), nil
+		}
+	}
+	return nil, nil
+}
+
+// nestedValue converts |v|, the value of the schema element at |idx|, to a JSON compatible value. Repeated elements
+// and lists become arrays, and structs and maps become objects.
+func (fs *fileSchema) nestedValue(idx int32, v reflect.Value) interface{} {
+	v = indirect(v)
+	if !v.IsValid() {
+		return nil
+	}
+
+	if isRepeated(fs.element(idx)) && v.Kind() == reflect.Slice {
+		arr := make([]interface{}, v.Len())
+		for i := range arr {
+			arr[i] = fs.singleValue(idx, indirect(v.Index(i)))
+		}
+		return arr
+	}
+	return fs.singleValue(idx, v)
+}
+
+// singleValue converts |v|, a single instance of the schema element at |idx|, to a JSON compatible value.
+func (fs *fileSchema) singleValue(idx int32, v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+
+	se := fs.element(idx)
+	children := fs.children[idx]
+	if len(children) == 0 {
+		val := convertPrimitive(se, v.Interface())
+		if _, _, isDecimal := decimalParams(se); isDecimal {
+			return json.Number(val.(string))
+		}
+		return jsonPrimitive(val)
+	}
+
+	switch v.Kind() {
+	case reflect.Slice:
+		// a LIST whose repeated group and element are named "list" and "element"
+		elemIdx := fs.children[children[0]][0]
+		arr := make([]interface{}, v.Len())
+		for i := range arr {
+			arr[i] = fs.nestedValue(elemIdx, v.Index(i))
+		}
+		return arr
+
+	case reflect.Map:
+		// a MAP whose repeated group has children named "key" and "value"
+		kv := fs.children[children[0]]
+		obj := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			key := fmt.Sprint(fs.nestedValue(kv[0], iter.Key()))
+			obj[key] = fs.nestedValue(kv[1], iter.Value())
+		}
+		return obj
+
+	case reflect.Struct:
+		if len(children) == 1 && isListOrMap(se) {
+			return fs.legacyListOrMap(children[0], v.FieldByName(fs.sh.Infos[children[0]].InName))
+		}
+		obj := make(map[string]interface{}, len(children))
+		for _, child := range children {
+			obj[fs.name(child)] = fs.nestedValue(child, v.FieldByName(fs.sh.Infos[child].InName))
+		}
+		return obj
+	}
+
+	return nil
+}
+
+// legacyListOrMap converts a LIST or MAP whose children do not use the standard names, and so was read as a struct
+// holding the repeated group at |idx|. Repeated key value pairs become an object, and single field groups are
+// unwrapped into their field.
+func (fs *fileSchema) legacyListOrMap(idx int32, v reflect.Value) interface{} {
+	v = indirect(v)
+	if !v.IsValid() || v.Kind() != reflect.Slice {
+		return fs.nestedValue(idx, v)
+	}
+
+	children := fs.children[idx]
+	switch len(children) {
+	case 1:
+		arr := make([]interface{}, v.Len())
+		for i := range arr {
+			elem := indirect(v.Index(i))
+			arr[i] = fs.nestedValue(children[0], elem.FieldByName(fs.sh.Infos[children[0]].InName))
+		}
+		return arr
+	case 2:
+		if hasConvertedType(fs.element(idx), parquet.ConvertedType_MAP_KEY_VALUE) || fs.name(children[0]) == "key" {
+			obj := make(map[string]interface{}, v.Len())
+			for i := 0; i < v.Len(); i++ {
+				elem := indirect(v.Index(i))
+				key := fmt.Sprint(fs.nestedValue(children[0], elem.FieldByName(fs.sh.Infos[children[0]].InName)))
+				obj[key] = fs.nestedValue(children[1], elem.FieldByName(fs.sh.Infos[children[1]].InName))
+			}
+			return obj
+		}
+	}
+	return fs.nestedValue(idx, v)
+}
+
+// jsonPrimitive converts a value returned by convertPrimitive to one that marshals to the expected JSON.
+func jsonPrimitive(val interface{}) interface{} {
+	switch v := val.(type) {
+	case time.Time:
+		return v.Format(jsonDatetimeFormat)
+	case time.Duration:
+		return gmstypes.Timespan(v.Microseconds()).String()
+	}
+	return val
+}
+
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
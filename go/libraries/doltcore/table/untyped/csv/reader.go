@@ -251,6 +251,11 @@ func rowValsToSQLRows(rowVals []*string) sql.Row {
 	return sqlRow
 }
 
+// LineNumber returns the line of the file that the last row read ended on.
+func (csvr *CSVReader) LineNumber() int {
+	return csvr.numLine
+}
+
 // GetSchema gets the schema of the rows that this reader will return
 func (csvr *CSVReader) GetSchema() schema.Schema {
 	return csvr.sch
@@ -60,19 +60,7 @@ func (w FixedWidthDiffTableWriter) WriteRow(
 		return fmt.Errorf("expected the same size for columns and diff types, got %d and %d", len(row), len(colDiffTypes))
 	}
 
-	diffMarker := ""
-	switch rowDiffType {
-	case diff.Removed:
-		diffMarker = "-"
-	case diff.Added:
-		diffMarker = "+"
-	case diff.ModifiedOld:
-		diffMarker = "<"
-	case diff.ModifiedNew:
-		diffMarker = ">"
-	}
-
-	newRow := append(sql.Row{diffMarker}, row...)
+	newRow := append(sql.Row{diffMarker(rowDiffType)}, row...)
 	newColDiffTypes := append([]diff.ChangeType{rowDiffType}, colDiffTypes...)
 
 	return w.tableWriter.WriteColoredSqlRow(ctx, newRow, colorsForDiffTypes(newColDiffTypes))
@@ -180,6 +168,22 @@ func (w FixedWidthDiffTableWriter) generateTextDiff(oldStr string, newStr string
 	return coloredStr.String(), true, ColoredStringWidth(coloredStr.String(), uncoloredStr.String())
 }
 
+// diffMarker returns the leading marker used for a row of the diff type given by the diff table writers.
+func diffMarker(rowDiffType diff.ChangeType) string {
+	switch rowDiffType {
+	case diff.Removed:
+		return "-"
+	case diff.Added:
+		return "+"
+	case diff.ModifiedOld:
+		return "<"
+	case diff.ModifiedNew:
+		return ">"
+	default:
+		return ""
+	}
+}
+
 func colorsForDiffTypes(colDiffTypes []diff.ChangeType) []*color.Color {
 	colors := make([]*color.Color, len(colDiffTypes))
 	for i := range colDiffTypes {
@@ -0,0 +1,92 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tabular
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+)
+
+// HTMLDiffTableWriter wraps an |HTMLTableWriter| to provide a leading diff type column and background colors for
+// changed cells, matching the colors used by |FixedWidthDiffTableWriter| on the terminal.
+type HTMLDiffTableWriter struct {
+	tableWriter *HTMLTableWriter
+}
+
+var _ diff.SqlRowDiffWriter = HTMLDiffTableWriter{}
+
+func NewHTMLDiffTableWriter(schema sql.Schema, wr io.WriteCloser) *HTMLDiffTableWriter {
+	// leading diff type column with empty name
+	schema = append(sql.Schema{&sql.Column{
+		Name: " ",
+		Type: types.Text,
+	}}, schema...)
+
+	return &HTMLDiffTableWriter{
+		tableWriter: NewHTMLTableWriter(schema, wr),
+	}
+}
+
+// HTMLDiffStyles are the cell styles used for each type of change by |HTMLDiffTableWriter|
+var HTMLDiffStyles = map[diff.ChangeType]HTMLCellStyle{
+	diff.Added:       {Class: "added", Style: "background-color:#ccffd8"},
+	diff.ModifiedOld: {Class: "modified-old", Style: "background-color:#ffebe9"},
+	diff.ModifiedNew: {Class: "modified-new", Style: "background-color:#e6ffec"},
+	diff.Removed:     {Class: "removed", Style: "background-color:#ffd7d5"},
+}
+
+func (w HTMLDiffTableWriter) WriteRow(
+	ctx context.Context,
+	row sql.Row,
+	rowDiffType diff.ChangeType,
+	colDiffTypes []diff.ChangeType,
+) error {
+	if len(row) != len(colDiffTypes) {
+		return fmt.Errorf("expected the same size for columns and diff types, got %d and %d", len(row), len(colDiffTypes))
+	}
+
+	newRow := append(sql.Row{diffMarker(rowDiffType)}, row...)
+	styles := make([]HTMLCellStyle, len(newRow))
+	styles[0] = HTMLDiffStyles[rowDiffType]
+	for i, dt := range colDiffTypes {
+		styles[i+1] = HTMLDiffStyles[dt]
+	}
+
+	return w.tableWriter.WriteStyledSqlRow(ctx, newRow, styles)
+}
+
+// WriteCombinedRow writes the old and new versions of a modified row as two rows, highlighting the cells that differ.
+// |mode| is ignored.
+func (w HTMLDiffTableWriter) WriteCombinedRow(ctx context.Context, oldRow, newRow sql.Row, mode diff.Mode) error {
+	// the schema of the table writer starts with the diff type column
+	oldDiffs, newDiffs, err := combinedRowColDiffs(w.tableWriter.schema[1:], oldRow, newRow)
+	if err != nil {
+		return err
+	}
+	if err := w.WriteRow(ctx, oldRow, diff.ModifiedOld, oldDiffs); err != nil {
+		return err
+	}
+	return w.WriteRow(ctx, newRow, diff.ModifiedNew, newDiffs)
+}
+
+func (w HTMLDiffTableWriter) Close(ctx context.Context) error {
+	return w.tableWriter.Close(ctx)
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tabular
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table"
+)
+
+// HTMLCellStyle describes the presentation of a single cell written by an |HTMLTableWriter|. Styles are written
+// inline, rather than in a stylesheet, so that they survive being pasted into email clients.
+type HTMLCellStyle struct {
+	// Class is the value of the cell's class attribute, if any
+	Class string
+	// Style is the value of the cell's style attribute, if any
+	Style string
+}
+
+// HTMLTableWriter writes rows as an HTML table fragment. The table is opened along with the first row, so nothing is
+// written for an empty result.
+type HTMLTableWriter struct {
+	schema         sql.Schema
+	closer         io.Closer
+	wr             *bufio.Writer
+	numRowsWritten int
+}
+
+var _ table.SqlRowWriter = (*HTMLTableWriter)(nil)
+
+func NewHTMLTableWriter(schema sql.Schema, wr io.WriteCloser) *HTMLTableWriter {
+	return &HTMLTableWriter{
+		schema: schema,
+		closer: wr,
+		wr:     bufio.NewWriterSize(wr, writeBufSize),
+	}
+}
+
+func (w *HTMLTableWriter) WriteSqlRow(ctx context.Context, r sql.Row) error {
+	return w.WriteStyledSqlRow(ctx, r, nil)
+}
+
+// WriteStyledSqlRow writes the row given, applying the style at the same index of |styles| to each cell.
+func (w *HTMLTableWriter) WriteStyledSqlRow(ctx context.Context, r sql.Row, styles []HTMLCellStyle) error {
+	if len(styles) > 0 && len(styles) != len(r) {
+		return fmt.Errorf("different sizes for row and styles: got %d and %d", len(r), len(styles))
+	}
+
+	if w.numRowsWritten == 0 {
+		if err := w.writeHeader(); err != nil {
+			return err
+		}
+	}
+	w.numRowsWritten++
+
+	var sb strings.Builder
+	sb.WriteString("<tr>")
+	for i := range r {
+		str, err := w.stringValue(i, r[i])
+		if err != nil {
+			return err
+		}
+		sb.WriteString("<td")
+		if len(styles) > 0 {
+			writeHTMLAttr(&sb, "class", styles[i].Class)
+			writeHTMLAttr(&sb, "style", styles[i].Style)
+		}
+		sb.WriteString(">")
+		sb.WriteString(strings.ReplaceAll(html.EscapeString(str), "\n", "<br>"))
+		sb.WriteString("</td>")
+	}
+	sb.WriteString("</tr>\n")
+
+	_, err := w.wr.WriteString(sb.String())
+	return err
+}
+
+func writeHTMLAttr(sb *strings.Builder, name, val string) {
+	if val == "" {
+		return
+	}
+	sb.WriteString(fmt.Sprintf(` %s="%s"`, name, html.EscapeString(val)))
+}
+
+func (w *HTMLTableWriter) stringValue(idx int, i interface{}) (string, error) {
+	if i == nil {
+		return "NULL", nil
+	}
+	return sqlutil.SqlColToStr(w.schema[idx].Type, i)
+}
+
+func (w *HTMLTableWriter) writeHeader() error {
+	var sb strings.Builder
+	sb.WriteString("<table>\n<thead>\n<tr>")
+	for _, col := range w.schema {
+		sb.WriteString("<th>")
+		sb.WriteString(html.EscapeString(col.Name))
+		sb.WriteString("</th>")
+	}
+	sb.WriteString("</tr>\n</thead>\n<tbody>\n")
+	_, err := w.wr.WriteString(sb.String())
+	return err
+}
+
+func (w *HTMLTableWriter) Close(ctx context.Context) error {
+	if w.numRowsWritten > 0 {
+		if _, err := w.wr.WriteString("</tbody>\n</table>\n"); err != nil {
+			return err
+		}
+	}
+	if err := w.wr.Flush(); err != nil {
+		return err
+	}
+	return w.closer.Close()
+}
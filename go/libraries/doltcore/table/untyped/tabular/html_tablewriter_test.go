@@ -0,0 +1,74 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tabular
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+)
+
+func TestHTMLTableWriter(t *testing.T) {
+	sch := sql.Schema{
+		{Name: nameColName, Type: types.Text},
+		{Name: ageColName, Type: types.Int64},
+	}
+	ctx := context.Background()
+
+	t.Run("empty table writes nothing", func(t *testing.T) {
+		var stringWr StringBuilderCloser
+		wr := NewHTMLTableWriter(sch, &stringWr)
+		require.NoError(t, wr.Close(ctx))
+		assert.Equal(t, "", stringWr.String())
+	})
+
+	t.Run("escapes cells", func(t *testing.T) {
+		var stringWr StringBuilderCloser
+		wr := NewHTMLTableWriter(sch, &stringWr)
+		require.NoError(t, wr.WriteSqlRow(ctx, sql.Row{"<b>Michael</b>", 43}))
+		require.NoError(t, wr.WriteSqlRow(ctx, sql.Row{"Pam\nBeasley", nil}))
+		require.NoError(t, wr.Close(ctx))
+
+		expected := "<table>\n<thead>\n<tr><th>name</th><th>age</th></tr>\n</thead>\n<tbody>\n" +
+			"<tr><td>&lt;b&gt;Michael&lt;/b&gt;</td><td>43</td></tr>\n" +
+			"<tr><td>Pam<br>Beasley</td><td>NULL</td></tr>\n" +
+			"</tbody>\n</table>\n"
+		assert.Equal(t, expected, stringWr.String())
+	})
+
+	t.Run("diff rows", func(t *testing.T) {
+		var stringWr StringBuilderCloser
+		wr := NewHTMLDiffTableWriter(sch, &stringWr)
+		require.NoError(t, wr.WriteRow(ctx, sql.Row{"Jim", 29}, diff.Added, []diff.ChangeType{diff.Added, diff.Added}))
+		require.NoError(t, wr.WriteCombinedRow(ctx, sql.Row{"Pam", 25}, sql.Row{"Pam", 26}, diff.ModeContext))
+		require.NoError(t, wr.Close(ctx))
+
+		added := `class="added" style="background-color:#ccffd8"`
+		oldStyle := `class="modified-old" style="background-color:#ffebe9"`
+		newStyle := `class="modified-new" style="background-color:#e6ffec"`
+		expected := "<table>\n<thead>\n<tr><th> </th><th>name</th><th>age</th></tr>\n</thead>\n<tbody>\n" +
+			"<tr><td " + added + ">+</td><td " + added + ">Jim</td><td " + added + ">29</td></tr>\n" +
+			"<tr><td " + oldStyle + ">&lt;</td><td>Pam</td><td " + oldStyle + ">25</td></tr>\n" +
+			"<tr><td " + newStyle + ">&gt;</td><td>Pam</td><td " + newStyle + ">26</td></tr>\n" +
+			"</tbody>\n</table>\n"
+		assert.Equal(t, expected, stringWr.String())
+	})
+}
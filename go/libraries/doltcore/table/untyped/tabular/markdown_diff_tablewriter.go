@@ -0,0 +1,114 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tabular
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+)
+
+// MarkdownDiffTableWriter wraps a |MarkdownTableWriter| to provide a leading diff type column and emphasis for
+// changed cells. Added cells are written in bold and removed cells are struck through, since markdown has no colors.
+type MarkdownDiffTableWriter struct {
+	tableWriter *MarkdownTableWriter
+}
+
+var _ diff.SqlRowDiffWriter = MarkdownDiffTableWriter{}
+
+func NewMarkdownDiffTableWriter(schema sql.Schema, wr io.WriteCloser) *MarkdownDiffTableWriter {
+	// leading diff type column with empty name
+	schema = append(sql.Schema{&sql.Column{
+		Name: " ",
+		Type: types.Text,
+	}}, schema...)
+
+	return &MarkdownDiffTableWriter{
+		tableWriter: NewMarkdownTableWriter(schema, wr),
+	}
+}
+
+var markdownDiffEmphasis = map[diff.ChangeType]string{
+	diff.Added:       "**",
+	diff.ModifiedNew: "**",
+	diff.Removed:     "~~",
+	diff.ModifiedOld: "~~",
+}
+
+func (w MarkdownDiffTableWriter) WriteRow(
+	ctx context.Context,
+	row sql.Row,
+	rowDiffType diff.ChangeType,
+	colDiffTypes []diff.ChangeType,
+) error {
+	if len(row) != len(colDiffTypes) {
+		return fmt.Errorf("expected the same size for columns and diff types, got %d and %d", len(row), len(colDiffTypes))
+	}
+
+	newRow := append(sql.Row{diffMarker(rowDiffType)}, row...)
+	emphasis := make([]string, len(newRow))
+	for i, dt := range colDiffTypes {
+		emphasis[i+1] = markdownDiffEmphasis[dt]
+	}
+
+	return w.tableWriter.WriteEmphasizedSqlRow(ctx, newRow, emphasis)
+}
+
+// WriteCombinedRow writes the old and new versions of a modified row as two rows, emphasizing the cells that differ.
+// Markdown tables cannot show an inline diff of a single cell, so |mode| is ignored.
+func (w MarkdownDiffTableWriter) WriteCombinedRow(ctx context.Context, oldRow, newRow sql.Row, mode diff.Mode) error {
+	// the schema of the table writer starts with the diff type column
+	oldDiffs, newDiffs, err := combinedRowColDiffs(w.tableWriter.schema[1:], oldRow, newRow)
+	if err != nil {
+		return err
+	}
+	if err := w.WriteRow(ctx, oldRow, diff.ModifiedOld, oldDiffs); err != nil {
+		return err
+	}
+	return w.WriteRow(ctx, newRow, diff.ModifiedNew, newDiffs)
+}
+
+func (w MarkdownDiffTableWriter) Close(ctx context.Context) error {
+	return w.tableWriter.Close(ctx)
+}
+
+// combinedRowColDiffs returns the column diff types for the old and new versions of a modified row with schema |sch|,
+// marking as modified each column whose value differs between the two, as compared by the column's type.
+func combinedRowColDiffs(sch sql.Schema, oldRow, newRow sql.Row) (oldDiffs, newDiffs []diff.ChangeType, err error) {
+	oldDiffs = make([]diff.ChangeType, len(oldRow))
+	newDiffs = make([]diff.ChangeType, len(newRow))
+	for i := range oldRow {
+		modified := i >= len(newRow) || i >= len(sch)
+		if !modified {
+			cmp, err := sch[i].Type.Compare(oldRow[i], newRow[i])
+			if err != nil {
+				return nil, nil, err
+			}
+			modified = cmp != 0
+		}
+		if modified {
+			oldDiffs[i] = diff.ModifiedOld
+			if i < len(newRow) {
+				newDiffs[i] = diff.ModifiedNew
+			}
+		}
+	}
+	return oldDiffs, newDiffs, nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tabular
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table"
+)
+
+// MarkdownTableWriter writes rows as a GitHub flavored markdown table. The header is written along with the first row,
+// so nothing is written for an empty result.
+type MarkdownTableWriter struct {
+	schema         sql.Schema
+	closer         io.Closer
+	wr             *bufio.Writer
+	numRowsWritten int
+}
+
+var _ table.SqlRowWriter = (*MarkdownTableWriter)(nil)
+
+func NewMarkdownTableWriter(schema sql.Schema, wr io.WriteCloser) *MarkdownTableWriter {
+	return &MarkdownTableWriter{
+		schema: schema,
+		closer: wr,
+		wr:     bufio.NewWriterSize(wr, writeBufSize),
+	}
+}
+
+func (w *MarkdownTableWriter) WriteSqlRow(ctx context.Context, r sql.Row) error {
+	return w.WriteEmphasizedSqlRow(ctx, r, nil)
+}
+
+// WriteEmphasizedSqlRow writes the row given, wrapping each non-empty cell in the markdown emphasis delimiter at the
+// same index of |emphasis|, such as "**" or "~~". An empty delimiter leaves the cell as is.
+func (w *MarkdownTableWriter) WriteEmphasizedSqlRow(ctx context.Context, r sql.Row, emphasis []string) error {
+	if len(emphasis) > 0 && len(emphasis) != len(r) {
+		return fmt.Errorf("different sizes for row and emphasis: got %d and %d", len(r), len(emphasis))
+	}
+
+	cells := make([]string, len(r))
+	for i := range r {
+		str, err := w.stringValue(i, r[i])
+		if err != nil {
+			return err
+		}
+		str = EscapeMarkdown(str)
+		if len(emphasis) > 0 && emphasis[i] != "" && str != "" {
+			str = emphasis[i] + str + emphasis[i]
+		}
+		cells[i] = str
+	}
+
+	return w.writeRow(cells)
+}
+
+func (w *MarkdownTableWriter) stringValue(idx int, i interface{}) (string, error) {
+	if i == nil {
+		return "NULL", nil
+	}
+	return sqlutil.SqlColToStr(w.schema[idx].Type, i)
+}
+
+func (w *MarkdownTableWriter) writeRow(cells []string) error {
+	if w.numRowsWritten == 0 {
+		if err := w.writeHeader(); err != nil {
+			return err
+		}
+	}
+	w.numRowsWritten++
+	return w.writeLine(cells)
+}
+
+func (w *MarkdownTableWriter) writeHeader() error {
+	names := make([]string, len(w.schema))
+	separators := make([]string, len(w.schema))
+	for i, col := range w.schema {
+		names[i] = EscapeMarkdown(col.Name)
+		separators[i] = "---"
+	}
+	if err := w.writeLine(names); err != nil {
+		return err
+	}
+	return w.writeLine(separators)
+}
+
+func (w *MarkdownTableWriter) writeLine(cells []string) error {
+	_, err := w.wr.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+	return err
+}
+
+func (w *MarkdownTableWriter) Close(ctx context.Context) error {
+	if w.numRowsWritten > 0 {
+		if _, err := w.wr.WriteString("\n"); err != nil {
+			return err
+		}
+	}
+	if err := w.wr.Flush(); err != nil {
+		return err
+	}
+	return w.closer.Close()
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"|", `\|`,
+	"*", `\*`,
+	"_", `\_`,
+	"~", `\~`,
+	"`", "\\`",
+	"<", "&lt;",
+	">", "&gt;",
+	"\r\n", "<br>",
+	"\n", "<br>",
+)
+
+// EscapeMarkdown escapes the characters in |s| that would otherwise be interpreted as markdown formatting, or that
+// would break a markdown table cell, such as pipes and newlines.
+func EscapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tabular
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+)
+
+func TestMarkdownTableWriter(t *testing.T) {
+	sch := sql.Schema{
+		{Name: nameColName, Type: types.Text},
+		{Name: ageColName, Type: types.Int64},
+	}
+	ctx := context.Background()
+
+	t.Run("empty table writes nothing", func(t *testing.T) {
+		var stringWr StringBuilderCloser
+		wr := NewMarkdownTableWriter(sch, &stringWr)
+		require.NoError(t, wr.Close(ctx))
+		assert.Equal(t, "", stringWr.String())
+	})
+
+	t.Run("escapes cells", func(t *testing.T) {
+		var stringWr StringBuilderCloser
+		wr := NewMarkdownTableWriter(sch, &stringWr)
+		require.NoError(t, wr.WriteSqlRow(ctx, sql.Row{"Michael | Scott", 43}))
+		require.NoError(t, wr.WriteSqlRow(ctx, sql.Row{"*Pam*\nBeasley", nil}))
+		require.NoError(t, wr.Close(ctx))
+
+		expected := "| name | age |\n" +
+			"| --- | --- |\n" +
+			"| Michael \\| Scott | 43 |\n" +
+			"| \\*Pam\\*<br>Beasley | NULL |\n" +
+			"\n"
+		assert.Equal(t, expected, stringWr.String())
+	})
+
+	t.Run("diff rows", func(t *testing.T) {
+		var stringWr StringBuilderCloser
+		wr := NewMarkdownDiffTableWriter(sch, &stringWr)
+		require.NoError(t, wr.WriteRow(ctx, sql.Row{"Jim", 29}, diff.Added, []diff.ChangeType{diff.Added, diff.Added}))
+		require.NoError(t, wr.WriteRow(ctx, sql.Row{"Dwight", 30}, diff.Removed, []diff.ChangeType{diff.Removed, diff.Removed}))
+		require.NoError(t, wr.WriteCombinedRow(ctx, sql.Row{"Pam", 25}, sql.Row{"Pam", 26}, diff.ModeContext))
+		require.NoError(t, wr.Close(ctx))
+
+		expected := "|   | name | age |\n" +
+			"| --- | --- | --- |\n" +
+			"| + | **Jim** | **29** |\n" +
+			"| - | ~~Dwight~~ | ~~30~~ |\n" +
+			"| &lt; | Pam | ~~25~~ |\n" +
+			"| &gt; | Pam | **26** |\n" +
+			"\n"
+		assert.Equal(t, expected, stringWr.String())
+	})
+
+	t.Run("combined rows compare cells by type", func(t *testing.T) {
+		sch := sql.Schema{
+			{Name: nameColName, Type: types.Text},
+			{Name: "salary", Type: types.MustCreateDecimalType(10, 2)},
+		}
+		var stringWr StringBuilderCloser
+		wr := NewMarkdownDiffTableWriter(sch, &stringWr)
+		require.NoError(t, wr.WriteCombinedRow(ctx,
+			sql.Row{"Pam", decimal.RequireFromString("1.50")},
+			sql.Row{"Pamela", decimal.RequireFromString("1.5")}, diff.ModeContext))
+		require.NoError(t, wr.Close(ctx))
+
+		expected := "|   | name | salary |\n" +
+			"| --- | --- | --- |\n" +
+			"| &lt; | ~~Pam~~ | 1.50 |\n" +
+			"| &gt; | **Pamela** | 1.5 |\n" +
+			"\n"
+		assert.Equal(t, expected, stringWr.String())
+	})
+}
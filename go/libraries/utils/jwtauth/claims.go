@@ -21,4 +21,6 @@ import (
 type Claims struct {
 	jwt.Claims
 	OnBehalfOf string `json:"on_behalf_of"`
+	// Private holds every claim of the token, including the registered claims, keyed by name.
+	Private map[string]interface{} `json:"-"`
 }
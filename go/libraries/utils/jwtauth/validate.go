@@ -55,7 +55,7 @@ func ValidateJWT(unparsed string, reqTime time.Time, keyProvider KeyProvider, ex
 	var claims Claims
 	claimsError := fmt.Errorf("ValidateJWT: KeyID: %v. Err: %w", keyID, ErrKeyNotFound)
 	for _, key := range keys {
-		claimsError = parsed.Claims(key.Key, &claims)
+		claimsError = parsed.Claims(key.Key, &claims, &claims.Private)
 		if claimsError == nil {
 			break
 		}
@@ -0,0 +1,217 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package schedule parses cron-like schedules. A schedule is either a standard
+// five field cron expression, such as "*/15 * * * *", one of the descriptors
+// @yearly, @annually, @monthly, @weekly, @daily, @midnight and @hourly, or
+// "@every <duration>", where the duration is parsed by time.ParseDuration.
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule returns the times at which something which runs on a schedule is due.
+type Schedule interface {
+	// Next returns the first time after |t| at which the schedule is due.
+	Next(t time.Time) time.Time
+}
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+const everyPrefix = "@every "
+
+// Parse parses |spec| into a Schedule.
+func Parse(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if strings.HasPrefix(spec, everyPrefix) {
+		d, err := time.ParseDuration(strings.TrimSpace(spec[len(everyPrefix):]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule '%s': %w", spec, err)
+		}
+		if d < time.Second {
+			return nil, fmt.Errorf("invalid schedule '%s': interval must be at least one second", spec)
+		}
+		return every(d), nil
+	}
+	if expr, ok := descriptors[spec]; ok {
+		return parseCron(expr)
+	}
+	if strings.HasPrefix(spec, "@") {
+		return nil, fmt.Errorf("invalid schedule '%s': unknown descriptor", spec)
+	}
+	s, err := parseCron(spec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule '%s': %w", spec, err)
+	}
+	return s, nil
+}
+
+// every is a Schedule which is due at a fixed interval.
+type every time.Duration
+
+func (e every) Next(t time.Time) time.Time {
+	return t.Add(time.Duration(e))
+}
+
+// cron is a Schedule given by a five field cron expression. Each field is a
+// bit set of the values it matches.
+type cron struct {
+	minute, hour, dom, month, dow uint64
+	// If either the day of month or the day of week is restricted, cron
+	// matches days on which either of them matches.
+	domStar, dowStar bool
+}
+
+type field struct {
+	name     string
+	min, max int
+}
+
+var fields = []field{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7},
+}
+
+func parseCron(expr string) (Schedule, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != len(fields) {
+		return nil, fmt.Errorf("expected %d fields, found %d", len(fields), len(parts))
+	}
+
+	var sets [5]uint64
+	for i, part := range parts {
+		set, err := parseField(part, fields[i])
+		if err != nil {
+			return nil, err
+		}
+		sets[i] = set
+	}
+
+	// Sunday is both 0 and 7
+	if sets[4]&(1<<7) != 0 {
+		sets[4] |= 1
+	}
+
+	return &cron{
+		minute:  sets[0],
+		hour:    sets[1],
+		dom:     sets[2],
+		month:   sets[3],
+		dow:     sets[4],
+		domStar: parts[2] == "*",
+		dowStar: parts[4] == "*",
+	}, nil
+}
+
+// parseField parses a comma separated list of values, ranges and steps, such
+// as "1,5-10,*/15", into the set of values it matches.
+func parseField(s string, f field) (uint64, error) {
+	var set uint64
+	for _, item := range strings.Split(s, ",") {
+		rng, stepStr, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepStr)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step '%s' in %s field", stepStr, f.name)
+			}
+		}
+
+		lo, hi := f.min, f.max
+		if rng != "*" {
+			loStr, hiStr, isRange := strings.Cut(rng, "-")
+			var err error
+			lo, err = parseValue(loStr, f)
+			if err != nil {
+				return 0, err
+			}
+			hi = lo
+			if isRange {
+				hi, err = parseValue(hiStr, f)
+				if err != nil {
+					return 0, err
+				}
+				if hi < lo {
+					return 0, fmt.Errorf("invalid range '%s' in %s field", rng, f.name)
+				}
+			} else if hasStep {
+				hi = f.max
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			set |= 1 << v
+		}
+	}
+	return set, nil
+}
+
+func parseValue(s string, f field) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid value '%s' in %s field; must be between %d and %d", s, f.name, f.min, f.max)
+	}
+	return v, nil
+}
+
+// maxSearch bounds how far Next looks for a time matching the schedule, so
+// that schedules which never match, such as "0 0 31 2 *", don't loop forever.
+const maxSearch = 5 * 366 * 24 * time.Hour
+
+// Next returns the first minute after |t| which matches the expression, or the
+// zero time if there isn't one within the next five years.
+func (c *cron) Next(t time.Time) time.Time {
+	end := t.Add(maxSearch)
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	for t.Before(end) {
+		switch {
+		case c.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !c.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case c.hour&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case c.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (c *cron) dayMatches(t time.Time) bool {
+	domMatch := c.dom&(1<<uint(t.Day())) != 0
+	dowMatch := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNext(t *testing.T) {
+	// A Wednesday
+	start := time.Date(2024, time.March, 13, 10, 7, 30, 0, time.UTC)
+
+	tests := []struct {
+		spec string
+		next time.Time
+	}{
+		{"@every 90m", start.Add(90 * time.Minute)},
+		{"@hourly", time.Date(2024, time.March, 13, 11, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2024, time.March, 14, 0, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2024, time.March, 17, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		{"@yearly", time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{"* * * * *", time.Date(2024, time.March, 13, 10, 8, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2024, time.March, 13, 10, 15, 0, 0, time.UTC)},
+		{"5 */6 * * *", time.Date(2024, time.March, 13, 12, 5, 0, 0, time.UTC)},
+		{"30 2 * * 1-5", time.Date(2024, time.March, 14, 2, 30, 0, 0, time.UTC)},
+		{"0 3 * * 7", time.Date(2024, time.March, 17, 3, 0, 0, 0, time.UTC)},
+		{"0 0 1,15 * *", time.Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC)},
+		// Either the day of month or the day of week matches
+		{"0 0 20 * 4", time.Date(2024, time.March, 14, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 31 2 *", time.Time{}},
+	}
+	for _, test := range tests {
+		t.Run(test.spec, func(t *testing.T) {
+			s, err := Parse(test.spec)
+			require.NoError(t, err)
+			assert.Equal(t, test.next, s.Next(start))
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"@every",
+		"@every 1x",
+		"@every 10ms",
+		"@fortnightly",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"10-5 * * * *",
+		"a * * * *",
+	} {
+		t.Run(spec, func(t *testing.T) {
+			_, err := Parse(spec)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -154,17 +154,20 @@ func validateDatasetIdComponent(refname string) (int, error) {
 }
 
 type WorkingSetHead struct {
-	Meta        *WorkingSetMeta
-	WorkingAddr hash.Hash
-	StagedAddr  *hash.Hash
-	MergeState  *MergeState
-	RebaseState *RebaseState
+	Meta            *WorkingSetMeta
+	WorkingAddr     hash.Hash
+	StagedAddr      *hash.Hash
+	MergeState      *MergeState
+	RebaseState     *RebaseState
+	CherryPickState *CherryPickState
 }
 
 type RebaseState struct {
 	preRebaseWorkingAddr *hash.Hash
 	ontoCommitAddr       *hash.Hash
 	branch               string
+	lastAttemptedStep    int64
+	rebasingStarted      bool
 }
 
 func (rs *RebaseState) PreRebaseWorkingAddr() hash.Hash {
@@ -179,6 +182,14 @@ func (rs *RebaseState) Branch(_ context.Context) string {
 	return rs.branch
 }
 
+func (rs *RebaseState) LastAttemptedStep(_ context.Context) int64 {
+	return rs.lastAttemptedStep
+}
+
+func (rs *RebaseState) RebasingStarted(_ context.Context) bool {
+	return rs.rebasingStarted
+}
+
 func (rs *RebaseState) OntoCommit(ctx context.Context, vr types.ValueReader) (*Commit, error) {
 	if rs.ontoCommitAddr != nil {
 		return LoadCommitAddr(ctx, vr, *rs.ontoCommitAddr)
@@ -186,6 +197,18 @@ func (rs *RebaseState) OntoCommit(ctx context.Context, vr types.ValueReader) (*C
 	return nil, nil
 }
 
+// CherryPickState records the commits which are still to be applied by a cherry-pick of several commits that
+// stopped to let the user resolve conflicts.
+type CherryPickState struct {
+	remainingCommitAddrs []hash.Hash
+}
+
+// RemainingCommitAddrs returns the addresses of the commits still to be cherry-picked, in the order they will be
+// applied.
+func (cs *CherryPickState) RemainingCommitAddrs() []hash.Hash {
+	return cs.remainingCommitAddrs
+}
+
 type MergeState struct {
 	preMergeWorkingAddr *hash.Hash
 	fromCommitAddr      *hash.Hash
@@ -433,7 +456,22 @@ func (h serialWorkingSetHead) HeadWorkingSet() (*WorkingSetHead, error) {
 		ret.RebaseState = NewRebaseState(
 			hash.New(rebaseState.PreWorkingRootAddrBytes()),
 			hash.New(rebaseState.OntoCommitAddrBytes()),
-			string(rebaseState.BranchBytes()))
+			string(rebaseState.BranchBytes()),
+			rebaseState.LastAttemptedStep(),
+			rebaseState.RebasingStarted())
+	}
+
+	cherryPickState, err := h.msg.TryCherryPickState(nil)
+	if err != nil {
+		return nil, err
+	}
+	if cherryPickState != nil {
+		addrs := cherryPickState.RemainingCommitAddrsBytes()
+		remaining := make([]hash.Hash, len(addrs)/hash.ByteLen)
+		for i := range remaining {
+			remaining[i] = hash.New(addrs[i*hash.ByteLen : (i+1)*hash.ByteLen])
+		}
+		ret.CherryPickState = NewCherryPickState(remaining)
 	}
 
 	return &ret, nil
@@ -82,11 +82,12 @@ func workingSetMetaFromWorkingSetSt(workingSetSt types.Struct) (*WorkingSetMeta,
 var mergeStateTemplate = types.MakeStructTemplate(mergeStateName, []string{mergeStateCommitField, mergeStateCommitSpecField, mergeStateWorkingPreMergeField})
 
 type WorkingSetSpec struct {
-	Meta        *WorkingSetMeta
-	WorkingRoot types.Ref
-	StagedRoot  types.Ref
-	MergeState  *MergeState
-	RebaseState *RebaseState
+	Meta            *WorkingSetMeta
+	WorkingRoot     types.Ref
+	StagedRoot      types.Ref
+	MergeState      *MergeState
+	RebaseState     *RebaseState
+	CherryPickState *CherryPickState
 }
 
 // newWorkingSet creates a new working set object.
@@ -112,10 +113,11 @@ func newWorkingSet(ctx context.Context, db *database, workingSetSpec WorkingSetS
 	stagedRef := workingSetSpec.StagedRoot
 	mergeState := workingSetSpec.MergeState
 	rebaseState := workingSetSpec.RebaseState
+	cherryPickState := workingSetSpec.CherryPickState
 
 	if db.Format().UsesFlatbuffers() {
 		stagedAddr := stagedRef.TargetHash()
-		data := workingset_flatbuffer(workingRef.TargetHash(), &stagedAddr, mergeState, rebaseState, meta)
+		data := workingset_flatbuffer(workingRef.TargetHash(), &stagedAddr, mergeState, rebaseState, cherryPickState, meta)
 
 		r, err := db.WriteValue(ctx, types.SerialMessage(data))
 		if err != nil {
@@ -163,10 +165,10 @@ func newWorkingSet(ctx context.Context, db *database, workingSetSpec WorkingSetS
 }
 
 // workingset_flatbuffer creates a flatbuffer message for working set metadata.
-func workingset_flatbuffer(working hash.Hash, staged *hash.Hash, mergeState *MergeState, rebaseState *RebaseState, meta *WorkingSetMeta) serial.Message {
+func workingset_flatbuffer(working hash.Hash, staged *hash.Hash, mergeState *MergeState, rebaseState *RebaseState, cherryPickState *CherryPickState, meta *WorkingSetMeta) serial.Message {
 	builder := flatbuffers.NewBuilder(1024)
 	workingoff := builder.CreateByteVector(working[:])
-	var stagedOff, mergeStateOff, rebaseStateOffset flatbuffers.UOffsetT
+	var stagedOff, mergeStateOff, rebaseStateOffset, cherryPickStateOffset flatbuffers.UOffsetT
 	if staged != nil {
 		stagedOff = builder.CreateByteVector((*staged)[:])
 	}
@@ -192,9 +194,22 @@ func workingset_flatbuffer(working hash.Hash, staged *hash.Hash, mergeState *Mer
 		serial.RebaseStateAddPreWorkingRootAddr(builder, preRebaseRootAddrOffset)
 		serial.RebaseStateAddBranch(builder, branchOffset)
 		serial.RebaseStateAddOntoCommitAddr(builder, ontoAddrOffset)
+		serial.RebaseStateAddLastAttemptedStep(builder, rebaseState.lastAttemptedStep)
+		serial.RebaseStateAddRebasingStarted(builder, rebaseState.rebasingStarted)
 		rebaseStateOffset = serial.RebaseStateEnd(builder)
 	}
 
+	if cherryPickState != nil {
+		remaining := make([]byte, 0, len(cherryPickState.remainingCommitAddrs)*hash.ByteLen)
+		for _, addr := range cherryPickState.remainingCommitAddrs {
+			remaining = append(remaining, addr[:]...)
+		}
+		remainingOffset := builder.CreateByteVector(remaining)
+		serial.CherryPickStateStart(builder)
+		serial.CherryPickStateAddRemainingCommitAddrs(builder, remainingOffset)
+		cherryPickStateOffset = serial.CherryPickStateEnd(builder)
+	}
+
 	var nameOff, emailOff, descOff flatbuffers.UOffsetT
 	if meta != nil {
 		nameOff = builder.CreateString(meta.Name)
@@ -213,6 +228,9 @@ func workingset_flatbuffer(working hash.Hash, staged *hash.Hash, mergeState *Mer
 	if rebaseStateOffset != 0 {
 		serial.WorkingSetAddRebaseState(builder, rebaseStateOffset)
 	}
+	if cherryPickStateOffset != 0 {
+		serial.WorkingSetAddCherryPickState(builder, cherryPickStateOffset)
+	}
 
 	if meta != nil {
 		serial.WorkingSetAddName(builder, nameOff)
@@ -260,14 +278,20 @@ func NewMergeState(
 	}
 }
 
-func NewRebaseState(preRebaseWorkingRoot hash.Hash, commitAddr hash.Hash, branch string) *RebaseState {
+func NewRebaseState(preRebaseWorkingRoot hash.Hash, commitAddr hash.Hash, branch string, lastAttemptedStep int64, rebasingStarted bool) *RebaseState {
 	return &RebaseState{
 		preRebaseWorkingAddr: &preRebaseWorkingRoot,
 		ontoCommitAddr:       &commitAddr,
 		branch:               branch,
+		lastAttemptedStep:    lastAttemptedStep,
+		rebasingStarted:      rebasingStarted,
 	}
 }
 
+func NewCherryPickState(remainingCommitAddrs []hash.Hash) *CherryPickState {
+	return &CherryPickState{remainingCommitAddrs: remainingCommitAddrs}
+}
+
 func IsWorkingSet(v types.Value) (bool, error) {
 	if s, ok := v.(types.Struct); ok {
 		// We're being more lenient here than in other checks, to make it more likely we can release changes to the
@@ -30,6 +30,8 @@ import (
 	"path/filepath"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+
 	"github.com/dolthub/dolt/go/store/hash"
 )
 
@@ -208,10 +210,15 @@ func (fra *fileReaderAt) Reader(ctx context.Context) (io.ReadCloser, error) {
 }
 
 func (fra *fileReaderAt) ReadAtWithStats(ctx context.Context, p []byte, off int64, stats *Stats) (n int, err error) {
+	_, span := tracer.Start(ctx, "nbs.fileReaderAt.ReadAt")
+	if span.IsRecording() {
+		span.SetAttributes(attribute.String("path", fra.path), attribute.Int64("offset", off), attribute.Int("bytes", len(p)))
+	}
 	t1 := time.Now()
 	defer func() {
 		stats.FileBytesPerRead.Sample(uint64(len(p)))
 		stats.FileReadLatency.SampleTimeSince(t1)
+		span.End()
 	}()
 	return fra.f.ReadAt(p, off)
 }
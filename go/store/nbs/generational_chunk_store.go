@@ -307,6 +307,13 @@ func (gcs *GenerationalNBS) Stats() interface{} {
 	return nil
 }
 
+// ChunksRequested returns the number of chunks which have been requested from
+// the old and new generations of this store. A chunk which is not found in the
+// old generation is counted again when it is requested from the new one.
+func (gcs *GenerationalNBS) ChunksRequested() uint64 {
+	return gcs.oldGen.ChunksRequested() + gcs.newGen.ChunksRequested()
+}
+
 // StatsSummary may return a string containing summarized statistics for
 // this ChunkStore. It must return "Unsupported" if this operation is not
 // supported.
@@ -28,6 +28,7 @@ import (
 
 	"github.com/dolthub/swiss"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/dolthub/dolt/go/store/chunks"
@@ -464,6 +465,8 @@ func (wr *journalWriter) commitRootHash(ctx context.Context, root hash.Hash) err
 
 func (wr *journalWriter) commitRootHashUnlocked(ctx context.Context, root hash.Hash) error {
 	defer trace.StartRegion(ctx, "commit-root").End()
+	ctx, span := tracer.Start(ctx, "nbs.journalWriter.commitRootHash")
+	defer span.End()
 
 	buf, err := wr.getBytes(ctx, rootHashRecordSize())
 	if err != nil {
@@ -552,6 +555,11 @@ func (wr *journalWriter) getBytes(ctx context.Context, n int) (buf []byte, err e
 // flush writes buffered data into the journal file.
 func (wr *journalWriter) flush(ctx context.Context) (err error) {
 	defer trace.StartRegion(ctx, "flush journal").End()
+	_, span := tracer.Start(ctx, "nbs.journalWriter.flush")
+	if span.IsRecording() {
+		span.SetAttributes(attribute.Int64("offset", wr.off), attribute.Int("bytes", len(wr.buf)))
+	}
+	defer span.End()
 	if _, err = wr.journal.WriteAt(wr.buf, wr.off); err != nil {
 		return err
 	}
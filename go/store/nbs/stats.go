@@ -24,6 +24,7 @@ package nbs
 import (
 	"fmt"
 
+	"github.com/dolthub/dolt/go/store/chunks"
 	"github.com/dolthub/dolt/go/store/metrics"
 )
 
@@ -98,6 +99,18 @@ func NewStats() *Stats {
 	}
 }
 
+// ChunksRequested returns the number of chunks which have been requested from |cs| if it is backed by table files, and
+// 0 otherwise. Unlike Stats(), it only copies the one histogram it needs, so it is cheap enough to sample around every query.
+func ChunksRequested(cs chunks.ChunkStore) uint64 {
+	switch cs := cs.(type) {
+	case *NomsBlockStore:
+		return cs.ChunksRequested()
+	case *GenerationalNBS:
+		return cs.ChunksRequested()
+	}
+	return 0
+}
+
 func (s *Stats) Clone() Stats {
 	return Stats{
 		*s.OpenLatency.Clone(),
@@ -202,3 +215,45 @@ WriteManifestLatency:             %s
 		s.ReadManifestLatency,
 		s.WriteManifestLatency)
 }
+
+// StorageStats describes the table files of a chunk store, for deciding when it is worth collecting its garbage.
+type StorageStats struct {
+	// Size is the total size, in bytes, of the table files of every generation of the store.
+	Size uint64
+	// NewGenTableFiles is the number of table files in the new generation of the store, including the chunk journal.
+	// A store which is not generational has only a new generation.
+	NewGenTableFiles int
+	// JournalSize is the size, in bytes, of the chunk journal, or 0 if the store does not have one.
+	JournalSize uint64
+}
+
+// GetStorageStats returns the StorageStats of |cs|, and false if it is not backed by table files.
+func GetStorageStats(cs chunks.ChunkStore) (StorageStats, bool) {
+	switch cs := cs.(type) {
+	case *NomsBlockStore:
+		return cs.storageStats(), true
+	case *GenerationalNBS:
+		stats := cs.newGen.storageStats()
+		stats.Size += cs.oldGen.storageStats().Size
+		return stats, true
+	}
+	return StorageStats{}, false
+}
+
+func (nbs *NomsBlockStore) storageStats() StorageStats {
+	nbs.mu.Lock()
+	defer nbs.mu.Unlock()
+
+	var stats StorageStats
+	for _, css := range []chunkSourceSet{nbs.tables.upstream, nbs.tables.novel} {
+		for _, cs := range css {
+			size := cs.currentSize()
+			stats.Size += size
+			stats.NewGenTableFiles++
+			if cs.hash() == journalAddr {
+				stats.JournalSize = size
+			}
+		}
+	}
+	return stats
+}
@@ -1334,6 +1334,13 @@ func (nbs *NomsBlockStore) Stats() interface{} {
 	return nbs.stats.Clone()
 }
 
+// ChunksRequested returns the number of chunks which have been requested from this store with Get and GetMany since
+// it was opened. The count is for the whole store, so it includes the chunks requested by every session and
+// background process using it, and isn't attributed to any one of them.
+func (nbs *NomsBlockStore) ChunksRequested() uint64 {
+	return nbs.stats.ChunksPerGet.Clone().Sum()
+}
+
 func (nbs *NomsBlockStore) StatsSummary() string {
 	nbs.mu.Lock()
 	defer nbs.mu.Unlock()
@@ -577,6 +577,18 @@ func (sm SerialMessage) WalkAddrs(nbf *NomsBinFormat, cb func(addr hash.Hash) er
 				return err
 			}
 		}
+		cherryPickState, err := msg.TryCherryPickState(nil)
+		if err != nil {
+			return err
+		}
+		if cherryPickState != nil {
+			addrs := cherryPickState.RemainingCommitAddrsBytes()
+			for i := 0; i < len(addrs)/hash.ByteLen; i++ {
+				if err = cb(hash.New(addrs[i*hash.ByteLen : (i+1)*hash.ByteLen])); err != nil {
+					return err
+				}
+			}
+		}
 	case serial.RootValueFileID:
 		var msg serial.RootValue
 		err := serial.InitRootValueRoot(&msg, []byte(sm), serial.MessagePrefixSz)
@@ -1811,3 +1811,75 @@ SQL
     [ $status -eq 1 ]
     [[ $output =~ "invalid Arguments" ]] || false
 }
+
+@test "diff: --json-paths shows changed paths within json columns" {
+    dolt sql -q "create table cfg (id int primary key, doc json, note varchar(20));"
+    dolt sql -q "insert into cfg values (1, '{\"a\": 1, \"b\": {\"c\": 2}}', 'x'), (2, '{\"k\": \"v\"}', 'y');"
+    dolt commit -Am "add cfg"
+    dolt sql -q "update cfg set doc = '{\"a\": 1, \"b\": {\"c\": 3}, \"d\": [1]}' where id = 1;"
+    dolt sql -q "update cfg set note = 'z' where id = 2;"
+
+    run dolt diff --json-paths
+    [ $status -eq 0 ]
+    [[ $output =~ "JSON paths changed in column doc" ]] || false
+    [[ $output =~ '| [1]         | $."b"."c" | modified  | 2          | 3        |' ]] || false
+    [[ $output =~ '| [1]         | $."d"     | added     | NULL       | [1]      |' ]] || false
+    ! [[ $output =~ '[2]' ]] || false
+
+    run dolt diff
+    [ $status -eq 0 ]
+    ! [[ $output =~ "JSON paths changed" ]] || false
+
+    run dolt diff --json-paths -r json
+    [ $status -eq 1 ]
+    [[ $output =~ "invalid Arguments" ]] || false
+
+    run dolt diff --json-paths --stat
+    [ $status -eq 1 ]
+    [[ $output =~ "invalid Arguments" ]] || false
+}
+
+@test "diff: markdown and html output formats" {
+    dolt sql -q "create table people (pk int primary key, name varchar(20));"
+    dolt sql -q "insert into people values (1, 'alice'), (2, 'bob');"
+    dolt commit -Am "add people"
+    dolt sql -q "update people set name = 'bobby' where pk = 2;"
+    dolt sql -q "insert into people values (3, 'c|d');"
+
+    run dolt diff -r markdown
+    [ $status -eq 0 ]
+    [[ $output =~ '### `people`' ]] || false
+    [[ $output =~ '|   | pk | name |' ]] || false
+    [[ $output =~ '| &lt; | 2 | ~~bob~~ |' ]] || false
+    [[ $output =~ '| &gt; | 2 | **bobby** |' ]] || false
+    [[ $output =~ '| + | **3** | **c\|d** |' ]] || false
+
+    run dolt diff -r html
+    [ $status -eq 0 ]
+    [[ $output =~ '<h3><code>people</code></h3>' ]] || false
+    [[ $output =~ '<td class="modified-new" style="background-color:#e6ffec">bobby</td>' ]] || false
+    [[ $output =~ '</table>' ]] || false
+
+    run dolt diff -r markdown --stat
+    [ $status -eq 0 ]
+    [[ $output =~ "- 1 Row Modified (50.00%)" ]] || false
+
+    run dolt diff -r html --summary
+    [ $status -eq 0 ]
+    [[ $output =~ "<tr><td>people</td><td>modified</td><td>true</td><td>false</td></tr>" ]] || false
+
+    dolt sql -q "alter table people add column age int;"
+    run dolt diff -r markdown --schema
+    [ $status -eq 0 ]
+    [[ $output =~ '```diff' ]] || false
+    [[ $output =~ '+  `age` int,' ]] || false
+
+    dolt commit -am "bobby"
+    run dolt show -r html
+    [ $status -eq 0 ]
+    [[ $output =~ '<h3><code>people</code></h3>' ]] || false
+
+    run dolt show -r markdown
+    [ $status -eq 0 ]
+    [[ $output =~ '**bobby**' ]] || false
+}
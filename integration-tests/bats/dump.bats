@@ -982,6 +982,125 @@ SQL
     # need to test binary, bit and blob types
 }
 
+@test "dump: dump at a revision" {
+    dolt sql -q "CREATE TABLE t (pk int PRIMARY KEY, c varchar(10));"
+    dolt sql -q "INSERT INTO t VALUES (1, 'a'), (2, 'b');"
+    dolt sql -q "CREATE VIEW v1 AS SELECT * FROM t;"
+    dolt add .
+    dolt commit -m "first"
+    dolt tag v1
+    dolt sql -q "INSERT INTO t VALUES (3, 'c');"
+    dolt sql -q "CREATE TABLE newtable (id int PRIMARY KEY);"
+    dolt sql -q "DROP VIEW v1;"
+
+    run dolt dump v1
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Successfully exported data." ]] || false
+    run grep -c INSERT doltdump.sql
+    [ "$output" -eq 1 ]
+    run cat doltdump.sql
+    [[ "$output" =~ "(1,'a'), (2,'b')" ]] || false
+    [[ "$output" =~ "CREATE VIEW v1" ]] || false
+    [[ ! "$output" =~ "newtable" ]] || false
+
+    run dolt dump -r csv v1
+    [ "$status" -eq 0 ]
+    [ -f doltdump/t.csv ]
+    [ ! -f doltdump/newtable.csv ]
+    run cat doltdump/t.csv
+    [ "${#lines[@]}" -eq 3 ]
+
+    run dolt dump -f doesnotexist
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "unable to resolve revision 'doesnotexist'" ]] || false
+}
+
+@test "dump: incremental dump with --since" {
+    dolt sql -q "CREATE TABLE t (pk int PRIMARY KEY, c varchar(10));"
+    dolt sql -q "INSERT INTO t VALUES (1, 'a'), (2, 'b');"
+    dolt sql -q "CREATE TABLE dropped (pk int PRIMARY KEY);"
+    dolt add .
+    dolt commit -m "first"
+    dolt tag v1
+    dolt sql -q "UPDATE t SET c = 'z' WHERE pk = 1;"
+    dolt sql -q "DELETE FROM t WHERE pk = 2;"
+    dolt sql -q "INSERT INTO t VALUES (3, 'c');"
+    dolt sql -q "ALTER TABLE t ADD COLUMN e int;"
+    dolt sql -q "DROP TABLE dropped;"
+    dolt add .
+    dolt commit -m "second"
+
+    run dolt dump --since v1 HEAD
+    [ "$status" -eq 0 ]
+    run cat doltdump.sql
+    [[ "$output" =~ "DROP TABLE \`dropped\`;" ]] || false
+    [[ "$output" =~ "ALTER TABLE \`t\` ADD \`e\` int;" ]] || false
+    [[ "$output" =~ "UPDATE \`t\` SET \`c\`='z' WHERE \`pk\`=1;" ]] || false
+    [[ "$output" =~ "DELETE FROM \`t\` WHERE \`pk\`=2;" ]] || false
+    [[ "$output" =~ "INSERT INTO \`t\` (\`pk\`,\`c\`,\`e\`) VALUES (3,'c',NULL);" ]] || false
+
+    # applying the incremental dump to the old revision gives the new one
+    dolt checkout -b replay v1
+    dolt sql < doltdump.sql
+    run dolt diff main --stat
+    [ "$status" -eq 0 ]
+    [ "$output" = "" ]
+    dolt reset --hard
+    dolt checkout main
+
+    # without a revision, changes up to the working set are dumped
+    dolt sql -q "INSERT INTO t VALUES (4, 'd', 4);"
+    run dolt dump -f --since v1
+    [ "$status" -eq 0 ]
+    run cat doltdump.sql
+    [[ "$output" =~ "VALUES (4,'d',4);" ]] || false
+
+    run dolt dump -r csv --since v1 HEAD
+    [ "$status" -eq 0 ]
+    [ -f doltdump/t.csv ]
+    # tables without changed rows have no file
+    [ ! -f doltdump/dropped.csv ]
+    run cat doltdump/t.csv
+    [ "${lines[0]}" = "diff_type,pk,c,e" ]
+    [ "${lines[1]}" = "modified,1,z," ]
+    [ "${lines[2]}" = "removed,2,b," ]
+    [ "${lines[3]}" = "added,3,c," ]
+
+    run dolt dump -r json --since v1
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "since is not supported for json exports" ]] || false
+}
+
+@test "dump: incremental dump with --since writes rows after the schema changes of their table" {
+    dolt sql -q "CREATE TABLE t (pk int PRIMARY KEY, a int, b varchar(10));"
+    dolt sql -q "INSERT INTO t VALUES (1, 1, 'a'), (2, 2, 'b');"
+    dolt add .
+    dolt commit -m "first"
+    dolt tag v1
+    dolt sql -q "ALTER TABLE t DROP COLUMN a;"
+    dolt sql -q "ALTER TABLE t ADD COLUMN c int;"
+    dolt sql -q "UPDATE t SET b = 'z', c = 5 WHERE pk = 1;"
+    dolt sql -q "INSERT INTO t VALUES (3, 'c', 3);"
+    dolt add .
+    dolt commit -m "second"
+
+    run dolt dump --since v1 HEAD
+    [ "$status" -eq 0 ]
+    run cat doltdump.sql
+    [[ "$output" =~ "ALTER TABLE \`t\` DROP \`a\`;" ]] || false
+    [[ "$output" =~ "UPDATE \`t\` SET \`b\`='z',\`c\`=5 WHERE \`pk\`=1;" ]] || false
+    [[ "$output" =~ "INSERT INTO \`t\` (\`pk\`,\`b\`,\`c\`) VALUES (3,'c',3);" ]] || false
+
+    dolt checkout -b replay v1
+    dolt sql < doltdump.sql
+    run dolt sql -q "SELECT * FROM t ORDER BY pk;" -r csv
+    [ "$status" -eq 0 ]
+    [ "${lines[0]}" = "pk,b,c" ]
+    [ "${lines[1]}" = "1,z,5" ]
+    [ "${lines[2]}" = "2,b," ]
+    [ "${lines[3]}" = "3,c,3" ]
+}
+
 function create_tables() {
   dolt sql -q "CREATE TABLE new_table(pk int primary key);"
   dolt sql -q "CREATE TABLE warehouse(warehouse_id int primary key, warehouse_name varchar(100));"
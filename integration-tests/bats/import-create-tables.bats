@@ -916,3 +916,115 @@ DELIM
     [ "$status" -eq 1 ]
     [[ "$output" =~ "parameters all-text and schema are mutually exclusive" ]] || false
 }
+
+@test "import-create-tables: create table from parquet file without a schema file" {
+    dolt sql -q "CREATE TABLE test (pk BIGINT PRIMARY KEY, name VARCHAR(20), created DATETIME, score DOUBLE);"
+    dolt sql -q "INSERT INTO test VALUES (1, 'one', '2020-04-09 11:11:11', 1.5), (2, NULL, '2021-01-01 00:00:00', NULL);"
+    dolt table export test test.parquet
+
+    run dolt table import -c --pk=pk test2 test.parquet
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Import completed successfully." ]] || false
+
+    run dolt schema show test2
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ '`pk` bigint NOT NULL' ]] || false
+    [[ "$output" =~ '`created` datetime(6)' ]] || false
+    [[ "$output" =~ '`score` double' ]] || false
+
+    run dolt sql -q "SELECT pk, name, created, score FROM test2 ORDER BY pk" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "1,one,2020-04-09 11:11:11,1.5" ]] || false
+    [[ "$output" =~ "2,,2021-01-01 00:00:00," ]] || false
+}
+
+@test "import-create-tables: --flatten-structs requires creating a table from a parquet file" {
+    cat <<DELIM > test.csv
+id,name
+1,one
+DELIM
+
+    run dolt table import -c --flatten-structs --pk=id test test.csv
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "--flatten-structs is only supported when creating a table from a parquet file" ]] || false
+}
+
+@test "import-create-tables: create table from fixed-width file with a spec" {
+    cat <<YAML > layout.yaml
+skip_lines: 1
+columns:
+  - name: id
+    start: 1
+    length: 6
+    type: int
+    trim_chars: "0 "
+  - name: name
+    start: 7
+    length: 10
+  - name: amount
+    start: 17
+    length: 8
+    type: decimal(8,2)
+YAML
+    cat <<DELIM > feed.txt
+ID    NAME      AMOUNT
+000001Alice        12.50
+000002Bob        notanum
+000003Carol       100.00
+000000Zero          0.00
+000010Ten          10.00
+000100Hundred     100.00
+DELIM
+
+    run dolt table import -c --pk=id --file-type fixedwidth feed feed.txt
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "Please specify a spec file with --spec for fixed-width files." ]] || false
+
+    run dolt table import -c --pk=id --file-type fixedwidth --spec layout.yaml feed feed.txt
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "line 3: column 'amount' cannot convert 'notanum'" ]] || false
+    [[ "$output" =~ "(on line 3)" ]] || false
+
+    run dolt table import -c --pk=id --continue --file-type fixedwidth --spec layout.yaml feed feed.txt
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "The following rows were skipped:" ]] || false
+    [[ "$output" =~ '[2,Bob,notanum]' ]] || false
+    [[ "$output" =~ "line 3: column 'amount' cannot convert 'notanum'" ]] || false
+    [[ "$output" =~ "Rows Processed: 5, Additions: 5" ]] || false
+
+    run dolt schema show feed
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ '`name` varchar(10)' ]] || false
+    [[ "$output" =~ '`amount` decimal(8,2)' ]] || false
+
+    run dolt sql -q "SELECT * FROM feed ORDER BY id" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "1,Alice,12.50" ]] || false
+    [[ "$output" =~ "0,Zero,0.00" ]] || false
+    [[ "$output" =~ "3,Carol,100.00" ]] || false
+    [[ "$output" =~ "10,Ten,10.00" ]] || false
+    [[ "$output" =~ "100,Hundred,100.00" ]] || false
+}
+
+@test "import-create-tables: create table from delimited file with a spec" {
+    cat <<YAML > layout.yaml
+delimiter: "|"
+columns:
+  - name: id
+    type: int
+  - name: name
+    trim: none
+YAML
+    cat <<DELIM > feed.txt
+1|Alice
+2|"Bob|""Bobby"""
+DELIM
+
+    run dolt table import -c --pk=id --file-type fixedwidth --spec layout.yaml feed feed.txt
+    [ "$status" -eq 0 ]
+
+    run dolt sql -q "SELECT id FROM feed WHERE name = 'Bob|\"Bobby\"'" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "2" ]] || false
+    [[ ! "$output" =~ "1" ]] || false
+}
@@ -45,10 +45,42 @@ setupCustomEditorScript() {
     [[ "$output" =~ "no rebase in progress" ]] || false
 }
 
-@test "rebase: -i flag required" {
-    run dolt rebase b1
-    [ "$status" -eq 1 ]
-    [[ "$output" =~ "non-interactive rebases not currently supported" ]] || false
+@test "rebase: non-interactive rebase" {
+    dolt checkout b1
+    run dolt rebase main
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Successfully rebased and updated refs/heads/b1" ]] || false
+
+    run dolt log
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "b1 commit 1" ]] || false
+    [[ "$output" =~ "main commit 2" ]] || false
+
+    run dolt branch
+    [ "$status" -eq 0 ]
+    ! [[ "$output" =~ "dolt_rebase_b1" ]] || false
+}
+
+@test "rebase: rebase onto a new base" {
+    dolt checkout -b b2 b1
+    dolt sql -q "CREATE table t3 (pk int primary key);"
+    dolt add t3
+    dolt commit -m "b2 commit 1"
+
+    run dolt rebase --onto main b1
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Successfully rebased and updated refs/heads/b2" ]] || false
+
+    run dolt log
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "b2 commit 1" ]] || false
+    [[ "$output" =~ "main commit 2" ]] || false
+    ! [[ "$output" =~ "b1 commit 1" ]] || false
+
+    run dolt sql -q "show tables"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "t3" ]] || false
+    ! [[ "$output" =~ "t2" ]] || false
 }
 
 @test "rebase: bad args" {
@@ -314,6 +346,53 @@ setupCustomEditorScript() {
     [[ "$output" =~ "main commit 2" ]] || false
 }
 
+@test "rebase: edit and exec actions stop the rebase" {
+    setupCustomEditorScript "editExecPlan.txt"
+
+    dolt checkout b1
+    dolt sql -q "INSERT INTO t2 VALUES (1);"
+    dolt commit -am "b1 commit 2"
+    run dolt show head~1
+    [ "$status" -eq 0 ]
+    COMMIT1=${lines[0]:12:32}
+    run dolt show head
+    [ "$status" -eq 0 ]
+    COMMIT2=${lines[0]:12:32}
+
+    touch editExecPlan.txt
+    echo "edit $COMMIT1 b1 commit 1" >> editExecPlan.txt
+    echo "pick $COMMIT2 b1 commit 2" >> editExecPlan.txt
+    echo "exec select count(*) = 1 from t2" >> editExecPlan.txt
+
+    run dolt rebase -i main
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Stopped at commit $COMMIT1" ]] || false
+    [[ "$output" =~ "dolt rebase --continue" ]] || false
+
+    dolt sql -q "INSERT INTO t2 VALUES (0);"
+    run dolt rebase --continue
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "exec failed: select count(*) = 1 from t2: assertion returned false" ]] || false
+
+    dolt sql -q "DELETE FROM t2 WHERE pk = 0;"
+    run dolt rebase --continue
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Successfully rebased and updated refs/heads/b1" ]] || false
+
+    run dolt sql -q "select * from t2" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "1" ]] || false
+    ! [[ "$output" =~ "0" ]] || false
+
+    run dolt show head~1
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "b1 commit 1" ]] || false
+
+    run dolt branch
+    [ "$status" -eq 0 ]
+    ! [[ "$output" =~ "dolt_rebase_b1" ]] || false
+}
+
 @test "rebase: rebase skips merge commits" {
     setupCustomEditorScript
 
@@ -4,7 +4,8 @@ go 1.22.2
 
 require (
 	github.com/dolthub/dolt/go v0.40.4
-	github.com/google/uuid v1.3.0
+	github.com/go-sql-driver/mysql v1.7.2-0.20231213112541-0004702b931d
+	github.com/google/uuid v1.6.0
 	github.com/stretchr/testify v1.8.4
 	golang.org/x/sync v0.7.0
 	gopkg.in/square/go-jose.v2 v2.5.1
@@ -15,7 +16,6 @@ require (
 	filippo.io/edwards25519 v1.1.0 // indirect
 	github.com/creasty/defaults v1.6.0 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
-	github.com/go-sql-driver/mysql v1.7.2-0.20231213112541-0004702b931d // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	golang.org/x/crypto v0.23.0 // indirect
 	golang.org/x/sys v0.20.0 // indirect
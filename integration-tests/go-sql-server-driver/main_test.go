@@ -64,6 +64,10 @@ func TestCluster(t *testing.T) {
 	RunTestsFile(t, "tests/sql-server-cluster.yaml")
 }
 
+func TestClusterAutoFailover(t *testing.T) {
+	RunTestsFile(t, "tests/sql-server-cluster-auto-failover.yaml")
+}
+
 func TestClusterUsersAndGrants(t *testing.T) {
 	RunTestsFile(t, "tests/sql-server-cluster-users-and-grants.yaml")
 }
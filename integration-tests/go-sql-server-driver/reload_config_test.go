@@ -0,0 +1,171 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	driver "github.com/dolthub/dolt/go/libraries/doltcore/dtestutils/sql_server_driver"
+)
+
+func TestReloadConfig(t *testing.T) {
+	ctx := context.Background()
+	u, err := driver.NewDoltUser()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		u.Cleanup()
+	})
+	rs, err := u.MakeRepoStore()
+	require.NoError(t, err)
+	repo, err := rs.MakeRepo("reload_config_test")
+	require.NoError(t, err)
+
+	gendir := os.Getenv("TESTGENDIR")
+	copyFile := func(src, dest string) {
+		contents, err := os.ReadFile(filepath.Join(gendir, src))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(repo.Dir, dest), contents, 0600))
+	}
+	writeConfig := func(config string) {
+		require.NoError(t, os.WriteFile(filepath.Join(repo.Dir, "server.yaml"), []byte(config), 0600))
+	}
+	copyFile("rsa_key.pem", "chain_key.pem")
+	copyFile("rsa_chain.pem", "chain_cert.pem")
+	writeConfig(`
+log_level: info
+listener:
+  max_connections: 10
+  tls_key: chain_key.pem
+  tls_cert: chain_cert.pem
+`)
+
+	// Record the certificate the server presents to each new connection
+	var mu sync.Mutex
+	var presented *x509.Certificate
+	require.NoError(t, mysql.RegisterTLSConfig("reload_config_test", &tls.Config{
+		InsecureSkipVerify: true,
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			mu.Lock()
+			defer mu.Unlock()
+			presented = cs.PeerCertificates[0]
+			return nil
+		},
+	}))
+	presentedKeyAlgorithm := func() x509.PublicKeyAlgorithm {
+		mu.Lock()
+		defer mu.Unlock()
+		return presented.PublicKeyAlgorithm
+	}
+
+	server := MakeServer(t, repo, &driver.Server{Args: []string{"--config", "server.yaml"}})
+	require.NotNil(t, server)
+	connection := driver.Connection{User: "root", DriverParams: map[string]string{"tls": "reload_config_test"}}
+	db, err := server.DB(connection)
+	require.NoError(t, err)
+	defer db.Close()
+
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+	defer conn.Close()
+	assert.Equal(t, x509.RSA, presentedKeyAlgorithm())
+
+	t.Run("dolt_reload_config", func(t *testing.T) {
+		copyFile("ed25519_key.pem", "chain_key.pem")
+		copyFile("ed25519_chain.pem", "chain_cert.pem")
+		writeConfig(`
+log_level: debug
+behavior:
+  read_only: true
+listener:
+  max_connections: 10
+  tls_key: chain_key.pem
+  tls_cert: chain_cert.pem
+user_session_vars:
+- name: root
+  vars:
+    aws_credentials_profile: reloaded
+`)
+		rows, err := conn.QueryContext(ctx, "call dolt_reload_config()")
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{
+			{"behavior.read_only", "requires restart"},
+			{"log_level", "applied"},
+			{"user_session_vars", "applied"},
+			{"listener.tls_cert", "applied"},
+		}, readRows(t, rows))
+
+		// The existing session is kept
+		rows, err = conn.QueryContext(ctx, "select @@aws_credentials_profile, @@global.dolt_log_level")
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{{"NULL", "debug"}}, readRows(t, rows))
+
+		// New sessions get the new certificate and session variables
+		newConn, err := db.Conn(ctx)
+		require.NoError(t, err)
+		defer newConn.Close()
+		assert.Equal(t, x509.Ed25519, presentedKeyAlgorithm())
+		rows, err = newConn.QueryContext(ctx, "select @@aws_credentials_profile")
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{{"reloaded"}}, readRows(t, rows))
+	})
+
+	t.Run("SIGHUP", func(t *testing.T) {
+		writeConfig(`
+log_level: debug
+behavior:
+  read_only: true
+listener:
+  max_connections: 20
+  tls_key: chain_key.pem
+  tls_cert: chain_cert.pem
+user_session_vars:
+- name: root
+  vars:
+    aws_credentials_profile: reloaded
+`)
+		require.NoError(t, server.Cmd.Process.Signal(syscall.SIGHUP))
+		require.Eventually(t, func() bool {
+			rows, err := conn.QueryContext(ctx, "select @@global.max_connections")
+			if err != nil {
+				return false
+			}
+			vals := readRows(t, rows)
+			return len(vals) == 1 && vals[0][0] == "20"
+		}, 5*time.Second, 50*time.Millisecond)
+	})
+
+	t.Run("invalid config is not applied", func(t *testing.T) {
+		writeConfig(`
+log_level: loud
+`)
+		_, err := conn.ExecContext(ctx, "call dolt_reload_config()")
+		require.Error(t, err)
+		rows, err := conn.QueryContext(ctx, "select @@global.dolt_log_level")
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{{"debug"}}, readRows(t, rows))
+	})
+}
@@ -0,0 +1,167 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	driver "github.com/dolthub/dolt/go/libraries/doltcore/dtestutils/sql_server_driver"
+)
+
+func TestResourceLimits(t *testing.T) {
+	ctx := context.Background()
+	u, err := driver.NewDoltUser()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		u.Cleanup()
+	})
+	rs, err := u.MakeRepoStore()
+	require.NoError(t, err)
+	repo, err := rs.MakeRepo("resource_limits_test")
+	require.NoError(t, err)
+	server := MakeServer(t, repo, &driver.Server{})
+	require.NotNil(t, server)
+
+	rootDb, err := server.DB(driver.Connection{User: "root"})
+	require.NoError(t, err)
+	defer rootDb.Close()
+	for _, q := range []string{
+		"create table resource_limits_test.t (pk int primary key)",
+		"insert into resource_limits_test.t values (1), (2), (3), (4), (5), (6), (7), (8)",
+		"create user analyst@'%' identified by 'analystpassword'",
+		"grant select on resource_limits_test.* to analyst@'%'",
+		"create role reporting",
+		"grant reporting to analyst@'%'",
+		"insert into resource_limits_test.dolt_resource_limits values ('analyst', '%', 2, null, 5), ('reporting', '%', 4, 500, null)",
+	} {
+		_, err = rootDb.ExecContext(ctx, q)
+		require.NoError(t, err, q)
+	}
+
+	rows, err := rootDb.QueryContext(ctx, "select user, host, max_connections, max_execution_time_millis, max_rows_returned from resource_limits_test.dolt_resource_limits")
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"analyst", "%", "2", "NULL", "5"},
+		{"reporting", "%", "4", "500", "NULL"},
+	}, readRows(t, rows))
+
+	analystDb, err := server.DB(driver.Connection{User: "analyst", Pass: "analystpassword"})
+	require.NoError(t, err)
+	defer analystDb.Close()
+
+	t.Run("max connections", func(t *testing.T) {
+		conn1, err := analystDb.Conn(ctx)
+		require.NoError(t, err)
+		conn2, err := analystDb.Conn(ctx)
+		require.NoError(t, err)
+		defer conn2.Close()
+
+		_, err = analystDb.Conn(ctx)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Error 1226")
+		assert.Contains(t, err.Error(), "max_user_connections")
+
+		// Closing a connection makes room for a new one.
+		require.NoError(t, conn1.Close())
+		require.Eventually(t, func() bool {
+			conn3, err := analystDb.Conn(ctx)
+			if err != nil {
+				return false
+			}
+			return conn3.Close() == nil
+		}, 5*time.Second, 50*time.Millisecond)
+	})
+
+	t.Run("max rows returned", func(t *testing.T) {
+		conn, err := analystDb.Conn(ctx)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		rows, err := conn.QueryContext(ctx, "select pk from resource_limits_test.t order by pk limit 5")
+		require.NoError(t, err)
+		assert.Len(t, readRows(t, rows), 5)
+
+		rows, err = conn.QueryContext(ctx, "select pk from resource_limits_test.t")
+		if err == nil {
+			for rows.Next() {
+			}
+			err = rows.Err()
+			rows.Close()
+		}
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Error 1226")
+		assert.Contains(t, err.Error(), "max_rows_returned")
+	})
+
+	t.Run("max execution time", func(t *testing.T) {
+		conn, err := analystDb.Conn(ctx)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		start := time.Now()
+		_, err = conn.ExecContext(ctx, "select sleep(5)")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Error 3024")
+		assert.Less(t, time.Since(start), 4*time.Second)
+
+		_, err = conn.ExecContext(ctx, "select sleep(0.1)")
+		require.NoError(t, err)
+	})
+
+	t.Run("only privileged users change limits", func(t *testing.T) {
+		conn, err := analystDb.Conn(ctx)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		_, err = conn.ExecContext(ctx, "delete from resource_limits_test.dolt_resource_limits")
+		require.Error(t, err)
+
+		rows, err := conn.QueryContext(ctx, "select user, max_connections from resource_limits_test.dolt_resource_limits")
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{{"analyst", "2"}}, readRows(t, rows))
+	})
+}
+
+func readRows(t *testing.T, rows *sql.Rows) [][]string {
+	defer rows.Close()
+	cols, err := rows.Columns()
+	require.NoError(t, err)
+	var ret [][]string
+	for rows.Next() {
+		vals := make([]sql.NullString, len(cols))
+		ptrs := make([]interface{}, len(cols))
+		for i := range vals {
+			ptrs[i] = &vals[i]
+		}
+		require.NoError(t, rows.Scan(ptrs...))
+		row := make([]string, len(cols))
+		for i, v := range vals {
+			if v.Valid {
+				row[i] = v.String
+			} else {
+				row[i] = "NULL"
+			}
+		}
+		ret = append(ret, row)
+	}
+	require.NoError(t, rows.Err())
+	return ret
+}
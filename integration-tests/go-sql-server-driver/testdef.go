@@ -176,9 +176,22 @@ func (test Test) Run(t *testing.T) {
 		}
 	}
 
+	stopped := make(map[string]bool)
+	t.Cleanup(func() {
+		// Servers are stopped, and their log_matches checked, in the
+		// cleanup registered by MakeServer, which runs after this one.
+		for name := range stopped {
+			assert.NoError(t, servers[name].Start(nil, nil))
+		}
+	})
 	for i, c := range test.Conns {
 		server := servers[c.On]
 		require.NotNilf(t, server, "error in test spec: could not find server %s for connection %d", c.On, i)
+		if stopped[c.On] {
+			err := server.Start(nil, nil)
+			require.NoError(t, err)
+			delete(stopped, c.On)
+		}
 		if c.RetryAttempts > 1 {
 			RetryTestRun(t, c.RetryAttempts, func(t require.TestingT) {
 				db, err := server.DB(c)
@@ -212,6 +225,11 @@ func (test Test) Run(t *testing.T) {
 			err := server.Restart(c.RestartServer.Args, c.RestartServer.Envs)
 			require.NoError(t, err)
 		}
+		if c.StopServer {
+			err := server.GracefulStop()
+			require.NoError(t, err)
+			stopped[c.On] = true
+		}
 	}
 }
 